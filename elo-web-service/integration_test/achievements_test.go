@@ -0,0 +1,220 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tolyandre/elo-web-service/pkg/db"
+	"github.com/tolyandre/elo-web-service/pkg/elo"
+)
+
+// TestAchievements_FollowHistoryEdits unlocks a first win with a new match,
+// then backdates an earlier win and deletes it again, checking that the
+// achievement moves with the history.
+func TestAchievements_FollowHistoryEdits(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	a := createTestPlayer(t, pool, "AchieveA")
+	b := createTestPlayer(t, pool, "AchieveB")
+	gameID := createTestGame(t, pool, "Achieve Chess")
+
+	svc := elo.NewMatchService(pool, elo.NewMarketService(pool))
+	players := elo.NewPlayerService(pool)
+	firstWin := func() *string {
+		t.Helper()
+		rows, err := players.ListAchievements(ctx, a)
+		if err != nil {
+			t.Fatalf("ListAchievements: %v", err)
+		}
+		for _, r := range rows {
+			if r.Kind == elo.AchievementFirstWin {
+				return r.MatchID
+			}
+		}
+		return nil
+	}
+
+	// Forward path: the current time, no replay.
+	latest, err := svc.AddMatch(ctx, gameID, map[string]float64{a: 10, b: 5}, time.Now(), elo.AddMatchOpts{ID: newID(t)})
+	if err != nil {
+		t.Fatalf("AddMatch: %v", err)
+	}
+	if got := firstWin(); got == nil || *got != latest.ID {
+		t.Fatalf("first win = %v, want the new match %s", got, latest.ID)
+	}
+
+	earlier, err := svc.AddMatch(ctx, gameID, map[string]float64{a: 10, b: 5}, time.Now().Add(-2*time.Hour), elo.AddMatchOpts{ID: newID(t), ClientDate: true})
+	if err != nil {
+		t.Fatalf("AddMatch backdated: %v", err)
+	}
+	if got := firstWin(); got == nil || *got != earlier.ID {
+		t.Errorf("first win after backdating = %v, want %s", got, earlier.ID)
+	}
+
+	if err := svc.DeleteMatch(ctx, earlier.ID); err != nil {
+		t.Fatalf("DeleteMatch: %v", err)
+	}
+	if got := firstWin(); got == nil || *got != latest.ID {
+		t.Errorf("first win after deleting = %v, want %s again", got, latest.ID)
+	}
+	rows, err := players.ListAchievements(ctx, b)
+	if err != nil {
+		t.Fatalf("ListAchievements: %v", err)
+	}
+	if len(rows) != 0 {
+		t.Errorf("b achievements = %+v, want none", rows)
+	}
+}
+
+// TestAchievements_TeamsAndCooperative checks that a winning team unlocks a
+// first win for every member and a cooperative match for no one.
+func TestAchievements_TeamsAndCooperative(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	a := createTestPlayer(t, pool, "TeamAchieveA")
+	b := createTestPlayer(t, pool, "TeamAchieveB")
+	c := createTestPlayer(t, pool, "TeamAchieveC")
+	gameID := createTestGame(t, pool, "Achieve Codenames")
+
+	svc := elo.NewMatchService(pool, elo.NewMarketService(pool))
+	players := elo.NewPlayerService(pool)
+	now := time.Now().Truncate(time.Second)
+	if _, err := svc.AddMatch(ctx, gameID, map[string]float64{a: 1, b: 9}, now.Add(-2*time.Hour),
+		elo.AddMatchOpts{ID: newID(t), ClientDate: true, Cooperative: &elo.Cooperative{Won: true}}); err != nil {
+		t.Fatalf("AddMatch coop: %v", err)
+	}
+	team, err := svc.AddMatch(ctx, gameID, map[string]float64{a: 5, b: 5, c: 2}, now.Add(-time.Hour),
+		elo.AddMatchOpts{ID: newID(t), ClientDate: true, Teams: map[string]string{a: "red", b: "red", c: "blue"}})
+	if err != nil {
+		t.Fatalf("AddMatch team: %v", err)
+	}
+
+	for _, p := range []string{a, b} {
+		rows, err := players.ListAchievements(ctx, p)
+		if err != nil {
+			t.Fatalf("ListAchievements: %v", err)
+		}
+		var firstWin *string
+		for _, r := range rows {
+			if r.Kind == elo.AchievementFirstWin {
+				firstWin = r.MatchID
+			}
+		}
+		if firstWin == nil || *firstWin != team.ID {
+			t.Errorf("first win of %s = %v, want the team match %s", p, firstWin, team.ID)
+		}
+	}
+}
+
+// TestAchievements_MarketWin resolves a market with a match and checks only
+// the bettor who gained unlocks a market win.
+func TestAchievements_MarketWin(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	a := createTestPlayer(t, pool, "MarketAchieveA")
+	b := createTestPlayer(t, pool, "MarketAchieveB")
+	guarantor := createTestPlayer(t, pool, "MarketAchieveGuarantor")
+	gameID := createTestGame(t, pool, "Achieve Poker")
+	adminID := createTestAdmin(t, pool)
+
+	marketSvc := elo.NewMarketService(pool)
+	svc := elo.NewMatchService(pool, marketSvc)
+	players := elo.NewPlayerService(pool)
+	market, err := marketSvc.CreateMarket(ctx, elo.CreateMarketParams{
+		ID:                 newID(t),
+		MarketType:         "match_winner",
+		StartsAt:           time.Now().Add(-time.Minute),
+		ClosesAt:           time.Now().Add(24 * time.Hour),
+		CreatedBy:          adminID,
+		GuarantorPlayerIDs: []string{guarantor},
+		MatchWinner: &elo.MatchWinnerCreateParams{
+			TargetPlayerIDs:   []string{a, b},
+			AllowOtherPlayers: true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateMarket: %v", err)
+	}
+	if _, err := svc.AddMatch(ctx, gameID, map[string]float64{a: 5, b: 5}, time.Now().Add(-2*time.Hour), newMatchOpts(t)); err != nil {
+		t.Fatalf("warm-up AddMatch: %v", err)
+	}
+	if err := placeBetAtCurrentPrice(ctx, t, marketSvc, market.ID, a, marketOutcomeID(t, ctx, marketSvc, market.ID, "player", a), 1); err != nil {
+		t.Fatalf("PlaceBet a: %v", err)
+	}
+	if err := placeBetAtCurrentPrice(ctx, t, marketSvc, market.ID, b, marketOutcomeID(t, ctx, marketSvc, market.ID, "other", ""), 1); err != nil {
+		t.Fatalf("PlaceBet b: %v", err)
+	}
+	resolving, err := svc.AddMatch(ctx, gameID, map[string]float64{a: 10, b: 2}, time.Now(), newMatchOpts(t))
+	if err != nil {
+		t.Fatalf("AddMatch: %v", err)
+	}
+
+	marketWin := func(playerID string) *db.PlayerAchievement {
+		t.Helper()
+		rows, err := players.ListAchievements(ctx, playerID)
+		if err != nil {
+			t.Fatalf("ListAchievements: %v", err)
+		}
+		for _, r := range rows {
+			if r.Kind == elo.AchievementMarketWin {
+				return &r
+			}
+		}
+		return nil
+	}
+	if got := marketWin(a); got == nil || got.MarketID == nil || *got.MarketID != market.ID ||
+		got.MatchID == nil || *got.MatchID != resolving.ID {
+		t.Errorf("a market win = %+v, want market %s resolved by %s", got, market.ID, resolving.ID)
+	}
+	if got := marketWin(b); got != nil {
+		t.Errorf("b lost the market but unlocked %+v", got)
+	}
+}
+
+// TestAchievements_BackfillFillsEmptyTable clears the achievements of an
+// existing history, as migration 057 leaves them, and checks that the startup
+// backfill restores them from the whole history.
+func TestAchievements_BackfillFillsEmptyTable(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	a := createTestPlayer(t, pool, "BackfillA")
+	b := createTestPlayer(t, pool, "BackfillB")
+	gameID := createTestGame(t, pool, "Backfill Chess")
+
+	svc := elo.NewMatchService(pool, elo.NewMarketService(pool))
+	m, err := svc.AddMatch(ctx, gameID, map[string]float64{a: 10, b: 5}, time.Now().Add(-time.Hour), elo.AddMatchOpts{ID: newID(t), ClientDate: true})
+	if err != nil {
+		t.Fatalf("AddMatch: %v", err)
+	}
+	if _, err := pool.Exec(ctx, `DELETE FROM player_achievements`); err != nil {
+		t.Fatalf("clear achievements: %v", err)
+	}
+
+	if err := svc.BackfillAchievements(ctx); err != nil {
+		t.Fatalf("BackfillAchievements: %v", err)
+	}
+	rows, err := elo.NewPlayerService(pool).ListAchievements(ctx, a)
+	if err != nil {
+		t.Fatalf("ListAchievements: %v", err)
+	}
+	found := false
+	for _, r := range rows {
+		if r.Kind == elo.AchievementFirstWin {
+			found = r.MatchID != nil && *r.MatchID == m.ID
+		}
+	}
+	if !found {
+		t.Errorf("achievements after backfill = %+v, want a first win in %s", rows, m.ID)
+	}
+}
@@ -0,0 +1,148 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/tolyandre/elo-web-service/pkg/elo"
+)
+
+// TestAuditLog_MatchLifecycle verifies that creating, editing and deleting a
+// match leaves one entry each, attributed to the acting user, with the match
+// before and after the change.
+func TestAuditLog_MatchLifecycle(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	_, userID := createTestUserWithID(t, pool, true)
+	ctx := elo.WithActor(context.Background(), userID)
+	playerA := createTestPlayer(t, pool, "AuditA")
+	playerB := createTestPlayer(t, pool, "AuditB")
+	gameID := createTestGame(t, pool, "Carcassonne")
+
+	svc := elo.NewMatchService(pool, elo.NewMarketService(pool))
+	audit := elo.NewAuditService(pool)
+	date := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	opts := elo.AddMatchOpts{ClientDate: true, ID: newID(t)}
+	m, err := svc.AddMatch(ctx, gameID, map[string]float64{playerA: 10, playerB: 5}, date, opts)
+	if err != nil {
+		t.Fatalf("AddMatch: %v", err)
+	}
+	// An offline-sync retry returns the stored match and must not log twice.
+	if _, err := svc.AddMatch(ctx, gameID, map[string]float64{playerA: 10, playerB: 5}, date, opts); err != nil {
+		t.Fatalf("AddMatch retry: %v", err)
+	}
+	if _, err := svc.UpdateMatch(ctx, m.ID, gameID, map[string]float64{playerA: 3, playerB: 8}, date, elo.UpdateMatchOpts{}); err != nil {
+		t.Fatalf("UpdateMatch: %v", err)
+	}
+	if err := svc.DeleteMatch(ctx, m.ID); err != nil {
+		t.Fatalf("DeleteMatch: %v", err)
+	}
+
+	entries, err := audit.ListAuditEntries(ctx, elo.AuditFilter{EntityType: elo.AuditEntityMatch, EntityID: m.ID, Limit: 10})
+	if err != nil {
+		t.Fatalf("ListAuditEntries: %v", err)
+	}
+	wantActions := []string{elo.AuditActionDelete, elo.AuditActionUpdate, elo.AuditActionCreate}
+	if len(entries) != len(wantActions) {
+		t.Fatalf("expected %d entries, got %d", len(wantActions), len(entries))
+	}
+	for i, e := range entries {
+		if e.Action != wantActions[i] {
+			t.Errorf("entry %d: action %q, want %q", i, e.Action, wantActions[i])
+		}
+		if e.UserID == nil || *e.UserID != userID {
+			t.Errorf("entry %d: user %v, want %s", i, e.UserID, userID)
+		}
+	}
+
+	create, update, del := entries[2], entries[1], entries[0]
+	if create.Before != nil || create.After == nil {
+		t.Errorf("create entry: before=%s after=%s, want only after", create.Before, create.After)
+	}
+	if del.Before == nil || del.After != nil {
+		t.Errorf("delete entry: before=%s after=%s, want only before", del.Before, del.After)
+	}
+	if got := auditScore(t, update.Before, playerA); got != 10 {
+		t.Errorf("update before: player A score %v, want 10", got)
+	}
+	if got := auditScore(t, update.After, playerA); got != 3 {
+		t.Errorf("update after: player A score %v, want 3", got)
+	}
+
+	count, err := audit.CountEntityEntries(ctx, elo.AuditEntityMatch, m.ID)
+	if err != nil {
+		t.Fatalf("CountEntityEntries: %v", err)
+	}
+	if count != 3 {
+		t.Errorf("CountEntityEntries = %d, want 3", count)
+	}
+
+	byUser, err := audit.ListAuditEntries(ctx, elo.AuditFilter{UserID: userID, Limit: 2})
+	if err != nil {
+		t.Fatalf("ListAuditEntries by user: %v", err)
+	}
+	if len(byUser) != 2 || byUser[0].ID != del.ID {
+		t.Fatalf("first page by user: got %d entries", len(byUser))
+	}
+	rest, err := audit.ListAuditEntries(ctx, elo.AuditFilter{UserID: userID, CursorID: byUser[1].ID, Limit: 2})
+	if err != nil {
+		t.Fatalf("ListAuditEntries second page: %v", err)
+	}
+	if len(rest) != 1 || rest[0].ID != create.ID {
+		t.Errorf("second page by user: got %d entries, want the create entry", len(rest))
+	}
+}
+
+// TestAuditLog_AppendOnly verifies that the database refuses to rewrite or
+// delete audit entries.
+func TestAuditLog_AppendOnly(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	clubs := elo.NewClubService(pool)
+	if _, err := clubs.CreateClub(ctx, newID(t), "Audit club"); err != nil {
+		t.Fatalf("CreateClub: %v", err)
+	}
+
+	if _, err := pool.Exec(ctx, `UPDATE audit_log SET action = 'update'`); err == nil {
+		t.Error("UPDATE audit_log succeeded, want an error")
+	}
+	if _, err := pool.Exec(ctx, `DELETE FROM audit_log`); err == nil {
+		t.Error("DELETE FROM audit_log succeeded, want an error")
+	}
+
+	var n int
+	if err := pool.QueryRow(ctx, `SELECT COUNT(*) FROM audit_log WHERE entity_type = 'club' AND user_id IS NULL`).Scan(&n); err != nil {
+		t.Fatalf("count club entries: %v", err)
+	}
+	if n != 1 {
+		t.Errorf("club entries without actor = %d, want 1", n)
+	}
+}
+
+func auditScore(t *testing.T, payload []byte, playerID string) float64 {
+	t.Helper()
+	var state struct {
+		Players []struct {
+			PlayerID string  `json:"player_id"`
+			Score    float64 `json:"score"`
+		} `json:"players"`
+	}
+	if err := json.Unmarshal(payload, &state); err != nil {
+		t.Fatalf("decode audit payload: %v", err)
+	}
+	for _, p := range state.Players {
+		if p.PlayerID == playerID {
+			return p.Score
+		}
+	}
+	t.Fatalf("player %s not in audit payload", playerID)
+	return 0
+}
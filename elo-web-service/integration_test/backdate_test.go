@@ -0,0 +1,106 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tolyandre/elo-web-service/pkg/elo"
+)
+
+// TestAddMatchWithOverride_ImportsOldMatch verifies that an admin can add a
+// match two months back, that the replay reports the players whose standing
+// changed, and that the reason lands in the audit log.
+func TestAddMatchWithOverride_ImportsOldMatch(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	_, adminID := createTestUserWithID(t, pool, true)
+	ctx := elo.WithActor(context.Background(), adminID)
+	playerA := createTestPlayer(t, pool, "CampA")
+	playerB := createTestPlayer(t, pool, "CampB")
+	bystander := createTestPlayer(t, pool, "Bystander")
+	gameID := createTestGame(t, pool, "Terraforming Mars")
+
+	svc := elo.NewMatchService(pool, elo.NewMarketService(pool))
+	now := time.Now().Truncate(time.Second)
+	if _, err := svc.AddMatch(ctx, gameID, map[string]float64{playerA: 10, playerB: 5}, now.Add(-24*time.Hour), elo.AddMatchOpts{ClientDate: true, ID: newID(t)}); err != nil {
+		t.Fatalf("AddMatch: %v", err)
+	}
+
+	campDate := now.AddDate(0, -2, 0)
+	scores := map[string]float64{playerA: 1, playerB: 20}
+	if _, err := svc.AddMatch(ctx, gameID, scores, campDate, elo.AddMatchOpts{ClientDate: true, ID: newID(t)}); !errors.Is(err, elo.ErrMatchDateOutOfRange) {
+		t.Fatalf("AddMatch two months back: got %v, want ErrMatchDateOutOfRange", err)
+	}
+	if _, _, err := svc.AddMatchWithOverride(ctx, gameID, scores, campDate, " ", elo.AddMatchOpts{}); !errors.Is(err, elo.ErrOverrideReasonRequired) {
+		t.Fatalf("override without reason: got %v, want ErrOverrideReasonRequired", err)
+	}
+
+	match, change, err := svc.AddMatchWithOverride(ctx, gameID, scores, campDate, "camp weekend", elo.AddMatchOpts{})
+	if err != nil {
+		t.Fatalf("AddMatchWithOverride: %v", err)
+	}
+
+	changed := map[string]elo.PlayerStandingChange{}
+	for _, p := range change.Players {
+		changed[p.PlayerID] = p
+	}
+	for _, id := range []string{playerA, playerB} {
+		p, ok := changed[id]
+		if !ok {
+			t.Fatalf("player %s missing from the change report: %+v", id, change.Players)
+		}
+		if p.RatingBefore == p.RatingAfter {
+			t.Errorf("player %s: rating unchanged at %.2f", id, p.RatingAfter)
+		}
+	}
+	if p, ok := changed[bystander]; ok && p.RatingBefore != p.RatingAfter {
+		t.Errorf("bystander rating changed: %+v", p)
+	}
+
+	var reason *string
+	if err := pool.QueryRow(context.Background(),
+		`SELECT reason FROM audit_log WHERE entity_type = 'match' AND entity_id = $1 AND action = 'create'`, match.ID,
+	).Scan(&reason); err != nil {
+		t.Fatalf("read audit entry: %v", err)
+	}
+	if reason == nil || *reason != "camp weekend" {
+		t.Errorf("audit reason = %v, want %q", reason, "camp weekend")
+	}
+}
+
+// TestUpdateMatchWithOverride_MovesDateFar verifies that the admin variant of
+// UpdateMatch moves a match past the three-day limit.
+func TestUpdateMatchWithOverride_MovesDateFar(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	playerA := createTestPlayer(t, pool, "MoveA")
+	playerB := createTestPlayer(t, pool, "MoveB")
+	gameID := createTestGame(t, pool, "Everdell")
+
+	svc := elo.NewMatchService(pool, elo.NewMarketService(pool))
+	now := time.Now().Truncate(time.Second)
+	scores := map[string]float64{playerA: 10, playerB: 5}
+	m, err := svc.AddMatch(ctx, gameID, scores, now.Add(-time.Hour), elo.AddMatchOpts{ClientDate: true, ID: newID(t)})
+	if err != nil {
+		t.Fatalf("AddMatch: %v", err)
+	}
+
+	target := now.AddDate(0, 0, -20)
+	if _, err := svc.UpdateMatch(ctx, m.ID, gameID, scores, target, elo.UpdateMatchOpts{}); !errors.Is(err, elo.ErrDateChangeTooLarge) {
+		t.Fatalf("UpdateMatch by 20 days: got %v, want ErrDateChangeTooLarge", err)
+	}
+	updated, _, err := svc.UpdateMatchWithOverride(ctx, m.ID, gameID, scores, target, "wrong day entered", elo.UpdateMatchOpts{})
+	if err != nil {
+		t.Fatalf("UpdateMatchWithOverride: %v", err)
+	}
+	if !updated.Date.Time.Equal(target) {
+		t.Errorf("match date = %v, want %v", updated.Date.Time, target)
+	}
+}
@@ -0,0 +1,149 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tolyandre/elo-web-service/pkg/elo"
+)
+
+// TestSettlementCheckpoints_ReplayFromCheckpoint recalculates a window that
+// starts after a checkpoint and checks the result against a replay from
+// scratch, then corrupts the checkpoint and expects verification to notice.
+func TestSettlementCheckpoints_ReplayFromCheckpoint(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	playerA := createTestPlayer(t, pool, "CheckpointA")
+	playerB := createTestPlayer(t, pool, "CheckpointB")
+	playerC := createTestPlayer(t, pool, "CheckpointC")
+	chess := createTestGame(t, pool, "Checkpoint Chess")
+
+	svc := elo.NewMatchService(pool, elo.NewMarketService(pool))
+
+	now := time.Now().Truncate(time.Second)
+	day := 24 * time.Hour
+	add := func(scores map[string]float64, date time.Time) {
+		t.Helper()
+		opts := elo.AddMatchOpts{ID: newID(t), ClientDate: true}
+		if _, err := svc.AddMatch(ctx, chess, scores, date, opts); err != nil {
+			t.Fatalf("AddMatch: %v", err)
+		}
+	}
+	add(map[string]float64{playerA: 10, playerB: 5}, now.Add(-10*day))
+	add(map[string]float64{playerB: 7, playerC: 3}, now.Add(-8*day))
+	add(map[string]float64{playerA: 2, playerC: 9}, now.Add(-4*day))
+
+	cp, err := svc.CreateSettlementCheckpoint(ctx, now.Add(-6*day))
+	if err != nil {
+		t.Fatalf("CreateSettlementCheckpoint: %v", err)
+	}
+
+	// A backdated match after the checkpoint replays the window from it.
+	add(map[string]float64{playerA: 6, playerB: 1, playerC: 4}, now.Add(-5*day))
+	fromCheckpoint := replaySnapshot(t, pool)
+
+	var kept int
+	if err := pool.QueryRow(ctx, `SELECT COUNT(*) FROM settlement_checkpoints WHERE id = $1`, cp.ID).Scan(&kept); err != nil {
+		t.Fatalf("count checkpoints: %v", err)
+	}
+	if kept != 1 {
+		t.Fatal("a checkpoint before the recalculation start must be kept")
+	}
+
+	if err := svc.(*elo.MatchService).RecalculateByEvent(ctx, time.Time{}); err != nil {
+		t.Fatalf("RecalculateByEvent: %v", err)
+	}
+	fromScratch := replaySnapshot(t, pool)
+	if len(fromCheckpoint) != len(fromScratch) {
+		t.Fatalf("replay from checkpoint produced %d rows, from scratch %d", len(fromCheckpoint), len(fromScratch))
+	}
+	for i := range fromScratch {
+		if fromCheckpoint[i] != fromScratch[i] {
+			t.Errorf("row %d differs:\n checkpoint %s\n scratch    %s", i, fromCheckpoint[i], fromScratch[i])
+		}
+	}
+
+	// RecalculateByEvent from the beginning dropped the checkpoint.
+	cp, err = svc.CreateSettlementCheckpoint(ctx, now.Add(-6*day))
+	if err != nil {
+		t.Fatalf("CreateSettlementCheckpoint: %v", err)
+	}
+	result, err := svc.VerifySettlementCheckpoints(ctx)
+	if err != nil {
+		t.Fatalf("VerifySettlementCheckpoints: %v", err)
+	}
+	if result.Checkpoints != 1 || len(result.Mismatches) != 0 {
+		t.Fatalf("intact checkpoint: got %d checkpoints, mismatches %+v", result.Checkpoints, result.Mismatches)
+	}
+
+	if _, err := pool.Exec(ctx, `UPDATE checkpoint_global_state SET rating_after = rating_after + 1
+		WHERE checkpoint_id = $1 AND player_id = $2`, cp.ID, playerA); err != nil {
+		t.Fatalf("corrupt checkpoint: %v", err)
+	}
+	result, err = svc.VerifySettlementCheckpoints(ctx)
+	if err != nil {
+		t.Fatalf("VerifySettlementCheckpoints: %v", err)
+	}
+	if len(result.Mismatches) != 1 {
+		t.Fatalf("want one mismatch, got %+v", result.Mismatches)
+	}
+	if m := result.Mismatches[0]; m.Scope != "global" || m.PlayerID != playerA || m.Field != "rating" {
+		t.Errorf("unexpected mismatch %+v", m)
+	}
+
+	var left int
+	if err := pool.QueryRow(ctx, `SELECT COUNT(*) FROM settlement_checkpoints`).Scan(&left); err != nil {
+		t.Fatalf("count checkpoints: %v", err)
+	}
+	if left != 1 {
+		t.Errorf("verification must roll back; %d checkpoints left", left)
+	}
+}
+
+// TestSettlementCheckpoints_CatchUpTimedSettlements leaves an inactivity
+// decay drop unwritten, as after downtime, and checks that taking a later
+// checkpoint writes it first, so the checkpoint includes it.
+func TestSettlementCheckpoints_CatchUpTimedSettlements(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	if _, err := pool.Exec(ctx, `UPDATE elo_settings SET inactivity_decay_days = 2, inactivity_decay_rate = 0.5`); err != nil {
+		t.Fatalf("enable decay: %v", err)
+	}
+	playerA := createTestPlayer(t, pool, "CatchUpA")
+	playerB := createTestPlayer(t, pool, "CatchUpB")
+	chess := createTestGame(t, pool, "Catch Up Chess")
+
+	svc := elo.NewMatchService(pool, elo.NewMarketService(pool))
+	now := time.Now().Truncate(time.Second)
+	day := 24 * time.Hour
+	if _, err := svc.AddMatch(ctx, chess, map[string]float64{playerA: 10, playerB: 5}, now.Add(-10*day), elo.AddMatchOpts{ID: newID(t), ClientDate: true}); err != nil {
+		t.Fatalf("AddMatch: %v", err)
+	}
+
+	if _, err := svc.CreateSettlementCheckpoint(ctx, now.Add(-6*day)); err != nil {
+		t.Fatalf("CreateSettlementCheckpoint: %v", err)
+	}
+	var drops int
+	if err := pool.QueryRow(ctx, `SELECT COUNT(*) FROM global_arena_settlement
+		WHERE discriminator = 'decay' AND date < $1`, now.Add(-6*day)).Scan(&drops); err != nil {
+		t.Fatalf("count decay drops: %v", err)
+	}
+	if drops == 0 {
+		t.Fatal("the overdue decay drop must be written before the checkpoint")
+	}
+
+	result, err := svc.VerifySettlementCheckpoints(ctx)
+	if err != nil {
+		t.Fatalf("VerifySettlementCheckpoints: %v", err)
+	}
+	if result.Checkpoints != 1 || len(result.Mismatches) != 0 {
+		t.Errorf("got %d checkpoints, mismatches %+v", result.Checkpoints, result.Mismatches)
+	}
+}
@@ -0,0 +1,74 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tolyandre/elo-web-service/pkg/elo"
+)
+
+// TestClubArena_MembershipChangesRebuild checks that an arena filtered by a
+// club settles a member's earlier matches once they join, drops them once they
+// leave, and keeps the club from being deleted.
+func TestClubArena_MembershipChangesRebuild(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	playerA := createTestPlayer(t, pool, "ClubArenaA")
+	playerB := createTestPlayer(t, pool, "ClubArenaB")
+	gameID := createTestGame(t, pool, "Азул")
+
+	clubs := elo.NewClubService(pool)
+	club, err := clubs.CreateClub(ctx, newID(t), "Arena Club")
+	if err != nil {
+		t.Fatalf("CreateClub: %v", err)
+	}
+	if err := clubs.AddMember(ctx, club.ID, playerA); err != nil {
+		t.Fatalf("AddMember A: %v", err)
+	}
+
+	svc := elo.NewMatchService(pool, elo.NewMarketService(pool))
+	if _, err := svc.AddMatch(ctx, gameID, map[string]float64{playerA: 10, playerB: 5},
+		time.Now().Add(-time.Hour), elo.AddMatchOpts{ID: newID(t), ClientDate: true}); err != nil {
+		t.Fatalf("AddMatch: %v", err)
+	}
+	arena, err := elo.NewArenaService(pool).CreateArena(ctx, newID(t), "Club Arena", elo.ArenaFilters{ClubID: &club.ID}, nil)
+	if err != nil {
+		t.Fatalf("CreateArena: %v", err)
+	}
+
+	settled := func() int {
+		t.Helper()
+		var n int
+		if err := pool.QueryRow(ctx, `SELECT count(*) FROM arena_settlement WHERE arena_id = $1`, arena.ID).Scan(&n); err != nil {
+			t.Fatalf("count arena settlements: %v", err)
+		}
+		return n
+	}
+	if n := settled(); n != 0 {
+		t.Fatalf("settlements with one member = %d, want 0", n)
+	}
+
+	if err := clubs.AddMember(ctx, club.ID, playerB); err != nil {
+		t.Fatalf("AddMember B: %v", err)
+	}
+	if n := settled(); n != 2 {
+		t.Errorf("settlements after B joined = %d, want 2", n)
+	}
+
+	if err := clubs.RemoveMember(ctx, club.ID, playerB); err != nil {
+		t.Fatalf("RemoveMember B: %v", err)
+	}
+	if n := settled(); n != 0 {
+		t.Errorf("settlements after B left = %d, want 0", n)
+	}
+
+	if _, err := clubs.DeleteClub(ctx, club.ID); !errors.Is(err, elo.ErrClubUsedByArena) {
+		t.Errorf("DeleteClub err = %v, want ErrClubUsedByArena", err)
+	}
+}
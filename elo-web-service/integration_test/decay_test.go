@@ -0,0 +1,88 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tolyandre/elo-web-service/pkg/db"
+	"github.com/tolyandre/elo-web-service/pkg/elo"
+)
+
+// TestTimedSettlements_ConcurrentWritersWriteOnce leaves a decay drop and a
+// season reset due, then lets several corrections catch them up at once and
+// checks each row was written a single time.
+func TestTimedSettlements_ConcurrentWritersWriteOnce(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	if _, err := pool.Exec(ctx, `UPDATE elo_settings SET inactivity_decay_days = 2, inactivity_decay_rate = 0.5`); err != nil {
+		t.Fatalf("enable decay: %v", err)
+	}
+	a := createTestPlayer(t, pool, "RaceA")
+	b := createTestPlayer(t, pool, "RaceB")
+	chess := createTestGame(t, pool, "Race Chess")
+
+	marketSvc := elo.NewMarketService(pool)
+	svc := elo.NewMatchService(pool, marketSvc)
+	now := time.Now().Truncate(time.Second)
+	if _, err := svc.AddMatch(ctx, chess, map[string]float64{a: 10, b: 5}, now.Add(-10*24*time.Hour), elo.AddMatchOpts{ID: newID(t), ClientDate: true}); err != nil {
+		t.Fatalf("AddMatch: %v", err)
+	}
+	// The reset falls due just after the season is created.
+	startsAt := time.Now().Add(time.Second)
+	if _, err := elo.NewSeasonService(pool, marketSvc).CreateSeason(ctx, db.CreateSeasonParams{
+		ID:          newID(t),
+		Name:        "Гонка",
+		StartsAt:    startsAt,
+		EndsAt:      startsAt.Add(24 * time.Hour),
+		ResetFactor: pgtype.Float8{Float64: 0.5, Valid: true},
+	}); err != nil {
+		t.Fatalf("CreateSeason: %v", err)
+	}
+	time.Sleep(time.Until(startsAt) + 500*time.Millisecond)
+
+	corrections := elo.NewCorrectionService(pool)
+	var wg sync.WaitGroup
+	errs := make(chan error, 4)
+	for i := 0; i < 4; i++ {
+		player := createTestPlayer(t, pool, fmt.Sprintf("RaceWriter%d", i))
+		id := newID(t)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- corrections.CreateGlobalArenaRatingCorrection(ctx, id, player, 1)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("CreateGlobalArenaRatingCorrection: %v", err)
+		}
+	}
+
+	for _, c := range []struct {
+		discriminator string
+		playerID      string
+	}{
+		{"decay", a}, // b lost and has nothing above the starting rating to drop
+		{"season_reset", a},
+		{"season_reset", b},
+	} {
+		var n int
+		if err := pool.QueryRow(ctx, `SELECT COUNT(*) FROM global_arena_settlement
+			WHERE discriminator = $1 AND player_id = $2 AND match_id IS NULL`, c.discriminator, c.playerID).Scan(&n); err != nil {
+			t.Fatalf("count %s rows: %v", c.discriminator, err)
+		}
+		if n != 1 {
+			t.Errorf("%s rows of %s = %d, want 1", c.discriminator, c.playerID, n)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tolyandre/elo-web-service/pkg/db"
+	"github.com/tolyandre/elo-web-service/pkg/elo"
+)
+
+// TestMergeGames_RebuildsGameArena merges a duplicate game that has a match,
+// a calculator fallback, a market filter and an arena filter into the kept
+// game and checks that the kept game's arena holds both matches exactly as a
+// full replay settles them.
+func TestMergeGames_RebuildsGameArena(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	playerA := createTestPlayer(t, pool, "GameMergeA")
+	playerB := createTestPlayer(t, pool, "GameMergeB")
+	kept := createTestGame(t, pool, "Каркассон")
+	duplicate := createTestGame(t, pool, "Carcassonne")
+	adminID := createTestAdmin(t, pool)
+
+	marketSvc := elo.NewMarketService(pool)
+	svc := elo.NewMatchService(pool, marketSvc)
+	now := time.Now().Truncate(time.Second)
+	add := func(gameID string, scores map[string]float64, date time.Time) string {
+		t.Helper()
+		m, err := svc.AddMatch(ctx, gameID, scores, date, elo.AddMatchOpts{ID: newID(t), ClientDate: true})
+		if err != nil {
+			t.Fatalf("AddMatch: %v", err)
+		}
+		return m.ID
+	}
+	add(kept, map[string]float64{playerA: 10, playerB: 5}, now.Add(-72*time.Hour))
+	dupMatch := add(duplicate, map[string]float64{playerA: 3, playerB: 8}, now.Add(-48*time.Hour))
+	if _, err := pool.Exec(ctx,
+		`UPDATE matches SET calculator_kind = 'skull_king', calculator_schema_version = 1,
+		        calculator_data = jsonb_build_object('fallback_game_id', $2::text)
+		 WHERE id = $1`, dupMatch, duplicate); err != nil {
+		t.Fatalf("set calculator data: %v", err)
+	}
+
+	market, err := marketSvc.CreateMarket(ctx, elo.CreateMarketParams{
+		ID:                 newID(t),
+		MarketType:         "match_winner",
+		StartsAt:           now.Add(-time.Minute),
+		ClosesAt:           now.Add(24 * time.Hour),
+		CreatedBy:          adminID,
+		GuarantorPlayerIDs: []string{playerB},
+		MatchWinner: &elo.MatchWinnerCreateParams{
+			TargetPlayerIDs:   []string{playerA},
+			AllowOtherPlayers: true,
+			GameIDs:           []string{duplicate},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateMarket: %v", err)
+	}
+	arena, err := elo.NewArenaService(pool).CreateArena(ctx, newID(t), "Merge Arena", elo.ArenaFilters{GameIDs: []string{duplicate}}, nil)
+	if err != nil {
+		t.Fatalf("CreateArena: %v", err)
+	}
+
+	merged, err := svc.MergeGames(ctx, kept, duplicate)
+	if err != nil {
+		t.Fatalf("MergeGames: %v", err)
+	}
+	if merged.ID != kept {
+		t.Errorf("merged game = %s, want %s", merged.ID, kept)
+	}
+
+	q := db.New(pool)
+	if _, err := q.GetGameByID(ctx, duplicate); !db.IsNoRows(err) {
+		t.Errorf("duplicate still exists (err = %v)", err)
+	}
+	var gameID, fallback string
+	if err := pool.QueryRow(ctx, `SELECT game_id, calculator_data->>'fallback_game_id' FROM matches WHERE id = $1`, dupMatch).Scan(&gameID, &fallback); err != nil {
+		t.Fatalf("read match: %v", err)
+	}
+	if gameID != kept || fallback != kept {
+		t.Errorf("match game = %s, fallback = %s, want both %s", gameID, fallback, kept)
+	}
+	var count int
+	if err := pool.QueryRow(ctx, `SELECT COUNT(*) FROM game_arena_settlement WHERE game_id = $1`, kept).Scan(&count); err != nil || count != 4 {
+		t.Errorf("kept game arena settlements = %d (err %v), want 4", count, err)
+	}
+	var marketGames []string
+	if err := pool.QueryRow(ctx, `SELECT game_ids FROM market_match_winner_params WHERE market_id = $1`, market.ID).Scan(&marketGames); err != nil ||
+		len(marketGames) != 1 || marketGames[0] != kept {
+		t.Errorf("market game filter = %v (err %v), want [%s]", marketGames, err, kept)
+	}
+	if err := pool.QueryRow(ctx, `SELECT COUNT(*) FROM arena_settlement WHERE arena_id = $1`, arena.ID).Scan(&count); err != nil || count != 4 {
+		t.Errorf("arena settlements = %d (err %v), want 4", count, err)
+	}
+	if err := pool.QueryRow(ctx, `SELECT COUNT(*) FROM audit_log WHERE entity_type = 'game' AND entity_id = $1 AND action = 'merge'`, kept).Scan(&count); err != nil || count != 1 {
+		t.Errorf("merge audit entries = %d (err %v), want 1", count, err)
+	}
+
+	want := replaySnapshot(t, pool)
+	if err := svc.RecalculateAllGameElo(ctx); err != nil {
+		t.Fatalf("RecalculateAllGameElo: %v", err)
+	}
+	got := replaySnapshot(t, pool)
+	if len(got) != len(want) {
+		t.Fatalf("full replay produced %d rows, merge left %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("row %d differs after full replay:\n got  %s\n want %s", i, got[i], want[i])
+		}
+	}
+
+	if _, err := svc.MergeGames(ctx, kept, kept); !errors.Is(err, elo.ErrGameMergeSelf) {
+		t.Errorf("MergeGames with itself error = %v, want ErrGameMergeSelf", err)
+	}
+}
+
+// TestMergeGames_ScoringConflict verifies that points of a score game are not
+// merged into a placement game, where they would be read as places, and that
+// nothing is moved.
+func TestMergeGames_ScoringConflict(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	playerA := createTestPlayer(t, pool, "ScoringA")
+	playerB := createTestPlayer(t, pool, "ScoringB")
+	kept := createTestGame(t, pool, "Scoring Places")
+	duplicate := createTestGame(t, pool, "Scoring Points")
+
+	svc := elo.NewMatchService(pool, elo.NewMarketService(pool))
+	if _, err := svc.UpdateGameScoring(ctx, kept, elo.GameScoring{Direction: elo.ScoringHigherWins, ResultType: elo.ResultTypePlacement}); err != nil {
+		t.Fatalf("UpdateGameScoring: %v", err)
+	}
+	m, err := svc.AddMatch(ctx, duplicate, map[string]float64{playerA: 120, playerB: 80}, time.Now().Add(-time.Hour), elo.AddMatchOpts{ID: newID(t), ClientDate: true})
+	if err != nil {
+		t.Fatalf("AddMatch: %v", err)
+	}
+
+	if _, err := svc.MergeGames(ctx, kept, duplicate); !errors.Is(err, elo.ErrGameMergeConflict) {
+		t.Fatalf("MergeGames error = %v, want ErrGameMergeConflict", err)
+	}
+	stored, err := db.New(pool).GetMatch(ctx, m.ID)
+	if err != nil {
+		t.Fatalf("GetMatch: %v", err)
+	}
+	if stored.GameID != duplicate {
+		t.Errorf("match moved to game %s by a failed merge", stored.GameID)
+	}
+}
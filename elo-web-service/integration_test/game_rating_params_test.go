@@ -0,0 +1,64 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/tolyandre/elo-web-service/pkg/elo"
+)
+
+// TestSetGameRatingParams_ReplaysWithGameK verifies that doubling a game's K
+// from before its matches doubles its game arena deltas on replay.
+func TestSetGameRatingParams_ReplaysWithGameK(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	playerA := createTestPlayer(t, pool, "WeightA")
+	playerB := createTestPlayer(t, pool, "WeightB")
+	gameID := createTestGame(t, pool, "Twilight Imperium")
+
+	svc := elo.NewMatchService(pool, elo.NewMarketService(pool))
+	date := time.Now().Add(-time.Hour).Truncate(time.Second)
+	if _, err := svc.AddMatch(ctx, gameID, map[string]float64{playerA: 10, playerB: 5}, date, elo.AddMatchOpts{ClientDate: true, ID: newID(t)}); err != nil {
+		t.Fatalf("AddMatch: %v", err)
+	}
+
+	gameDelta := func() float64 {
+		t.Helper()
+		var staked, earned float64
+		if err := pool.QueryRow(ctx,
+			`SELECT elo_staked, elo_earned FROM game_arena_settlement WHERE player_id = $1 AND game_id = $2`,
+			playerA, gameID,
+		).Scan(&staked, &earned); err != nil {
+			t.Fatalf("read game settlement: %v", err)
+		}
+		return staked + earned
+	}
+	before := gameDelta()
+
+	var k float64
+	if err := pool.QueryRow(ctx, `SELECT elo_const_k FROM elo_settings ORDER BY effective_date DESC LIMIT 1`).Scan(&k); err != nil {
+		t.Fatalf("read elo settings: %v", err)
+	}
+	doubled := 2 * k
+	if err := svc.SetGameRatingParams(ctx, gameID, elo.GameRatingParams{EffectiveDate: date.Add(-24 * time.Hour), K: &doubled}); err != nil {
+		t.Fatalf("SetGameRatingParams: %v", err)
+	}
+
+	if after := gameDelta(); math.Abs(after-2*before) > 1e-9 {
+		t.Errorf("game delta = %.4f after doubling K, want %.4f", after, 2*before)
+	}
+
+	entries, err := elo.NewAuditService(pool).ListAuditEntries(ctx, elo.AuditFilter{EntityType: elo.AuditEntityGame, EntityID: gameID, Limit: 10})
+	if err != nil {
+		t.Fatalf("ListAuditEntries: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Action != elo.AuditActionRatingParams || entries[0].Before != nil || entries[0].After == nil {
+		t.Errorf("audit entries = %+v, want one rating_params entry with only after", entries)
+	}
+}
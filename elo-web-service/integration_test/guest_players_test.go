@@ -0,0 +1,189 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tolyandre/elo-web-service/pkg/db"
+	"github.com/tolyandre/elo-web-service/pkg/elo"
+)
+
+// TestGuestPlayers_NoSettlements plays a match with a guest, checks that only
+// the regular player is settled, and that turning the flag off and on again
+// replays the guest's match each time.
+func TestGuestPlayers_NoSettlements(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	regular := createTestPlayer(t, pool, "GuestHost")
+	guest, err := db.New(pool).CreatePlayer(ctx, db.CreatePlayerParams{ID: newID(t), Name: "Гость", IsGuest: true})
+	if err != nil {
+		t.Fatalf("create guest: %v", err)
+	}
+	gameID := createTestGame(t, pool, "Guest Chess")
+
+	svc := elo.NewMatchService(pool, elo.NewMarketService(pool))
+	match, err := svc.AddMatch(ctx, gameID, map[string]float64{regular: 10, guest.ID: 5}, time.Now().Add(-time.Hour), elo.AddMatchOpts{ID: newID(t), ClientDate: true})
+	if err != nil {
+		t.Fatalf("AddMatch: %v", err)
+	}
+
+	var count int
+	if err := pool.QueryRow(ctx, `SELECT COUNT(*) FROM match_scores WHERE match_id = $1`, match.ID).Scan(&count); err != nil || count != 2 {
+		t.Errorf("match scores = %d (err %v), want 2", count, err)
+	}
+	if n := settlementsOf(t, pool, guest.ID); n != 0 {
+		t.Errorf("guest settlements = %d, want 0", n)
+	}
+	if n := settlementsOf(t, pool, regular); n != 2 {
+		t.Errorf("regular player settlements = %d, want 2", n)
+	}
+
+	if _, err := svc.SetPlayerGuest(ctx, guest.ID, false); err != nil {
+		t.Fatalf("SetPlayerGuest(false): %v", err)
+	}
+	if n := settlementsOf(t, pool, guest.ID); n != 2 {
+		t.Errorf("settlements after leaving guests = %d, want 2", n)
+	}
+	if _, err := svc.SetPlayerGuest(ctx, guest.ID, true); err != nil {
+		t.Fatalf("SetPlayerGuest(true): %v", err)
+	}
+	if n := settlementsOf(t, pool, guest.ID); n != 0 {
+		t.Errorf("settlements after becoming a guest again = %d, want 0", n)
+	}
+	if err := pool.QueryRow(ctx, `SELECT COUNT(*) FROM audit_log WHERE entity_type = 'player' AND entity_id = $1 AND action = 'update'`, guest.ID).Scan(&count); err != nil || count != 2 {
+		t.Errorf("guest flag audit entries = %d (err %v), want 2", count, err)
+	}
+
+	want := replaySnapshot(t, pool)
+	if err := svc.RecalculateAllGameElo(ctx); err != nil {
+		t.Fatalf("RecalculateAllGameElo: %v", err)
+	}
+	got := replaySnapshot(t, pool)
+	if len(got) != len(want) {
+		t.Fatalf("full replay produced %d rows, per-event path left %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("row %d differs after full replay:\n got  %s\n want %s", i, got[i], want[i])
+		}
+	}
+}
+
+// TestListPlayers_HidesGuests checks that guests are listed only on request,
+// unranked.
+func TestListPlayers_HidesGuests(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	createTestPlayer(t, pool, "Regular")
+	if _, err := db.New(pool).CreatePlayer(ctx, db.CreatePlayerParams{ID: newID(t), Name: "Гость", IsGuest: true}); err != nil {
+		t.Fatalf("create guest: %v", err)
+	}
+	router := setupRouter(pool)
+
+	type listedPlayer struct {
+		Name    string `json:"name"`
+		IsGuest bool   `json:"is_guest"`
+		Rank    struct {
+			Now struct {
+				Rank *int `json:"rank"`
+			} `json:"now"`
+		} `json:"rank"`
+	}
+	list := func(url string) []listedPlayer {
+		t.Helper()
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, url, nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("GET %s: expected 200, got %d: %s", url, w.Code, w.Body.String())
+		}
+		var resp struct {
+			Data []listedPlayer `json:"data"`
+		}
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		return resp.Data
+	}
+
+	if players := list("/players"); len(players) != 1 || players[0].Name != "Regular" {
+		t.Errorf("default list = %+v, want only Regular", players)
+	}
+	players := list("/players?include_guests=true")
+	if len(players) != 2 {
+		t.Fatalf("list with guests has %d players, want 2", len(players))
+	}
+	if g := players[1]; g.Name != "Гость" || !g.IsGuest || g.Rank.Now.Rank != nil {
+		t.Errorf("guest entry = %+v, want an unranked guest listed last", g)
+	}
+}
+
+// settlementsOf counts a player's global and game arena settlements.
+func settlementsOf(t *testing.T, pool *pgxpool.Pool, playerID string) int {
+	t.Helper()
+	var n int
+	if err := pool.QueryRow(context.Background(),
+		`SELECT (SELECT COUNT(*) FROM global_arena_settlement WHERE player_id = $1)
+		      + (SELECT COUNT(*) FROM game_arena_settlement WHERE player_id = $1)`, playerID).Scan(&n); err != nil {
+		t.Fatalf("count settlements of %s: %v", playerID, err)
+	}
+	return n
+}
+
+// TestGuestPlayers_StakesBlockGuestFlag verifies that a player with a rating
+// correction cannot become a guest, that a failed PATCH leaves the name
+// untouched, and that guests get neither corrections nor guarantees.
+func TestGuestPlayers_StakesBlockGuestFlag(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	player := createTestPlayer(t, pool, "StakesPlayer")
+	if err := elo.NewCorrectionService(pool).CreateGlobalArenaRatingCorrection(ctx, newID(t), player, 5); err != nil {
+		t.Fatalf("CreateGlobalArenaRatingCorrection: %v", err)
+	}
+
+	svc := elo.NewMatchService(pool, elo.NewMarketService(pool))
+	guest := true
+	if _, err := svc.UpdatePlayer(ctx, player, "StakesRenamed", &guest); !errors.Is(err, elo.ErrGuestHasStakes) {
+		t.Fatalf("UpdatePlayer error = %v, want ErrGuestHasStakes", err)
+	}
+	stored, err := db.New(pool).GetPlayer(ctx, player)
+	if err != nil {
+		t.Fatalf("GetPlayer: %v", err)
+	}
+	if stored.Name != "StakesPlayer" || stored.IsGuest {
+		t.Errorf("player after a failed update = %+v, want unchanged", stored)
+	}
+
+	visitor, err := db.New(pool).CreatePlayer(ctx, db.CreatePlayerParams{ID: newID(t), Name: "Гость без рейтинга", IsGuest: true})
+	if err != nil {
+		t.Fatalf("create guest: %v", err)
+	}
+	if err := elo.NewCorrectionService(pool).CreateGlobalArenaRatingCorrection(ctx, newID(t), visitor.ID, 5); !errors.Is(err, elo.ErrGuestNotRated) {
+		t.Errorf("correction for a guest error = %v, want ErrGuestNotRated", err)
+	}
+	now := time.Now()
+	if _, err := elo.NewMarketService(pool).CreateMarket(ctx, elo.CreateMarketParams{
+		ID:                 newID(t),
+		MarketType:         "match_winner",
+		StartsAt:           now.Add(-time.Minute),
+		ClosesAt:           now.Add(24 * time.Hour),
+		CreatedBy:          createTestAdmin(t, pool),
+		GuarantorPlayerIDs: []string{visitor.ID},
+		MatchWinner:        &elo.MatchWinnerCreateParams{TargetPlayerIDs: []string{player}, AllowOtherPlayers: true},
+	}); !errors.Is(err, elo.ErrGuestNotRated) {
+		t.Errorf("market guaranteed by a guest error = %v, want ErrGuestNotRated", err)
+	}
+}
@@ -0,0 +1,130 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/tolyandre/elo-web-service/pkg/elo"
+)
+
+// TestHeadToHead_RecordAndRivals plays two players against each other and
+// with a third, then checks the head-to-head record, the rating exchanged in
+// a two-player match and the rivals of the weaker player.
+func TestHeadToHead_RecordAndRivals(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	a := createTestPlayer(t, pool, "RivalA")
+	b := createTestPlayer(t, pool, "RivalB")
+	c := createTestPlayer(t, pool, "RivalC")
+	gameID := createTestGame(t, pool, "Rival Chess")
+
+	svc := elo.NewMatchService(pool, elo.NewMarketService(pool))
+	now := time.Now().Truncate(time.Second)
+	for i, scores := range []map[string]float64{
+		{a: 10, b: 5},
+		{a: 3, b: 7, c: 1},
+		{a: 8, b: 2},
+	} {
+		if _, err := svc.AddMatch(ctx, gameID, scores, now.Add(time.Duration(i-3)*time.Hour), elo.AddMatchOpts{ID: newID(t), ClientDate: true}); err != nil {
+			t.Fatalf("AddMatch: %v", err)
+		}
+	}
+
+	players := elo.NewPlayerService(pool)
+	h, err := players.HeadToHead(ctx, a, b)
+	if err != nil {
+		t.Fatalf("HeadToHead: %v", err)
+	}
+	if h.Matches != 3 || h.PlayerAbove != 2 || h.OtherAbove != 1 {
+		t.Errorf("record = %+v, want a above b twice in 3 matches", h.HeadToHeadRecord)
+	}
+	if h.Streak.LeaderID == nil || *h.Streak.LeaderID != a || h.Streak.Length != 1 {
+		t.Errorf("streak = %+v, want a for 1 match", h.Streak)
+	}
+	if len(h.Games) != 1 || h.Games[0].Matches != 3 {
+		t.Errorf("games = %+v, want one game with 3 matches", h.Games)
+	}
+	reverse, err := players.HeadToHead(ctx, b, a)
+	if err != nil {
+		t.Fatalf("HeadToHead reversed: %v", err)
+	}
+	if math.Abs(h.RatingExchanged+reverse.RatingExchanged) > 1e-9 || h.RatingExchanged <= 0 {
+		t.Errorf("rating exchanged = %v and %v reversed, want a positive amount and its negation", h.RatingExchanged, reverse.RatingExchanged)
+	}
+
+	if _, err := players.HeadToHead(ctx, a, a); !errors.Is(err, elo.ErrHeadToHeadSelf) {
+		t.Errorf("same player: err = %v, want ErrHeadToHeadSelf", err)
+	}
+
+	rivals, err := players.Rivals(ctx, b)
+	if err != nil {
+		t.Fatalf("Rivals: %v", err)
+	}
+	if len(rivals.Opponents) != 2 || rivals.Opponents[0].PlayerID != a || rivals.Opponents[0].Matches != 3 {
+		t.Errorf("opponents = %+v, want a first with 3 matches", rivals.Opponents)
+	}
+	if rivals.Nemesis == nil || rivals.Nemesis.PlayerID != a {
+		t.Errorf("nemesis = %+v, want a", rivals.Nemesis)
+	}
+}
+
+// TestHeadToHead_SkipsTeammatesAndCoop plays two players as teammates and in
+// a cooperative match, then once against each other, and checks that only
+// that last match counts and the teammate is no nemesis.
+func TestHeadToHead_SkipsTeammatesAndCoop(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	a := createTestPlayer(t, pool, "MateA")
+	b := createTestPlayer(t, pool, "MateB")
+	c := createTestPlayer(t, pool, "MateC")
+	d := createTestPlayer(t, pool, "MateD")
+	gameID := createTestGame(t, pool, "Mate Codenames")
+
+	svc := elo.NewMatchService(pool, elo.NewMarketService(pool))
+	now := time.Now().Truncate(time.Second)
+	// a and b win together as a team against c and d.
+	if _, err := svc.AddMatch(ctx, gameID, map[string]float64{a: 1, b: 1, c: 0, d: 0}, now.Add(-3*time.Hour),
+		elo.AddMatchOpts{ID: newID(t), ClientDate: true, Teams: map[string]string{a: "red", b: "red", c: "blue", d: "blue"}}); err != nil {
+		t.Fatalf("AddMatch team: %v", err)
+	}
+	// A cooperative loss where b happened to record more points.
+	if _, err := svc.AddMatch(ctx, gameID, map[string]float64{a: 1, b: 9}, now.Add(-2*time.Hour),
+		elo.AddMatchOpts{ID: newID(t), ClientDate: true, Cooperative: &elo.Cooperative{Won: false}}); err != nil {
+		t.Fatalf("AddMatch coop: %v", err)
+	}
+	if _, err := svc.AddMatch(ctx, gameID, map[string]float64{a: 10, b: 5}, now.Add(-time.Hour),
+		elo.AddMatchOpts{ID: newID(t), ClientDate: true}); err != nil {
+		t.Fatalf("AddMatch: %v", err)
+	}
+
+	players := elo.NewPlayerService(pool)
+	h, err := players.HeadToHead(ctx, a, b)
+	if err != nil {
+		t.Fatalf("HeadToHead: %v", err)
+	}
+	if h.Matches != 1 || h.PlayerAbove != 1 || h.OtherAbove != 0 {
+		t.Errorf("record = %+v, want only the match against each other", h.HeadToHeadRecord)
+	}
+
+	rivals, err := players.Rivals(ctx, a)
+	if err != nil {
+		t.Fatalf("Rivals: %v", err)
+	}
+	for _, o := range rivals.Opponents {
+		if o.PlayerID == b && o.Matches != 1 {
+			t.Errorf("b as an opponent = %+v, want 1 match", o)
+		}
+	}
+	if rivals.Nemesis != nil {
+		t.Errorf("nemesis = %+v, want none", rivals.Nemesis)
+	}
+}
@@ -0,0 +1,89 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tolyandre/elo-web-service/pkg/elo"
+)
+
+// TestLeaderboard_AsOfAndDiff plays two matches a week apart and reads the
+// global, game and club tables before, between and after them.
+func TestLeaderboard_AsOfAndDiff(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	alice := createTestPlayer(t, pool, "LeaderAlice")
+	bob := createTestPlayer(t, pool, "LeaderBob")
+	carol := createTestPlayer(t, pool, "LeaderCarol")
+	gameID := createTestGame(t, pool, "Leader Chess")
+
+	svc := elo.NewMatchService(pool, elo.NewMarketService(pool))
+	now := time.Now().Truncate(time.Second)
+	first, second := now.Add(-10*24*time.Hour), now.Add(-3*24*time.Hour)
+	for _, m := range []struct {
+		scores map[string]float64
+		date   time.Time
+	}{
+		{map[string]float64{alice: 10, bob: 5}, first},
+		{map[string]float64{bob: 10, carol: 1}, second},
+	} {
+		if _, err := svc.AddMatch(ctx, gameID, m.scores, m.date, elo.AddMatchOpts{ID: newID(t), ClientDate: true}); err != nil {
+			t.Fatalf("AddMatch: %v", err)
+		}
+	}
+
+	players := elo.NewPlayerService(pool)
+	between := first.Add(24 * time.Hour)
+	table, err := players.Leaderboard(ctx, between, elo.LeaderboardQuery{})
+	if err != nil {
+		t.Fatalf("Leaderboard: %v", err)
+	}
+	if len(table) != 2 || table[0].PlayerID != alice || table[0].Rank != 1 || table[1].PlayerID != bob {
+		t.Fatalf("table after the first match = %+v, want alice then bob", table)
+	}
+	if table, err := players.Leaderboard(ctx, first.Add(-time.Hour), elo.LeaderboardQuery{}); err != nil || len(table) != 0 {
+		t.Errorf("table before any match = %+v (err %v), want empty", table, err)
+	}
+
+	diff, err := players.LeaderboardDiff(ctx, between, now, elo.LeaderboardQuery{GameID: gameID})
+	if err != nil {
+		t.Fatalf("LeaderboardDiff: %v", err)
+	}
+	if len(diff) != 3 {
+		t.Fatalf("game table has %d players, want 3", len(diff))
+	}
+	for _, e := range diff {
+		if (e.PlayerID == carol) != (e.PreviousRank == nil) {
+			t.Errorf("%s previous rank = %v, want it set for everyone but carol", e.Name, e.PreviousRank)
+		}
+	}
+
+	clubID := newID(t)
+	if _, err := pool.Exec(ctx, `INSERT INTO clubs (id, name) VALUES ($1, 'Leader Club')`, clubID); err != nil {
+		t.Fatalf("create club: %v", err)
+	}
+	if _, err := pool.Exec(ctx, `INSERT INTO player_club_membership (club_id, player_id) VALUES ($1, $2), ($1, $3)`, clubID, bob, carol); err != nil {
+		t.Fatalf("add club members: %v", err)
+	}
+	clubTable, err := players.Leaderboard(ctx, now, elo.LeaderboardQuery{ClubID: &clubID})
+	if err != nil {
+		t.Fatalf("Leaderboard for club: %v", err)
+	}
+	if len(clubTable) != 2 || clubTable[0].PlayerID != bob || clubTable[0].Rank != 1 {
+		t.Errorf("club table = %+v, want bob first of two", clubTable)
+	}
+
+	if _, err := players.LeaderboardDiff(ctx, now, between, elo.LeaderboardQuery{}); !errors.Is(err, elo.ErrInvalidLeaderboardPeriod) {
+		t.Errorf("reversed period error = %v, want ErrInvalidLeaderboardPeriod", err)
+	}
+	unknown := newID(t)
+	if _, err := players.Leaderboard(ctx, now, elo.LeaderboardQuery{ClubID: &unknown}); !errors.Is(err, elo.ErrClubNotFound) {
+		t.Errorf("unknown club error = %v, want ErrClubNotFound", err)
+	}
+}
@@ -0,0 +1,65 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/tolyandre/elo-web-service/pkg/elo"
+)
+
+// TestDeleteMatch_RecalculatesLaterMatches verifies that deleting a middle match
+// leaves the history exactly as if it had never been added.
+func TestDeleteMatch_RecalculatesLaterMatches(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	playerA := createTestPlayer(t, pool, "DeleteA")
+	playerB := createTestPlayer(t, pool, "DeleteB")
+	playerC := createTestPlayer(t, pool, "ControlC")
+	playerD := createTestPlayer(t, pool, "ControlD")
+	gameID := createTestGame(t, pool, "Azul")
+
+	svc := elo.NewMatchService(pool, elo.NewMarketService(pool))
+	now := time.Now().Truncate(time.Second)
+	t1, t2, t3 := now.Add(-3*time.Hour), now.Add(-2*time.Hour), now.Add(-1*time.Hour)
+	add := func(scores map[string]float64, date time.Time) string {
+		t.Helper()
+		m, err := svc.AddMatch(ctx, gameID, scores, date, elo.AddMatchOpts{ClientDate: true, ID: newID(t)})
+		if err != nil {
+			t.Fatalf("AddMatch: %v", err)
+		}
+		return m.ID
+	}
+
+	add(map[string]float64{playerA: 10, playerB: 5}, t1)
+	mistaken := add(map[string]float64{playerA: 1, playerB: 10}, t2)
+	add(map[string]float64{playerA: 10, playerB: 5}, t3)
+
+	// Control pair: the same history without the mistaken match.
+	add(map[string]float64{playerC: 10, playerD: 5}, t1)
+	add(map[string]float64{playerC: 10, playerD: 5}, t3)
+
+	if err := svc.DeleteMatch(ctx, mistaken); err != nil {
+		t.Fatalf("DeleteMatch: %v", err)
+	}
+
+	for _, pair := range [][2]string{{playerA, playerC}, {playerB, playerD}} {
+		got, want := latestElo(t, pool, pair[0]), latestElo(t, pool, pair[1])
+		if math.Abs(got-want) > 1e-9 {
+			t.Errorf("player %s elo = %.4f, want %.4f as without the deleted match", pair[0], got, want)
+		}
+		if rows := playerRatingRows(t, pool, pair[0]); len(rows) != 2 {
+			t.Errorf("player %s: expected 2 settlement rows, got %d", pair[0], len(rows))
+		}
+	}
+
+	if err := svc.DeleteMatch(ctx, mistaken); !errors.Is(err, elo.ErrMatchNotFound) {
+		t.Errorf("second delete: got %v, want ErrMatchNotFound", err)
+	}
+}
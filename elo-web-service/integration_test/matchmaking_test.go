@@ -0,0 +1,69 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tolyandre/elo-web-service/pkg/elo"
+)
+
+// TestMatchmaking_NewPairingsAndTournamentScope seats players who have met
+// at different tables, and checks that a tournament scope ignores matches
+// outside it and rejects attendees who are not entrants.
+func TestMatchmaking_NewPairingsAndTournamentScope(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	a := createTestPlayer(t, pool, "MatchmakingA")
+	b := createTestPlayer(t, pool, "MatchmakingB")
+	c := createTestPlayer(t, pool, "MatchmakingC")
+	d := createTestPlayer(t, pool, "MatchmakingD")
+	gameID := createTestGame(t, pool, "Matchmaking Chess")
+
+	svc := elo.NewMatchService(pool, elo.NewMarketService(pool))
+	now := time.Now().Truncate(time.Second)
+	for _, scores := range []map[string]float64{{a: 10, b: 5}, {c: 10, d: 5}} {
+		if _, err := svc.AddMatch(ctx, gameID, scores, now.Add(-2*time.Hour), elo.AddMatchOpts{ID: newID(t), ClientDate: true}); err != nil {
+			t.Fatalf("AddMatch: %v", err)
+		}
+	}
+
+	mm := elo.NewMatchmakingService(pool)
+	tables := []elo.MatchmakingTable{
+		{GameID: gameID, MinPlayers: 2, MaxPlayers: 2},
+		{GameID: gameID, MinPlayers: 2, MaxPlayers: 2},
+	}
+	suggestions, err := mm.SuggestTables(ctx, elo.MatchmakingRequest{PlayerIDs: []string{a, b, c, d}, Tables: tables, Objective: elo.MatchmakingNewPairings})
+	if err != nil {
+		t.Fatalf("SuggestTables: %v", err)
+	}
+	for _, s := range suggestions {
+		if len(s.Players) != 2 || s.RepeatPairings != 0 || s.NewPairings != 1 {
+			t.Errorf("table = %+v, want two players who have not met", s)
+		}
+	}
+
+	tournaments := elo.NewTournamentService(pool)
+	tour, err := tournaments.CreateTournament(ctx, newID(t), "Matchmaking Cup", now.Add(-time.Hour), now.Add(time.Hour), []string{a, b, c})
+	if err != nil {
+		t.Fatalf("create tournament: %v", err)
+	}
+	if _, err := mm.SuggestTables(ctx, elo.MatchmakingRequest{PlayerIDs: []string{a, b, c, d}, Tables: tables, Objective: elo.MatchmakingNewPairings, TournamentID: &tour.ID}); !errors.Is(err, elo.ErrMatchmakingOutsider) {
+		t.Errorf("attendee outside the tournament: err = %v, want ErrMatchmakingOutsider", err)
+	}
+
+	// a and b met before the tournament, which does not count within it.
+	single := []elo.MatchmakingTable{{GameID: gameID, MinPlayers: 2, MaxPlayers: 3}}
+	suggestions, err = mm.SuggestTables(ctx, elo.MatchmakingRequest{PlayerIDs: []string{a, b, c}, Tables: single, Objective: elo.MatchmakingBalance, TournamentID: &tour.ID})
+	if err != nil {
+		t.Fatalf("SuggestTables in tournament: %v", err)
+	}
+	if got := suggestions[0]; len(got.Players) != 3 || got.RepeatPairings != 0 || got.NewPairings != 3 {
+		t.Errorf("tournament table = %+v, want three players and no repeat pairings", got)
+	}
+}
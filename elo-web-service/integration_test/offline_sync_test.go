@@ -141,11 +141,11 @@ func TestCreatePlayerAndGame_IdempotencyKey(t *testing.T) {
 	gameSvc := elo.NewGameService(pool)
 
 	playerKey := newID(t)
-	p1, err := playerSvc.CreatePlayer(ctx, playerKey, "Оффлайн Игрок")
+	p1, err := playerSvc.CreatePlayer(ctx, playerKey, "Оффлайн Игрок", false)
 	if err != nil {
 		t.Fatalf("first CreatePlayer: %v", err)
 	}
-	p2, err := playerSvc.CreatePlayer(ctx, playerKey, "Оффлайн Игрок")
+	p2, err := playerSvc.CreatePlayer(ctx, playerKey, "Оффлайн Игрок", false)
 	if err != nil {
 		t.Fatalf("retry CreatePlayer: %v", err)
 	}
@@ -153,7 +153,7 @@ func TestCreatePlayerAndGame_IdempotencyKey(t *testing.T) {
 		t.Errorf("player retry created a duplicate: first=%s second=%s", p1.ID, p2.ID)
 	}
 	// Same name with a different key must still hit the name unique constraint.
-	if _, err := playerSvc.CreatePlayer(ctx, newID(t), "Оффлайн Игрок"); !db.IsUniqueViolation(err) {
+	if _, err := playerSvc.CreatePlayer(ctx, newID(t), "Оффлайн Игрок", false); !db.IsUniqueViolation(err) {
 		t.Errorf("duplicate name with new key: expected unique violation, got %v", err)
 	}
 
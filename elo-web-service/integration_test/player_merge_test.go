@@ -0,0 +1,214 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tolyandre/elo-web-service/pkg/db"
+	"github.com/tolyandre/elo-web-service/pkg/elo"
+)
+
+// TestMergePlayers_MovesHistory merges a duplicate that played, bet and
+// belongs to a club into the kept player and checks that everything moved
+// and that the settlements equal a full replay of the merged history.
+func TestMergePlayers_MovesHistory(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	kept := createTestPlayer(t, pool, "Саша")
+	duplicate := createTestPlayer(t, pool, "Саша К.")
+	opponent := createTestPlayer(t, pool, "MergeOpponent")
+	gameID := createTestGame(t, pool, "Merge Chess")
+	adminID := createTestAdmin(t, pool)
+
+	marketSvc := elo.NewMarketService(pool)
+	svc := elo.NewMatchService(pool, marketSvc)
+	now := time.Now().Truncate(time.Second)
+	add := func(scores map[string]float64, date time.Time) string {
+		t.Helper()
+		m, err := svc.AddMatch(ctx, gameID, scores, date, elo.AddMatchOpts{ID: newID(t), ClientDate: true})
+		if err != nil {
+			t.Fatalf("AddMatch: %v", err)
+		}
+		return m.ID
+	}
+	add(map[string]float64{kept: 10, opponent: 5}, now.Add(-72*time.Hour))
+	dupMatch := add(map[string]float64{duplicate: 10, opponent: 5}, now.Add(-48*time.Hour))
+	add(map[string]float64{kept: 1, opponent: 5}, now.Add(-24*time.Hour))
+
+	clubID := newID(t)
+	if _, err := pool.Exec(ctx, `INSERT INTO clubs (id, name) VALUES ($1, 'Merge Club')`, clubID); err != nil {
+		t.Fatalf("create club: %v", err)
+	}
+	if _, err := pool.Exec(ctx, `INSERT INTO player_club_membership (club_id, player_id) VALUES ($1, $2)`, clubID, duplicate); err != nil {
+		t.Fatalf("add club member: %v", err)
+	}
+	if _, err := pool.Exec(ctx, `UPDATE users SET player_id = $1 WHERE id = $2`, duplicate, adminID); err != nil {
+		t.Fatalf("link user: %v", err)
+	}
+
+	market, err := marketSvc.CreateMarket(ctx, elo.CreateMarketParams{
+		ID:                 newID(t),
+		MarketType:         "match_winner",
+		StartsAt:           now.Add(-time.Minute),
+		ClosesAt:           now.Add(24 * time.Hour),
+		CreatedBy:          adminID,
+		GuarantorPlayerIDs: []string{opponent},
+		MatchWinner: &elo.MatchWinnerCreateParams{
+			TargetPlayerIDs:   []string{opponent},
+			AllowOtherPlayers: true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateMarket: %v", err)
+	}
+	outcome := marketOutcomeID(t, ctx, marketSvc, market.ID, "player", opponent)
+	if err := placeBetAtCurrentPrice(ctx, t, marketSvc, market.ID, duplicate, outcome, 1); err != nil {
+		t.Fatalf("PlaceBet: %v", err)
+	}
+
+	merged, err := svc.MergePlayers(ctx, kept, duplicate)
+	if err != nil {
+		t.Fatalf("MergePlayers: %v", err)
+	}
+	if merged.ID != kept || merged.Name != "Саша" {
+		t.Errorf("merged player = %s %q, want %s %q", merged.ID, merged.Name, kept, "Саша")
+	}
+
+	q := db.New(pool)
+	if _, err := q.GetPlayer(ctx, duplicate); !db.IsNoRows(err) {
+		t.Errorf("duplicate still exists (err = %v)", err)
+	}
+	var count int
+	if err := pool.QueryRow(ctx, `SELECT COUNT(*) FROM match_scores WHERE match_id = $1 AND player_id = $2`, dupMatch, kept).Scan(&count); err != nil || count != 1 {
+		t.Errorf("kept player's scores in the duplicate's match = %d (err %v), want 1", count, err)
+	}
+	if err := pool.QueryRow(ctx, `SELECT COUNT(*) FROM global_arena_settlement WHERE player_id = $1 AND discriminator = 'match'`, kept).Scan(&count); err != nil || count != 3 {
+		t.Errorf("kept player's match settlements = %d (err %v), want 3", count, err)
+	}
+	if err := pool.QueryRow(ctx, `SELECT COUNT(*) FROM bets WHERE player_id = $1`, kept).Scan(&count); err != nil || count != 1 {
+		t.Errorf("kept player's bets = %d (err %v), want 1", count, err)
+	}
+	if err := pool.QueryRow(ctx, `SELECT COUNT(*) FROM player_club_membership WHERE club_id = $1 AND player_id = $2`, clubID, kept).Scan(&count); err != nil || count != 1 {
+		t.Errorf("kept player's club memberships = %d (err %v), want 1", count, err)
+	}
+	var linked *string
+	if err := pool.QueryRow(ctx, `SELECT player_id FROM users WHERE id = $1`, adminID).Scan(&linked); err != nil || linked == nil || *linked != kept {
+		t.Errorf("user linked to %v (err %v), want %s", linked, err, kept)
+	}
+	if err := pool.QueryRow(ctx, `SELECT COUNT(*) FROM audit_log WHERE entity_type = 'player' AND entity_id = $1 AND action = 'merge'`, kept).Scan(&count); err != nil || count != 1 {
+		t.Errorf("merge audit entries = %d (err %v), want 1", count, err)
+	}
+
+	want := replaySnapshot(t, pool)
+	if err := svc.RecalculateAllGameElo(ctx); err != nil {
+		t.Fatalf("RecalculateAllGameElo: %v", err)
+	}
+	got := replaySnapshot(t, pool)
+	if len(got) != len(want) {
+		t.Fatalf("full replay produced %d rows, merge left %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("row %d differs after full replay:\n got  %s\n want %s", i, got[i], want[i])
+		}
+	}
+}
+
+// TestMergePlayers_SharedMatchConflict verifies that players who played the
+// same match are not merged and both stay as they were.
+func TestMergePlayers_SharedMatchConflict(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	kept := createTestPlayer(t, pool, "ConflictKept")
+	duplicate := createTestPlayer(t, pool, "ConflictDuplicate")
+	gameID := createTestGame(t, pool, "Conflict Chess")
+
+	svc := elo.NewMatchService(pool, elo.NewMarketService(pool))
+	if _, err := svc.AddMatch(ctx, gameID, map[string]float64{kept: 10, duplicate: 5}, time.Now().Add(-time.Hour), elo.AddMatchOpts{ID: newID(t), ClientDate: true}); err != nil {
+		t.Fatalf("AddMatch: %v", err)
+	}
+
+	if _, err := svc.MergePlayers(ctx, kept, duplicate); !errors.Is(err, elo.ErrPlayerMergeConflict) {
+		t.Fatalf("MergePlayers error = %v, want ErrPlayerMergeConflict", err)
+	}
+	if _, err := svc.MergePlayers(ctx, kept, kept); !errors.Is(err, elo.ErrPlayerMergeSelf) {
+		t.Errorf("MergePlayers with itself error = %v, want ErrPlayerMergeSelf", err)
+	}
+	if _, err := db.New(pool).GetPlayer(ctx, duplicate); err != nil {
+		t.Errorf("duplicate was removed by a failed merge: %v", err)
+	}
+}
+
+// TestMergePlayers_SharedMarketTargetConflict verifies that players who are
+// both targets of one market are not merged, which would list one target
+// twice.
+func TestMergePlayers_SharedMarketTargetConflict(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	kept := createTestPlayer(t, pool, "TargetKept")
+	duplicate := createTestPlayer(t, pool, "TargetDuplicate")
+	guarantor := createTestPlayer(t, pool, "TargetGuarantor")
+	adminID := createTestAdmin(t, pool)
+
+	now := time.Now()
+	if _, err := elo.NewMarketService(pool).CreateMarket(ctx, elo.CreateMarketParams{
+		ID:                 newID(t),
+		MarketType:         "match_winner",
+		StartsAt:           now.Add(-time.Minute),
+		ClosesAt:           now.Add(24 * time.Hour),
+		CreatedBy:          adminID,
+		GuarantorPlayerIDs: []string{guarantor},
+		MatchWinner: &elo.MatchWinnerCreateParams{
+			TargetPlayerIDs:   []string{kept, duplicate},
+			AllowOtherPlayers: true,
+		},
+	}); err != nil {
+		t.Fatalf("CreateMarket: %v", err)
+	}
+
+	svc := elo.NewMatchService(pool, elo.NewMarketService(pool))
+	if _, err := svc.MergePlayers(ctx, kept, duplicate); !errors.Is(err, elo.ErrPlayerMergeConflict) {
+		t.Fatalf("MergePlayers error = %v, want ErrPlayerMergeConflict", err)
+	}
+}
+
+// TestMergePlayers_GuestConflict verifies that a player with a rating
+// correction is not merged into a guest and that a guest is not merged into
+// a rated player.
+func TestMergePlayers_GuestConflict(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	rated := createTestPlayer(t, pool, "GuestMergeRated")
+	guest, err := db.New(pool).CreatePlayer(ctx, db.CreatePlayerParams{ID: newID(t), Name: "GuestMergeGuest", IsGuest: true})
+	if err != nil {
+		t.Fatalf("create guest: %v", err)
+	}
+	if err := elo.NewCorrectionService(pool).CreateGlobalArenaRatingCorrection(ctx, newID(t), rated, 5); err != nil {
+		t.Fatalf("CreateGlobalArenaRatingCorrection: %v", err)
+	}
+
+	svc := elo.NewMatchService(pool, elo.NewMarketService(pool))
+	if _, err := svc.MergePlayers(ctx, guest.ID, rated); !errors.Is(err, elo.ErrPlayerMergeConflict) {
+		t.Errorf("merge into a guest error = %v, want ErrPlayerMergeConflict", err)
+	}
+	if _, err := svc.MergePlayers(ctx, rated, guest.ID); !errors.Is(err, elo.ErrPlayerMergeConflict) {
+		t.Errorf("merge of a guest error = %v, want ErrPlayerMergeConflict", err)
+	}
+	for _, id := range []string{rated, guest.ID} {
+		if _, err := db.New(pool).GetPlayer(ctx, id); err != nil {
+			t.Errorf("player %s was removed by a failed merge: %v", id, err)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tolyandre/elo-web-service/pkg/db"
+	"github.com/tolyandre/elo-web-service/pkg/elo"
+)
+
+// TestPredictMatch_UsesCurrentElo checks that a prediction reads each arena's
+// latest Elo, keeps the request order and favours the stronger player.
+func TestPredictMatch_UsesCurrentElo(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	alice := createTestPlayer(t, pool, "PredictAlice")
+	bob := createTestPlayer(t, pool, "PredictBob")
+	carol := createTestPlayer(t, pool, "PredictCarol")
+	chess := createTestGame(t, pool, "Predict Chess")
+	goGame := createTestGame(t, pool, "Predict Go")
+
+	svc := elo.NewMatchService(pool, elo.NewMarketService(pool))
+	if _, err := svc.AddMatch(ctx, chess, map[string]float64{alice: 10, bob: 0}, time.Now().Add(-time.Hour), elo.AddMatchOpts{ID: newID(t), ClientDate: true}); err != nil {
+		t.Fatalf("AddMatch: %v", err)
+	}
+
+	predictions, err := svc.PredictMatch(ctx, goGame, []string{bob, alice, carol, alice})
+	if err != nil {
+		t.Fatalf("PredictMatch: %v", err)
+	}
+	if len(predictions) != 3 || predictions[0].PlayerID != bob || predictions[1].PlayerID != alice || predictions[2].PlayerID != carol {
+		t.Fatalf("predictions = %+v, want bob, alice, carol", predictions)
+	}
+	if got := predictions[1].Global.Elo; got != latestElo(t, pool, alice) {
+		t.Errorf("alice global elo = %v, want %v", got, latestElo(t, pool, alice))
+	}
+	if predictions[1].Global.WinProbability <= predictions[2].Global.WinProbability ||
+		predictions[2].Global.WinProbability <= predictions[0].Global.WinProbability {
+		t.Errorf("global win probabilities do not rank alice > carol > bob: %+v", predictions)
+	}
+	// Nobody has played Go yet, so the game arena is even.
+	for _, p := range predictions {
+		if p.Game.Elo != predictions[0].Game.Elo {
+			t.Errorf("game elo of %s = %v, want the starting elo %v", p.PlayerID, p.Game.Elo, predictions[0].Game.Elo)
+		}
+	}
+
+	if _, err := svc.PredictMatch(ctx, chess, []string{alice, alice}); !errors.Is(err, elo.ErrTooFewPlayers) {
+		t.Errorf("one distinct player: err = %v, want ErrTooFewPlayers", err)
+	}
+	if _, err := svc.PredictMatch(ctx, chess, []string{alice, newID(t)}); !db.IsNoRows(err) {
+		t.Errorf("unknown player: err = %v, want no rows", err)
+	}
+}
@@ -0,0 +1,138 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tolyandre/elo-web-service/pkg/db"
+	"github.com/tolyandre/elo-web-service/pkg/elo"
+)
+
+// TestRecords_FollowHistoryEdits sets a high score, edits the match and
+// checks the record moves with it, along with the game's win streak and the
+// matches-in-a-day count.
+func TestRecords_FollowHistoryEdits(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	a := createTestPlayer(t, pool, "RecordA")
+	b := createTestPlayer(t, pool, "RecordB")
+	gameID := createTestGame(t, pool, "Record Skull King")
+
+	svc := elo.NewMatchService(pool, elo.NewMarketService(pool))
+	now := time.Now().Truncate(time.Second)
+	var matches []db.Match
+	for i, scores := range []map[string]float64{
+		{a: 120, b: -40},
+		{a: 90, b: 30},
+		{a: 200, b: 10},
+	} {
+		m, err := svc.AddMatch(ctx, gameID, scores, now.Add(time.Duration(i-3)*time.Minute), elo.AddMatchOpts{ID: newID(t), ClientDate: true})
+		if err != nil {
+			t.Fatalf("AddMatch: %v", err)
+		}
+		matches = append(matches, m)
+	}
+
+	records := elo.NewRecordsService(pool)
+	got, err := records.GameRecords(ctx, gameID)
+	if err != nil {
+		t.Fatalf("GameRecords: %v", err)
+	}
+	if got.Scores == nil || got.Scores.HighScore.PlayerID != a || got.Scores.HighScore.Value != 200 ||
+		*got.Scores.HighScore.MatchID != matches[2].ID {
+		t.Errorf("high score = %+v, want a's 200 in the last match", got.Scores)
+	}
+	if got.Scores.LowScore.PlayerID != b || got.Scores.LowScore.Value != -40 {
+		t.Errorf("low score = %+v, want b's -40", got.Scores.LowScore)
+	}
+	if s := got.LongestWinStreak; s == nil || s.PlayerID != a || s.Length != 3 || s.PlayerName != "RecordA" {
+		t.Errorf("win streak = %+v, want a for 3 matches", s)
+	}
+	if got.LargestRatingGain == nil || got.LargestRatingGain.Value <= 0 {
+		t.Errorf("largest rating gain = %+v, want a positive gain", got.LargestRatingGain)
+	}
+
+	// b now wins the middle match with a new high score.
+	if _, err := svc.UpdateMatch(ctx, matches[1].ID, gameID, map[string]float64{a: 90, b: 250}, matches[1].Date.Time, elo.UpdateMatchOpts{}); err != nil {
+		t.Fatalf("UpdateMatch: %v", err)
+	}
+	got, err = records.GameRecords(ctx, gameID)
+	if err != nil {
+		t.Fatalf("GameRecords: %v", err)
+	}
+	if h := got.Scores.HighScore; h.PlayerID != b || h.Value != 250 || *h.MatchID != matches[1].ID {
+		t.Errorf("high score after edit = %+v, want b's 250", h)
+	}
+	if s := got.LongestWinStreak; s == nil || s.PlayerID != a || s.Length != 1 || s.LastMatchID != matches[0].ID {
+		t.Errorf("win streak after edit = %+v, want a's first win", s)
+	}
+
+	all, err := records.Records(ctx)
+	if err != nil {
+		t.Fatalf("Records: %v", err)
+	}
+	if len(all.Scores) != 1 || all.Scores[0].GameID != gameID {
+		t.Errorf("scores = %+v, want the one game", all.Scores)
+	}
+	if all.MostMatchesInDay == nil || all.MostMatchesInDay.Value < 1 {
+		t.Errorf("most matches in a day = %+v, want at least one", all.MostMatchesInDay)
+	}
+	if all.BiggestMarketPayout != nil {
+		t.Errorf("market payout = %+v, want none without markets", all.BiggestMarketPayout)
+	}
+
+	if _, err := records.GameRecords(ctx, newID(t)); !db.IsNoRows(err) {
+		t.Errorf("unknown game error = %v, want not found", err)
+	}
+}
+
+// TestRecords_WinStreakPerGame checks that a game's win streak counts only
+// that game's matches.
+func TestRecords_WinStreakPerGame(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	a := createTestPlayer(t, pool, "StreakA")
+	b := createTestPlayer(t, pool, "StreakB")
+	chess := createTestGame(t, pool, "Streak Chess")
+	poker := createTestGame(t, pool, "Streak Poker")
+
+	svc := elo.NewMatchService(pool, elo.NewMarketService(pool))
+	now := time.Now().Truncate(time.Second)
+	for i, m := range []struct {
+		gameID string
+		scores map[string]float64
+	}{
+		{chess, map[string]float64{a: 10, b: 1}},
+		{poker, map[string]float64{a: 1, b: 10}},
+		{poker, map[string]float64{a: 1, b: 10}},
+		{chess, map[string]float64{a: 10, b: 1}},
+		{poker, map[string]float64{a: 1, b: 10}},
+	} {
+		if _, err := svc.AddMatch(ctx, m.gameID, m.scores, now.Add(time.Duration(i-5)*time.Minute), elo.AddMatchOpts{ID: newID(t), ClientDate: true}); err != nil {
+			t.Fatalf("AddMatch: %v", err)
+		}
+	}
+
+	records := elo.NewRecordsService(pool)
+	got, err := records.GameRecords(ctx, chess)
+	if err != nil {
+		t.Fatalf("GameRecords: %v", err)
+	}
+	if s := got.LongestWinStreak; s == nil || s.PlayerID != a || s.Length != 2 {
+		t.Errorf("chess streak = %+v, want a for 2 matches", s)
+	}
+	all, err := records.Records(ctx)
+	if err != nil {
+		t.Fatalf("Records: %v", err)
+	}
+	if s := all.LongestWinStreak; s == nil || s.PlayerID != b || s.Length != 2 {
+		t.Errorf("global streak = %+v, want b's first run of 2", s)
+	}
+}
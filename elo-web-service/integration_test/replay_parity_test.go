@@ -0,0 +1,151 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tolyandre/elo-web-service/pkg/elo"
+)
+
+// TestRecalculate_InMemoryMatchesByEvent replays a history with every kind of
+// derived event — decay, a cooperative match, a correction and a market
+// resolved by a match — and a game rating parameter version through both
+// replay paths and compares the results.
+func TestRecalculate_InMemoryMatchesByEvent(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	if _, err := pool.Exec(ctx, `UPDATE elo_settings SET inactivity_decay_days = 2, inactivity_decay_rate = 0.5`); err != nil {
+		t.Fatalf("enable decay: %v", err)
+	}
+
+	playerA := createTestPlayer(t, pool, "ReplayA")
+	playerB := createTestPlayer(t, pool, "ReplayB")
+	playerC := createTestPlayer(t, pool, "ReplayC")
+	playerD := createTestPlayer(t, pool, "ReplayD")
+	guarantor := createTestPlayer(t, pool, "ReplayGuarantor")
+	chess := createTestGame(t, pool, "Replay Chess")
+	hanabi := createTestGame(t, pool, "Replay Hanabi")
+	adminID := createTestAdmin(t, pool)
+
+	marketSvc := elo.NewMarketService(pool)
+	svc := elo.NewMatchService(pool, marketSvc)
+	correctionSvc := elo.NewCorrectionService(pool)
+
+	now := time.Now().Truncate(time.Second)
+	day := 24 * time.Hour
+	// Chess weighs more from the second match on.
+	if _, err := pool.Exec(ctx, `INSERT INTO game_rating_params (game_id, effective_date, elo_const_k, elo_const_d) VALUES ($1, $2, 48, 300)`,
+		chess, now.Add(-9*day)); err != nil {
+		t.Fatalf("insert game rating params: %v", err)
+	}
+	add := func(gameID string, scores map[string]float64, date time.Time, coop *elo.Cooperative) {
+		t.Helper()
+		opts := elo.AddMatchOpts{ID: newID(t), ClientDate: true, Cooperative: coop}
+		if _, err := svc.AddMatch(ctx, gameID, scores, date, opts); err != nil {
+			t.Fatalf("AddMatch: %v", err)
+		}
+	}
+
+	add(chess, map[string]float64{playerA: 10, playerB: 5, playerC: 1}, now.Add(-10*day), nil)
+	add(chess, map[string]float64{playerC: 7, playerD: 3}, now.Add(-9*day), nil)
+	add(hanabi, map[string]float64{playerA: 20, playerC: 20}, now.Add(-6*day), &elo.Cooperative{Won: true, GlobalArena: true})
+	add(hanabi, map[string]float64{playerB: 12, playerD: 12}, now.Add(-5*day), &elo.Cooperative{Won: false})
+	add(chess, map[string]float64{playerA: 2, playerD: 9}, now.Add(-3*day), nil)
+
+	if err := correctionSvc.CreateGlobalArenaRatingCorrection(ctx, newID(t), playerD, 15); err != nil {
+		t.Fatalf("CreateGlobalArenaRatingCorrection: %v", err)
+	}
+
+	market, err := marketSvc.CreateMarket(ctx, elo.CreateMarketParams{
+		ID:                 newID(t),
+		MarketType:         "match_winner",
+		StartsAt:           now.Add(-time.Minute),
+		ClosesAt:           now.Add(day),
+		CreatedBy:          adminID,
+		GuarantorPlayerIDs: []string{guarantor},
+		MatchWinner: &elo.MatchWinnerCreateParams{
+			TargetPlayerIDs:   []string{playerA, playerB},
+			AllowOtherPlayers: true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateMarket: %v", err)
+	}
+	outcomeA := marketOutcomeID(t, ctx, marketSvc, market.ID, "player", playerA)
+	if err := placeBetAtCurrentPrice(ctx, t, marketSvc, market.ID, playerB, outcomeA, 1); err != nil {
+		t.Fatalf("PlaceBet: %v", err)
+	}
+	if _, err := svc.AddMatch(ctx, chess, map[string]float64{playerA: 8, playerB: 4}, time.Now(), newMatchOpts(t)); err != nil {
+		t.Fatalf("AddMatch (trigger): %v", err)
+	}
+
+	if err := svc.(*elo.MatchService).RecalculateByEvent(ctx, time.Time{}); err != nil {
+		t.Fatalf("RecalculateByEvent: %v", err)
+	}
+	want := replaySnapshot(t, pool)
+
+	if err := svc.RecalculateAllGameElo(ctx); err != nil {
+		t.Fatalf("RecalculateAllGameElo: %v", err)
+	}
+	got := replaySnapshot(t, pool)
+
+	if len(got) != len(want) {
+		t.Fatalf("in-memory replay produced %d rows, per-event replay %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("row %d differs:\n got  %s\n want %s", i, got[i], want[i])
+		}
+	}
+}
+
+// replaySnapshot lists every derived row a replay writes, without generated
+// ids and rounded past float noise, in a stable order.
+func replaySnapshot(t *testing.T, pool *pgxpool.Pool) []string {
+	t.Helper()
+	queries := []string{
+		`SELECT format('global %s %s %s %s %s %s %s %s %s %s %s %s %s %s',
+		    player_id, date, discriminator, match_id, market_id, correction_id,
+		    round(rating_after::numeric, 6), round(elo_after::numeric, 6),
+		    round(elo_staked::numeric, 6), round(elo_earned::numeric, 6),
+		    round(rating_staked::numeric, 6), round(rating_earned::numeric, 6),
+		    league, round(deviation_after::numeric, 6))
+		 FROM global_arena_settlement`,
+		`SELECT format('game %s %s %s %s %s %s %s %s %s %s %s',
+		    player_id, game_id, date, match_id,
+		    round(rating_after::numeric, 6), round(elo_after::numeric, 6),
+		    round(elo_staked::numeric, 6), round(elo_earned::numeric, 6),
+		    round(rating_staked::numeric, 6), round(rating_earned::numeric, 6), league)
+		 FROM game_arena_settlement`,
+		`SELECT format('virtual %s %s %s %s', game_id, match_id, date, round(elo_after::numeric, 6))
+		 FROM game_virtual_opponent_settlement`,
+		`SELECT format('market %s %s %s %s', id, status, resolved_at, resolution_outcome) FROM markets`,
+		`SELECT format('player %s %s', id, round(bet_limit::numeric, 6)) FROM players`,
+	}
+	var rows []string
+	for _, query := range queries {
+		r, err := pool.Query(context.Background(), query+` ORDER BY 1`)
+		if err != nil {
+			t.Fatalf("snapshot query: %v", err)
+		}
+		for r.Next() {
+			var s string
+			if err := r.Scan(&s); err != nil {
+				r.Close()
+				t.Fatalf("scan snapshot row: %v", err)
+			}
+			rows = append(rows, s)
+		}
+		r.Close()
+		if err := r.Err(); err != nil {
+			t.Fatalf("snapshot rows: %v", err)
+		}
+	}
+	return rows
+}
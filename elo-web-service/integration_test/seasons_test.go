@@ -0,0 +1,189 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tolyandre/elo-web-service/pkg/db"
+	"github.com/tolyandre/elo-web-service/pkg/elo"
+)
+
+// TestSeasons_ResetTableAndArchive creates a past season with a soft reset
+// after its matches were played, checks the reset rows, replay parity, the
+// season table and its archived copy.
+func TestSeasons_ResetTableAndArchive(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	alice := createTestPlayer(t, pool, "SeasonAlice")
+	bob := createTestPlayer(t, pool, "SeasonBob")
+	carol := createTestPlayer(t, pool, "SeasonCarol")
+	gameID := createTestGame(t, pool, "Season Chess")
+
+	marketSvc := elo.NewMarketService(pool)
+	svc := elo.NewMatchService(pool, marketSvc)
+	now := time.Now().Truncate(time.Second)
+	day := 24 * time.Hour
+	for _, m := range []struct {
+		scores map[string]float64
+		date   time.Time
+	}{
+		{map[string]float64{alice: 10, bob: 5}, now.Add(-20 * day)},
+		{map[string]float64{bob: 10, carol: 1}, now.Add(-10 * day)},
+	} {
+		if _, err := svc.AddMatch(ctx, gameID, m.scores, m.date, elo.AddMatchOpts{ID: newID(t), ClientDate: true}); err != nil {
+			t.Fatalf("AddMatch: %v", err)
+		}
+	}
+
+	var aliceBefore float64
+	if err := pool.QueryRow(ctx, `SELECT rating_after FROM global_arena_settlement WHERE player_id = $1 ORDER BY date DESC LIMIT 1`, alice).Scan(&aliceBefore); err != nil {
+		t.Fatalf("alice rating: %v", err)
+	}
+
+	seasons := elo.NewSeasonService(pool, marketSvc)
+	season, err := seasons.CreateSeason(ctx, db.CreateSeasonParams{
+		ID:          newID(t),
+		Name:        "Весна",
+		StartsAt:    now.Add(-15 * day),
+		EndsAt:      now.Add(-5 * day),
+		ResetFactor: pgtype.Float8{Float64: 0.5, Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("CreateSeason: %v", err)
+	}
+
+	var resets int
+	var aliceAfter float64
+	if err := pool.QueryRow(ctx, `SELECT COUNT(*) FROM global_arena_settlement WHERE discriminator = 'season_reset'`).Scan(&resets); err != nil || resets != 2 {
+		t.Errorf("reset rows = %d (err %v), want 2: alice and bob", resets, err)
+	}
+	if err := pool.QueryRow(ctx, `SELECT rating_after FROM global_arena_settlement WHERE player_id = $1 AND discriminator = 'season_reset'`, alice).Scan(&aliceAfter); err != nil {
+		t.Fatalf("alice reset row: %v", err)
+	}
+	settings, err := elo.NewEloSettingsService(pool).GetLatest(ctx)
+	if err != nil {
+		t.Fatalf("GetLatest settings: %v", err)
+	}
+	if want := settings.StartingRatingGlobalArena + 0.5*(aliceBefore-settings.StartingRatingGlobalArena); math.Abs(aliceAfter-want) > 1e-9 {
+		t.Errorf("alice rating after the reset = %v, want %v", aliceAfter, want)
+	}
+
+	want := replaySnapshot(t, pool)
+	if err := svc.(*elo.MatchService).RecalculateByEvent(ctx, time.Time{}); err != nil {
+		t.Fatalf("RecalculateByEvent: %v", err)
+	}
+	byEvent := replaySnapshot(t, pool)
+	if err := svc.RecalculateAllGameElo(ctx); err != nil {
+		t.Fatalf("RecalculateAllGameElo: %v", err)
+	}
+	inMemory := replaySnapshot(t, pool)
+	for name, got := range map[string][]string{"per-event": byEvent, "in-memory": inMemory} {
+		if len(got) != len(want) {
+			t.Fatalf("%s replay produced %d rows, want %d", name, len(got), len(want))
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("%s replay row %d differs:\n got  %s\n want %s", name, i, got[i], want[i])
+			}
+		}
+	}
+
+	table, archived, err := seasons.SeasonLeaderboard(ctx, season.ID, "")
+	if err != nil || archived {
+		t.Fatalf("SeasonLeaderboard = archived %v, err %v", archived, err)
+	}
+	if len(table) != 2 || table[0].PlayerID != bob {
+		t.Fatalf("season table = %+v, want bob first of bob and carol", table)
+	}
+	for _, e := range table {
+		if (e.PlayerID == carol) != (e.PreviousRank == nil) {
+			t.Errorf("%s start rank = %v, want it set for everyone but carol", e.Name, e.PreviousRank)
+		}
+	}
+
+	if _, err := seasons.ArchiveSeason(ctx, season.ID); err != nil {
+		t.Fatalf("ArchiveSeason: %v", err)
+	}
+	frozen, archived, err := seasons.SeasonLeaderboard(ctx, season.ID, "")
+	if err != nil || !archived || len(frozen) != len(table) || frozen[0].PlayerID != bob {
+		t.Errorf("archived table = %+v (archived %v, err %v), want the computed one", frozen, archived, err)
+	}
+	champions, err := seasons.ListSeasonChampions(ctx)
+	if err != nil {
+		t.Fatalf("ListSeasonChampions: %v", err)
+	}
+	if len(champions) != 2 || champions[0].GameID != nil || champions[0].PlayerID != bob || champions[1].GameID == nil {
+		t.Errorf("champions = %+v, want bob in the global and the game arena", champions)
+	}
+
+	if _, err := seasons.ArchiveSeason(ctx, season.ID); !errors.Is(err, elo.ErrSeasonArchived) {
+		t.Errorf("second archive error = %v, want ErrSeasonArchived", err)
+	}
+	if _, err := seasons.DeleteSeason(ctx, season.ID); !errors.Is(err, elo.ErrSeasonArchived) {
+		t.Errorf("delete archived season error = %v, want ErrSeasonArchived", err)
+	}
+	if _, err := seasons.CreateSeason(ctx, db.CreateSeasonParams{
+		ID:       newID(t),
+		Name:     "Лето",
+		StartsAt: now.Add(-6 * day),
+		EndsAt:   now.Add(30 * day),
+	}); !errors.Is(err, elo.ErrSeasonOverlap) {
+		t.Errorf("overlapping season error = %v, want ErrSeasonOverlap", err)
+	}
+}
+
+// TestSeasons_DeleteUndoesReset checks that deleting a season replays its
+// reset away.
+func TestSeasons_DeleteUndoesReset(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	alice := createTestPlayer(t, pool, "ResetAlice")
+	bob := createTestPlayer(t, pool, "ResetBob")
+	gameID := createTestGame(t, pool, "Reset Chess")
+
+	marketSvc := elo.NewMarketService(pool)
+	svc := elo.NewMatchService(pool, marketSvc)
+	now := time.Now().Truncate(time.Second)
+	if _, err := svc.AddMatch(ctx, gameID, map[string]float64{alice: 10, bob: 5}, now.Add(-48*time.Hour), elo.AddMatchOpts{ID: newID(t), ClientDate: true}); err != nil {
+		t.Fatalf("AddMatch: %v", err)
+	}
+	want := replaySnapshot(t, pool)
+
+	seasons := elo.NewSeasonService(pool, marketSvc)
+	season, err := seasons.CreateSeason(ctx, db.CreateSeasonParams{
+		ID:          newID(t),
+		Name:        "Осень",
+		StartsAt:    now.Add(-24 * time.Hour),
+		EndsAt:      now.Add(24 * time.Hour),
+		ResetFactor: pgtype.Float8{Float64: 0, Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("CreateSeason: %v", err)
+	}
+	if _, err := seasons.ArchiveSeason(ctx, season.ID); !errors.Is(err, elo.ErrSeasonNotEnded) {
+		t.Errorf("archive of a running season error = %v, want ErrSeasonNotEnded", err)
+	}
+	if _, err := seasons.DeleteSeason(ctx, season.ID); err != nil {
+		t.Fatalf("DeleteSeason: %v", err)
+	}
+
+	got := replaySnapshot(t, pool)
+	if len(got) != len(want) {
+		t.Fatalf("after delete %d rows, before the season %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("row %d differs after delete:\n got  %s\n want %s", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,66 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tolyandre/elo-web-service/pkg/db"
+	"github.com/tolyandre/elo-web-service/pkg/elo"
+)
+
+// TestUpdateMatch_TeamsOmittedKeepsTeams edits a team match without sending
+// teams and checks the assignment survives; an explicit empty assignment
+// turns it into an individual match.
+func TestUpdateMatch_TeamsOmittedKeepsTeams(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	a := createTestPlayer(t, pool, "TeamKeepA")
+	b := createTestPlayer(t, pool, "TeamKeepB")
+	c := createTestPlayer(t, pool, "TeamKeepC")
+	d := createTestPlayer(t, pool, "TeamKeepD")
+	gameID := createTestGame(t, pool, "Team Keep Codenames")
+
+	svc := elo.NewMatchService(pool, elo.NewMarketService(pool))
+	teams := map[string]string{a: "red", b: "red", c: "blue", d: "blue"}
+	m, err := svc.AddMatch(ctx, gameID, map[string]float64{a: 1, b: 1, c: 0, d: 0}, time.Now().Add(-time.Hour), elo.AddMatchOpts{ID: newID(t), ClientDate: true, Teams: teams})
+	if err != nil {
+		t.Fatalf("AddMatch: %v", err)
+	}
+	storedTeams := func() map[string]string {
+		t.Helper()
+		rows, err := db.New(pool).GetMatchScoresForMatch(ctx, m.ID)
+		if err != nil {
+			t.Fatalf("GetMatchScoresForMatch: %v", err)
+		}
+		got := make(map[string]string)
+		for _, r := range rows {
+			if r.Team.Valid {
+				got[r.PlayerID] = r.Team.String
+			}
+		}
+		return got
+	}
+
+	// The blue team now wins; teams are not sent.
+	if _, err := svc.UpdateMatch(ctx, m.ID, gameID, map[string]float64{a: 0, b: 0, c: 1, d: 1}, m.Date.Time, elo.UpdateMatchOpts{}); err != nil {
+		t.Fatalf("UpdateMatch without teams: %v", err)
+	}
+	got := storedTeams()
+	for playerID, team := range teams {
+		if got[playerID] != team {
+			t.Errorf("team of %s = %q, want %q", playerID, got[playerID], team)
+		}
+	}
+
+	if _, err := svc.UpdateMatch(ctx, m.ID, gameID, map[string]float64{a: 3, b: 2, c: 1, d: 0}, m.Date.Time, elo.UpdateMatchOpts{Teams: map[string]string{}}); err != nil {
+		t.Fatalf("UpdateMatch clearing teams: %v", err)
+	}
+	if got := storedTeams(); len(got) != 0 {
+		t.Errorf("teams after clearing = %v, want none", got)
+	}
+}
@@ -46,8 +46,14 @@ func main() {
 	apiHandler := api.New(pool)
 	oauth2Handler := oauth2.New(pool)
 
+	if err := apiHandler.MatchService.BackfillAchievements(context.Background()); err != nil {
+		log.Fatalf("achievements backfill failed: %v", err)
+	}
+
 	go apiHandler.MarketService.ScheduleNextExpiry(context.Background())
 	go apiHandler.SkullKingTableService.ScheduleNextCleanup(context.Background())
+	go apiHandler.MatchService.ScheduleInactivityDecay(context.Background())
+	go apiHandler.MatchService.ScheduleSettlementCheckpoints(context.Background())
 
 	router := gin.Default()
 
@@ -97,6 +103,11 @@ func main() {
 	editorAuth := func() []gin.HandlerFunc {
 		return []gin.HandlerFunc{oauth2Handler.DeserializeUser(), apiHandler.RequireEditor()}
 	}
+	// adminAuth gates the admin-only routes: the overrides of match date
+	// limits, the rating settings simulation and the checkpoint verification.
+	adminAuth := func() []gin.HandlerFunc {
+		return []gin.HandlerFunc{oauth2Handler.DeserializeUser(), apiHandler.RequireAdmin()}
+	}
 	// playerAuth is the player-gated chain (valid session + linked player) used
 	// by the Skull King live-table routes.
 	playerAuth := func() []gin.HandlerFunc {
@@ -108,9 +119,13 @@ func main() {
 	// Players
 	router.GET("/players", strictWrapper.ListPlayers)
 	router.GET("/players/:id/stats", strictWrapper.GetPlayerStats)
+	router.GET("/players/:id/head-to-head/:otherId", strictWrapper.GetPlayerHeadToHead)
+	router.GET("/players/:id/rivals", strictWrapper.GetPlayerRivals)
 	router.POST("/players", append(editorAuth(), strictWrapper.CreatePlayer)...)
 	router.PATCH("/players/:id", append(editorAuth(), strictWrapper.PatchPlayer)...)
 	router.DELETE("/players/:id", append(editorAuth(), strictWrapper.DeletePlayer)...)
+	router.POST("/players/:id/merge", append(editorAuth(), strictWrapper.MergePlayer)...)
+	router.GET("/leaderboard", strictWrapper.GetLeaderboard)
 
 	// Users
 	router.GET("/users", strictWrapper.ListUsers)
@@ -119,9 +134,14 @@ func main() {
 	// Matches
 	router.GET("/matches", strictWrapper.ListMatches)
 	router.POST("/matches", append(editorAuth(), strictWrapper.AddMatch)...)
+	router.POST("/matches/preview", append(editorAuth(), strictWrapper.PreviewMatch)...)
 	router.GET("/matches/:id", strictWrapper.GetMatchById)
 	router.GET("/matches/:id/markets", strictWrapper.GetMarketsByMatchId)
 	router.PUT("/matches/:id", append(editorAuth(), strictWrapper.UpdateMatch)...)
+	router.DELETE("/matches/:id", append(editorAuth(), strictWrapper.DeleteMatch)...)
+	router.POST("/predictions", strictWrapper.PredictMatch)
+	router.POST("/matchmaking", strictWrapper.SuggestTables)
+	router.GET("/records", strictWrapper.GetRecords)
 
 	// Settings
 	router.GET("/settings", strictWrapper.GetSettings)
@@ -133,12 +153,19 @@ func main() {
 	router.GET("/games", strictWrapper.ListGames)
 	router.GET("/games/:id", strictWrapper.GetGame)
 	router.GET("/games/:id/matches", strictWrapper.GetGameMatches)
+	router.GET("/games/:id/records", strictWrapper.GetGameRecords)
 	router.DELETE("/games/:id", append(editorAuth(), strictWrapper.DeleteGame)...)
 	router.PATCH("/games/:id", append(editorAuth(), strictWrapper.PatchGame)...)
+	router.POST("/games/:id/merge", append(editorAuth(), strictWrapper.MergeGame)...)
 	router.POST("/games", append(editorAuth(), strictWrapper.CreateGame)...)
 	router.POST("/admin/recalculate-game-elo", strictWrapper.RecalculateGameElo)
 	router.POST("/admin/players/:id/corrections", append(editorAuth(), strictWrapper.CreatePlayerCorrection)...)
+	router.POST("/admin/settings/simulate", append(adminAuth(), strictWrapper.SimulateSettings)...)
+	router.POST("/admin/checkpoints/verify", append(adminAuth(), strictWrapper.VerifySettlementCheckpoints)...)
+	router.POST("/admin/matches", append(adminAuth(), strictWrapper.AdminAddMatch)...)
+	router.PUT("/admin/matches/:id", append(adminAuth(), strictWrapper.AdminUpdateMatch)...)
 	router.GET("/corrections", strictWrapper.ListCorrections)
+	router.GET("/audit", strictWrapper.ListAuditEntries)
 
 	// Voice
 	router.POST("/voice/parse", append(editorAuth(), strictWrapper.ParseVoiceInput)...)
@@ -177,6 +204,22 @@ func main() {
 	router.PUT("/tournaments/:id", append(editorAuth(), strictWrapper.UpdateTournament)...)
 	router.DELETE("/tournaments/:id", append(editorAuth(), strictWrapper.DeleteTournament)...)
 
+	// Seasons: creating or deleting one may replay history (its rating reset)
+	router.GET("/seasons", strictWrapper.ListSeasons)
+	router.GET("/seasons/:id/leaderboard", strictWrapper.GetSeasonLeaderboard)
+	router.POST("/seasons", append(editorAuth(), strictWrapper.CreateSeason)...)
+	router.DELETE("/seasons/:id", append(editorAuth(), strictWrapper.DeleteSeason)...)
+	router.POST("/seasons/:id/archive", append(editorAuth(), strictWrapper.ArchiveSeason)...)
+
+	// Custom arenas
+	router.GET("/arenas", strictWrapper.ListArenas)
+	router.GET("/arenas/:id", strictWrapper.GetArena)
+	router.GET("/arenas/:id/leaderboard", strictWrapper.GetArenaLeaderboard)
+	router.GET("/arenas/:id/players/:playerId/history", strictWrapper.GetArenaPlayerHistory)
+	router.POST("/arenas", append(editorAuth(), strictWrapper.CreateArena)...)
+	router.PUT("/arenas/:id", append(editorAuth(), strictWrapper.UpdateArena)...)
+	router.DELETE("/arenas/:id", append(editorAuth(), strictWrapper.DeleteArena)...)
+
 	// Markets
 	router.GET("/markets", oauth2Handler.OptionalDeserializeUser(), strictWrapper.ListMarkets)
 	router.POST("/markets", append(editorAuth(), strictWrapper.CreateMarket)...)
@@ -0,0 +1,16 @@
+-- Migration 042: Team assignment per match player.
+--
+-- Team games (Codenames, Decrypto, partnership card games) are recorded as a
+-- regular match whose players are grouped into teams. `team` is a free-form
+-- label that is only meaningful within its own match ("A", "Красные", …);
+-- NULL for every player of an individual match. Either all players of a match
+-- have a team or none do — enforced by the service, not by the schema, because
+-- the rows of one match are written one at a time.
+--
+-- Settlement treats each team as a single opponent: the team's Elo is the mean
+-- of its members' Elo and every member receives the team's staked/earned delta
+-- (global and game arena alike). Teammates share one score, so "player holds the
+-- maximum score" keeps meaning "player's team won" for win-streak statistics.
+
+ALTER TABLE match_scores
+    ADD COLUMN team TEXT NULL;
@@ -0,0 +1,41 @@
+-- Migration 043: Cooperative matches (players vs. the game).
+--
+-- In a cooperative game (Pandemic, Spirit Island, Hanabi) the whole group wins
+-- or loses together against the game itself. Such a match is settled as a team
+-- of all its players against a per-game "virtual opponent" whose Elo is learned
+-- from every cooperative result of that game: a hard game keeps beating groups
+-- and climbs, an easy one sinks. Players' scores stay in match_scores for
+-- display (group points, if the game has any); the outcome is
+-- cooperative_result.
+--
+--   cooperative_result        NULL for a competitive match; 'won' / 'lost' for
+--                             a cooperative one.
+--   cooperative_global_arena  whether the result also moves the global arena.
+--                             When false only the game arena is settled and the
+--                             match has no global_arena_settlement rows.
+--
+-- The virtual opponent's Elo track is a settlement like any other (ADR-01):
+-- one row per cooperative match, upserted during EventProcessor replay in match
+-- order, so RecalculateFrom reproduces it deterministically.
+
+ALTER TABLE matches
+    ADD COLUMN cooperative_result       TEXT    NULL CHECK (cooperative_result IN ('won', 'lost')),
+    ADD COLUMN cooperative_global_arena BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE matches
+    ADD CONSTRAINT matches_cooperative_global_arena_consistency CHECK (
+        cooperative_result IS NOT NULL OR cooperative_global_arena = false
+    );
+
+CREATE TABLE game_virtual_opponent_settlement (
+    id         UUID                     NOT NULL PRIMARY KEY,
+    game_id    UUID                     NOT NULL REFERENCES games(id),
+    match_id   UUID                     NOT NULL UNIQUE REFERENCES matches(id),
+    date       TIMESTAMP WITH TIME ZONE NOT NULL,
+    elo_after  FLOAT                    NOT NULL,
+    elo_staked FLOAT                    NOT NULL,
+    elo_earned FLOAT                    NOT NULL
+);
+
+CREATE INDEX game_virtual_opponent_settlement_game_date_idx
+    ON game_virtual_opponent_settlement (game_id, date, match_id);
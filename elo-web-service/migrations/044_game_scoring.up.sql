@@ -0,0 +1,23 @@
+-- Migration 044: Per-game scoring rules.
+--
+-- Until now every game was "highest score wins". Two game-level settings make
+-- the engine read match_scores.score according to the game:
+--
+--   scoring_direction  'higher_wins' (default) or 'lower_wins' (Hearts,
+--                      golf-style games).
+--   result_type        'score' (default) — score holds the game's points;
+--                      'placement' — only the finishing order is known and
+--                      score holds the place: 1, 2, 2, 4 … (standard
+--                      competition ranking, ties share a place). Placement is
+--                      lower-is-better by definition, scoring_direction is
+--                      ignored for it.
+--
+-- The raw score is never rewritten: the engine converts it into a
+-- higher-is-better ranking score at settlement time (pkg/elo/scoring.go), so
+-- changing a game's rules only requires replaying Elo from its first match.
+
+ALTER TABLE games
+    ADD COLUMN scoring_direction TEXT NOT NULL DEFAULT 'higher_wins'
+        CHECK (scoring_direction IN ('higher_wins', 'lower_wins')),
+    ADD COLUMN result_type       TEXT NOT NULL DEFAULT 'score'
+        CHECK (result_type IN ('score', 'placement'));
@@ -0,0 +1,49 @@
+-- Migration 045: Pluggable rating algorithms per arena.
+--
+-- The true-skill track of each arena (settlement elo_after) was always the
+-- classic multiplayer Elo. Two more algorithms can now drive it, chosen per
+-- arena in the elo_settings history so that a switch scheduled for a date is
+-- replayed correctly by RecalculateFrom (settings are looked up by match date):
+--
+--   'elo'        multiplayer Elo (K, D, win_reward) — unchanged default;
+--   'glicko2'    Glicko-2: every other side of the match is an opponent in
+--                one rating period; tracks rating deviation and volatility;
+--   'trueskill'  TrueSkill over the match ranking (equal scores draw);
+--                tracks the skill's standard deviation.
+--
+-- All three run on the Elo scale and are seeded at starting_elo, so the
+-- display rating track and leagues (ADR-03) work unchanged on top of them.
+--
+-- Settlements carry the algorithm state beyond the rating itself:
+--
+--   deviation_after   Glicko-2 RD / TrueSkill sigma after the event; NULL for
+--                     Elo settlements and for corrections / markets.
+--   volatility_after  Glicko-2 volatility; NULL otherwise.
+--
+-- Uncertainty is read from the latest settlement that tracked it; a player who
+-- has none starts from the algorithm's starting deviation / volatility.
+
+ALTER TABLE elo_settings
+    ADD COLUMN global_arena_algorithm      TEXT  NOT NULL DEFAULT 'elo'
+        CHECK (global_arena_algorithm IN ('elo', 'glicko2', 'trueskill')),
+    ADD COLUMN game_arena_algorithm        TEXT  NOT NULL DEFAULT 'elo'
+        CHECK (game_arena_algorithm IN ('elo', 'glicko2', 'trueskill')),
+    ADD COLUMN glicko2_starting_deviation  FLOAT NOT NULL DEFAULT 350,
+    ADD COLUMN glicko2_starting_volatility FLOAT NOT NULL DEFAULT 0.06,
+    ADD COLUMN glicko2_tau                 FLOAT NOT NULL DEFAULT 0.5,
+    ADD COLUMN trueskill_starting_sigma    FLOAT NOT NULL DEFAULT 400,
+    ADD COLUMN trueskill_beta              FLOAT NOT NULL DEFAULT 200,
+    ADD COLUMN trueskill_tau               FLOAT NOT NULL DEFAULT 4,
+    ADD COLUMN trueskill_draw_probability  FLOAT NOT NULL DEFAULT 0.1;
+
+ALTER TABLE global_arena_settlement
+    ADD COLUMN deviation_after  FLOAT NULL,
+    ADD COLUMN volatility_after FLOAT NULL;
+
+ALTER TABLE game_arena_settlement
+    ADD COLUMN deviation_after  FLOAT NULL,
+    ADD COLUMN volatility_after FLOAT NULL;
+
+ALTER TABLE game_virtual_opponent_settlement
+    ADD COLUMN deviation_after  FLOAT NULL,
+    ADD COLUMN volatility_after FLOAT NULL;
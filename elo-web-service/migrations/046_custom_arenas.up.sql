@@ -0,0 +1,52 @@
+-- Migration 046: User-defined arenas (ADR-02).
+--
+-- Besides the built-in global and per-game arenas, editors can define arenas
+-- as filtered competitive scopes with an independent rating. A match enters an
+-- arena when it passes every filter that is set:
+--
+--   game_ids       the match's game is one of these (empty: any game);
+--   starts_at /    the match date is inside [starts_at, ends_at)
+--   ends_at        (NULL: open-ended);
+--   tournament_id  the match is linked to this tournament;
+--   player_ids /   only the listed players / current club members are rated;
+--   club_id        the match is settled as a sub-match among them and needs
+--                  at least two sides left (empty / NULL: everyone).
+--
+-- Cooperative matches are not part of custom arenas: their virtual opponent is
+-- a per-game construct.
+--
+-- arena_settlement is the generic settlement of a custom arena, one row per
+-- (arena, match, player). Like game_arena_settlement it is rewritten per match
+-- during EventProcessor replay, so RecalculateFrom keeps it in sync; changing
+-- an arena's filters rebuilds that arena from scratch. The arena's true-skill
+-- track runs the global arena's rating algorithm from elo_settings.
+
+CREATE TABLE arenas (
+    id            UUID                     PRIMARY KEY,
+    name          TEXT                     NOT NULL,
+    game_ids      UUID[]                   NOT NULL DEFAULT '{}',
+    player_ids    UUID[]                   NOT NULL DEFAULT '{}',
+    club_id       UUID                     NULL REFERENCES clubs(id),
+    tournament_id UUID                     NULL REFERENCES tournaments(id),
+    starts_at     TIMESTAMP WITH TIME ZONE NULL,
+    ends_at       TIMESTAMP WITH TIME ZONE NULL,
+    CONSTRAINT arenas_name_unique UNIQUE (name),
+    CONSTRAINT arenas_dates_check CHECK (starts_at IS NULL OR ends_at IS NULL OR starts_at < ends_at)
+);
+
+CREATE TABLE arena_settlement (
+    id               UUID                     NOT NULL PRIMARY KEY,
+    arena_id         UUID                     NOT NULL REFERENCES arenas(id) ON DELETE CASCADE,
+    player_id        UUID                     NOT NULL REFERENCES players(id),
+    match_id         UUID                     NOT NULL REFERENCES matches(id),
+    date             TIMESTAMP WITH TIME ZONE NOT NULL,
+    elo_after        FLOAT                    NOT NULL,
+    elo_staked       FLOAT                    NOT NULL,
+    elo_earned       FLOAT                    NOT NULL,
+    deviation_after  FLOAT                    NULL,
+    volatility_after FLOAT                    NULL,
+    CONSTRAINT arena_settlement_match_unique UNIQUE (arena_id, match_id, player_id)
+);
+
+CREATE INDEX arena_settlement_arena_player_date_idx ON arena_settlement (arena_id, player_id, date);
+CREATE INDEX arena_settlement_match_idx ON arena_settlement (match_id);
@@ -0,0 +1,34 @@
+-- Migration 047: Configurable league ladders for custom arenas (ADR-02).
+--
+-- arenas.league_ladder is an optional ladder of leagues (NULL: the arena has
+-- no leagues). Leagues are listed from the lowest; every league above the
+-- first names the progression rules a player must meet to enter it:
+--
+--   cubes           arena matches played (one "cube" per match);
+--   meeples         every opponent in a match gives 1/(n−1) meeple, n being
+--                   the number of rated players, at most 2 per unique opponent;
+--   rating          arena Elo;
+--   recent_matches  arena matches within the last `days` days.
+--
+-- A league may also be kept only while its rules hold ("keep") and cost a
+-- league for every `inactivity_days` without an arena match. The document is
+-- validated by elo.ParseLeagueLadder, e.g.
+--
+--   {"leagues": [
+--     {"name": "bronze"},
+--     {"name": "silver", "requires": [{"kind": "cubes", "min": 10}], "inactivity_days": 90},
+--     {"name": "gold", "requires": [{"kind": "meeples", "min": 10}, {"kind": "rating", "min": 1100}], "keep": true}
+--   ]}
+--
+-- Progress is persisted with every arena settlement: cubes and meeples are
+-- running totals, league is the league after the match (NULL without a
+-- ladder). The built-in global and game arenas keep their ADR-03 leagues,
+-- which drive the display rating; the same ladder engine evaluates them.
+
+ALTER TABLE arenas
+    ADD COLUMN league_ladder JSONB NULL;
+
+ALTER TABLE arena_settlement
+    ADD COLUMN league  TEXT  NULL,
+    ADD COLUMN cubes   FLOAT NOT NULL DEFAULT 0,
+    ADD COLUMN meeples FLOAT NOT NULL DEFAULT 0;
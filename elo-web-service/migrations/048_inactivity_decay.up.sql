@@ -0,0 +1,38 @@
+-- Migration 048: Inactivity rating decay (global arena display rating).
+--
+-- A player whose last global match is older than inactivity_decay_days (taken
+-- from the settings in force on that match date; 0 disables decay) loses
+-- inactivity_decay_rate of their display rating above the starting rating.
+-- The drop is one global_arena_settlement row with discriminator 'decay',
+-- dated last match + inactivity_decay_days, match_id NULL and the loss in
+-- rating_staked. Elo and league are carried over unchanged.
+--
+-- When the player plays again the drop is reversed by a second 'decay' row
+-- that references the match (match_id) and carries the amount back in
+-- rating_earned; it precedes the match's own settlement, which is therefore
+-- rated from the restored rating. A match row and its reversal share
+-- (match_id, player_id), so the match unique index now includes the
+-- discriminator, as the market one does since migration 039.
+--
+-- Decay rows are derived: RecalculateFrom deletes them with every other
+-- settlement from its start date and the event processor generates them again
+-- in event order, so replay is deterministic.
+
+ALTER TABLE elo_settings
+    ADD COLUMN inactivity_decay_days INT   NOT NULL DEFAULT 0 CHECK (inactivity_decay_days >= 0),
+    ADD COLUMN inactivity_decay_rate FLOAT NOT NULL DEFAULT 0.1
+        CHECK (inactivity_decay_rate >= 0 AND inactivity_decay_rate <= 1);
+
+ALTER TABLE global_arena_settlement DROP CONSTRAINT global_arena_settlement_discriminator_check;
+ALTER TABLE global_arena_settlement ADD CONSTRAINT global_arena_settlement_discriminator_check
+    CHECK (discriminator IN ('match', 'market', 'market_guarantor', 'correction', 'decay'));
+
+DROP INDEX global_arena_settlement_match_unique;
+
+CREATE UNIQUE INDEX global_arena_settlement_match_unique
+    ON global_arena_settlement (match_id, player_id, discriminator)
+    WHERE match_id IS NOT NULL;
+
+CREATE INDEX global_arena_settlement_decay_idx
+    ON global_arena_settlement (player_id, date)
+    WHERE discriminator = 'decay';
@@ -0,0 +1,62 @@
+-- Migration 049: Settlement checkpoints.
+--
+-- A checkpoint snapshots, as of its date, each player's latest global and game
+-- arena state, each game's virtual opponent and the markets still open. It
+-- covers settlements dated strictly before the date, so RecalculateFrom can
+-- load the state before its window from the nearest checkpoint at or before
+-- the start plus the settlements between the two, instead of scanning all of
+-- history.
+--
+-- Checkpoints are derived like settlements: RecalculateFrom deletes those
+-- dated after its start date. They are taken by a periodic job a day behind
+-- the clock, so that late-written rows (inactivity decay, market expiry) are
+-- already in place, and POST /admin/checkpoints/verify recomputes history from
+-- scratch to diff them against the stored snapshots.
+
+CREATE TABLE settlement_checkpoints (
+    id         UUID        PRIMARY KEY,
+    date       TIMESTAMPTZ NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE checkpoint_global_state (
+    checkpoint_id    UUID   NOT NULL REFERENCES settlement_checkpoints(id) ON DELETE CASCADE,
+    player_id        UUID   NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    elo_after        FLOAT  NOT NULL,
+    rating_after     FLOAT  NOT NULL,
+    league           TEXT   NOT NULL,
+    -- Uncertainty of the latest settlement that tracked it, NULL when none did.
+    deviation_after  FLOAT  NULL,
+    volatility_after FLOAT  NULL,
+    PRIMARY KEY (checkpoint_id, player_id)
+);
+
+CREATE TABLE checkpoint_game_state (
+    checkpoint_id    UUID   NOT NULL REFERENCES settlement_checkpoints(id) ON DELETE CASCADE,
+    player_id        UUID   NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    game_id          UUID   NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    elo_after        FLOAT  NOT NULL,
+    rating_after     FLOAT  NOT NULL,
+    league           TEXT   NOT NULL,
+    deviation_after  FLOAT  NULL,
+    volatility_after FLOAT  NULL,
+    PRIMARY KEY (checkpoint_id, player_id, game_id)
+);
+
+CREATE TABLE checkpoint_virtual_opponent_state (
+    checkpoint_id    UUID   NOT NULL REFERENCES settlement_checkpoints(id) ON DELETE CASCADE,
+    game_id          UUID   NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    elo_after        FLOAT  NOT NULL,
+    deviation_after  FLOAT  NULL,
+    volatility_after FLOAT  NULL,
+    PRIMARY KEY (checkpoint_id, game_id)
+);
+
+-- Markets created before the checkpoint date and not yet resolved or
+-- cancelled at it; betting_closed tells whether betting had closed by then.
+CREATE TABLE checkpoint_markets (
+    checkpoint_id  UUID    NOT NULL REFERENCES settlement_checkpoints(id) ON DELETE CASCADE,
+    market_id      UUID    NOT NULL REFERENCES markets(id) ON DELETE CASCADE,
+    betting_closed BOOLEAN NOT NULL,
+    PRIMARY KEY (checkpoint_id, market_id)
+);
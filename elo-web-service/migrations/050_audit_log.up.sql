@@ -0,0 +1,39 @@
+-- Migration 050: Audit log of user events and history edits.
+--
+-- One row per change made through the API to a match, correction, market,
+-- settings entry, club or tournament: who made it and the entity as it was
+-- before and after (NULL for a create or a delete respectively). Rows are
+-- written in the transaction of the change, so a rolled-back change leaves
+-- none, and are never updated or deleted — the triggers below enforce that.
+--
+-- entity_id is TEXT because settings entries are keyed by their effective
+-- date. user_id has no foreign key so the log outlives deleted users; it is
+-- NULL for changes made without a signed-in user (e.g. tests, jobs).
+-- Ids are UUIDv7, so id order is insertion order and serves as the cursor.
+
+CREATE TABLE audit_log (
+    id          UUID        PRIMARY KEY,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    user_id     UUID        NULL,
+    entity_type TEXT        NOT NULL
+                    CHECK (entity_type IN ('match', 'correction', 'market', 'settings', 'club', 'tournament')),
+    entity_id   TEXT        NOT NULL,
+    action      TEXT        NOT NULL
+                    CHECK (action IN ('create', 'update', 'delete', 'lock', 'add_member', 'remove_member')),
+    before      JSONB       NULL,
+    after       JSONB       NULL
+);
+
+CREATE INDEX audit_log_entity_idx ON audit_log (entity_type, entity_id, id DESC);
+CREATE INDEX audit_log_user_idx ON audit_log (user_id, id DESC);
+
+CREATE FUNCTION audit_log_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_no_update BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_append_only();
+CREATE TRIGGER audit_log_no_truncate BEFORE TRUNCATE ON audit_log
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_log_append_only();
@@ -0,0 +1,11 @@
+-- Migration 051: Admin override for match dates.
+--
+-- Editors may add a match at most 30 days back and move a match by at most
+-- three days. Admins may do either at any past date — e.g. to import a
+-- forgotten weekend — but must give a reason, kept with the audit entry of
+-- the change. There is no API to grant the flag; set it in the database.
+
+ALTER TABLE users ADD COLUMN is_admin BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Why the change was made; NULL for ordinary changes.
+ALTER TABLE audit_log ADD COLUMN reason TEXT NULL;
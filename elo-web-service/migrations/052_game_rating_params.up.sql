@@ -0,0 +1,20 @@
+-- Migration 052: Per-game rating parameters.
+--
+-- A short filler and a four-hour game used to move ratings by the same K.
+-- A game may now override K, D and win_reward, versioned by effective date
+-- like elo_settings: a match uses the game's latest row dated at or before
+-- it, and a NULL column (or no row at all) falls back to elo_settings.
+--
+-- The game arena rates with the overridden values. The global arena keeps
+-- its own D and win_reward but takes the game's K in place of the
+-- elo_settings K, so a long game moves global ratings more than a filler
+-- while every game is still compared on the same scale.
+
+CREATE TABLE game_rating_params (
+    game_id        UUID        NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    effective_date TIMESTAMPTZ NOT NULL,
+    elo_const_k    FLOAT       NULL CHECK (elo_const_k > 0),
+    elo_const_d    FLOAT       NULL CHECK (elo_const_d > 0),
+    win_reward     FLOAT       NULL CHECK (win_reward BETWEEN 0.1 AND 5),
+    PRIMARY KEY (game_id, effective_date)
+);
@@ -0,0 +1,14 @@
+-- Migration 053: Merging duplicate players.
+--
+-- Offline entry keeps creating the same person twice ("Саша", "Саша К.").
+-- A merge moves every reference to the duplicate over to the kept player,
+-- deletes the duplicate and replays history from its first event. The audit
+-- log records it against the kept player, with the duplicate as "before".
+
+ALTER TABLE audit_log DROP CONSTRAINT audit_log_entity_type_check;
+ALTER TABLE audit_log ADD CONSTRAINT audit_log_entity_type_check
+    CHECK (entity_type IN ('match', 'correction', 'market', 'settings', 'club', 'tournament', 'player'));
+
+ALTER TABLE audit_log DROP CONSTRAINT audit_log_action_check;
+ALTER TABLE audit_log ADD CONSTRAINT audit_log_action_check
+    CHECK (action IN ('create', 'update', 'delete', 'lock', 'add_member', 'remove_member', 'merge'));
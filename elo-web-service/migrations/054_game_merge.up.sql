@@ -0,0 +1,11 @@
+-- Migration 054: Merging duplicate games.
+--
+-- "Каркассон" and "Carcassonne" each got a game arena of their own. A merge
+-- moves the duplicate's matches, market and arena game filters and the
+-- calculators' fallback game over to the kept game, deletes the duplicate and
+-- replays history, so the kept game's arena covers both. Like a player merge
+-- it is recorded in the audit log against the kept game.
+
+ALTER TABLE audit_log DROP CONSTRAINT audit_log_entity_type_check;
+ALTER TABLE audit_log ADD CONSTRAINT audit_log_entity_type_check
+    CHECK (entity_type IN ('match', 'correction', 'market', 'settings', 'club', 'tournament', 'player', 'game'));
@@ -0,0 +1,11 @@
+-- Migration 055: Guest players.
+--
+-- A friend who drops by for one evening should be in the match record, and
+-- their score should still count when the other players' results are
+-- normalised, but they should not end up on the leaderboard with a rating
+-- earned from a single game. A guest always plays at the starting Elo and
+-- rating, no settlements are written for them in any arena, and the players
+-- list hides them unless asked to show them. Turning the flag on or off
+-- replays history from the player's first event.
+
+ALTER TABLE players ADD COLUMN is_guest BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,64 @@
+-- Migration 056: Seasons.
+--
+-- A season is a named, non-overlapping window [starts_at, ends_at) of
+-- history, usually a year or a quarter. Its leaderboard ranks the players
+-- who played a match within the window by their rating at its end, next to
+-- their rank and rating at its start.
+--
+-- A season may softly reset the global arena at its start: with reset_factor
+-- f every player settled before starts_at keeps only f of their distance from
+-- the starting rating and the starting Elo. The reset is one
+-- global_arena_settlement row per player with discriminator 'season_reset',
+-- dated one microsecond before starts_at, so it lands after every event of
+-- the previous season and before every event of the new one; match_id is
+-- NULL, the change goes to rating_earned and elo_earned and the league is
+-- carried over. Like decay rows the reset rows are derived: RecalculateFrom
+-- deletes them with every other settlement from its start date and the event
+-- processor generates them again in event order. Game arenas are not reset.
+--
+-- Archiving a season that has ended freezes its final standings in
+-- season_standings, one table for the global arena (game_id NULL) and one for
+-- every game played in the window, with each player's rank and rating at the
+-- start (NULL for a player who was not on the table yet). A replay does not
+-- touch them; rank 1 of each table is that arena's champion.
+
+CREATE TABLE seasons (
+    id           UUID        PRIMARY KEY,
+    name         TEXT        NOT NULL UNIQUE,
+    starts_at    TIMESTAMPTZ NOT NULL,
+    ends_at      TIMESTAMPTZ NOT NULL,
+    reset_factor FLOAT       NULL CHECK (reset_factor >= 0 AND reset_factor < 1),
+    archived_at  TIMESTAMPTZ NULL,
+    CHECK (starts_at < ends_at),
+    EXCLUDE USING gist (tstzrange(starts_at, ends_at) WITH &&)
+);
+
+CREATE TABLE season_standings (
+    season_id    UUID  NOT NULL REFERENCES seasons(id) ON DELETE CASCADE,
+    game_id      UUID  NULL REFERENCES games(id) ON DELETE CASCADE,
+    player_id    UUID  NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    rank         INT   NOT NULL,
+    rating       FLOAT NOT NULL,
+    league       TEXT  NOT NULL,
+    start_rank   INT   NULL,
+    start_rating FLOAT NULL
+);
+
+CREATE UNIQUE INDEX season_standings_unique
+    ON season_standings (season_id, COALESCE(game_id, '00000000-0000-0000-0000-000000000000'::uuid), player_id);
+
+ALTER TABLE global_arena_settlement DROP CONSTRAINT global_arena_settlement_discriminator_check;
+ALTER TABLE global_arena_settlement ADD CONSTRAINT global_arena_settlement_discriminator_check
+    CHECK (discriminator IN ('match', 'market', 'market_guarantor', 'correction', 'decay', 'season_reset'));
+
+CREATE INDEX global_arena_settlement_season_reset_idx
+    ON global_arena_settlement (date)
+    WHERE discriminator = 'season_reset';
+
+ALTER TABLE audit_log DROP CONSTRAINT audit_log_entity_type_check;
+ALTER TABLE audit_log ADD CONSTRAINT audit_log_entity_type_check
+    CHECK (entity_type IN ('match', 'correction', 'market', 'settings', 'club', 'tournament', 'player', 'game', 'season'));
+
+ALTER TABLE audit_log DROP CONSTRAINT audit_log_action_check;
+ALTER TABLE audit_log ADD CONSTRAINT audit_log_action_check
+    CHECK (action IN ('create', 'update', 'delete', 'lock', 'add_member', 'remove_member', 'merge', 'archive'));
@@ -0,0 +1,27 @@
+-- Migration 057: Player achievements.
+--
+-- An achievement is unlocked once per player by a rule over the settled
+-- history: the first sole win, ten sole wins in a row, finishing above the
+-- global leaderboard's #1, playing twenty distinct games, a net gain from a
+-- resolved market, and reaching the elite league. Each row keeps the date of
+-- the unlocking event and its match (for a market, the match that resolved
+-- it, if any).
+--
+-- Like settlements the rows are derived. After every replay and every new
+-- match the match rules are evaluated again from the edited date on, in
+-- event order, and the table is brought in line, so editing history moves,
+-- adds or removes achievements deterministically; rows unlocked before that
+-- date stand. Elite league and market wins are re-read over the whole
+-- history. Guests never unlock any. The table starts empty; the service
+-- fills it from the whole history on its first start after this migration.
+
+CREATE TABLE player_achievements (
+    player_id   UUID        NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    kind        TEXT        NOT NULL CHECK (kind IN (
+                    'first_win', 'win_streak_10', 'beat_leader',
+                    'distinct_games_20', 'market_win', 'elite_league')),
+    unlocked_at TIMESTAMPTZ NOT NULL,
+    match_id    UUID        NULL REFERENCES matches(id) ON DELETE CASCADE,
+    market_id   UUID        NULL REFERENCES markets(id) ON DELETE CASCADE,
+    PRIMARY KEY (player_id, kind)
+);
@@ -0,0 +1,10 @@
+-- Migration 058: Audit game rating parameter changes.
+--
+-- Setting a game's K, D or win_reward replays history from the version's
+-- effective date, so it is audited like the other history-rewriting changes:
+-- a 'rating_params' entry on the game with the version in effect at that date
+-- before and the stored version after.
+
+ALTER TABLE audit_log DROP CONSTRAINT audit_log_action_check;
+ALTER TABLE audit_log ADD CONSTRAINT audit_log_action_check
+    CHECK (action IN ('create', 'update', 'delete', 'lock', 'add_member', 'remove_member', 'merge', 'archive', 'rating_params'));
@@ -0,0 +1,26 @@
+-- Migration 059: One decay drop per player and date.
+--
+-- Decay drops are written by whichever transaction first calls
+-- applyTimedSettlements past their date: the hourly decay job, market expiry,
+-- corrections and new matches. Each one lists the players still missing a
+-- drop and inserts it, so two concurrent callers could both write the same
+-- drop and the player would lose the rating twice. A player has at most one
+-- drop (match_id NULL) on a date, so the drop becomes unique and the insert
+-- skips a drop another transaction has already written.
+--
+-- Existing duplicates keep their first row; a full replay rewrites the rows
+-- that follow them.
+
+DELETE FROM global_arena_settlement d
+USING global_arena_settlement keep
+WHERE d.discriminator = 'decay'
+  AND d.match_id IS NULL
+  AND keep.discriminator = 'decay'
+  AND keep.match_id IS NULL
+  AND keep.player_id = d.player_id
+  AND keep.date = d.date
+  AND keep.id < d.id;
+
+CREATE UNIQUE INDEX global_arena_settlement_decay_drop_unique
+    ON global_arena_settlement (player_id, date)
+    WHERE discriminator = 'decay' AND match_id IS NULL;
@@ -0,0 +1,21 @@
+-- Migration 060: One season reset per player and date.
+--
+-- Season resets are caught up by applyTimedSettlements like decay drops
+-- (migration 059), so two concurrent callers could both write a player's
+-- reset. The reset becomes unique and the insert skips a reset another
+-- transaction has already written.
+--
+-- Existing duplicates keep their first row; a full replay rewrites the rows
+-- that follow them.
+
+DELETE FROM global_arena_settlement d
+USING global_arena_settlement keep
+WHERE d.discriminator = 'season_reset'
+  AND keep.discriminator = 'season_reset'
+  AND keep.player_id = d.player_id
+  AND keep.date = d.date
+  AND keep.id < d.id;
+
+CREATE UNIQUE INDEX global_arena_settlement_season_reset_unique
+    ON global_arena_settlement (player_id, date)
+    WHERE discriminator = 'season_reset';
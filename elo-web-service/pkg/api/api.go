@@ -11,11 +11,16 @@ type API struct {
 	GameService           elo.IGameService
 	PlayerService         elo.IPlayerService
 	MatchService          elo.IMatchService
+	MatchmakingService    elo.IMatchmakingService
+	RecordsService        elo.IRecordsService
 	MarketService         elo.IMarketService
 	CorrectionService     elo.ICorrectionService
 	EloSettingsService    elo.IEloSettingsService
 	ClubService           elo.IClubService
 	TournamentService     elo.ITournamentService
+	SeasonService         elo.ISeasonService
+	ArenaService          elo.IArenaService
+	AuditService          elo.IAuditService
 	SkullKingTableService elo.ISkullKingTableService
 	SkullKingHub          *elo.SkullKingHub
 	MarketsHub            *elo.MarketsHub
@@ -33,11 +38,16 @@ func New(pool *pgxpool.Pool) *API {
 		GameService:           elo.NewGameService(pool),
 		PlayerService:         elo.NewPlayerService(pool),
 		MatchService:          elo.NewMatchService(pool, marketService),
+		MatchmakingService:    elo.NewMatchmakingService(pool),
+		RecordsService:        elo.NewRecordsService(pool),
 		MarketService:         marketService,
 		CorrectionService:     elo.NewCorrectionService(pool),
 		EloSettingsService:    elo.NewEloSettingsService(pool),
 		ClubService:           elo.NewClubService(pool),
 		TournamentService:     elo.NewTournamentService(pool),
+		SeasonService:         elo.NewSeasonService(pool, marketService),
+		ArenaService:          elo.NewArenaService(pool),
+		AuditService:          elo.NewAuditService(pool),
 		SkullKingHub:          skullKingHub,
 		SkullKingTableService: elo.NewSkullKingTableService(pool, skullKingHub),
 		MarketsHub:            marketsHub,
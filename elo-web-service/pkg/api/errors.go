@@ -33,15 +33,35 @@ func domainStatusCode(err error) int {
 	case errors.Is(err, elo.ErrTooFewPlayers),
 		errors.Is(err, elo.ErrDateChangeTooLarge),
 		errors.Is(err, elo.ErrMatchDateOutOfRange),
+		errors.Is(err, elo.ErrMatchDateInFuture),
+		errors.Is(err, elo.ErrOverrideReasonRequired),
+		errors.Is(err, elo.ErrInvalidTeams),
+		errors.Is(err, elo.ErrTeamScoreMismatch),
+		errors.Is(err, elo.ErrInvalidPlacement),
+		errors.Is(err, elo.ErrInvalidGameScoring),
+		errors.Is(err, elo.ErrInvalidRatingAlgorithm),
+		errors.Is(err, elo.ErrInvalidLeagueLadder),
+		errors.Is(err, elo.ErrInvalidEloSettings),
+		errors.Is(err, elo.ErrInvalidGameRatingParams),
+		errors.Is(err, elo.ErrPlayerMergeSelf),
+		errors.Is(err, elo.ErrGameMergeSelf),
+		errors.Is(err, elo.ErrInvalidLeaderboardPeriod),
+		errors.Is(err, elo.ErrInvalidSeason),
+		errors.Is(err, elo.ErrInvalidMatchmaking),
+		errors.Is(err, elo.ErrMatchmakingOutsider),
+		errors.Is(err, elo.ErrHeadToHeadSelf),
+		errors.Is(err, elo.ErrGuestNotRated),
 		db.IsForeignKeyViolation(err):
 		return http.StatusBadRequest
 
-	// --- 403 Forbidden: authenticated but lacking a linked player -----------
-	case errors.Is(err, elo.ErrPlayerHasNoLinkedPlayer):
+	// --- 403 Forbidden: authenticated but lacking a player allowed to act ---
+	case errors.Is(err, elo.ErrPlayerHasNoLinkedPlayer),
+		errors.Is(err, elo.ErrGuestCannotBet):
 		return http.StatusForbidden
 
 	// --- 404 Not Found ------------------------------------------------------
 	case errors.Is(err, elo.ErrMatchNotFound),
+		errors.Is(err, elo.ErrClubNotFound),
 		db.IsNoRows(err):
 		return http.StatusNotFound
 
@@ -52,7 +72,15 @@ func domainStatusCode(err error) int {
 		errors.Is(err, elo.ErrTournamentMemberHasMatches),
 		errors.Is(err, elo.ErrTournamentDatesNarrowEloRange),
 		errors.Is(err, elo.ErrTournamentHasMembers),
+		errors.Is(err, elo.ErrTournamentUsedByArena),
+		errors.Is(err, elo.ErrClubUsedByArena),
 		errors.Is(err, elo.ErrPlayerAlreadyLinked),
+		errors.Is(err, elo.ErrPlayerMergeConflict),
+		errors.Is(err, elo.ErrGameMergeConflict),
+		errors.Is(err, elo.ErrGuestHasStakes),
+		errors.Is(err, elo.ErrSeasonOverlap),
+		errors.Is(err, elo.ErrSeasonNotEnded),
+		errors.Is(err, elo.ErrSeasonArchived),
 		db.IsUniqueViolation(err):
 		return http.StatusConflict
 
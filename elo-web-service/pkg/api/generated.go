@@ -45,6 +45,108 @@ func (e ApiSuccessMessageStatus) Valid() bool {
 	}
 }
 
+// Defines values for AuditEntityType.
+const (
+	AuditEntityTypeClub       AuditEntityType = "club"
+	AuditEntityTypeCorrection AuditEntityType = "correction"
+	AuditEntityTypeGame       AuditEntityType = "game"
+	AuditEntityTypeMarket     AuditEntityType = "market"
+	AuditEntityTypeMatch      AuditEntityType = "match"
+	AuditEntityTypePlayer     AuditEntityType = "player"
+	AuditEntityTypeSeason     AuditEntityType = "season"
+	AuditEntityTypeSettings   AuditEntityType = "settings"
+	AuditEntityTypeTournament AuditEntityType = "tournament"
+)
+
+// Valid indicates whether the value is a known member of the AuditEntityType enum.
+func (e AuditEntityType) Valid() bool {
+	switch e {
+	case AuditEntityTypeClub:
+		return true
+	case AuditEntityTypeCorrection:
+		return true
+	case AuditEntityTypeGame:
+		return true
+	case AuditEntityTypeMarket:
+		return true
+	case AuditEntityTypeMatch:
+		return true
+	case AuditEntityTypePlayer:
+		return true
+	case AuditEntityTypeSeason:
+		return true
+	case AuditEntityTypeSettings:
+		return true
+	case AuditEntityTypeTournament:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AuditEntryAction.
+const (
+	AddMember    AuditEntryAction = "add_member"
+	Archive      AuditEntryAction = "archive"
+	Create       AuditEntryAction = "create"
+	Delete       AuditEntryAction = "delete"
+	Lock         AuditEntryAction = "lock"
+	Merge        AuditEntryAction = "merge"
+	RatingParams AuditEntryAction = "rating_params"
+	RemoveMember AuditEntryAction = "remove_member"
+	Update       AuditEntryAction = "update"
+)
+
+// Valid indicates whether the value is a known member of the AuditEntryAction enum.
+func (e AuditEntryAction) Valid() bool {
+	switch e {
+	case AddMember:
+		return true
+	case Archive:
+		return true
+	case Create:
+		return true
+	case Delete:
+		return true
+	case Lock:
+		return true
+	case Merge:
+		return true
+	case RatingParams:
+		return true
+	case RemoveMember:
+		return true
+	case Update:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for CheckpointMismatchScope.
+const (
+	CheckpointMismatchScopeGame    CheckpointMismatchScope = "game"
+	CheckpointMismatchScopeGlobal  CheckpointMismatchScope = "global"
+	CheckpointMismatchScopeMarket  CheckpointMismatchScope = "market"
+	CheckpointMismatchScopeVirtual CheckpointMismatchScope = "virtual"
+)
+
+// Valid indicates whether the value is a known member of the CheckpointMismatchScope enum.
+func (e CheckpointMismatchScope) Valid() bool {
+	switch e {
+	case CheckpointMismatchScopeGame:
+		return true
+	case CheckpointMismatchScopeGlobal:
+		return true
+	case CheckpointMismatchScopeMarket:
+		return true
+	case CheckpointMismatchScopeVirtual:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for EloRankLeague.
 const (
 	EloRankLeagueAmateur EloRankLeague = "amateur"
@@ -84,6 +186,66 @@ func (e GamePlayerLeague) Valid() bool {
 	}
 }
 
+// Defines values for GameResultType.
+const (
+	Placement GameResultType = "placement"
+	Score     GameResultType = "score"
+)
+
+// Valid indicates whether the value is a known member of the GameResultType enum.
+func (e GameResultType) Valid() bool {
+	switch e {
+	case Placement:
+		return true
+	case Score:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GameScoringDirection.
+const (
+	HigherWins GameScoringDirection = "higher_wins"
+	LowerWins  GameScoringDirection = "lower_wins"
+)
+
+// Valid indicates whether the value is a known member of the GameScoringDirection enum.
+func (e GameScoringDirection) Valid() bool {
+	switch e {
+	case HigherWins:
+		return true
+	case LowerWins:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for LeagueRuleKind.
+const (
+	Cubes         LeagueRuleKind = "cubes"
+	Meeples       LeagueRuleKind = "meeples"
+	Rating        LeagueRuleKind = "rating"
+	RecentMatches LeagueRuleKind = "recent_matches"
+)
+
+// Valid indicates whether the value is a known member of the LeagueRuleKind enum.
+func (e LeagueRuleKind) Valid() bool {
+	switch e {
+	case Cubes:
+		return true
+	case Meeples:
+		return true
+	case Rating:
+		return true
+	case RecentMatches:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for MarketMarketType.
 const (
 	MarketMarketTypeMatchWinner MarketMarketType = "match_winner"
@@ -174,6 +336,93 @@ func (e MarketDetailStatus) Valid() bool {
 	}
 }
 
+// Defines values for MatchCooperativeResult.
+const (
+	Lost MatchCooperativeResult = "lost"
+	Won  MatchCooperativeResult = "won"
+)
+
+// Valid indicates whether the value is a known member of the MatchCooperativeResult enum.
+func (e MatchCooperativeResult) Valid() bool {
+	switch e {
+	case Lost:
+		return true
+	case Won:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for MatchPreviewPayoutRole.
+const (
+	Buyer     MatchPreviewPayoutRole = "buyer"
+	Guarantor MatchPreviewPayoutRole = "guarantor"
+)
+
+// Valid indicates whether the value is a known member of the MatchPreviewPayoutRole enum.
+func (e MatchPreviewPayoutRole) Valid() bool {
+	switch e {
+	case Buyer:
+		return true
+	case Guarantor:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PlayerAchievementKind.
+const (
+	BeatLeader      PlayerAchievementKind = "beat_leader"
+	DistinctGames20 PlayerAchievementKind = "distinct_games_20"
+	EliteLeague     PlayerAchievementKind = "elite_league"
+	FirstWin        PlayerAchievementKind = "first_win"
+	MarketWin       PlayerAchievementKind = "market_win"
+	WinStreak10     PlayerAchievementKind = "win_streak_10"
+)
+
+// Valid indicates whether the value is a known member of the PlayerAchievementKind enum.
+func (e PlayerAchievementKind) Valid() bool {
+	switch e {
+	case BeatLeader:
+		return true
+	case DistinctGames20:
+		return true
+	case EliteLeague:
+		return true
+	case FirstWin:
+		return true
+	case MarketWin:
+		return true
+	case WinStreak10:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for RatingAlgorithm.
+const (
+	Elo       RatingAlgorithm = "elo"
+	Glicko2   RatingAlgorithm = "glicko2"
+	Trueskill RatingAlgorithm = "trueskill"
+)
+
+// Valid indicates whether the value is a known member of the RatingAlgorithm enum.
+func (e RatingAlgorithm) Valid() bool {
+	switch e {
+	case Elo:
+		return true
+	case Glicko2:
+		return true
+	case Trueskill:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for SkullKingCardImageResult0Type.
 const (
 	Chest      SkullKingCardImageResult0Type = "chest"
@@ -336,6 +585,55 @@ func (e PatchMarketJSONBodyStatus) Valid() bool {
 	}
 }
 
+// Defines values for SuggestTablesJSONBodyObjective.
+const (
+	Balance     SuggestTablesJSONBodyObjective = "balance"
+	NewPairings SuggestTablesJSONBodyObjective = "new_pairings"
+)
+
+// Valid indicates whether the value is a known member of the SuggestTablesJSONBodyObjective enum.
+func (e SuggestTablesJSONBodyObjective) Valid() bool {
+	switch e {
+	case Balance:
+		return true
+	case NewPairings:
+		return true
+	default:
+		return false
+	}
+}
+
+// AdminMatchInput defines model for AdminMatchInput.
+type AdminMatchInput struct {
+	// Cooperative Outcome of a cooperative match: the players win or lose together against the game's virtual opponent, whose Elo is learned per game. A cooperative match needs at least one player and cannot have teams; score values are kept for display only.
+	Cooperative *MatchCooperative `json:"cooperative,omitempty"`
+
+	// Date Any past date
+	Date   time.Time `json:"date"`
+	GameId string    `json:"game_id"`
+
+	// Id Client-generated UUIDv7, encoded as a short Base58 string (~22 chars, Bitcoin alphabet — no 0/O/I/l). The client generates this on create; it serves as both the primary key and the idempotency key. A repeated request with the same id returns the already-created entity. The backend also accepts the standard 36-char canonical UUID form for backward compatibility.
+	Id *ULID `json:"id,omitempty"`
+
+	// Reason Why the date limits are overridden; recorded in the audit log
+	Reason string `json:"reason"`
+
+	// Score Map of player_id (string) to numeric score
+	Score         map[string]float64 `json:"score"`
+	Teams         *[]MatchTeamInput  `json:"teams,omitempty"`
+	TournamentIds *[]string          `json:"tournament_ids,omitempty"`
+}
+
+// AdminMatchResult defines model for AdminMatchResult.
+type AdminMatchResult struct {
+	// Markets Markets whose status, outcome or resolving match changed
+	Markets []MarketOutcomeChange `json:"markets"`
+	MatchId string                `json:"match_id"`
+
+	// Players Players whose current rating, league or rank changed
+	Players []PlayerStandingChange `json:"players"`
+}
+
 // ApiError defines model for ApiError.
 type ApiError struct {
 	Message string         `json:"message"`
@@ -354,6 +652,152 @@ type ApiSuccessMessage struct {
 // ApiSuccessMessageStatus defines model for ApiSuccessMessage.Status.
 type ApiSuccessMessageStatus string
 
+// Arena defines model for Arena.
+type Arena struct {
+	ClubId  *string    `json:"club_id,omitempty"`
+	EndsAt  *time.Time `json:"ends_at,omitempty"`
+	GameIds []string   `json:"game_ids"`
+	Id      string     `json:"id"`
+
+	// LeagueLadder Leagues of a custom arena, from the lowest. A player climbs while they meet the rules of the next league; the first league has no rules. Null: the arena has no leagues.
+	LeagueLadder *LeagueLadder `json:"league_ladder,omitempty"`
+	Name         string        `json:"name"`
+	PlayerIds    []string      `json:"player_ids"`
+	StartsAt     *time.Time    `json:"starts_at,omitempty"`
+	TournamentId *string       `json:"tournament_id,omitempty"`
+}
+
+// ArenaHistoryEntry defines model for ArenaHistoryEntry.
+type ArenaHistoryEntry struct {
+	// Cubes Arena matches played, one cube per match
+	Cubes          float64   `json:"cubes"`
+	Date           time.Time `json:"date"`
+	DeviationAfter *float64  `json:"deviation_after,omitempty"`
+	EloAfter       float64   `json:"elo_after"`
+	EloEarned      float64   `json:"elo_earned"`
+	EloStaked      float64   `json:"elo_staked"`
+	GameId         string    `json:"game_id"`
+
+	// League League after the match; null without a ladder
+	League  *string `json:"league,omitempty"`
+	MatchId string  `json:"match_id"`
+
+	// Meeples 1/(n−1) per opponent of every match, at most 2 per unique opponent
+	Meeples float64 `json:"meeples"`
+}
+
+// ArenaInput A custom arena (ADR-02). A match is rated in the arena when it passes every filter that is set; empty / omitted filters match everything. Only the listed players and club members are rated, as a sub-match among them.
+type ArenaInput struct {
+	ClubId *string `json:"club_id,omitempty"`
+
+	// EndsAt Exclusive upper bound of the match date
+	EndsAt  *time.Time `json:"ends_at,omitempty"`
+	GameIds *[]string  `json:"game_ids,omitempty"`
+
+	// Id Client-generated UUIDv7, encoded as a short Base58 string (~22 chars, Bitcoin alphabet — no 0/O/I/l). The client generates this on create; it serves as both the primary key and the idempotency key. A repeated request with the same id returns the already-created entity. The backend also accepts the standard 36-char canonical UUID form for backward compatibility.
+	Id ULID `json:"id"`
+
+	// LeagueLadder Leagues of a custom arena, from the lowest. A player climbs while they meet the rules of the next league; the first league has no rules. Null: the arena has no leagues.
+	LeagueLadder *LeagueLadder `json:"league_ladder,omitempty"`
+	Name         string        `json:"name"`
+	PlayerIds    *[]string     `json:"player_ids,omitempty"`
+
+	// StartsAt Inclusive lower bound of the match date
+	StartsAt     *time.Time `json:"starts_at,omitempty"`
+	TournamentId *string    `json:"tournament_id,omitempty"`
+}
+
+// ArenaLeaderboardEntry defines model for ArenaLeaderboardEntry.
+type ArenaLeaderboardEntry struct {
+	// Cubes Arena matches played, one cube per match
+	Cubes float64 `json:"cubes"`
+
+	// Deviation Rating deviation when the arena's algorithm tracks it
+	Deviation     *float64  `json:"deviation,omitempty"`
+	Elo           float64   `json:"elo"`
+	LastMatchDate time.Time `json:"last_match_date"`
+
+	// League League held now (inactivity and keep rules applied); null without a ladder
+	League        *string `json:"league,omitempty"`
+	MatchesPlayed int     `json:"matches_played"`
+
+	// Meeples 1/(n−1) per opponent of every match, at most 2 per unique opponent
+	Meeples    float64 `json:"meeples"`
+	PlayerId   string  `json:"player_id"`
+	PlayerName string  `json:"player_name"`
+}
+
+// ArenaPrediction defines model for ArenaPrediction.
+type ArenaPrediction struct {
+	Elo float64 `json:"elo"`
+
+	// ExpectedScore Expected normalized score; the values of all players sum to 1
+	ExpectedScore float64 `json:"expected_score"`
+
+	// WinProbability Probability of finishing first alone; the values of all players sum to 1
+	WinProbability float64 `json:"win_probability"`
+}
+
+// AuditEntityType defines model for AuditEntityType.
+type AuditEntityType string
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	Action AuditEntryAction `json:"action"`
+
+	// After The entity after the change; null for a delete
+	After *map[string]interface{} `json:"after,omitempty"`
+
+	// Before The entity before the change; null for a create
+	Before     *map[string]interface{} `json:"before,omitempty"`
+	CreatedAt  time.Time               `json:"created_at"`
+	EntityId   string                  `json:"entity_id"`
+	EntityType AuditEntityType         `json:"entity_type"`
+	Id         string                  `json:"id"`
+
+	// Reason Why the change was made, given for admin date overrides
+	Reason   *string `json:"reason,omitempty"`
+	UserId   *string `json:"user_id,omitempty"`
+	UserName *string `json:"user_name,omitempty"`
+}
+
+// AuditEntryAction defines model for AuditEntry.Action.
+type AuditEntryAction string
+
+// AuditPage defines model for AuditPage.
+type AuditPage struct {
+	Data []AuditEntry `json:"data"`
+
+	// Next Cursor token for the next page; null if no more pages
+	Next   *string `json:"next,omitempty"`
+	Status string  `json:"status"`
+}
+
+// CheckpointMismatch defines model for CheckpointMismatch.
+type CheckpointMismatch struct {
+	CheckpointId string    `json:"checkpoint_id"`
+	Date         time.Time `json:"date"`
+
+	// Field elo, rating, league, deviation, volatility or betting_closed; presence when the entry exists on one side only
+	Field      string                  `json:"field"`
+	GameId     *string                 `json:"game_id,omitempty"`
+	MarketId   *string                 `json:"market_id,omitempty"`
+	PlayerId   *string                 `json:"player_id,omitempty"`
+	Recomputed string                  `json:"recomputed"`
+	Scope      CheckpointMismatchScope `json:"scope"`
+	Stored     string                  `json:"stored"`
+}
+
+// CheckpointMismatchScope defines model for CheckpointMismatch.Scope.
+type CheckpointMismatchScope string
+
+// CheckpointVerification defines model for CheckpointVerification.
+type CheckpointVerification struct {
+	// Checkpoints Number of checkpoints checked
+	Checkpoints int                  `json:"checkpoints"`
+	Mismatches  []CheckpointMismatch `json:"mismatches"`
+}
+
 // Club defines model for Club.
 type Club struct {
 	GeologistName *string `json:"geologist_name,omitempty"`
@@ -385,6 +829,15 @@ type CorrectionsPage struct {
 	Status string  `json:"status"`
 }
 
+// EditHistory Where to find the audit entries of an entity
+type EditHistory struct {
+	// Entries Number of audit entries
+	Entries int `json:"entries"`
+
+	// Href GET /audit URL listing them
+	Href string `json:"href"`
+}
+
 // EloRank defines model for EloRank.
 type EloRank struct {
 	League EloRankLeague `json:"league"`
@@ -410,16 +863,44 @@ type EloSettingEntry struct {
 	EffectiveDate string  `json:"effective_date"`
 	EloConstD     float64 `json:"elo_const_d"`
 	EloConstK     float64 `json:"elo_const_k"`
-	StartingElo   float64 `json:"starting_elo"`
-	WinReward     float64 `json:"win_reward"`
+
+	// GameArenaAlgorithm Algorithm of an arena's true-skill track: multiplayer Elo, Glicko-2
+	// (rating deviation + volatility) or TrueSkill. Omitted in CreateSettings →
+	// kept from the newest settings entry.
+	GameArenaAlgorithm RatingAlgorithm `json:"game_arena_algorithm"`
+
+	// GlobalArenaAlgorithm Algorithm of an arena's true-skill track: multiplayer Elo, Glicko-2
+	// (rating deviation + volatility) or TrueSkill. Omitted in CreateSettings →
+	// kept from the newest settings entry.
+	GlobalArenaAlgorithm RatingAlgorithm `json:"global_arena_algorithm"`
+
+	// InactivityDecayDays Days without a match before the display rating decays (0 disables decay)
+	InactivityDecayDays int `json:"inactivity_decay_days"`
+
+	// InactivityDecayRate Share of the rating above the starting rating lost to inactivity
+	InactivityDecayRate float64 `json:"inactivity_decay_rate"`
+	StartingElo         float64 `json:"starting_elo"`
+	WinReward           float64 `json:"win_reward"`
 }
 
 // Game defines model for Game.
 type Game struct {
-	Id           string       `json:"id"`
-	Name         string       `json:"name"`
-	Players      []GamePlayer `json:"players"`
-	TotalMatches int          `json:"total_matches"`
+	Id      string       `json:"id"`
+	Name    string       `json:"name"`
+	Players []GamePlayer `json:"players"`
+
+	// RatingParams Versions of the game's rating parameter overrides, newest first
+	RatingParams []GameRatingParams `json:"rating_params"`
+
+	// ResultType score — match scores are the game's points; placement — only the finishing order is recorded and each score is the player's place (1, 2, 2, 4 … — tied players share a place and the next place is skipped). In a team match teammates share their team's place and places count teams (1, 1, 2, 2 for a 2v2).
+	ResultType GameResultType `json:"result_type"`
+
+	// ScoringDirection Whether the highest or the lowest score wins. Ignored for placement results.
+	ScoringDirection GameScoringDirection `json:"scoring_direction"`
+	TotalMatches     int                  `json:"total_matches"`
+
+	// VirtualOpponentElo Elo of the game's virtual opponent, learned from cooperative matches. Null until the game has a cooperative match.
+	VirtualOpponentElo *float64 `json:"virtual_opponent_elo,omitempty"`
 }
 
 // GameEloStat defines model for GameEloStat.
@@ -497,6 +978,88 @@ type GamePlayer struct {
 // GamePlayerLeague defines model for GamePlayer.League.
 type GamePlayerLeague string
 
+// GameRatingParams Overrides of the elo_settings K, D and win_reward for matches of the game from effective_date on; null keeps the elo_settings value. The game arena rates with them; the global arena uses only K, as the match's weight.
+type GameRatingParams struct {
+	D             *float64  `json:"d,omitempty"`
+	EffectiveDate time.Time `json:"effective_date"`
+	K             *float64  `json:"k,omitempty"`
+	WinReward     *float64  `json:"win_reward,omitempty"`
+}
+
+// GameRatingParamsInput A new version of the game's overrides; all null reverts the game to elo_settings from effective_date.
+type GameRatingParamsInput struct {
+	D *float64 `json:"d,omitempty"`
+
+	// EffectiveDate Defaults to now; a version at the same date is replaced
+	EffectiveDate *time.Time `json:"effective_date,omitempty"`
+	K             *float64   `json:"k,omitempty"`
+	WinReward     *float64   `json:"win_reward,omitempty"`
+}
+
+// GameRecords defines model for GameRecords.
+type GameRecords struct {
+	GameId            string  `json:"game_id"`
+	GameName          string  `json:"game_name"`
+	LargestRatingGain *Record `json:"largest_rating_gain,omitempty"`
+
+	// LongestWinStreak Consecutive matches finished first alone
+	LongestWinStreak *WinStreakRecord `json:"longest_win_streak,omitempty"`
+	MostMatchesInDay *Record          `json:"most_matches_in_day,omitempty"`
+
+	// Scores Highest and lowest raw score of a score-based game
+	Scores *GameScoreRecords `json:"scores,omitempty"`
+}
+
+// GameResultType score — match scores are the game's points; placement — only the finishing order is recorded and each score is the player's place (1, 2, 2, 4 … — tied players share a place and the next place is skipped). In a team match teammates share their team's place and places count teams (1, 1, 2, 2 for a 2v2).
+type GameResultType string
+
+// GameScoreRecords Highest and lowest raw score of a score-based game
+type GameScoreRecords struct {
+	GameId    string `json:"game_id"`
+	GameName  string `json:"game_name"`
+	HighScore Record `json:"high_score"`
+	LowScore  Record `json:"low_score"`
+}
+
+// GameScoringDirection Whether the highest or the lowest score wins. Ignored for placement results.
+type GameScoringDirection string
+
+// HeadToHead defines model for HeadToHead.
+type HeadToHead struct {
+	Games       []HeadToHeadGame `json:"games"`
+	Matches     int              `json:"matches"`
+	OtherAbove  int              `json:"other_above"`
+	OtherId     string           `json:"other_id"`
+	PlayerAbove int              `json:"player_above"`
+	PlayerId    string           `json:"player_id"`
+
+	// RatingExchanged Global arena rating the player took from the other; negative when they lost it
+	RatingExchanged float64          `json:"rating_exchanged"`
+	Streak          HeadToHeadStreak `json:"streak"`
+	Ties            int              `json:"ties"`
+}
+
+// HeadToHeadGame defines model for HeadToHeadGame.
+type HeadToHeadGame struct {
+	GameId      string `json:"game_id"`
+	GameName    string `json:"game_name"`
+	Matches     int    `json:"matches"`
+	OtherAbove  int    `json:"other_above"`
+	PlayerAbove int    `json:"player_above"`
+
+	// RatingExchanged Rating the player took from the other in the game's arena
+	RatingExchanged float64          `json:"rating_exchanged"`
+	Streak          HeadToHeadStreak `json:"streak"`
+	Ties            int              `json:"ties"`
+}
+
+// HeadToHeadStreak defines model for HeadToHeadStreak.
+type HeadToHeadStreak struct {
+	// LeaderId Who finished above in every match of the run; null after a tie or without shared matches
+	LeaderId *string `json:"leader_id,omitempty"`
+	Length   int     `json:"length"`
+}
+
 // HistoryRank defines model for HistoryRank.
 type HistoryRank struct {
 	DayAgo  EloRank `json:"day_ago"`
@@ -504,6 +1067,58 @@ type HistoryRank struct {
 	WeekAgo EloRank `json:"week_ago"`
 }
 
+// LeaderboardEntry defines model for LeaderboardEntry.
+type LeaderboardEntry struct {
+	League   string `json:"league"`
+	Name     string `json:"name"`
+	PlayerId string `json:"player_id"`
+
+	// PreviousRank Rank as of `since`; null without `since` or when the player was not ranked then
+	PreviousRank   *int     `json:"previous_rank,omitempty"`
+	PreviousRating *float64 `json:"previous_rating,omitempty"`
+	Rank           int      `json:"rank"`
+	Rating         float64  `json:"rating"`
+
+	// RatingDiff rating − previous_rating
+	RatingDiff *float64 `json:"rating_diff,omitempty"`
+}
+
+// League defines model for League.
+type League struct {
+	// InactivityDays A league is lost for every this many days without an arena match (0 or omitted = never)
+	InactivityDays *int `json:"inactivity_days,omitempty"`
+
+	// Keep The league is kept only while its rules hold
+	Keep     *bool         `json:"keep,omitempty"`
+	Name     string        `json:"name"`
+	Requires *[]LeagueRule `json:"requires,omitempty"`
+}
+
+// LeagueLadder Leagues of a custom arena, from the lowest. A player climbs while they meet the rules of the next league; the first league has no rules. Null: the arena has no leagues.
+type LeagueLadder struct {
+	Leagues []League `json:"leagues"`
+}
+
+// LeagueRequirement defines model for LeagueRequirement.
+type LeagueRequirement struct {
+	Current float64 `json:"current"`
+	Days    *int    `json:"days,omitempty"`
+	Kind    string  `json:"kind"`
+	Met     bool    `json:"met"`
+	Min     float64 `json:"min"`
+}
+
+// LeagueRule defines model for LeagueRule.
+type LeagueRule struct {
+	// Days Window of recent_matches, in days
+	Days *int           `json:"days,omitempty"`
+	Kind LeagueRuleKind `json:"kind"`
+	Min  float64        `json:"min"`
+}
+
+// LeagueRuleKind defines model for LeagueRule.Kind.
+type LeagueRuleKind string
+
 // Market defines model for Market.
 type Market struct {
 	BettingClosedAt *time.Time `json:"betting_closed_at,omitempty"`
@@ -607,18 +1222,38 @@ type MarketDetail_Params struct {
 // MarketDetailStatus defines model for MarketDetail.Status.
 type MarketDetailStatus string
 
+// MarketOutcomeChange defines model for MarketOutcomeChange.
+type MarketOutcomeChange struct {
+	After    MarketState `json:"after"`
+	Before   MarketState `json:"before"`
+	MarketId string      `json:"market_id"`
+}
+
+// MarketState defines model for MarketState.
+type MarketState struct {
+	ResolutionMatchId   *string `json:"resolution_match_id,omitempty"`
+	ResolutionOutcomeId *string `json:"resolution_outcome_id,omitempty"`
+	Status              string  `json:"status"`
+}
+
 // Match defines model for Match.
 type Match struct {
 	// CalculatorData Intermediate calculator state. Present only when calculator_kind is non-null. Opaque at the OpenAPI layer; see pkg/calculator for the per-kind JSON Schemas.
 	CalculatorData *map[string]interface{} `json:"calculator_data,omitempty"`
 
 	// CalculatorKind Identifier of the calculator that produced this match, or null when the match was created via the generic form. Clients use this to decide whether to open the match in the calculator (history mode) or the generic edit form.
-	CalculatorKind *string   `json:"calculator_kind,omitempty"`
-	Date           time.Time `json:"date"`
-	GameId         string    `json:"game_id"`
-	GameName       string    `json:"game_name"`
-	HasMarkets     bool      `json:"has_markets"`
-	Id             string    `json:"id"`
+	CalculatorKind *string `json:"calculator_kind,omitempty"`
+
+	// Cooperative Outcome of a cooperative match: the players win or lose together against the game's virtual opponent, whose Elo is learned per game. A cooperative match needs at least one player and cannot have teams; score values are kept for display only.
+	Cooperative *MatchCooperative `json:"cooperative,omitempty"`
+	Date        time.Time         `json:"date"`
+
+	// EditHistory Where to find the audit entries of an entity
+	EditHistory *EditHistory `json:"edit_history,omitempty"`
+	GameId      string       `json:"game_id"`
+	GameName    string       `json:"game_name"`
+	HasMarkets  bool         `json:"has_markets"`
+	Id          string       `json:"id"`
 
 	// Score Map of player_id (string) to player score data
 	Score map[string]MatchPlayer `json:"score"`
@@ -627,12 +1262,83 @@ type Match struct {
 	Tournaments *[]MatchTournament `json:"tournaments,omitempty"`
 }
 
+// MatchArenaDelta A player's rating, Elo and league before and after the match in one arena
+type MatchArenaDelta struct {
+	EloAfter    float64 `json:"elo_after"`
+	EloBefore   float64 `json:"elo_before"`
+	LeagueAfter string  `json:"league_after"`
+
+	// LeagueBefore Absent for the player's first match in the arena
+	LeagueBefore *string `json:"league_before,omitempty"`
+	RatingAfter  float64 `json:"rating_after"`
+	RatingBefore float64 `json:"rating_before"`
+}
+
+// MatchCooperative Outcome of a cooperative match: the players win or lose together against the game's virtual opponent, whose Elo is learned per game. A cooperative match needs at least one player and cannot have teams; score values are kept for display only.
+type MatchCooperative struct {
+	// GlobalArena Whether the result also moves the global arena (the game arena is always settled)
+	GlobalArena *bool                  `json:"global_arena,omitempty"`
+	Result      MatchCooperativeResult `json:"result"`
+}
+
+// MatchCooperativeResult defines model for MatchCooperative.Result.
+type MatchCooperativeResult string
+
 // MatchPlayer Per-player data within a match (keyed by player_id in the score map)
 type MatchPlayer struct {
 	RatingAfter  float64 `json:"rating_after"`
 	RatingEarned float64 `json:"rating_earned"`
 	RatingStaked float64 `json:"rating_staked"`
 	Score        float64 `json:"score"`
+
+	// Team Team label of the player; absent in an individual match
+	Team *string `json:"team,omitempty"`
+}
+
+// MatchPreview defines model for MatchPreview.
+type MatchPreview struct {
+	Markets []MatchPreviewMarket `json:"markets"`
+	Players []MatchPreviewPlayer `json:"players"`
+}
+
+// MatchPreviewMarket A market whose resolution the match would change
+type MatchPreviewMarket struct {
+	MarketId string               `json:"market_id"`
+	Payouts  []MatchPreviewPayout `json:"payouts"`
+
+	// ResolutionOutcomeId Winning outcome; null while unresolved and for a cancelled market
+	ResolutionOutcomeId *string `json:"resolution_outcome_id,omitempty"`
+
+	// Status Status after the match (open or betting_closed when a replay unsettles it)
+	Status string `json:"status"`
+}
+
+// MatchPreviewPayout defines model for MatchPreviewPayout.
+type MatchPreviewPayout struct {
+	Earned   float64                `json:"earned"`
+	PlayerId string                 `json:"player_id"`
+	Role     MatchPreviewPayoutRole `json:"role"`
+	Staked   float64                `json:"staked"`
+}
+
+// MatchPreviewPayoutRole defines model for MatchPreviewPayout.Role.
+type MatchPreviewPayoutRole string
+
+// MatchPreviewPlayer defines model for MatchPreviewPlayer.
+type MatchPreviewPlayer struct {
+	// Game A player's rating, Elo and league before and after the match in one arena
+	Game *MatchArenaDelta `json:"game,omitempty"`
+
+	// Global A player's rating, Elo and league before and after the match in one arena
+	Global   *MatchArenaDelta `json:"global,omitempty"`
+	PlayerId string           `json:"player_id"`
+}
+
+// MatchTeamInput One team of a team match
+type MatchTeamInput struct {
+	// Name Team label, unique within the match (e.g. "A", "Красные")
+	Name      string   `json:"name"`
+	PlayerIds []string `json:"player_ids"`
 }
 
 // MatchTournament A tournament a match belongs to
@@ -660,30 +1366,119 @@ type MatchesPage struct {
 	Status string  `json:"status"`
 }
 
+// MatchmakingTableInput defines model for MatchmakingTableInput.
+type MatchmakingTableInput struct {
+	GameId     string `json:"game_id"`
+	MaxPlayers int    `json:"max_players"`
+	MinPlayers int    `json:"min_players"`
+}
+
+// Opponent defines model for Opponent.
+type Opponent struct {
+	Matches int    `json:"matches"`
+	Name    string `json:"name"`
+
+	// OpponentAbove Shared matches the opponent finished above the player
+	OpponentAbove int `json:"opponent_above"`
+
+	// PlayerAbove Shared matches the player finished above the opponent
+	PlayerAbove int    `json:"player_above"`
+	PlayerId    string `json:"player_id"`
+}
+
 // Player defines model for Player.
 type Player struct {
 	GeologistName *string     `json:"geologist_name,omitempty"`
 	Id            string      `json:"id"`
+	IsGuest       bool        `json:"is_guest"`
 	Name          string      `json:"name"`
 	Rank          HistoryRank `json:"rank"`
 	UserId        *string     `json:"user_id,omitempty"`
 }
 
+// PlayerAchievement An achievement, derived from the settled history like settlements and recomputed when it is edited. `first_win`: first match finished first alone; `win_streak_10`: ten such wins in a row; `beat_leader`: finished above the global #1; `distinct_games_20`: played twenty distinct games; `market_win`: net rating gain from a resolved market; `elite_league`: reached the elite league.
+type PlayerAchievement struct {
+	Kind     PlayerAchievementKind `json:"kind"`
+	MarketId *string               `json:"market_id,omitempty"`
+
+	// MatchId The unlocking match; for a market win the match that resolved the market
+	MatchId    *string   `json:"match_id,omitempty"`
+	UnlockedAt time.Time `json:"unlocked_at"`
+}
+
+// PlayerAchievementKind defines model for PlayerAchievement.Kind.
+type PlayerAchievementKind string
+
+// PlayerArenaLeague A player's progress in one custom arena
+type PlayerArenaLeague struct {
+	ArenaId       string    `json:"arena_id"`
+	ArenaName     string    `json:"arena_name"`
+	Cubes         float64   `json:"cubes"`
+	Elo           float64   `json:"elo"`
+	LastMatchDate time.Time `json:"last_match_date"`
+
+	// League League held now; null when the arena has no ladder
+	League        *string `json:"league,omitempty"`
+	MatchesPlayed int     `json:"matches_played"`
+	Meeples       float64 `json:"meeples"`
+
+	// NextLeague Null at the top of the ladder or without one
+	NextLeague             *string             `json:"next_league,omitempty"`
+	NextLeagueRequirements []LeagueRequirement `json:"next_league_requirements"`
+}
+
+// PlayerPrediction defines model for PlayerPrediction.
+type PlayerPrediction struct {
+	Game     ArenaPrediction `json:"game"`
+	Global   ArenaPrediction `json:"global"`
+	PlayerId string          `json:"player_id"`
+}
+
 // PlayerRef Minimal player object returned after create/patch
 type PlayerRef struct {
 	Id   string `json:"id"`
 	Name string `json:"name"`
 }
 
+// PlayerStandingChange defines model for PlayerStandingChange.
+type PlayerStandingChange struct {
+	LeagueAfter  string  `json:"league_after"`
+	LeagueBefore string  `json:"league_before"`
+	Name         string  `json:"name"`
+	PlayerId     string  `json:"player_id"`
+	RankAfter    *int    `json:"rank_after,omitempty"`
+	RankBefore   *int    `json:"rank_before,omitempty"`
+	RatingAfter  float64 `json:"rating_after"`
+	RatingBefore float64 `json:"rating_before"`
+}
+
 // PlayerStats defines model for PlayerStats.
 type PlayerStats struct {
-	PlayerName            string          `json:"player_name"`
-	RatingHistory         []RatingPoint   `json:"rating_history"`
-	TopGamesByEloEarned   []GameEloStat   `json:"top_games_by_elo_earned"`
-	TopGamesByMatches     []GameMatchStat `json:"top_games_by_matches"`
-	WorstGamesByEloEarned []GameEloStat   `json:"worst_games_by_elo_earned"`
+	// Achievements Unlocked achievements, oldest first
+	Achievements []PlayerAchievement `json:"achievements"`
+
+	// ArenaLeagues Progress in every custom arena the player has played in
+	ArenaLeagues          []PlayerArenaLeague `json:"arena_leagues"`
+	PlayerName            string              `json:"player_name"`
+	RatingHistory         []RatingPoint       `json:"rating_history"`
+	TopGamesByEloEarned   []GameEloStat       `json:"top_games_by_elo_earned"`
+	TopGamesByMatches     []GameMatchStat     `json:"top_games_by_matches"`
+	WorstGamesByEloEarned []GameEloStat       `json:"worst_games_by_elo_earned"`
 }
 
+// PredictionMetrics How well WinExpectation predicted the replayed competitive matches. pairwise_accuracy is the share of decisive side pairs won by the higher-rated side; mean_squared_error compares each side's expectation with its normalized score.
+type PredictionMetrics struct {
+	Matches          int     `json:"matches"`
+	MeanSquaredError float64 `json:"mean_squared_error"`
+	Pairs            int     `json:"pairs"`
+	PairwiseAccuracy float64 `json:"pairwise_accuracy"`
+}
+
+// RatingAlgorithm Algorithm of an arena's true-skill track: multiplayer Elo, Glicko-2
+// (rating deviation + volatility) or TrueSkill. Omitted in CreateSettings →
+// kept from the newest settings entry.
+type RatingAlgorithm string
+
 // RatingPoint defines model for RatingPoint.
 type RatingPoint struct {
 	Date   time.Time `json:"date"`
@@ -691,12 +1486,86 @@ type RatingPoint struct {
 	Rating float64   `json:"rating"`
 }
 
+// Record defines model for Record.
+type Record struct {
+	// Date When the record was set; the start of the UTC day for matches in a day
+	Date       time.Time `json:"date"`
+	MarketId   *string   `json:"market_id,omitempty"`
+	MatchId    *string   `json:"match_id,omitempty"`
+	PlayerId   string    `json:"player_id"`
+	PlayerName string    `json:"player_name"`
+	Value      float64   `json:"value"`
+}
+
+// Records defines model for Records.
+type Records struct {
+	BiggestMarketPayout *Record `json:"biggest_market_payout,omitempty"`
+	LargestRatingGain   *Record `json:"largest_rating_gain,omitempty"`
+
+	// LongestWinStreak Consecutive matches finished first alone
+	LongestWinStreak *WinStreakRecord   `json:"longest_win_streak,omitempty"`
+	MostMatchesInDay *Record            `json:"most_matches_in_day,omitempty"`
+	Scores           []GameScoreRecords `json:"scores"`
+}
+
+// Rivals defines model for Rivals.
+type Rivals struct {
+	Nemesis   *Opponent  `json:"nemesis,omitempty"`
+	Opponents []Opponent `json:"opponents"`
+}
+
+// Season defines model for Season.
+type Season struct {
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+
+	// Champions Rank 1 of every archived table; empty until the season is archived
+	Champions   []SeasonChampion `json:"champions"`
+	EndsAt      time.Time        `json:"ends_at"`
+	Id          string           `json:"id"`
+	Name        string           `json:"name"`
+	ResetFactor *float64         `json:"reset_factor,omitempty"`
+	StartsAt    time.Time        `json:"starts_at"`
+}
+
+// SeasonChampion defines model for SeasonChampion.
+type SeasonChampion struct {
+	// GameId The game whose arena was won; null for the global arena
+	GameId   *string `json:"game_id,omitempty"`
+	GameName *string `json:"game_name,omitempty"`
+	Name     string  `json:"name"`
+	PlayerId string  `json:"player_id"`
+	Rating   float64 `json:"rating"`
+}
+
+// SeasonLeaderboard defines model for SeasonLeaderboard.
+type SeasonLeaderboard struct {
+	// Archived Whether these are the frozen final standings
+	Archived bool               `json:"archived"`
+	Entries  []LeaderboardEntry `json:"entries"`
+}
+
 // Settings defines model for Settings.
 type Settings struct {
 	EliteLeagueMatches2months int     `json:"elite_league_matches_2months"`
 	EliteLeagueMatches6months int     `json:"elite_league_matches_6months"`
 	EloConstD                 float64 `json:"elo_const_d"`
 	EloConstK                 float64 `json:"elo_const_k"`
+
+	// GameArenaAlgorithm Algorithm of an arena's true-skill track: multiplayer Elo, Glicko-2
+	// (rating deviation + volatility) or TrueSkill. Omitted in CreateSettings →
+	// kept from the newest settings entry.
+	GameArenaAlgorithm RatingAlgorithm `json:"game_arena_algorithm"`
+
+	// GlobalArenaAlgorithm Algorithm of an arena's true-skill track: multiplayer Elo, Glicko-2
+	// (rating deviation + volatility) or TrueSkill. Omitted in CreateSettings →
+	// kept from the newest settings entry.
+	GlobalArenaAlgorithm RatingAlgorithm `json:"global_arena_algorithm"`
+
+	// InactivityDecayDays Days without a match before the display rating decays (0 disables decay)
+	InactivityDecayDays int `json:"inactivity_decay_days"`
+
+	// InactivityDecayRate Share of the rating above the starting rating lost to inactivity
+	InactivityDecayRate       float64 `json:"inactivity_decay_rate"`
 	NewbieLeagueEarnedMax     float64 `json:"newbie_league_earned_max"`
 	NewbieLeagueEarnedMin     float64 `json:"newbie_league_earned_min"`
 	NewbieLeagueEarnedTau     float64 `json:"newbie_league_earned_tau"`
@@ -707,6 +1576,44 @@ type Settings struct {
 	WinReward                 float64 `json:"win_reward"`
 }
 
+// SettingsProposal Elo settings to simulate; every field is optional
+type SettingsProposal struct {
+	EliteLeagueMatches2months *int     `json:"elite_league_matches_2months,omitempty"`
+	EliteLeagueMatches6months *int     `json:"elite_league_matches_6months,omitempty"`
+	EloConstD                 *float64 `json:"elo_const_d,omitempty"`
+	EloConstK                 *float64 `json:"elo_const_k,omitempty"`
+
+	// GameArenaAlgorithm Algorithm of an arena's true-skill track: multiplayer Elo, Glicko-2
+	// (rating deviation + volatility) or TrueSkill. Omitted in CreateSettings →
+	// kept from the newest settings entry.
+	GameArenaAlgorithm *RatingAlgorithm `json:"game_arena_algorithm,omitempty"`
+
+	// GlobalArenaAlgorithm Algorithm of an arena's true-skill track: multiplayer Elo, Glicko-2
+	// (rating deviation + volatility) or TrueSkill. Omitted in CreateSettings →
+	// kept from the newest settings entry.
+	GlobalArenaAlgorithm      *RatingAlgorithm `json:"global_arena_algorithm,omitempty"`
+	InactivityDecayDays       *int             `json:"inactivity_decay_days,omitempty"`
+	InactivityDecayRate       *float64         `json:"inactivity_decay_rate,omitempty"`
+	NewbieLeagueEarnedMax     *float64         `json:"newbie_league_earned_max,omitempty"`
+	NewbieLeagueEarnedMin     *float64         `json:"newbie_league_earned_min,omitempty"`
+	NewbieLeagueEarnedTau     *float64         `json:"newbie_league_earned_tau,omitempty"`
+	NewbieLeagueGoalGap       *float64         `json:"newbie_league_goal_gap,omitempty"`
+	StartingElo               *float64         `json:"starting_elo,omitempty"`
+	StartingRatingGameArena   *float64         `json:"starting_rating_game_arena,omitempty"`
+	StartingRatingGlobalArena *float64         `json:"starting_rating_global_arena,omitempty"`
+	WinReward                 *float64         `json:"win_reward,omitempty"`
+}
+
+// SettingsSimulation defines model for SettingsSimulation.
+type SettingsSimulation struct {
+	// CurrentMetrics How well WinExpectation predicted the replayed competitive matches. pairwise_accuracy is the share of decisive side pairs won by the higher-rated side; mean_squared_error compares each side's expectation with its normalized score.
+	CurrentMetrics PredictionMetrics           `json:"current_metrics"`
+	Leaderboard    []SimulatedLeaderboardEntry `json:"leaderboard"`
+
+	// ProposedMetrics How well WinExpectation predicted the replayed competitive matches. pairwise_accuracy is the share of decisive side pairs won by the higher-rated side; mean_squared_error compares each side's expectation with its normalized score.
+	ProposedMetrics PredictionMetrics `json:"proposed_metrics"`
+}
+
 // SettlementDetail defines model for SettlementDetail.
 type SettlementDetail struct {
 	Earned     float64 `json:"earned"`
@@ -715,6 +1622,21 @@ type SettlementDetail struct {
 	Staked     float64 `json:"staked"`
 }
 
+// SimulatedLeaderboardEntry defines model for SimulatedLeaderboardEntry.
+type SimulatedLeaderboardEntry struct {
+	CurrentLeague string  `json:"current_league"`
+	CurrentRank   *int    `json:"current_rank,omitempty"`
+	CurrentRating float64 `json:"current_rating"`
+	League        string  `json:"league"`
+	Name          string  `json:"name"`
+	PlayerId      string  `json:"player_id"`
+	Rank          *int    `json:"rank,omitempty"`
+	Rating        float64 `json:"rating"`
+
+	// RatingDiff rating − current_rating
+	RatingDiff float64 `json:"rating_diff"`
+}
+
 // SkullKingCardImageResult defines model for SkullKingCardImageResult.
 type SkullKingCardImageResult struct {
 	union json.RawMessage
@@ -775,6 +1697,22 @@ type SkullKingTableSummary struct {
 	Id                 string             `json:"id"`
 }
 
+// TableSuggestion defines model for TableSuggestion.
+type TableSuggestion struct {
+	// Balance 1 when every player has the same chance to win in the game arena, 0 when one is certain to
+	Balance float64 `json:"balance"`
+	GameId  string  `json:"game_id"`
+
+	// NewPairings Pairs at the table who have not played together before
+	NewPairings int `json:"new_pairings"`
+
+	// Players Favourite in the game arena first
+	Players []PlayerPrediction `json:"players"`
+
+	// RepeatPairings Pairs at the table who have played together before
+	RepeatPairings int `json:"repeat_pairings"`
+}
+
 // Tournament defines model for Tournament.
 type Tournament struct {
 	EndDate time.Time `json:"end_date"`
@@ -819,8 +1757,11 @@ type ULID = string
 
 // User defines model for User.
 type User struct {
-	CanEdit  bool    `json:"can_edit"`
-	Id       string  `json:"id"`
+	CanEdit bool   `json:"can_edit"`
+	Id      string `json:"id"`
+
+	// IsAdmin May override match date limits
+	IsAdmin  bool    `json:"is_admin"`
 	Name     string  `json:"name"`
 	PlayerId *string `json:"player_id,omitempty"`
 }
@@ -845,13 +1786,23 @@ type WinStreakParams struct {
 	WinsRequired   int      `json:"wins_required"`
 }
 
+// WinStreakRecord Consecutive matches finished first alone
+type WinStreakRecord struct {
+	EndedAt     time.Time `json:"ended_at"`
+	LastMatchId string    `json:"last_match_id"`
+	Length      int       `json:"length"`
+	PlayerId    string    `json:"player_id"`
+	PlayerName  string    `json:"player_name"`
+	StartedAt   time.Time `json:"started_at"`
+}
+
 // MarketsMarketGuarantor A player who backs a market and splits its settlement residual (deficit or surplus).
 type MarketsMarketGuarantor struct {
 	PlayerId   string `json:"player_id"`
 	PlayerName string `json:"player_name"`
 }
 
-// MarketsMarketOutcome One mutually-exclusive outcome of a market. The id is the business-logic identifier (bets and resolution reference it); the name is derived on the fly for display only (player outcome → player name, other → Ничья, yes/no → «Да»/«Нет»).
+// MarketsMarketOutcome One mutually-exclusive outcome of a market. The id is the business-logic identifier (bets and resolution reference it); the name is derived on the fly for display only (player outcome → player name, other → «Ничья», yes/no → «Да»/«Нет»).
 type MarketsMarketOutcome struct {
 	Id string `json:"id"`
 
@@ -887,6 +1838,24 @@ type CreatePlayerCorrectionJSONBody struct {
 // CreatePlayerCorrectionJSONBodyDiscriminator defines parameters for CreatePlayerCorrection.
 type CreatePlayerCorrectionJSONBodyDiscriminator string
 
+// ListAuditEntriesParams defines parameters for ListAuditEntries.
+type ListAuditEntriesParams struct {
+	// EntityType Filter by entity type
+	EntityType *AuditEntityType `form:"entity_type,omitempty" json:"entity_type,omitempty"`
+
+	// EntityId Filter by entity ID; a settings entry is identified by its effective date in RFC 3339
+	EntityId *string `form:"entity_id,omitempty" json:"entity_id,omitempty"`
+
+	// UserId Filter by the acting user
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// Next Cursor token from previous page's "next" field
+	Next *string `form:"next,omitempty" json:"next,omitempty"`
+
+	// Limit Number of entries per page
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // PatchMeJSONBody defines parameters for PatchMe.
 type PatchMeJSONBody struct {
 	PlayerId *string `json:"player_id,omitempty"`
@@ -940,7 +1909,37 @@ type CreateGameJSONBody struct {
 
 // PatchGameJSONBody defines parameters for PatchGame.
 type PatchGameJSONBody struct {
-	Name string `json:"name"`
+	Name *string `json:"name,omitempty"`
+
+	// RatingParams A new version of the game's overrides; all null reverts the game to elo_settings from effective_date.
+	RatingParams *GameRatingParamsInput `json:"rating_params,omitempty"`
+
+	// ResultType score — match scores are the game's points; placement — only the finishing order is recorded and each score is the player's place (1, 2, 2, 4 … — tied players share a place and the next place is skipped). In a team match teammates share their team's place and places count teams (1, 1, 2, 2 for a 2v2).
+	ResultType *GameResultType `json:"result_type,omitempty"`
+
+	// ScoringDirection Whether the highest or the lowest score wins. Ignored for placement results.
+	ScoringDirection *GameScoringDirection `json:"scoring_direction,omitempty"`
+}
+
+// MergeGameJSONBody defines parameters for MergeGame.
+type MergeGameJSONBody struct {
+	// DuplicateId The game that is merged in and deleted
+	DuplicateId string `json:"duplicate_id"`
+}
+
+// GetLeaderboardParams defines parameters for GetLeaderboard.
+type GetLeaderboardParams struct {
+	// At Instant of the table; now when omitted
+	At *time.Time `form:"at,omitempty" json:"at,omitempty"`
+
+	// Since Start of the period to compare with; must be before `at`
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// GameId Rank in this game's arena instead of the global arena
+	GameId *string `form:"game_id,omitempty" json:"game_id,omitempty"`
+
+	// ClubId Only the club's current members, ranked among themselves
+	ClubId *string `form:"club_id,omitempty" json:"club_id,omitempty"`
 }
 
 // CreateMarketJSONBody defines parameters for CreateMarket.
@@ -1023,6 +2022,9 @@ type AddMatchJSONBody struct {
 	// CalculatorKind Identifier of the calculator that produced this match (e.g. "skull-king", "iaww"). When set, calculator_data is required and is validated server-side against the JSON Schema registered for this kind (see pkg/calculator). When absent, the match was created via the generic form.
 	CalculatorKind *string `json:"calculator_kind,omitempty"`
 
+	// Cooperative Outcome of a cooperative match: the players win or lose together against the game's virtual opponent, whose Elo is learned per game. A cooperative match needs at least one player and cannot have teams; score values are kept for display only.
+	Cooperative *MatchCooperative `json:"cooperative,omitempty"`
+
 	// Date Optional match time for offline-created matches. Must not be in the future and not older than 30 days; Elo is recalculated from this date. When omitted the server uses the current time.
 	Date   *time.Time `json:"date,omitempty"`
 	GameId string     `json:"game_id"`
@@ -1033,37 +2035,118 @@ type AddMatchJSONBody struct {
 	// Score Map of player_id (string) to numeric score
 	Score map[string]float64 `json:"score"`
 
+	// Teams Optional team assignment for a team game. When present every scored player must belong to exactly one team, there must be at least two teams, and teammates must share the same score. Each team is rated as one opponent (mean Elo of its members).
+	Teams *[]MatchTeamInput `json:"teams,omitempty"`
+
 	// TournamentIds Optional tournament IDs this match belongs to. Every match player is auto-enrolled into each tournament.
 	TournamentIds *[]string `json:"tournament_ids,omitempty"`
 }
 
+// PreviewMatchJSONBody defines parameters for PreviewMatch.
+type PreviewMatchJSONBody struct {
+	// Cooperative Outcome of a cooperative match: the players win or lose together against the game's virtual opponent, whose Elo is learned per game. A cooperative match needs at least one player and cannot have teams; score values are kept for display only.
+	Cooperative *MatchCooperative `json:"cooperative,omitempty"`
+
+	// Date Optional match time, validated as in AddMatch. When omitted the server uses the current time.
+	Date   *time.Time `json:"date,omitempty"`
+	GameId string     `json:"game_id"`
+
+	// Score Map of player_id (string) to numeric score
+	Score         map[string]float64 `json:"score"`
+	Teams         *[]MatchTeamInput  `json:"teams,omitempty"`
+	TournamentIds *[]string          `json:"tournament_ids,omitempty"`
+}
+
 // UpdateMatchJSONBody defines parameters for UpdateMatch.
 type UpdateMatchJSONBody struct {
 	// CalculatorData Intermediate calculator state. Opaque at the OpenAPI layer; validated against a per-calculator-kind JSON Schema in the Go handler. Required when calculator_kind is non-null.
 	CalculatorData *map[string]interface{} `json:"calculator_data,omitempty"`
 
 	// CalculatorKind Identifier of the calculator that produced this match (e.g. "skull-king", "iaww"). Validated server-side against the JSON Schema registered for this kind (see pkg/calculator). Set to null to clear calculator data on the match.
-	CalculatorKind *string   `json:"calculator_kind,omitempty"`
-	Date           time.Time `json:"date"`
-	GameId         string    `json:"game_id"`
+	CalculatorKind *string `json:"calculator_kind,omitempty"`
+
+	// Cooperative Outcome of a cooperative match: the players win or lose together against the game's virtual opponent, whose Elo is learned per game. A cooperative match needs at least one player and cannot have teams; score values are kept for display only.
+	Cooperative *MatchCooperative `json:"cooperative,omitempty"`
+	Date        time.Time         `json:"date"`
+	GameId      string            `json:"game_id"`
 
 	// Score Map of player_id (string) to numeric score
 	Score map[string]float64 `json:"score"`
 
+	// Teams Team assignment for a team game. Omit to keep the current teams of the players still in the match; send an empty list to make it an individual match. When non-empty every scored player must belong to exactly one team, there must be at least two teams, and teammates must share the same score. Each team is rated as one opponent (mean Elo of its members).
+	Teams *[]MatchTeamInput `json:"teams,omitempty"`
+
 	// TournamentIds Tournament IDs this match belongs to. Associations are replaced with this set; players are enrolled but never un-enrolled.
 	TournamentIds *[]string `json:"tournament_ids,omitempty"`
 }
 
+// SuggestTablesJSONBody defines parameters for SuggestTables.
+type SuggestTablesJSONBody struct {
+	ClubId       *string                        `json:"club_id,omitempty"`
+	Objective    SuggestTablesJSONBodyObjective `json:"objective"`
+	PlayerIds    []string                       `json:"player_ids"`
+	Tables       []MatchmakingTableInput        `json:"tables"`
+	TournamentId *string                        `json:"tournament_id,omitempty"`
+}
+
+// SuggestTablesJSONBodyObjective defines parameters for SuggestTables.
+type SuggestTablesJSONBodyObjective string
+
+// ListPlayersParams defines parameters for ListPlayers.
+type ListPlayersParams struct {
+	// IncludeGuests Also list guest players, after the ranked players and without a rank
+	IncludeGuests *bool `form:"include_guests,omitempty" json:"include_guests,omitempty"`
+}
+
 // CreatePlayerJSONBody defines parameters for CreatePlayer.
 type CreatePlayerJSONBody struct {
 	// Id Client-generated UUIDv7, encoded as a short Base58 string (~22 chars, Bitcoin alphabet — no 0/O/I/l). The client generates this on create; it serves as both the primary key and the idempotency key. A repeated request with the same id returns the already-created entity. The backend also accepts the standard 36-char canonical UUID form for backward compatibility.
-	Id   ULID   `json:"id"`
-	Name string `json:"name"`
+	Id ULID `json:"id"`
+
+	// IsGuest A guest plays at the starting rating and gets no settlements
+	IsGuest *bool  `json:"is_guest,omitempty"`
+	Name    string `json:"name"`
 }
 
 // PatchPlayerJSONBody defines parameters for PatchPlayer.
 type PatchPlayerJSONBody struct {
+	// IsGuest Changes the guest flag and replays history from the player's first event, in the same transaction as the rename. A player with bets, market guarantees or corrections cannot become a guest (409).
+	IsGuest *bool  `json:"is_guest,omitempty"`
+	Name    string `json:"name"`
+}
+
+// MergePlayerJSONBody defines parameters for MergePlayer.
+type MergePlayerJSONBody struct {
+	// DuplicateId The player that is merged in and deleted
+	DuplicateId string `json:"duplicate_id"`
+}
+
+// PredictMatchJSONBody defines parameters for PredictMatch.
+type PredictMatchJSONBody struct {
+	GameId string `json:"game_id"`
+
+	// PlayerIds At least two distinct players
+	PlayerIds []string `json:"player_ids"`
+}
+
+// CreateSeasonJSONBody defines parameters for CreateSeason.
+type CreateSeasonJSONBody struct {
+	// EndsAt Exclusive end of the season
+	EndsAt time.Time `json:"ends_at"`
+
+	// Id Client-generated UUIDv7, encoded as a short Base58 string (~22 chars, Bitcoin alphabet — no 0/O/I/l). The client generates this on create; it serves as both the primary key and the idempotency key. A repeated request with the same id returns the already-created entity. The backend also accepts the standard 36-char canonical UUID form for backward compatibility.
+	Id   ULID   `json:"id"`
 	Name string `json:"name"`
+
+	// ResetFactor Share of the distance from the starting values kept at the season start, in [0, 1); null for no reset
+	ResetFactor *float64  `json:"reset_factor,omitempty"`
+	StartsAt    time.Time `json:"starts_at"`
+}
+
+// GetSeasonLeaderboardParams defines parameters for GetSeasonLeaderboard.
+type GetSeasonLeaderboardParams struct {
+	// GameId The game's arena instead of the global one
+	GameId *string `form:"game_id,omitempty" json:"game_id,omitempty"`
 }
 
 // DeleteSettingsJSONBody defines parameters for DeleteSettings.
@@ -1077,8 +2160,24 @@ type CreateSettingsJSONBody struct {
 	EffectiveDate time.Time `json:"effective_date"`
 	EloConstD     float64   `json:"elo_const_d"`
 	EloConstK     float64   `json:"elo_const_k"`
-	StartingElo   float64   `json:"starting_elo"`
-	WinReward     float64   `json:"win_reward"`
+
+	// GameArenaAlgorithm Algorithm of an arena's true-skill track: multiplayer Elo, Glicko-2
+	// (rating deviation + volatility) or TrueSkill. Omitted in CreateSettings →
+	// kept from the newest settings entry.
+	GameArenaAlgorithm *RatingAlgorithm `json:"game_arena_algorithm,omitempty"`
+
+	// GlobalArenaAlgorithm Algorithm of an arena's true-skill track: multiplayer Elo, Glicko-2
+	// (rating deviation + volatility) or TrueSkill. Omitted in CreateSettings →
+	// kept from the newest settings entry.
+	GlobalArenaAlgorithm *RatingAlgorithm `json:"global_arena_algorithm,omitempty"`
+
+	// InactivityDecayDays Days without a match before the display rating decays (0 disables decay)
+	InactivityDecayDays *int `json:"inactivity_decay_days,omitempty"`
+
+	// InactivityDecayRate Share of the rating above the starting rating lost to inactivity
+	InactivityDecayRate *float64 `json:"inactivity_decay_rate,omitempty"`
+	StartingElo         float64  `json:"starting_elo"`
+	WinReward           float64  `json:"win_reward"`
 }
 
 // ParseSkullKingCardImageJSONBody defines parameters for ParseSkullKingCardImage.
@@ -1128,9 +2227,24 @@ type ParseVoiceInputJSONBody struct {
 	Text string `json:"text"`
 }
 
+// AdminAddMatchJSONRequestBody defines body for AdminAddMatch for application/json ContentType.
+type AdminAddMatchJSONRequestBody = AdminMatchInput
+
+// AdminUpdateMatchJSONRequestBody defines body for AdminUpdateMatch for application/json ContentType.
+type AdminUpdateMatchJSONRequestBody = AdminMatchInput
+
 // CreatePlayerCorrectionJSONRequestBody defines body for CreatePlayerCorrection for application/json ContentType.
 type CreatePlayerCorrectionJSONRequestBody CreatePlayerCorrectionJSONBody
 
+// SimulateSettingsJSONRequestBody defines body for SimulateSettings for application/json ContentType.
+type SimulateSettingsJSONRequestBody = SettingsProposal
+
+// CreateArenaJSONRequestBody defines body for CreateArena for application/json ContentType.
+type CreateArenaJSONRequestBody = ArenaInput
+
+// UpdateArenaJSONRequestBody defines body for UpdateArena for application/json ContentType.
+type UpdateArenaJSONRequestBody = ArenaInput
+
 // PatchMeJSONRequestBody defines body for PatchMe for application/json ContentType.
 type PatchMeJSONRequestBody PatchMeJSONBody
 
@@ -1149,6 +2263,9 @@ type CreateGameJSONRequestBody CreateGameJSONBody
 // PatchGameJSONRequestBody defines body for PatchGame for application/json ContentType.
 type PatchGameJSONRequestBody PatchGameJSONBody
 
+// MergeGameJSONRequestBody defines body for MergeGame for application/json ContentType.
+type MergeGameJSONRequestBody MergeGameJSONBody
+
 // CreateMarketJSONRequestBody defines body for CreateMarket for application/json ContentType.
 type CreateMarketJSONRequestBody CreateMarketJSONBody
 
@@ -1161,15 +2278,30 @@ type PlaceBetJSONRequestBody PlaceBetJSONBody
 // AddMatchJSONRequestBody defines body for AddMatch for application/json ContentType.
 type AddMatchJSONRequestBody AddMatchJSONBody
 
+// PreviewMatchJSONRequestBody defines body for PreviewMatch for application/json ContentType.
+type PreviewMatchJSONRequestBody PreviewMatchJSONBody
+
 // UpdateMatchJSONRequestBody defines body for UpdateMatch for application/json ContentType.
 type UpdateMatchJSONRequestBody UpdateMatchJSONBody
 
+// SuggestTablesJSONRequestBody defines body for SuggestTables for application/json ContentType.
+type SuggestTablesJSONRequestBody SuggestTablesJSONBody
+
 // CreatePlayerJSONRequestBody defines body for CreatePlayer for application/json ContentType.
 type CreatePlayerJSONRequestBody CreatePlayerJSONBody
 
 // PatchPlayerJSONRequestBody defines body for PatchPlayer for application/json ContentType.
 type PatchPlayerJSONRequestBody PatchPlayerJSONBody
 
+// MergePlayerJSONRequestBody defines body for MergePlayer for application/json ContentType.
+type MergePlayerJSONRequestBody MergePlayerJSONBody
+
+// PredictMatchJSONRequestBody defines body for PredictMatch for application/json ContentType.
+type PredictMatchJSONRequestBody PredictMatchJSONBody
+
+// CreateSeasonJSONRequestBody defines body for CreateSeason for application/json ContentType.
+type CreateSeasonJSONRequestBody CreateSeasonJSONBody
+
 // DeleteSettingsJSONRequestBody defines body for DeleteSettings for application/json ContentType.
 type DeleteSettingsJSONRequestBody DeleteSettingsJSONBody
 
@@ -1391,12 +2523,48 @@ func (t *SkullKingCardImageResult) UnmarshalJSON(b []byte) error {
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// VerifySettlementCheckpoints Check stored settlement checkpoints against history recomputed from scratch
+	// (POST /admin/checkpoints/verify)
+	VerifySettlementCheckpoints(c *gin.Context)
+	// AdminAddMatch Add a match at any past date (admin override)
+	// (POST /admin/matches)
+	AdminAddMatch(c *gin.Context)
+	// AdminUpdateMatch Update a match moving its date arbitrarily (admin override)
+	// (PUT /admin/matches/{id})
+	AdminUpdateMatch(c *gin.Context, id string)
 	// CreatePlayerCorrection Apply a manual rating correction for a player
 	// (POST /admin/players/{id}/corrections)
 	CreatePlayerCorrection(c *gin.Context, id string)
 	// RecalculateGameElo Recalculate all game-specific Elo ratings
 	// (POST /admin/recalculate-game-elo)
 	RecalculateGameElo(c *gin.Context)
+	// SimulateSettings Replay the whole history under proposed Elo settings without saving
+	// (POST /admin/settings/simulate)
+	SimulateSettings(c *gin.Context)
+	// ListArenas List custom arenas (by name)
+	// (GET /arenas)
+	ListArenas(c *gin.Context)
+	// CreateArena Create a custom arena and settle every past match that passes its filters
+	// (POST /arenas)
+	CreateArena(c *gin.Context)
+	// DeleteArena Delete a custom arena together with its ratings
+	// (DELETE /arenas/{id})
+	DeleteArena(c *gin.Context, id string)
+	// GetArena Get a custom arena by ID
+	// (GET /arenas/{id})
+	GetArena(c *gin.Context, id string)
+	// UpdateArena Replace an arena's name and filters and rebuild its ratings
+	// (PUT /arenas/{id})
+	UpdateArena(c *gin.Context, id string)
+	// GetArenaLeaderboard Players of an arena by their latest arena Elo (highest first)
+	// (GET /arenas/{id}/leaderboard)
+	GetArenaLeaderboard(c *gin.Context, id string)
+	// GetArenaPlayerHistory A player's arena settlements (newest first)
+	// (GET /arenas/{id}/players/{playerId}/history)
+	GetArenaPlayerHistory(c *gin.Context, id string, playerId string)
+	// ListAuditEntries List audit log entries with cursor-based pagination
+	// (GET /audit)
+	ListAuditEntries(c *gin.Context, params ListAuditEntriesParams)
 	// AuthLogin Initiate Google OAuth2 login flow
 	// (GET /auth/login)
 	AuthLogin(c *gin.Context)
@@ -1448,12 +2616,21 @@ type ServerInterface interface {
 	// GetGame Get game details and player Elo rankings
 	// (GET /games/{id})
 	GetGame(c *gin.Context, id string)
-	// PatchGame Update game name
+	// PatchGame Update game name and scoring rules
 	// (PATCH /games/{id})
 	PatchGame(c *gin.Context, id string)
 	// GetGameMatches Get all matches for a game
 	// (GET /games/{id}/matches)
 	GetGameMatches(c *gin.Context, id string)
+	// MergeGame Merge a duplicate game into this one
+	// (POST /games/{id}/merge)
+	MergeGame(c *gin.Context, id string)
+	// GetGameRecords Hall of fame of one game
+	// (GET /games/{id}/records)
+	GetGameRecords(c *gin.Context, id string)
+	// GetLeaderboard Ranked leaderboard as of a date, or its changes over a period
+	// (GET /leaderboard)
+	GetLeaderboard(c *gin.Context, params GetLeaderboardParams)
 	// ListMarkets List active and closed markets
 	// (GET /markets)
 	ListMarkets(c *gin.Context)
@@ -1481,6 +2658,12 @@ type ServerInterface interface {
 	// AddMatch Add a new match
 	// (POST /matches)
 	AddMatch(c *gin.Context)
+	// PreviewMatch Preview what adding a match would settle
+	// (POST /matches/preview)
+	PreviewMatch(c *gin.Context)
+	// DeleteMatch Delete a match and recalculate history from its date
+	// (DELETE /matches/{id})
+	DeleteMatch(c *gin.Context, id string)
 	// GetMatchById Get a match by ID
 	// (GET /matches/{id})
 	GetMatchById(c *gin.Context, id string)
@@ -1490,24 +2673,57 @@ type ServerInterface interface {
 	// GetMarketsByMatchId Get markets associated with a match
 	// (GET /matches/{id}/markets)
 	GetMarketsByMatchId(c *gin.Context, id string)
+	// SuggestTables Split the attendees of a game night across tables
+	// (POST /matchmaking)
+	SuggestTables(c *gin.Context)
 	// GetPing Health check
 	// (GET /ping)
 	GetPing(c *gin.Context)
 	// ListPlayers List all players with Elo rankings
 	// (GET /players)
-	ListPlayers(c *gin.Context)
+	ListPlayers(c *gin.Context, params ListPlayersParams)
 	// CreatePlayer Create a new player
 	// (POST /players)
 	CreatePlayer(c *gin.Context)
 	// DeletePlayer Delete a player
 	// (DELETE /players/{id})
 	DeletePlayer(c *gin.Context, id string)
-	// PatchPlayer Update player name
+	// PatchPlayer Update player name and guest flag
 	// (PATCH /players/{id})
 	PatchPlayer(c *gin.Context, id string)
+	// GetPlayerHeadToHead Compare two players over the matches they both played
+	// (GET /players/{id}/head-to-head/{otherId})
+	GetPlayerHeadToHead(c *gin.Context, id string, otherId string)
+	// MergePlayer Merge a duplicate player into this one
+	// (POST /players/{id}/merge)
+	MergePlayer(c *gin.Context, id string)
+	// GetPlayerRivals Most common opponents and the nemesis of a player
+	// (GET /players/{id}/rivals)
+	GetPlayerRivals(c *gin.Context, id string)
 	// GetPlayerStats Get player rating history and game statistics
 	// (GET /players/{id}/stats)
 	GetPlayerStats(c *gin.Context, id string)
+	// PredictMatch Predict the outcome of a proposed lineup
+	// (POST /predictions)
+	PredictMatch(c *gin.Context)
+	// GetRecords Hall of fame across all games
+	// (GET /records)
+	GetRecords(c *gin.Context)
+	// ListSeasons List seasons, newest first, with the champions of archived ones
+	// (GET /seasons)
+	ListSeasons(c *gin.Context)
+	// CreateSeason Create a season
+	// (POST /seasons)
+	CreateSeason(c *gin.Context)
+	// DeleteSeason Delete a season that is not archived, undoing its reset
+	// (DELETE /seasons/{id})
+	DeleteSeason(c *gin.Context, id string)
+	// ArchiveSeason Freeze the final standings of an ended season
+	// (POST /seasons/{id}/archive)
+	ArchiveSeason(c *gin.Context, id string)
+	// GetSeasonLeaderboard Season table of the global arena or of a game's arena
+	// (GET /seasons/{id}/leaderboard)
+	GetSeasonLeaderboard(c *gin.Context, id string, params GetSeasonLeaderboardParams)
 	// DeleteSettings Delete future Elo settings
 	// (DELETE /settings)
 	DeleteSettings(c *gin.Context)
@@ -1585,8 +2801,34 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
-// CreatePlayerCorrection operation middleware
-func (siw *ServerInterfaceWrapper) CreatePlayerCorrection(c *gin.Context) {
+// VerifySettlementCheckpoints operation middleware
+func (siw *ServerInterfaceWrapper) VerifySettlementCheckpoints(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.VerifySettlementCheckpoints(c)
+}
+
+// AdminAddMatch operation middleware
+func (siw *ServerInterfaceWrapper) AdminAddMatch(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AdminAddMatch(c)
+}
+
+// AdminUpdateMatch operation middleware
+func (siw *ServerInterfaceWrapper) AdminUpdateMatch(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -1607,11 +2849,23 @@ func (siw *ServerInterfaceWrapper) CreatePlayerCorrection(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreatePlayerCorrection(c, id)
+	siw.Handler.AdminUpdateMatch(c, id)
 }
 
-// RecalculateGameElo operation middleware
-func (siw *ServerInterfaceWrapper) RecalculateGameElo(c *gin.Context) {
+// CreatePlayerCorrection operation middleware
+func (siw *ServerInterfaceWrapper) CreatePlayerCorrection(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1620,11 +2874,11 @@ func (siw *ServerInterfaceWrapper) RecalculateGameElo(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RecalculateGameElo(c)
+	siw.Handler.CreatePlayerCorrection(c, id)
 }
 
-// AuthLogin operation middleware
-func (siw *ServerInterfaceWrapper) AuthLogin(c *gin.Context) {
+// RecalculateGameElo operation middleware
+func (siw *ServerInterfaceWrapper) RecalculateGameElo(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1633,11 +2887,11 @@ func (siw *ServerInterfaceWrapper) AuthLogin(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.AuthLogin(c)
+	siw.Handler.RecalculateGameElo(c)
 }
 
-// AuthLogout operation middleware
-func (siw *ServerInterfaceWrapper) AuthLogout(c *gin.Context) {
+// SimulateSettings operation middleware
+func (siw *ServerInterfaceWrapper) SimulateSettings(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1646,11 +2900,11 @@ func (siw *ServerInterfaceWrapper) AuthLogout(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.AuthLogout(c)
+	siw.Handler.SimulateSettings(c)
 }
 
-// GetMe operation middleware
-func (siw *ServerInterfaceWrapper) GetMe(c *gin.Context) {
+// ListArenas operation middleware
+func (siw *ServerInterfaceWrapper) ListArenas(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1659,11 +2913,11 @@ func (siw *ServerInterfaceWrapper) GetMe(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetMe(c)
+	siw.Handler.ListArenas(c)
 }
 
-// PatchMe operation middleware
-func (siw *ServerInterfaceWrapper) PatchMe(c *gin.Context) {
+// CreateArena operation middleware
+func (siw *ServerInterfaceWrapper) CreateArena(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1672,31 +2926,21 @@ func (siw *ServerInterfaceWrapper) PatchMe(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PatchMe(c)
+	siw.Handler.CreateArena(c)
 }
 
-// AuthOAuth2Callback operation middleware
-func (siw *ServerInterfaceWrapper) AuthOAuth2Callback(c *gin.Context) {
+// DeleteArena operation middleware
+func (siw *ServerInterfaceWrapper) DeleteArena(c *gin.Context) {
 
 	var err error
 	_ = err
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params AuthOAuth2CallbackParams
-
-	// ------------- Optional query parameter "code" -------------
-
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "code", c.Request.URL.Query(), &params.Code, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Optional query parameter "state" -------------
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "state", c.Request.URL.Query(), &params.State, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter state: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1707,11 +2951,23 @@ func (siw *ServerInterfaceWrapper) AuthOAuth2Callback(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.AuthOAuth2Callback(c, params)
+	siw.Handler.DeleteArena(c, id)
 }
 
-// ListClubs operation middleware
-func (siw *ServerInterfaceWrapper) ListClubs(c *gin.Context) {
+// GetArena operation middleware
+func (siw *ServerInterfaceWrapper) GetArena(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1720,74 +2976,11 @@ func (siw *ServerInterfaceWrapper) ListClubs(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListClubs(c)
+	siw.Handler.GetArena(c, id)
 }
 
-// CreateClub operation middleware
-func (siw *ServerInterfaceWrapper) CreateClub(c *gin.Context) {
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.CreateClub(c)
-}
-
-// DeleteClub operation middleware
-func (siw *ServerInterfaceWrapper) DeleteClub(c *gin.Context) {
-
-	var err error
-	_ = err
-
-	// ------------- Path parameter "id" -------------
-	var id string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.DeleteClub(c, id)
-}
-
-// GetClub operation middleware
-func (siw *ServerInterfaceWrapper) GetClub(c *gin.Context) {
-
-	var err error
-	_ = err
-
-	// ------------- Path parameter "id" -------------
-	var id string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.GetClub(c, id)
-}
-
-// PatchClub operation middleware
-func (siw *ServerInterfaceWrapper) PatchClub(c *gin.Context) {
+// UpdateArena operation middleware
+func (siw *ServerInterfaceWrapper) UpdateArena(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -1808,11 +3001,11 @@ func (siw *ServerInterfaceWrapper) PatchClub(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PatchClub(c, id)
+	siw.Handler.UpdateArena(c, id)
 }
 
-// AddClubMember operation middleware
-func (siw *ServerInterfaceWrapper) AddClubMember(c *gin.Context) {
+// GetArenaLeaderboard operation middleware
+func (siw *ServerInterfaceWrapper) GetArenaLeaderboard(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -1833,11 +3026,11 @@ func (siw *ServerInterfaceWrapper) AddClubMember(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.AddClubMember(c, id)
+	siw.Handler.GetArenaLeaderboard(c, id)
 }
 
-// RemoveClubMember operation middleware
-func (siw *ServerInterfaceWrapper) RemoveClubMember(c *gin.Context) {
+// GetArenaPlayerHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetArenaPlayerHistory(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -1867,31 +3060,39 @@ func (siw *ServerInterfaceWrapper) RemoveClubMember(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RemoveClubMember(c, id, playerId)
+	siw.Handler.GetArenaPlayerHistory(c, id, playerId)
 }
 
-// ListCorrections operation middleware
-func (siw *ServerInterfaceWrapper) ListCorrections(c *gin.Context) {
+// ListAuditEntries operation middleware
+func (siw *ServerInterfaceWrapper) ListAuditEntries(c *gin.Context) {
 
 	var err error
 	_ = err
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListCorrectionsParams
+	var params ListAuditEntriesParams
 
-	// ------------- Optional query parameter "player_id" -------------
+	// ------------- Optional query parameter "entity_type" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "player_id", c.Request.URL.Query(), &params.PlayerId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "entity_type", c.Request.URL.Query(), &params.EntityType, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter player_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter entity_type: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "club_id" -------------
+	// ------------- Optional query parameter "entity_id" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "club_id", c.Request.URL.Query(), &params.ClubId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "entity_id", c.Request.URL.Query(), &params.EntityId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter club_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter entity_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "user_id", c.Request.URL.Query(), &params.UserId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1918,11 +3119,11 @@ func (siw *ServerInterfaceWrapper) ListCorrections(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListCorrections(c, params)
+	siw.Handler.ListAuditEntries(c, params)
 }
 
-// ListGames operation middleware
-func (siw *ServerInterfaceWrapper) ListGames(c *gin.Context) {
+// AuthLogin operation middleware
+func (siw *ServerInterfaceWrapper) AuthLogin(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1931,11 +3132,11 @@ func (siw *ServerInterfaceWrapper) ListGames(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListGames(c)
+	siw.Handler.AuthLogin(c)
 }
 
-// CreateGame operation middleware
-func (siw *ServerInterfaceWrapper) CreateGame(c *gin.Context) {
+// AuthLogout operation middleware
+func (siw *ServerInterfaceWrapper) AuthLogout(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1944,23 +3145,11 @@ func (siw *ServerInterfaceWrapper) CreateGame(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateGame(c)
+	siw.Handler.AuthLogout(c)
 }
 
-// DeleteGame operation middleware
-func (siw *ServerInterfaceWrapper) DeleteGame(c *gin.Context) {
-
-	var err error
-	_ = err
-
-	// ------------- Path parameter "id" -------------
-	var id string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
-		return
-	}
+// GetMe operation middleware
+func (siw *ServerInterfaceWrapper) GetMe(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1969,23 +3158,11 @@ func (siw *ServerInterfaceWrapper) DeleteGame(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteGame(c, id)
+	siw.Handler.GetMe(c)
 }
 
-// GetGame operation middleware
-func (siw *ServerInterfaceWrapper) GetGame(c *gin.Context) {
-
-	var err error
-	_ = err
-
-	// ------------- Path parameter "id" -------------
-	var id string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
-		return
-	}
+// PatchMe operation middleware
+func (siw *ServerInterfaceWrapper) PatchMe(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1994,46 +3171,31 @@ func (siw *ServerInterfaceWrapper) GetGame(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetGame(c, id)
+	siw.Handler.PatchMe(c)
 }
 
-// PatchGame operation middleware
-func (siw *ServerInterfaceWrapper) PatchGame(c *gin.Context) {
+// AuthOAuth2Callback operation middleware
+func (siw *ServerInterfaceWrapper) AuthOAuth2Callback(c *gin.Context) {
 
 	var err error
 	_ = err
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AuthOAuth2CallbackParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	// ------------- Optional query parameter "code" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "code", c.Request.URL.Query(), &params.Code, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.PatchGame(c, id)
-}
-
-// GetGameMatches operation middleware
-func (siw *ServerInterfaceWrapper) GetGameMatches(c *gin.Context) {
-
-	var err error
-	_ = err
-
-	// ------------- Path parameter "id" -------------
-	var id string
+	// ------------- Optional query parameter "state" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "state", c.Request.URL.Query(), &params.State, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter state: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -2044,11 +3206,11 @@ func (siw *ServerInterfaceWrapper) GetGameMatches(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetGameMatches(c, id)
+	siw.Handler.AuthOAuth2Callback(c, params)
 }
 
-// ListMarkets operation middleware
-func (siw *ServerInterfaceWrapper) ListMarkets(c *gin.Context) {
+// ListClubs operation middleware
+func (siw *ServerInterfaceWrapper) ListClubs(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -2057,11 +3219,11 @@ func (siw *ServerInterfaceWrapper) ListMarkets(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListMarkets(c)
+	siw.Handler.ListClubs(c)
 }
 
-// CreateMarket operation middleware
-func (siw *ServerInterfaceWrapper) CreateMarket(c *gin.Context) {
+// CreateClub operation middleware
+func (siw *ServerInterfaceWrapper) CreateClub(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -2070,11 +3232,11 @@ func (siw *ServerInterfaceWrapper) CreateMarket(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateMarket(c)
+	siw.Handler.CreateClub(c)
 }
 
-// DeleteMarket operation middleware
-func (siw *ServerInterfaceWrapper) DeleteMarket(c *gin.Context) {
+// DeleteClub operation middleware
+func (siw *ServerInterfaceWrapper) DeleteClub(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2095,11 +3257,11 @@ func (siw *ServerInterfaceWrapper) DeleteMarket(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteMarket(c, id)
+	siw.Handler.DeleteClub(c, id)
 }
 
-// GetMarket operation middleware
-func (siw *ServerInterfaceWrapper) GetMarket(c *gin.Context) {
+// GetClub operation middleware
+func (siw *ServerInterfaceWrapper) GetClub(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2120,11 +3282,11 @@ func (siw *ServerInterfaceWrapper) GetMarket(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetMarket(c, id)
+	siw.Handler.GetClub(c, id)
 }
 
-// PatchMarket operation middleware
-func (siw *ServerInterfaceWrapper) PatchMarket(c *gin.Context) {
+// PatchClub operation middleware
+func (siw *ServerInterfaceWrapper) PatchClub(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2145,11 +3307,11 @@ func (siw *ServerInterfaceWrapper) PatchMarket(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PatchMarket(c, id)
+	siw.Handler.PatchClub(c, id)
 }
 
-// PlaceBet operation middleware
-func (siw *ServerInterfaceWrapper) PlaceBet(c *gin.Context) {
+// AddClubMember operation middleware
+func (siw *ServerInterfaceWrapper) AddClubMember(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2170,11 +3332,11 @@ func (siw *ServerInterfaceWrapper) PlaceBet(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PlaceBet(c, id)
+	siw.Handler.AddClubMember(c, id)
 }
 
-// GetMarketPriceHistory operation middleware
-func (siw *ServerInterfaceWrapper) GetMarketPriceHistory(c *gin.Context) {
+// RemoveClubMember operation middleware
+func (siw *ServerInterfaceWrapper) RemoveClubMember(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2188,6 +3350,15 @@ func (siw *ServerInterfaceWrapper) GetMarketPriceHistory(c *gin.Context) {
 		return
 	}
 
+	// ------------- Path parameter "playerId" -------------
+	var playerId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "playerId", c.Param("playerId"), &playerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter playerId: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -2195,25 +3366,17 @@ func (siw *ServerInterfaceWrapper) GetMarketPriceHistory(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetMarketPriceHistory(c, id)
+	siw.Handler.RemoveClubMember(c, id, playerId)
 }
 
-// ListMatches operation middleware
-func (siw *ServerInterfaceWrapper) ListMatches(c *gin.Context) {
+// ListCorrections operation middleware
+func (siw *ServerInterfaceWrapper) ListCorrections(c *gin.Context) {
 
 	var err error
 	_ = err
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListMatchesParams
-
-	// ------------- Optional query parameter "game_id" -------------
-
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "game_id", c.Request.URL.Query(), &params.GameId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter game_id: %w", err), http.StatusBadRequest)
-		return
-	}
+	var params ListCorrectionsParams
 
 	// ------------- Optional query parameter "player_id" -------------
 
@@ -2254,11 +3417,11 @@ func (siw *ServerInterfaceWrapper) ListMatches(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListMatches(c, params)
+	siw.Handler.ListCorrections(c, params)
 }
 
-// AddMatch operation middleware
-func (siw *ServerInterfaceWrapper) AddMatch(c *gin.Context) {
+// ListGames operation middleware
+func (siw *ServerInterfaceWrapper) ListGames(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -2267,23 +3430,11 @@ func (siw *ServerInterfaceWrapper) AddMatch(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.AddMatch(c)
+	siw.Handler.ListGames(c)
 }
 
-// GetMatchById operation middleware
-func (siw *ServerInterfaceWrapper) GetMatchById(c *gin.Context) {
-
-	var err error
-	_ = err
-
-	// ------------- Path parameter "id" -------------
-	var id string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
-		return
-	}
+// CreateGame operation middleware
+func (siw *ServerInterfaceWrapper) CreateGame(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -2292,11 +3443,11 @@ func (siw *ServerInterfaceWrapper) GetMatchById(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetMatchById(c, id)
+	siw.Handler.CreateGame(c)
 }
 
-// UpdateMatch operation middleware
-func (siw *ServerInterfaceWrapper) UpdateMatch(c *gin.Context) {
+// DeleteGame operation middleware
+func (siw *ServerInterfaceWrapper) DeleteGame(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2317,11 +3468,11 @@ func (siw *ServerInterfaceWrapper) UpdateMatch(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateMatch(c, id)
+	siw.Handler.DeleteGame(c, id)
 }
 
-// GetMarketsByMatchId operation middleware
-func (siw *ServerInterfaceWrapper) GetMarketsByMatchId(c *gin.Context) {
+// GetGame operation middleware
+func (siw *ServerInterfaceWrapper) GetGame(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2342,38 +3493,24 @@ func (siw *ServerInterfaceWrapper) GetMarketsByMatchId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetMarketsByMatchId(c, id)
+	siw.Handler.GetGame(c, id)
 }
 
-// GetPing operation middleware
-func (siw *ServerInterfaceWrapper) GetPing(c *gin.Context) {
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+// PatchGame operation middleware
+func (siw *ServerInterfaceWrapper) PatchGame(c *gin.Context) {
 
-	siw.Handler.GetPing(c)
-}
+	var err error
+	_ = err
 
-// ListPlayers operation middleware
-func (siw *ServerInterfaceWrapper) ListPlayers(c *gin.Context) {
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	siw.Handler.ListPlayers(c)
-}
-
-// CreatePlayer operation middleware
-func (siw *ServerInterfaceWrapper) CreatePlayer(c *gin.Context) {
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -2381,11 +3518,11 @@ func (siw *ServerInterfaceWrapper) CreatePlayer(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreatePlayer(c)
+	siw.Handler.PatchGame(c, id)
 }
 
-// DeletePlayer operation middleware
-func (siw *ServerInterfaceWrapper) DeletePlayer(c *gin.Context) {
+// GetGameMatches operation middleware
+func (siw *ServerInterfaceWrapper) GetGameMatches(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2406,11 +3543,11 @@ func (siw *ServerInterfaceWrapper) DeletePlayer(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeletePlayer(c, id)
+	siw.Handler.GetGameMatches(c, id)
 }
 
-// PatchPlayer operation middleware
-func (siw *ServerInterfaceWrapper) PatchPlayer(c *gin.Context) {
+// MergeGame operation middleware
+func (siw *ServerInterfaceWrapper) MergeGame(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2431,11 +3568,11 @@ func (siw *ServerInterfaceWrapper) PatchPlayer(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PatchPlayer(c, id)
+	siw.Handler.MergeGame(c, id)
 }
 
-// GetPlayerStats operation middleware
-func (siw *ServerInterfaceWrapper) GetPlayerStats(c *gin.Context) {
+// GetGameRecords operation middleware
+func (siw *ServerInterfaceWrapper) GetGameRecords(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2456,63 +3593,49 @@ func (siw *ServerInterfaceWrapper) GetPlayerStats(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetPlayerStats(c, id)
+	siw.Handler.GetGameRecords(c, id)
 }
 
-// DeleteSettings operation middleware
-func (siw *ServerInterfaceWrapper) DeleteSettings(c *gin.Context) {
+// GetLeaderboard operation middleware
+func (siw *ServerInterfaceWrapper) GetLeaderboard(c *gin.Context) {
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	var err error
+	_ = err
 
-	siw.Handler.DeleteSettings(c)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLeaderboardParams
 
-// GetSettings operation middleware
-func (siw *ServerInterfaceWrapper) GetSettings(c *gin.Context) {
+	// ------------- Optional query parameter "at" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "at", c.Request.URL.Query(), &params.At, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter at: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	siw.Handler.GetSettings(c)
-}
-
-// CreateSettings operation middleware
-func (siw *ServerInterfaceWrapper) CreateSettings(c *gin.Context) {
+	// ------------- Optional query parameter "since" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "since", c.Request.URL.Query(), &params.Since, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter since: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	siw.Handler.CreateSettings(c)
-}
-
-// ListAllSettings operation middleware
-func (siw *ServerInterfaceWrapper) ListAllSettings(c *gin.Context) {
+	// ------------- Optional query parameter "game_id" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "game_id", c.Request.URL.Query(), &params.GameId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter game_id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	siw.Handler.ListAllSettings(c)
-}
+	// ------------- Optional query parameter "club_id" -------------
 
-// ParseSkullKingCardImage operation middleware
-func (siw *ServerInterfaceWrapper) ParseSkullKingCardImage(c *gin.Context) {
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "club_id", c.Request.URL.Query(), &params.ClubId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter club_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -2521,11 +3644,11 @@ func (siw *ServerInterfaceWrapper) ParseSkullKingCardImage(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ParseSkullKingCardImage(c)
+	siw.Handler.GetLeaderboard(c, params)
 }
 
-// ListSkullKingTables operation middleware
-func (siw *ServerInterfaceWrapper) ListSkullKingTables(c *gin.Context) {
+// ListMarkets operation middleware
+func (siw *ServerInterfaceWrapper) ListMarkets(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -2534,11 +3657,11 @@ func (siw *ServerInterfaceWrapper) ListSkullKingTables(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListSkullKingTables(c)
+	siw.Handler.ListMarkets(c)
 }
 
-// CreateSkullKingTable operation middleware
-func (siw *ServerInterfaceWrapper) CreateSkullKingTable(c *gin.Context) {
+// CreateMarket operation middleware
+func (siw *ServerInterfaceWrapper) CreateMarket(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -2547,11 +3670,11 @@ func (siw *ServerInterfaceWrapper) CreateSkullKingTable(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateSkullKingTable(c)
+	siw.Handler.CreateMarket(c)
 }
 
-// DeleteSkullKingTable operation middleware
-func (siw *ServerInterfaceWrapper) DeleteSkullKingTable(c *gin.Context) {
+// DeleteMarket operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMarket(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2565,17 +3688,6 @@ func (siw *ServerInterfaceWrapper) DeleteSkullKingTable(c *gin.Context) {
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteSkullKingTableParams
-
-	// ------------- Optional query parameter "match_id" -------------
-
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "match_id", c.Request.URL.Query(), &params.MatchId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter match_id: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -2583,11 +3695,11 @@ func (siw *ServerInterfaceWrapper) DeleteSkullKingTable(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteSkullKingTable(c, id, params)
+	siw.Handler.DeleteMarket(c, id)
 }
 
-// GetSkullKingTable operation middleware
-func (siw *ServerInterfaceWrapper) GetSkullKingTable(c *gin.Context) {
+// GetMarket operation middleware
+func (siw *ServerInterfaceWrapper) GetMarket(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2608,11 +3720,11 @@ func (siw *ServerInterfaceWrapper) GetSkullKingTable(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetSkullKingTable(c, id)
+	siw.Handler.GetMarket(c, id)
 }
 
-// SubmitSkullKingBid operation middleware
-func (siw *ServerInterfaceWrapper) SubmitSkullKingBid(c *gin.Context) {
+// PatchMarket operation middleware
+func (siw *ServerInterfaceWrapper) PatchMarket(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2633,11 +3745,11 @@ func (siw *ServerInterfaceWrapper) SubmitSkullKingBid(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.SubmitSkullKingBid(c, id)
+	siw.Handler.PatchMarket(c, id)
 }
 
-// JoinSkullKingTable operation middleware
-func (siw *ServerInterfaceWrapper) JoinSkullKingTable(c *gin.Context) {
+// PlaceBet operation middleware
+func (siw *ServerInterfaceWrapper) PlaceBet(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2658,11 +3770,11 @@ func (siw *ServerInterfaceWrapper) JoinSkullKingTable(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.JoinSkullKingTable(c, id)
+	siw.Handler.PlaceBet(c, id)
 }
 
-// SubmitSkullKingResult operation middleware
-func (siw *ServerInterfaceWrapper) SubmitSkullKingResult(c *gin.Context) {
+// GetMarketPriceHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetMarketPriceHistory(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2683,21 +3795,55 @@ func (siw *ServerInterfaceWrapper) SubmitSkullKingResult(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.SubmitSkullKingResult(c, id)
+	siw.Handler.GetMarketPriceHistory(c, id)
 }
 
-// UpdateSkullKingTableState operation middleware
-func (siw *ServerInterfaceWrapper) UpdateSkullKingTableState(c *gin.Context) {
+// ListMatches operation middleware
+func (siw *ServerInterfaceWrapper) ListMatches(c *gin.Context) {
 
 	var err error
 	_ = err
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMatchesParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	// ------------- Optional query parameter "game_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "game_id", c.Request.URL.Query(), &params.GameId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter game_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "player_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "player_id", c.Request.URL.Query(), &params.PlayerId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter player_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "club_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "club_id", c.Request.URL.Query(), &params.ClubId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter club_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "next", c.Request.URL.Query(), &params.Next, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter next: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", c.Request.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -2708,11 +3854,11 @@ func (siw *ServerInterfaceWrapper) UpdateSkullKingTableState(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateSkullKingTableState(c, id)
+	siw.Handler.ListMatches(c, params)
 }
 
-// ListTournaments operation middleware
-func (siw *ServerInterfaceWrapper) ListTournaments(c *gin.Context) {
+// AddMatch operation middleware
+func (siw *ServerInterfaceWrapper) AddMatch(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -2721,11 +3867,11 @@ func (siw *ServerInterfaceWrapper) ListTournaments(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListTournaments(c)
+	siw.Handler.AddMatch(c)
 }
 
-// CreateTournament operation middleware
-func (siw *ServerInterfaceWrapper) CreateTournament(c *gin.Context) {
+// PreviewMatch operation middleware
+func (siw *ServerInterfaceWrapper) PreviewMatch(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -2734,11 +3880,11 @@ func (siw *ServerInterfaceWrapper) CreateTournament(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateTournament(c)
+	siw.Handler.PreviewMatch(c)
 }
 
-// DeleteTournament operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTournament(c *gin.Context) {
+// DeleteMatch operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMatch(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2759,11 +3905,11 @@ func (siw *ServerInterfaceWrapper) DeleteTournament(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteTournament(c, id)
+	siw.Handler.DeleteMatch(c, id)
 }
 
-// GetTournament operation middleware
-func (siw *ServerInterfaceWrapper) GetTournament(c *gin.Context) {
+// GetMatchById operation middleware
+func (siw *ServerInterfaceWrapper) GetMatchById(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2784,11 +3930,11 @@ func (siw *ServerInterfaceWrapper) GetTournament(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetTournament(c, id)
+	siw.Handler.GetMatchById(c, id)
 }
 
-// UpdateTournament operation middleware
-func (siw *ServerInterfaceWrapper) UpdateTournament(c *gin.Context) {
+// UpdateMatch operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMatch(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2809,11 +3955,11 @@ func (siw *ServerInterfaceWrapper) UpdateTournament(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateTournament(c, id)
+	siw.Handler.UpdateMatch(c, id)
 }
 
-// GetTournamentStats operation middleware
-func (siw *ServerInterfaceWrapper) GetTournamentStats(c *gin.Context) {
+// GetMarketsByMatchId operation middleware
+func (siw *ServerInterfaceWrapper) GetMarketsByMatchId(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -2834,11 +3980,11 @@ func (siw *ServerInterfaceWrapper) GetTournamentStats(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetTournamentStats(c, id)
+	siw.Handler.GetMarketsByMatchId(c, id)
 }
 
-// ListUsers operation middleware
-func (siw *ServerInterfaceWrapper) ListUsers(c *gin.Context) {
+// SuggestTables operation middleware
+func (siw *ServerInterfaceWrapper) SuggestTables(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -2847,21 +3993,36 @@ func (siw *ServerInterfaceWrapper) ListUsers(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListUsers(c)
+	siw.Handler.SuggestTables(c)
 }
 
-// PatchUser operation middleware
-func (siw *ServerInterfaceWrapper) PatchUser(c *gin.Context) {
+// GetPing operation middleware
+func (siw *ServerInterfaceWrapper) GetPing(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPing(c)
+}
+
+// ListPlayers operation middleware
+func (siw *ServerInterfaceWrapper) ListPlayers(c *gin.Context) {
 
 	var err error
 	_ = err
 
-	// ------------- Path parameter "userId" -------------
-	var userId string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPlayersParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	// ------------- Optional query parameter "include_guests" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "include_guests", c.Request.URL.Query(), &params.IncludeGuests, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include_guests: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -2872,11 +4033,11 @@ func (siw *ServerInterfaceWrapper) PatchUser(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PatchUser(c, userId)
+	siw.Handler.ListPlayers(c, params)
 }
 
-// ParseVoiceInput operation middleware
-func (siw *ServerInterfaceWrapper) ParseVoiceInput(c *gin.Context) {
+// CreatePlayer operation middleware
+func (siw *ServerInterfaceWrapper) CreatePlayer(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -2885,800 +4046,866 @@ func (siw *ServerInterfaceWrapper) ParseVoiceInput(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ParseVoiceInput(c)
+	siw.Handler.CreatePlayer(c)
 }
 
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
-}
+// DeletePlayer operation middleware
+func (siw *ServerInterfaceWrapper) DeletePlayer(c *gin.Context) {
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
-}
+	var err error
+	_ = err
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
-		}
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandler:       errorHandler,
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	router.POST(options.BaseURL+"/admin/players/:id/corrections", wrapper.CreatePlayerCorrection)
-	router.POST(options.BaseURL+"/admin/recalculate-game-elo", wrapper.RecalculateGameElo)
-	router.GET(options.BaseURL+"/auth/login", wrapper.AuthLogin)
-	router.POST(options.BaseURL+"/auth/logout", wrapper.AuthLogout)
-	router.GET(options.BaseURL+"/auth/me", wrapper.GetMe)
-	router.PATCH(options.BaseURL+"/auth/me", wrapper.PatchMe)
-	router.GET(options.BaseURL+"/auth/oauth2-callback", wrapper.AuthOAuth2Callback)
-	router.GET(options.BaseURL+"/clubs", wrapper.ListClubs)
-	router.POST(options.BaseURL+"/clubs", wrapper.CreateClub)
-	router.DELETE(options.BaseURL+"/clubs/:id", wrapper.DeleteClub)
-	router.GET(options.BaseURL+"/clubs/:id", wrapper.GetClub)
-	router.PATCH(options.BaseURL+"/clubs/:id", wrapper.PatchClub)
-	router.POST(options.BaseURL+"/clubs/:id/members", wrapper.AddClubMember)
-	router.DELETE(options.BaseURL+"/clubs/:id/members/:playerId", wrapper.RemoveClubMember)
-	router.GET(options.BaseURL+"/corrections", wrapper.ListCorrections)
-	router.GET(options.BaseURL+"/games", wrapper.ListGames)
-	router.POST(options.BaseURL+"/games", wrapper.CreateGame)
-	router.DELETE(options.BaseURL+"/games/:id", wrapper.DeleteGame)
-	router.GET(options.BaseURL+"/games/:id", wrapper.GetGame)
-	router.PATCH(options.BaseURL+"/games/:id", wrapper.PatchGame)
-	router.GET(options.BaseURL+"/games/:id/matches", wrapper.GetGameMatches)
-	router.GET(options.BaseURL+"/markets", wrapper.ListMarkets)
-	router.POST(options.BaseURL+"/markets", wrapper.CreateMarket)
-	router.DELETE(options.BaseURL+"/markets/:id", wrapper.DeleteMarket)
-	router.GET(options.BaseURL+"/markets/:id", wrapper.GetMarket)
-	router.PATCH(options.BaseURL+"/markets/:id", wrapper.PatchMarket)
-	router.POST(options.BaseURL+"/markets/:id/bets", wrapper.PlaceBet)
-	router.GET(options.BaseURL+"/markets/:id/price-history", wrapper.GetMarketPriceHistory)
-	router.GET(options.BaseURL+"/matches", wrapper.ListMatches)
-	router.POST(options.BaseURL+"/matches", wrapper.AddMatch)
-	router.GET(options.BaseURL+"/matches/:id", wrapper.GetMatchById)
-	router.PUT(options.BaseURL+"/matches/:id", wrapper.UpdateMatch)
-	router.GET(options.BaseURL+"/matches/:id/markets", wrapper.GetMarketsByMatchId)
-	router.GET(options.BaseURL+"/ping", wrapper.GetPing)
-	router.GET(options.BaseURL+"/players", wrapper.ListPlayers)
-	router.POST(options.BaseURL+"/players", wrapper.CreatePlayer)
-	router.DELETE(options.BaseURL+"/players/:id", wrapper.DeletePlayer)
-	router.PATCH(options.BaseURL+"/players/:id", wrapper.PatchPlayer)
-	router.GET(options.BaseURL+"/players/:id/stats", wrapper.GetPlayerStats)
-	router.DELETE(options.BaseURL+"/settings", wrapper.DeleteSettings)
-	router.GET(options.BaseURL+"/settings", wrapper.GetSettings)
-	router.POST(options.BaseURL+"/settings", wrapper.CreateSettings)
-	router.GET(options.BaseURL+"/settings/all", wrapper.ListAllSettings)
-	router.POST(options.BaseURL+"/skull-king/parse-card-image", wrapper.ParseSkullKingCardImage)
-	router.GET(options.BaseURL+"/skull-king/tables", wrapper.ListSkullKingTables)
-	router.POST(options.BaseURL+"/skull-king/tables", wrapper.CreateSkullKingTable)
-	router.DELETE(options.BaseURL+"/skull-king/tables/:id", wrapper.DeleteSkullKingTable)
-	router.GET(options.BaseURL+"/skull-king/tables/:id", wrapper.GetSkullKingTable)
-	router.POST(options.BaseURL+"/skull-king/tables/:id/bid", wrapper.SubmitSkullKingBid)
-	router.POST(options.BaseURL+"/skull-king/tables/:id/join", wrapper.JoinSkullKingTable)
-	router.POST(options.BaseURL+"/skull-king/tables/:id/result", wrapper.SubmitSkullKingResult)
-	router.PATCH(options.BaseURL+"/skull-king/tables/:id/state", wrapper.UpdateSkullKingTableState)
-	router.GET(options.BaseURL+"/tournaments", wrapper.ListTournaments)
-	router.POST(options.BaseURL+"/tournaments", wrapper.CreateTournament)
-	router.DELETE(options.BaseURL+"/tournaments/:id", wrapper.DeleteTournament)
-	router.GET(options.BaseURL+"/tournaments/:id", wrapper.GetTournament)
-	router.PUT(options.BaseURL+"/tournaments/:id", wrapper.UpdateTournament)
-	router.GET(options.BaseURL+"/tournaments/:id/stats", wrapper.GetTournamentStats)
-	router.GET(options.BaseURL+"/users", wrapper.ListUsers)
-	router.PATCH(options.BaseURL+"/users/:userId", wrapper.PatchUser)
-	router.POST(options.BaseURL+"/voice/parse", wrapper.ParseVoiceInput)
+	siw.Handler.DeletePlayer(c, id)
 }
 
-type CreatePlayerCorrectionRequestObject struct {
-	Id   string `json:"id"`
-	Body *CreatePlayerCorrectionJSONRequestBody
-}
+// PatchPlayer operation middleware
+func (siw *ServerInterfaceWrapper) PatchPlayer(c *gin.Context) {
 
-type CreatePlayerCorrectionResponseObject interface {
-	VisitCreatePlayerCorrectionResponse(w http.ResponseWriter) error
-}
+	var err error
+	_ = err
 
-type CreatePlayerCorrection200JSONResponse ApiSuccessMessage
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response CreatePlayerCorrection200JSONResponse) VisitCreatePlayerCorrectionResponse(w http.ResponseWriter) error {
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
+
+	siw.Handler.PatchPlayer(c, id)
 }
 
-type CreatePlayerCorrection400JSONResponse ApiError
+// GetPlayerHeadToHead operation middleware
+func (siw *ServerInterfaceWrapper) GetPlayerHeadToHead(c *gin.Context) {
 
-func (response CreatePlayerCorrection400JSONResponse) VisitCreatePlayerCorrectionResponse(w http.ResponseWriter) error {
+	var err error
+	_ = err
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type CreatePlayerCorrection500JSONResponse ApiError
+	// ------------- Path parameter "otherId" -------------
+	var otherId string
 
-func (response CreatePlayerCorrection500JSONResponse) VisitCreatePlayerCorrectionResponse(w http.ResponseWriter) error {
+	err = runtime.BindStyledParameterWithOptions("simple", "otherId", c.Param("otherId"), &otherId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter otherId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type RecalculateGameEloRequestObject struct {
+	siw.Handler.GetPlayerHeadToHead(c, id, otherId)
 }
 
-type RecalculateGameEloResponseObject interface {
-	VisitRecalculateGameEloResponse(w http.ResponseWriter) error
-}
+// MergePlayer operation middleware
+func (siw *ServerInterfaceWrapper) MergePlayer(c *gin.Context) {
 
-type RecalculateGameElo200JSONResponse ApiSuccessMessage
+	var err error
+	_ = err
 
-func (response RecalculateGameElo200JSONResponse) VisitRecalculateGameEloResponse(w http.ResponseWriter) error {
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
-}
-
-type RecalculateGameElo500JSONResponse ApiError
 
-func (response RecalculateGameElo500JSONResponse) VisitRecalculateGameEloResponse(w http.ResponseWriter) error {
-
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-	_, err := buf.WriteTo(w)
-	return err
-}
-
-type AuthLoginRequestObject struct {
-}
 
-type AuthLoginResponseObject interface {
-	VisitAuthLoginResponse(w http.ResponseWriter) error
+	siw.Handler.MergePlayer(c, id)
 }
 
-type AuthLogin302Response struct {
-}
+// GetPlayerRivals operation middleware
+func (siw *ServerInterfaceWrapper) GetPlayerRivals(c *gin.Context) {
 
-func (response AuthLogin302Response) VisitAuthLoginResponse(w http.ResponseWriter) error {
-	w.WriteHeader(302)
-	return nil
-}
+	var err error
+	_ = err
 
-type AuthLogoutRequestObject struct {
-}
-
-type AuthLogoutResponseObject interface {
-	VisitAuthLogoutResponse(w http.ResponseWriter) error
-}
-
-type AuthLogout200JSONResponse ApiSuccessMessage
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response AuthLogout200JSONResponse) VisitAuthLogoutResponse(w http.ResponseWriter) error {
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type GetMeRequestObject struct {
+	siw.Handler.GetPlayerRivals(c, id)
 }
 
-type GetMeResponseObject interface {
-	VisitGetMeResponse(w http.ResponseWriter) error
-}
+// GetPlayerStats operation middleware
+func (siw *ServerInterfaceWrapper) GetPlayerStats(c *gin.Context) {
 
-type GetMe200JSONResponse struct {
-	Data   User   `json:"data"`
-	Status string `json:"status"`
-}
+	var err error
+	_ = err
 
-func (response GetMe200JSONResponse) VisitGetMeResponse(w http.ResponseWriter) error {
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type GetMe401JSONResponse ApiError
-
-func (response GetMe401JSONResponse) VisitGetMeResponse(w http.ResponseWriter) error {
-
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type PatchMeRequestObject struct {
-	Body *PatchMeJSONRequestBody
+	siw.Handler.GetPlayerStats(c, id)
 }
 
-type PatchMeResponseObject interface {
-	VisitPatchMeResponse(w http.ResponseWriter) error
-}
+// PredictMatch operation middleware
+func (siw *ServerInterfaceWrapper) PredictMatch(c *gin.Context) {
 
-type PatchMe204Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-func (response PatchMe204Response) VisitPatchMeResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+	siw.Handler.PredictMatch(c)
 }
 
-type PatchMe400JSONResponse ApiError
-
-func (response PatchMe400JSONResponse) VisitPatchMeResponse(w http.ResponseWriter) error {
+// GetRecords operation middleware
+func (siw *ServerInterfaceWrapper) GetRecords(c *gin.Context) {
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type PatchMe401JSONResponse ApiError
+	siw.Handler.GetRecords(c)
+}
 
-func (response PatchMe401JSONResponse) VisitPatchMeResponse(w http.ResponseWriter) error {
+// ListSeasons operation middleware
+func (siw *ServerInterfaceWrapper) ListSeasons(c *gin.Context) {
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type PatchMe409JSONResponse ApiError
+	siw.Handler.ListSeasons(c)
+}
 
-func (response PatchMe409JSONResponse) VisitPatchMeResponse(w http.ResponseWriter) error {
+// CreateSeason operation middleware
+func (siw *ServerInterfaceWrapper) CreateSeason(c *gin.Context) {
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type AuthOAuth2CallbackRequestObject struct {
-	Params AuthOAuth2CallbackParams
+	siw.Handler.CreateSeason(c)
 }
 
-type AuthOAuth2CallbackResponseObject interface {
-	VisitAuthOAuth2CallbackResponse(w http.ResponseWriter) error
-}
+// DeleteSeason operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSeason(c *gin.Context) {
 
-type AuthOAuth2Callback200JSONResponse struct {
-	Status string `json:"status"`
-}
+	var err error
+	_ = err
 
-func (response AuthOAuth2Callback200JSONResponse) VisitAuthOAuth2CallbackResponse(w http.ResponseWriter) error {
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type ListClubsRequestObject struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type ListClubsResponseObject interface {
-	VisitListClubsResponse(w http.ResponseWriter) error
+	siw.Handler.DeleteSeason(c, id)
 }
 
-type ListClubs200JSONResponse struct {
-	Data   []Club `json:"data"`
-	Status string `json:"status"`
-}
+// ArchiveSeason operation middleware
+func (siw *ServerInterfaceWrapper) ArchiveSeason(c *gin.Context) {
 
-func (response ListClubs200JSONResponse) VisitListClubsResponse(w http.ResponseWriter) error {
+	var err error
+	_ = err
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type CreateClubRequestObject struct {
-	Body *CreateClubJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type CreateClubResponseObject interface {
-	VisitCreateClubResponse(w http.ResponseWriter) error
+	siw.Handler.ArchiveSeason(c, id)
 }
 
-type CreateClub200JSONResponse struct {
-	Data   Club   `json:"data"`
-	Status string `json:"status"`
-}
+// GetSeasonLeaderboard operation middleware
+func (siw *ServerInterfaceWrapper) GetSeasonLeaderboard(c *gin.Context) {
 
-func (response CreateClub200JSONResponse) VisitCreateClubResponse(w http.ResponseWriter) error {
+	var err error
+	_ = err
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type CreateClub400JSONResponse ApiError
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSeasonLeaderboardParams
 
-func (response CreateClub400JSONResponse) VisitCreateClubResponse(w http.ResponseWriter) error {
+	// ------------- Optional query parameter "game_id" -------------
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "game_id", c.Request.URL.Query(), &params.GameId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter game_id: %w", err), http.StatusBadRequest)
+		return
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type CreateClub401JSONResponse ApiError
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-func (response CreateClub401JSONResponse) VisitCreateClubResponse(w http.ResponseWriter) error {
+	siw.Handler.GetSeasonLeaderboard(c, id, params)
+}
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+// DeleteSettings operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSettings(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type CreateClub403JSONResponse ApiError
+	siw.Handler.DeleteSettings(c)
+}
 
-func (response CreateClub403JSONResponse) VisitCreateClubResponse(w http.ResponseWriter) error {
+// GetSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetSettings(c *gin.Context) {
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type CreateClub409JSONResponse ApiError
+	siw.Handler.GetSettings(c)
+}
 
-func (response CreateClub409JSONResponse) VisitCreateClubResponse(w http.ResponseWriter) error {
+// CreateSettings operation middleware
+func (siw *ServerInterfaceWrapper) CreateSettings(c *gin.Context) {
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type DeleteClubRequestObject struct {
-	Id string `json:"id"`
+	siw.Handler.CreateSettings(c)
 }
 
-type DeleteClubResponseObject interface {
-	VisitDeleteClubResponse(w http.ResponseWriter) error
-}
+// ListAllSettings operation middleware
+func (siw *ServerInterfaceWrapper) ListAllSettings(c *gin.Context) {
 
-type DeleteClub200JSONResponse ApiSuccessMessage
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-func (response DeleteClub200JSONResponse) VisitDeleteClubResponse(w http.ResponseWriter) error {
+	siw.Handler.ListAllSettings(c)
+}
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+// ParseSkullKingCardImage operation middleware
+func (siw *ServerInterfaceWrapper) ParseSkullKingCardImage(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type DeleteClub400JSONResponse ApiError
+	siw.Handler.ParseSkullKingCardImage(c)
+}
 
-func (response DeleteClub400JSONResponse) VisitDeleteClubResponse(w http.ResponseWriter) error {
+// ListSkullKingTables operation middleware
+func (siw *ServerInterfaceWrapper) ListSkullKingTables(c *gin.Context) {
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type DeleteClub401JSONResponse ApiError
+	siw.Handler.ListSkullKingTables(c)
+}
 
-func (response DeleteClub401JSONResponse) VisitDeleteClubResponse(w http.ResponseWriter) error {
+// CreateSkullKingTable operation middleware
+func (siw *ServerInterfaceWrapper) CreateSkullKingTable(c *gin.Context) {
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
-	_, err := buf.WriteTo(w)
-	return err
+
+	siw.Handler.CreateSkullKingTable(c)
 }
 
-type DeleteClub403JSONResponse ApiError
+// DeleteSkullKingTable operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSkullKingTable(c *gin.Context) {
 
-func (response DeleteClub403JSONResponse) VisitDeleteClubResponse(w http.ResponseWriter) error {
+	var err error
+	_ = err
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type DeleteClub404JSONResponse ApiError
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteSkullKingTableParams
 
-func (response DeleteClub404JSONResponse) VisitDeleteClubResponse(w http.ResponseWriter) error {
+	// ------------- Optional query parameter "match_id" -------------
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "match_id", c.Request.URL.Query(), &params.MatchId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter match_id: %w", err), http.StatusBadRequest)
+		return
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-	_, err := buf.WriteTo(w)
-	return err
-}
-
-type GetClubRequestObject struct {
-	Id string `json:"id"`
-}
 
-type GetClubResponseObject interface {
-	VisitGetClubResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type GetClub200JSONResponse struct {
-	Data   Club   `json:"data"`
-	Status string `json:"status"`
+	siw.Handler.DeleteSkullKingTable(c, id, params)
 }
 
-func (response GetClub200JSONResponse) VisitGetClubResponse(w http.ResponseWriter) error {
+// GetSkullKingTable operation middleware
+func (siw *ServerInterfaceWrapper) GetSkullKingTable(c *gin.Context) {
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
-	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
-}
+	var err error
+	_ = err
 
-type GetClub400JSONResponse ApiError
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response GetClub400JSONResponse) VisitGetClubResponse(w http.ResponseWriter) error {
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-	_, err := buf.WriteTo(w)
-	return err
+
+	siw.Handler.GetSkullKingTable(c, id)
 }
 
-type GetClub404JSONResponse ApiError
+// SubmitSkullKingBid operation middleware
+func (siw *ServerInterfaceWrapper) SubmitSkullKingBid(c *gin.Context) {
 
-func (response GetClub404JSONResponse) VisitGetClubResponse(w http.ResponseWriter) error {
+	var err error
+	_ = err
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type PatchClubRequestObject struct {
-	Id   string `json:"id"`
-	Body *PatchClubJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type PatchClubResponseObject interface {
-	VisitPatchClubResponse(w http.ResponseWriter) error
+	siw.Handler.SubmitSkullKingBid(c, id)
 }
 
-type PatchClub200JSONResponse struct {
-	Data   Club   `json:"data"`
-	Status string `json:"status"`
-}
+// JoinSkullKingTable operation middleware
+func (siw *ServerInterfaceWrapper) JoinSkullKingTable(c *gin.Context) {
 
-func (response PatchClub200JSONResponse) VisitPatchClubResponse(w http.ResponseWriter) error {
-
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
-	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
-}
+	var err error
+	_ = err
 
-type PatchClub400JSONResponse ApiError
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response PatchClub400JSONResponse) VisitPatchClubResponse(w http.ResponseWriter) error {
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type PatchClub401JSONResponse ApiError
+	siw.Handler.JoinSkullKingTable(c, id)
+}
 
-func (response PatchClub401JSONResponse) VisitPatchClubResponse(w http.ResponseWriter) error {
+// SubmitSkullKingResult operation middleware
+func (siw *ServerInterfaceWrapper) SubmitSkullKingResult(c *gin.Context) {
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
-	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
-	_, err := buf.WriteTo(w)
-	return err
-}
+	var err error
+	_ = err
 
-type PatchClub403JSONResponse ApiError
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response PatchClub403JSONResponse) VisitPatchClubResponse(w http.ResponseWriter) error {
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
-	_, err := buf.WriteTo(w)
-	return err
+
+	siw.Handler.SubmitSkullKingResult(c, id)
 }
 
-type PatchClub404JSONResponse ApiError
+// UpdateSkullKingTableState operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSkullKingTableState(c *gin.Context) {
 
-func (response PatchClub404JSONResponse) VisitPatchClubResponse(w http.ResponseWriter) error {
+	var err error
+	_ = err
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type AddClubMemberRequestObject struct {
-	Id   string `json:"id"`
-	Body *AddClubMemberJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type AddClubMemberResponseObject interface {
-	VisitAddClubMemberResponse(w http.ResponseWriter) error
+	siw.Handler.UpdateSkullKingTableState(c, id)
 }
 
-type AddClubMember200JSONResponse ApiSuccessMessage
-
-func (response AddClubMember200JSONResponse) VisitAddClubMemberResponse(w http.ResponseWriter) error {
+// ListTournaments operation middleware
+func (siw *ServerInterfaceWrapper) ListTournaments(c *gin.Context) {
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type AddClubMember400JSONResponse ApiError
+	siw.Handler.ListTournaments(c)
+}
 
-func (response AddClubMember400JSONResponse) VisitAddClubMemberResponse(w http.ResponseWriter) error {
+// CreateTournament operation middleware
+func (siw *ServerInterfaceWrapper) CreateTournament(c *gin.Context) {
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type AddClubMember401JSONResponse ApiError
+	siw.Handler.CreateTournament(c)
+}
 
-func (response AddClubMember401JSONResponse) VisitAddClubMemberResponse(w http.ResponseWriter) error {
+// DeleteTournament operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTournament(c *gin.Context) {
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
-	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
-	_, err := buf.WriteTo(w)
-	return err
-}
+	var err error
+	_ = err
 
-type AddClubMember403JSONResponse ApiError
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response AddClubMember403JSONResponse) VisitAddClubMemberResponse(w http.ResponseWriter) error {
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
-	_, err := buf.WriteTo(w)
-	return err
-}
 
-type RemoveClubMemberRequestObject struct {
-	Id       string `json:"id"`
-	PlayerId string `json:"playerId"`
+	siw.Handler.DeleteTournament(c, id)
 }
 
-type RemoveClubMemberResponseObject interface {
-	VisitRemoveClubMemberResponse(w http.ResponseWriter) error
-}
+// GetTournament operation middleware
+func (siw *ServerInterfaceWrapper) GetTournament(c *gin.Context) {
 
-type RemoveClubMember200JSONResponse ApiSuccessMessage
+	var err error
+	_ = err
 
-func (response RemoveClubMember200JSONResponse) VisitRemoveClubMemberResponse(w http.ResponseWriter) error {
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTournament(c, id)
 }
 
-type RemoveClubMember400JSONResponse ApiError
+// UpdateTournament operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTournament(c *gin.Context) {
 
-func (response RemoveClubMember400JSONResponse) VisitRemoveClubMemberResponse(w http.ResponseWriter) error {
+	var err error
+	_ = err
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-	_, err := buf.WriteTo(w)
-	return err
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateTournament(c, id)
 }
 
-type RemoveClubMember401JSONResponse ApiError
+// GetTournamentStats operation middleware
+func (siw *ServerInterfaceWrapper) GetTournamentStats(c *gin.Context) {
 
-func (response RemoveClubMember401JSONResponse) VisitRemoveClubMemberResponse(w http.ResponseWriter) error {
+	var err error
+	_ = err
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
-	_, err := buf.WriteTo(w)
-	return err
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTournamentStats(c, id)
 }
 
-type RemoveClubMember403JSONResponse ApiError
+// ListUsers operation middleware
+func (siw *ServerInterfaceWrapper) ListUsers(c *gin.Context) {
 
-func (response RemoveClubMember403JSONResponse) VisitRemoveClubMemberResponse(w http.ResponseWriter) error {
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	siw.Handler.ListUsers(c)
+}
+
+// PatchUser operation middleware
+func (siw *ServerInterfaceWrapper) PatchUser(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
-	_, err := buf.WriteTo(w)
-	return err
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchUser(c, userId)
 }
 
-type ListCorrectionsRequestObject struct {
-	Params ListCorrectionsParams
+// ParseVoiceInput operation middleware
+func (siw *ServerInterfaceWrapper) ParseVoiceInput(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ParseVoiceInput(c)
 }
 
-type ListCorrectionsResponseObject interface {
-	VisitListCorrectionsResponse(w http.ResponseWriter) error
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
 }
 
-type ListCorrections200JSONResponse CorrectionsPage
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
 
-func (response ListCorrections200JSONResponse) VisitListCorrectionsResponse(w http.ResponseWriter) error {
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.POST(options.BaseURL+"/admin/checkpoints/verify", wrapper.VerifySettlementCheckpoints)
+	router.POST(options.BaseURL+"/admin/matches", wrapper.AdminAddMatch)
+	router.PUT(options.BaseURL+"/admin/matches/:id", wrapper.AdminUpdateMatch)
+	router.POST(options.BaseURL+"/admin/players/:id/corrections", wrapper.CreatePlayerCorrection)
+	router.POST(options.BaseURL+"/admin/recalculate-game-elo", wrapper.RecalculateGameElo)
+	router.POST(options.BaseURL+"/admin/settings/simulate", wrapper.SimulateSettings)
+	router.GET(options.BaseURL+"/arenas", wrapper.ListArenas)
+	router.POST(options.BaseURL+"/arenas", wrapper.CreateArena)
+	router.DELETE(options.BaseURL+"/arenas/:id", wrapper.DeleteArena)
+	router.GET(options.BaseURL+"/arenas/:id", wrapper.GetArena)
+	router.PUT(options.BaseURL+"/arenas/:id", wrapper.UpdateArena)
+	router.GET(options.BaseURL+"/arenas/:id/leaderboard", wrapper.GetArenaLeaderboard)
+	router.GET(options.BaseURL+"/arenas/:id/players/:playerId/history", wrapper.GetArenaPlayerHistory)
+	router.GET(options.BaseURL+"/audit", wrapper.ListAuditEntries)
+	router.GET(options.BaseURL+"/auth/login", wrapper.AuthLogin)
+	router.POST(options.BaseURL+"/auth/logout", wrapper.AuthLogout)
+	router.GET(options.BaseURL+"/auth/me", wrapper.GetMe)
+	router.PATCH(options.BaseURL+"/auth/me", wrapper.PatchMe)
+	router.GET(options.BaseURL+"/auth/oauth2-callback", wrapper.AuthOAuth2Callback)
+	router.GET(options.BaseURL+"/clubs", wrapper.ListClubs)
+	router.POST(options.BaseURL+"/clubs", wrapper.CreateClub)
+	router.DELETE(options.BaseURL+"/clubs/:id", wrapper.DeleteClub)
+	router.GET(options.BaseURL+"/clubs/:id", wrapper.GetClub)
+	router.PATCH(options.BaseURL+"/clubs/:id", wrapper.PatchClub)
+	router.POST(options.BaseURL+"/clubs/:id/members", wrapper.AddClubMember)
+	router.DELETE(options.BaseURL+"/clubs/:id/members/:playerId", wrapper.RemoveClubMember)
+	router.GET(options.BaseURL+"/corrections", wrapper.ListCorrections)
+	router.GET(options.BaseURL+"/games", wrapper.ListGames)
+	router.POST(options.BaseURL+"/games", wrapper.CreateGame)
+	router.DELETE(options.BaseURL+"/games/:id", wrapper.DeleteGame)
+	router.GET(options.BaseURL+"/games/:id", wrapper.GetGame)
+	router.PATCH(options.BaseURL+"/games/:id", wrapper.PatchGame)
+	router.GET(options.BaseURL+"/games/:id/matches", wrapper.GetGameMatches)
+	router.POST(options.BaseURL+"/games/:id/merge", wrapper.MergeGame)
+	router.GET(options.BaseURL+"/games/:id/records", wrapper.GetGameRecords)
+	router.GET(options.BaseURL+"/leaderboard", wrapper.GetLeaderboard)
+	router.GET(options.BaseURL+"/markets", wrapper.ListMarkets)
+	router.POST(options.BaseURL+"/markets", wrapper.CreateMarket)
+	router.DELETE(options.BaseURL+"/markets/:id", wrapper.DeleteMarket)
+	router.GET(options.BaseURL+"/markets/:id", wrapper.GetMarket)
+	router.PATCH(options.BaseURL+"/markets/:id", wrapper.PatchMarket)
+	router.POST(options.BaseURL+"/markets/:id/bets", wrapper.PlaceBet)
+	router.GET(options.BaseURL+"/markets/:id/price-history", wrapper.GetMarketPriceHistory)
+	router.GET(options.BaseURL+"/matches", wrapper.ListMatches)
+	router.POST(options.BaseURL+"/matches", wrapper.AddMatch)
+	router.POST(options.BaseURL+"/matches/preview", wrapper.PreviewMatch)
+	router.DELETE(options.BaseURL+"/matches/:id", wrapper.DeleteMatch)
+	router.GET(options.BaseURL+"/matches/:id", wrapper.GetMatchById)
+	router.PUT(options.BaseURL+"/matches/:id", wrapper.UpdateMatch)
+	router.GET(options.BaseURL+"/matches/:id/markets", wrapper.GetMarketsByMatchId)
+	router.POST(options.BaseURL+"/matchmaking", wrapper.SuggestTables)
+	router.GET(options.BaseURL+"/ping", wrapper.GetPing)
+	router.GET(options.BaseURL+"/players", wrapper.ListPlayers)
+	router.POST(options.BaseURL+"/players", wrapper.CreatePlayer)
+	router.DELETE(options.BaseURL+"/players/:id", wrapper.DeletePlayer)
+	router.PATCH(options.BaseURL+"/players/:id", wrapper.PatchPlayer)
+	router.GET(options.BaseURL+"/players/:id/head-to-head/:otherId", wrapper.GetPlayerHeadToHead)
+	router.POST(options.BaseURL+"/players/:id/merge", wrapper.MergePlayer)
+	router.GET(options.BaseURL+"/players/:id/rivals", wrapper.GetPlayerRivals)
+	router.GET(options.BaseURL+"/players/:id/stats", wrapper.GetPlayerStats)
+	router.POST(options.BaseURL+"/predictions", wrapper.PredictMatch)
+	router.GET(options.BaseURL+"/records", wrapper.GetRecords)
+	router.GET(options.BaseURL+"/seasons", wrapper.ListSeasons)
+	router.POST(options.BaseURL+"/seasons", wrapper.CreateSeason)
+	router.DELETE(options.BaseURL+"/seasons/:id", wrapper.DeleteSeason)
+	router.POST(options.BaseURL+"/seasons/:id/archive", wrapper.ArchiveSeason)
+	router.GET(options.BaseURL+"/seasons/:id/leaderboard", wrapper.GetSeasonLeaderboard)
+	router.DELETE(options.BaseURL+"/settings", wrapper.DeleteSettings)
+	router.GET(options.BaseURL+"/settings", wrapper.GetSettings)
+	router.POST(options.BaseURL+"/settings", wrapper.CreateSettings)
+	router.GET(options.BaseURL+"/settings/all", wrapper.ListAllSettings)
+	router.POST(options.BaseURL+"/skull-king/parse-card-image", wrapper.ParseSkullKingCardImage)
+	router.GET(options.BaseURL+"/skull-king/tables", wrapper.ListSkullKingTables)
+	router.POST(options.BaseURL+"/skull-king/tables", wrapper.CreateSkullKingTable)
+	router.DELETE(options.BaseURL+"/skull-king/tables/:id", wrapper.DeleteSkullKingTable)
+	router.GET(options.BaseURL+"/skull-king/tables/:id", wrapper.GetSkullKingTable)
+	router.POST(options.BaseURL+"/skull-king/tables/:id/bid", wrapper.SubmitSkullKingBid)
+	router.POST(options.BaseURL+"/skull-king/tables/:id/join", wrapper.JoinSkullKingTable)
+	router.POST(options.BaseURL+"/skull-king/tables/:id/result", wrapper.SubmitSkullKingResult)
+	router.PATCH(options.BaseURL+"/skull-king/tables/:id/state", wrapper.UpdateSkullKingTableState)
+	router.GET(options.BaseURL+"/tournaments", wrapper.ListTournaments)
+	router.POST(options.BaseURL+"/tournaments", wrapper.CreateTournament)
+	router.DELETE(options.BaseURL+"/tournaments/:id", wrapper.DeleteTournament)
+	router.GET(options.BaseURL+"/tournaments/:id", wrapper.GetTournament)
+	router.PUT(options.BaseURL+"/tournaments/:id", wrapper.UpdateTournament)
+	router.GET(options.BaseURL+"/tournaments/:id/stats", wrapper.GetTournamentStats)
+	router.GET(options.BaseURL+"/users", wrapper.ListUsers)
+	router.PATCH(options.BaseURL+"/users/:userId", wrapper.PatchUser)
+	router.POST(options.BaseURL+"/voice/parse", wrapper.ParseVoiceInput)
+}
+
+type VerifySettlementCheckpointsRequestObject struct {
+}
+
+type VerifySettlementCheckpointsResponseObject interface {
+	VisitVerifySettlementCheckpointsResponse(w http.ResponseWriter) error
+}
+
+type VerifySettlementCheckpoints200JSONResponse struct {
+	Data   CheckpointVerification `json:"data"`
+	Status string                 `json:"status"`
+}
+
+func (response VerifySettlementCheckpoints200JSONResponse) VisitVerifySettlementCheckpointsResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3690,61 +4917,62 @@ func (response ListCorrections200JSONResponse) VisitListCorrectionsResponse(w ht
 	return err
 }
 
-type ListCorrections400JSONResponse ApiError
+type VerifySettlementCheckpoints401JSONResponse ApiError
 
-func (response ListCorrections400JSONResponse) VisitListCorrectionsResponse(w http.ResponseWriter) error {
+func (response VerifySettlementCheckpoints401JSONResponse) VisitVerifySettlementCheckpointsResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type ListGamesRequestObject struct {
-}
+type VerifySettlementCheckpoints403JSONResponse ApiError
 
-type ListGamesResponseObject interface {
-	VisitListGamesResponse(w http.ResponseWriter) error
-}
+func (response VerifySettlementCheckpoints403JSONResponse) VisitVerifySettlementCheckpointsResponse(w http.ResponseWriter) error {
 
-type ListGames200JSONResponse struct {
-	Data   GameList `json:"data"`
-	Status string   `json:"status"`
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
 }
 
-func (response ListGames200JSONResponse) VisitListGamesResponse(w http.ResponseWriter) error {
+type VerifySettlementCheckpoints500JSONResponse ApiError
+
+func (response VerifySettlementCheckpoints500JSONResponse) VisitVerifySettlementCheckpointsResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(500)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type CreateGameRequestObject struct {
-	Body *CreateGameJSONRequestBody
+type AdminAddMatchRequestObject struct {
+	Body *AdminAddMatchJSONRequestBody
 }
 
-type CreateGameResponseObject interface {
-	VisitCreateGameResponse(w http.ResponseWriter) error
+type AdminAddMatchResponseObject interface {
+	VisitAdminAddMatchResponse(w http.ResponseWriter) error
 }
 
-type CreateGame200JSONResponse struct {
-	Data struct {
-		Id   string `json:"id"`
-		Name string `json:"name"`
-	} `json:"data"`
-	Status string `json:"status"`
+type AdminAddMatch200JSONResponse struct {
+	Data   AdminMatchResult `json:"data"`
+	Status string           `json:"status"`
 }
 
-func (response CreateGame200JSONResponse) VisitCreateGameResponse(w http.ResponseWriter) error {
+func (response AdminAddMatch200JSONResponse) VisitAdminAddMatchResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3756,9 +4984,9 @@ func (response CreateGame200JSONResponse) VisitCreateGameResponse(w http.Respons
 	return err
 }
 
-type CreateGame400JSONResponse ApiError
+type AdminAddMatch400JSONResponse ApiError
 
-func (response CreateGame400JSONResponse) VisitCreateGameResponse(w http.ResponseWriter) error {
+func (response AdminAddMatch400JSONResponse) VisitAdminAddMatchResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3770,9 +4998,9 @@ func (response CreateGame400JSONResponse) VisitCreateGameResponse(w http.Respons
 	return err
 }
 
-type CreateGame401JSONResponse ApiError
+type AdminAddMatch401JSONResponse ApiError
 
-func (response CreateGame401JSONResponse) VisitCreateGameResponse(w http.ResponseWriter) error {
+func (response AdminAddMatch401JSONResponse) VisitAdminAddMatchResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3784,9 +5012,9 @@ func (response CreateGame401JSONResponse) VisitCreateGameResponse(w http.Respons
 	return err
 }
 
-type CreateGame403JSONResponse ApiError
+type AdminAddMatch403JSONResponse ApiError
 
-func (response CreateGame403JSONResponse) VisitCreateGameResponse(w http.ResponseWriter) error {
+func (response AdminAddMatch403JSONResponse) VisitAdminAddMatchResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3798,9 +5026,9 @@ func (response CreateGame403JSONResponse) VisitCreateGameResponse(w http.Respons
 	return err
 }
 
-type CreateGame409JSONResponse ApiError
+type AdminAddMatch409JSONResponse ApiError
 
-func (response CreateGame409JSONResponse) VisitCreateGameResponse(w http.ResponseWriter) error {
+func (response AdminAddMatch409JSONResponse) VisitAdminAddMatchResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3812,17 +5040,21 @@ func (response CreateGame409JSONResponse) VisitCreateGameResponse(w http.Respons
 	return err
 }
 
-type DeleteGameRequestObject struct {
-	Id string `json:"id"`
+type AdminUpdateMatchRequestObject struct {
+	Id   string `json:"id"`
+	Body *AdminUpdateMatchJSONRequestBody
 }
 
-type DeleteGameResponseObject interface {
-	VisitDeleteGameResponse(w http.ResponseWriter) error
+type AdminUpdateMatchResponseObject interface {
+	VisitAdminUpdateMatchResponse(w http.ResponseWriter) error
 }
 
-type DeleteGame200JSONResponse ApiSuccessMessage
+type AdminUpdateMatch200JSONResponse struct {
+	Data   AdminMatchResult `json:"data"`
+	Status string           `json:"status"`
+}
 
-func (response DeleteGame200JSONResponse) VisitDeleteGameResponse(w http.ResponseWriter) error {
+func (response AdminUpdateMatch200JSONResponse) VisitAdminUpdateMatchResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3834,9 +5066,9 @@ func (response DeleteGame200JSONResponse) VisitDeleteGameResponse(w http.Respons
 	return err
 }
 
-type DeleteGame400JSONResponse ApiError
+type AdminUpdateMatch400JSONResponse ApiError
 
-func (response DeleteGame400JSONResponse) VisitDeleteGameResponse(w http.ResponseWriter) error {
+func (response AdminUpdateMatch400JSONResponse) VisitAdminUpdateMatchResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3848,9 +5080,9 @@ func (response DeleteGame400JSONResponse) VisitDeleteGameResponse(w http.Respons
 	return err
 }
 
-type DeleteGame401JSONResponse ApiError
+type AdminUpdateMatch401JSONResponse ApiError
 
-func (response DeleteGame401JSONResponse) VisitDeleteGameResponse(w http.ResponseWriter) error {
+func (response AdminUpdateMatch401JSONResponse) VisitAdminUpdateMatchResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3862,9 +5094,9 @@ func (response DeleteGame401JSONResponse) VisitDeleteGameResponse(w http.Respons
 	return err
 }
 
-type DeleteGame403JSONResponse ApiError
+type AdminUpdateMatch403JSONResponse ApiError
 
-func (response DeleteGame403JSONResponse) VisitDeleteGameResponse(w http.ResponseWriter) error {
+func (response AdminUpdateMatch403JSONResponse) VisitAdminUpdateMatchResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3876,9 +5108,9 @@ func (response DeleteGame403JSONResponse) VisitDeleteGameResponse(w http.Respons
 	return err
 }
 
-type DeleteGame404JSONResponse ApiError
+type AdminUpdateMatch404JSONResponse ApiError
 
-func (response DeleteGame404JSONResponse) VisitDeleteGameResponse(w http.ResponseWriter) error {
+func (response AdminUpdateMatch404JSONResponse) VisitAdminUpdateMatchResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3890,34 +5122,46 @@ func (response DeleteGame404JSONResponse) VisitDeleteGameResponse(w http.Respons
 	return err
 }
 
-type GetGameRequestObject struct {
-	Id string `json:"id"`
-}
-
-type GetGameResponseObject interface {
-	VisitGetGameResponse(w http.ResponseWriter) error
-}
-
-type GetGame200JSONResponse struct {
-	Data   Game   `json:"data"`
-	Status string `json:"status"`
-}
+type AdminUpdateMatch409JSONResponse ApiError
 
-func (response GetGame200JSONResponse) VisitGetGameResponse(w http.ResponseWriter) error {
+func (response AdminUpdateMatch409JSONResponse) VisitAdminUpdateMatchResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(409)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type GetGame400JSONResponse ApiError
+type CreatePlayerCorrectionRequestObject struct {
+	Id   string `json:"id"`
+	Body *CreatePlayerCorrectionJSONRequestBody
+}
 
-func (response GetGame400JSONResponse) VisitGetGameResponse(w http.ResponseWriter) error {
+type CreatePlayerCorrectionResponseObject interface {
+	VisitCreatePlayerCorrectionResponse(w http.ResponseWriter) error
+}
+
+type CreatePlayerCorrection200JSONResponse ApiSuccessMessage
+
+func (response CreatePlayerCorrection200JSONResponse) VisitCreatePlayerCorrectionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreatePlayerCorrection400JSONResponse ApiError
+
+func (response CreatePlayerCorrection400JSONResponse) VisitCreatePlayerCorrectionResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3929,24 +5173,30 @@ func (response GetGame400JSONResponse) VisitGetGameResponse(w http.ResponseWrite
 	return err
 }
 
-type PatchGameRequestObject struct {
-	Id   string `json:"id"`
-	Body *PatchGameJSONRequestBody
+type CreatePlayerCorrection500JSONResponse ApiError
+
+func (response CreatePlayerCorrection500JSONResponse) VisitCreatePlayerCorrectionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
 }
 
-type PatchGameResponseObject interface {
-	VisitPatchGameResponse(w http.ResponseWriter) error
+type RecalculateGameEloRequestObject struct {
 }
 
-type PatchGame200JSONResponse struct {
-	Data struct {
-		Id   string `json:"id"`
-		Name string `json:"name"`
-	} `json:"data"`
-	Status string `json:"status"`
+type RecalculateGameEloResponseObject interface {
+	VisitRecalculateGameEloResponse(w http.ResponseWriter) error
 }
 
-func (response PatchGame200JSONResponse) VisitPatchGameResponse(w http.ResponseWriter) error {
+type RecalculateGameElo200JSONResponse ApiSuccessMessage
+
+func (response RecalculateGameElo200JSONResponse) VisitRecalculateGameEloResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3958,117 +5208,114 @@ func (response PatchGame200JSONResponse) VisitPatchGameResponse(w http.ResponseW
 	return err
 }
 
-type PatchGame400JSONResponse ApiError
+type RecalculateGameElo500JSONResponse ApiError
 
-func (response PatchGame400JSONResponse) VisitPatchGameResponse(w http.ResponseWriter) error {
+func (response RecalculateGameElo500JSONResponse) VisitRecalculateGameEloResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(500)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type PatchGame401JSONResponse ApiError
+type SimulateSettingsRequestObject struct {
+	Body *SimulateSettingsJSONRequestBody
+}
 
-func (response PatchGame401JSONResponse) VisitPatchGameResponse(w http.ResponseWriter) error {
+type SimulateSettingsResponseObject interface {
+	VisitSimulateSettingsResponse(w http.ResponseWriter) error
+}
+
+type SimulateSettings200JSONResponse struct {
+	Data   SettingsSimulation `json:"data"`
+	Status string             `json:"status"`
+}
+
+func (response SimulateSettings200JSONResponse) VisitSimulateSettingsResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(200)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type PatchGame403JSONResponse ApiError
+type SimulateSettings400JSONResponse ApiError
 
-func (response PatchGame403JSONResponse) VisitPatchGameResponse(w http.ResponseWriter) error {
+func (response SimulateSettings400JSONResponse) VisitSimulateSettingsResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(400)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type PatchGame404JSONResponse ApiError
+type SimulateSettings401JSONResponse ApiError
 
-func (response PatchGame404JSONResponse) VisitPatchGameResponse(w http.ResponseWriter) error {
+func (response SimulateSettings401JSONResponse) VisitSimulateSettingsResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(401)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type GetGameMatchesRequestObject struct {
-	Id string `json:"id"`
-}
-
-type GetGameMatchesResponseObject interface {
-	VisitGetGameMatchesResponse(w http.ResponseWriter) error
-}
-
-type GetGameMatches200JSONResponse struct {
-	Data   []GameMatch `json:"data"`
-	Status string      `json:"status"`
-}
+type SimulateSettings403JSONResponse ApiError
 
-func (response GetGameMatches200JSONResponse) VisitGetGameMatchesResponse(w http.ResponseWriter) error {
+func (response SimulateSettings403JSONResponse) VisitSimulateSettingsResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(403)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type GetGameMatches400JSONResponse ApiError
+type SimulateSettings409JSONResponse ApiError
 
-func (response GetGameMatches400JSONResponse) VisitGetGameMatchesResponse(w http.ResponseWriter) error {
+func (response SimulateSettings409JSONResponse) VisitSimulateSettingsResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(409)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type ListMarketsRequestObject struct {
+type ListArenasRequestObject struct {
 }
 
-type ListMarketsResponseObject interface {
-	VisitListMarketsResponse(w http.ResponseWriter) error
+type ListArenasResponseObject interface {
+	VisitListArenasResponse(w http.ResponseWriter) error
 }
 
-type ListMarkets200JSONResponse struct {
-	Data struct {
-		Active []Market `json:"active"`
-		Closed []Market `json:"closed"`
-	} `json:"data"`
-	Status string `json:"status"`
+type ListArenas200JSONResponse struct {
+	Data   []Arena `json:"data"`
+	Status string  `json:"status"`
 }
 
-func (response ListMarkets200JSONResponse) VisitListMarketsResponse(w http.ResponseWriter) error {
+func (response ListArenas200JSONResponse) VisitListArenasResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4080,36 +5327,34 @@ func (response ListMarkets200JSONResponse) VisitListMarketsResponse(w http.Respo
 	return err
 }
 
-type CreateMarketRequestObject struct {
-	Body *CreateMarketJSONRequestBody
+type CreateArenaRequestObject struct {
+	Body *CreateArenaJSONRequestBody
 }
 
-type CreateMarketResponseObject interface {
-	VisitCreateMarketResponse(w http.ResponseWriter) error
+type CreateArenaResponseObject interface {
+	VisitCreateArenaResponse(w http.ResponseWriter) error
 }
 
-type CreateMarket201JSONResponse struct {
-	Data struct {
-		Id string `json:"id"`
-	} `json:"data"`
+type CreateArena200JSONResponse struct {
+	Data   Arena  `json:"data"`
 	Status string `json:"status"`
 }
 
-func (response CreateMarket201JSONResponse) VisitCreateMarketResponse(w http.ResponseWriter) error {
+func (response CreateArena200JSONResponse) VisitCreateArenaResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type CreateMarket400JSONResponse ApiError
+type CreateArena400JSONResponse ApiError
 
-func (response CreateMarket400JSONResponse) VisitCreateMarketResponse(w http.ResponseWriter) error {
+func (response CreateArena400JSONResponse) VisitCreateArenaResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4121,9 +5366,9 @@ func (response CreateMarket400JSONResponse) VisitCreateMarketResponse(w http.Res
 	return err
 }
 
-type CreateMarket401JSONResponse ApiError
+type CreateArena401JSONResponse ApiError
 
-func (response CreateMarket401JSONResponse) VisitCreateMarketResponse(w http.ResponseWriter) error {
+func (response CreateArena401JSONResponse) VisitCreateArenaResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4135,9 +5380,9 @@ func (response CreateMarket401JSONResponse) VisitCreateMarketResponse(w http.Res
 	return err
 }
 
-type CreateMarket403JSONResponse ApiError
+type CreateArena403JSONResponse ApiError
 
-func (response CreateMarket403JSONResponse) VisitCreateMarketResponse(w http.ResponseWriter) error {
+func (response CreateArena403JSONResponse) VisitCreateArenaResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4149,45 +5394,45 @@ func (response CreateMarket403JSONResponse) VisitCreateMarketResponse(w http.Res
 	return err
 }
 
-type DeleteMarketRequestObject struct {
-	Id string `json:"id"`
-}
-
-type DeleteMarketResponseObject interface {
-	VisitDeleteMarketResponse(w http.ResponseWriter) error
-}
-
-type DeleteMarket200JSONResponse ApiSuccessMessage
+type CreateArena409JSONResponse ApiError
 
-func (response DeleteMarket200JSONResponse) VisitDeleteMarketResponse(w http.ResponseWriter) error {
+func (response CreateArena409JSONResponse) VisitCreateArenaResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(409)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type DeleteMarket400JSONResponse ApiError
+type DeleteArenaRequestObject struct {
+	Id string `json:"id"`
+}
 
-func (response DeleteMarket400JSONResponse) VisitDeleteMarketResponse(w http.ResponseWriter) error {
+type DeleteArenaResponseObject interface {
+	VisitDeleteArenaResponse(w http.ResponseWriter) error
+}
+
+type DeleteArena200JSONResponse ApiSuccessMessage
+
+func (response DeleteArena200JSONResponse) VisitDeleteArenaResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type DeleteMarket401JSONResponse ApiError
+type DeleteArena401JSONResponse ApiError
 
-func (response DeleteMarket401JSONResponse) VisitDeleteMarketResponse(w http.ResponseWriter) error {
+func (response DeleteArena401JSONResponse) VisitDeleteArenaResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4199,9 +5444,9 @@ func (response DeleteMarket401JSONResponse) VisitDeleteMarketResponse(w http.Res
 	return err
 }
 
-type DeleteMarket403JSONResponse ApiError
+type DeleteArena403JSONResponse ApiError
 
-func (response DeleteMarket403JSONResponse) VisitDeleteMarketResponse(w http.ResponseWriter) error {
+func (response DeleteArena403JSONResponse) VisitDeleteArenaResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4213,34 +5458,34 @@ func (response DeleteMarket403JSONResponse) VisitDeleteMarketResponse(w http.Res
 	return err
 }
 
-type DeleteMarket409JSONResponse ApiError
+type DeleteArena404JSONResponse ApiError
 
-func (response DeleteMarket409JSONResponse) VisitDeleteMarketResponse(w http.ResponseWriter) error {
+func (response DeleteArena404JSONResponse) VisitDeleteArenaResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(404)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type GetMarketRequestObject struct {
+type GetArenaRequestObject struct {
 	Id string `json:"id"`
 }
 
-type GetMarketResponseObject interface {
-	VisitGetMarketResponse(w http.ResponseWriter) error
+type GetArenaResponseObject interface {
+	VisitGetArenaResponse(w http.ResponseWriter) error
 }
 
-type GetMarket200JSONResponse struct {
-	Data   MarketDetail `json:"data"`
-	Status string       `json:"status"`
+type GetArena200JSONResponse struct {
+	Data   Arena  `json:"data"`
+	Status string `json:"status"`
 }
 
-func (response GetMarket200JSONResponse) VisitGetMarketResponse(w http.ResponseWriter) error {
+func (response GetArena200JSONResponse) VisitGetArenaResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4252,102 +5497,105 @@ func (response GetMarket200JSONResponse) VisitGetMarketResponse(w http.ResponseW
 	return err
 }
 
-type GetMarket400JSONResponse ApiError
+type GetArena404JSONResponse ApiError
 
-func (response GetMarket400JSONResponse) VisitGetMarketResponse(w http.ResponseWriter) error {
+func (response GetArena404JSONResponse) VisitGetArenaResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(404)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type GetMarket404JSONResponse ApiError
+type UpdateArenaRequestObject struct {
+	Id   string `json:"id"`
+	Body *UpdateArenaJSONRequestBody
+}
 
-func (response GetMarket404JSONResponse) VisitGetMarketResponse(w http.ResponseWriter) error {
+type UpdateArenaResponseObject interface {
+	VisitUpdateArenaResponse(w http.ResponseWriter) error
+}
+
+type UpdateArena200JSONResponse struct {
+	Data   Arena  `json:"data"`
+	Status string `json:"status"`
+}
+
+func (response UpdateArena200JSONResponse) VisitUpdateArenaResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(200)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type PatchMarketRequestObject struct {
-	Id   string `json:"id"`
-	Body *PatchMarketJSONRequestBody
-}
-
-type PatchMarketResponseObject interface {
-	VisitPatchMarketResponse(w http.ResponseWriter) error
-}
-
-type PatchMarket200JSONResponse ApiSuccessMessage
+type UpdateArena400JSONResponse ApiError
 
-func (response PatchMarket200JSONResponse) VisitPatchMarketResponse(w http.ResponseWriter) error {
+func (response UpdateArena400JSONResponse) VisitUpdateArenaResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(400)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type PatchMarket400JSONResponse ApiError
+type UpdateArena401JSONResponse ApiError
 
-func (response PatchMarket400JSONResponse) VisitPatchMarketResponse(w http.ResponseWriter) error {
+func (response UpdateArena401JSONResponse) VisitUpdateArenaResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type PatchMarket401JSONResponse ApiError
+type UpdateArena403JSONResponse ApiError
 
-func (response PatchMarket401JSONResponse) VisitPatchMarketResponse(w http.ResponseWriter) error {
+func (response UpdateArena403JSONResponse) VisitUpdateArenaResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type PatchMarket403JSONResponse ApiError
+type UpdateArena404JSONResponse ApiError
 
-func (response PatchMarket403JSONResponse) VisitPatchMarketResponse(w http.ResponseWriter) error {
+func (response UpdateArena404JSONResponse) VisitUpdateArenaResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type PatchMarket409JSONResponse ApiError
+type UpdateArena409JSONResponse ApiError
 
-func (response PatchMarket409JSONResponse) VisitPatchMarketResponse(w http.ResponseWriter) error {
+func (response UpdateArena409JSONResponse) VisitUpdateArenaResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4359,135 +5607,146 @@ func (response PatchMarket409JSONResponse) VisitPatchMarketResponse(w http.Respo
 	return err
 }
 
-type PlaceBetRequestObject struct {
-	Id   string `json:"id"`
-	Body *PlaceBetJSONRequestBody
+type GetArenaLeaderboardRequestObject struct {
+	Id string `json:"id"`
 }
 
-type PlaceBetResponseObject interface {
-	VisitPlaceBetResponse(w http.ResponseWriter) error
+type GetArenaLeaderboardResponseObject interface {
+	VisitGetArenaLeaderboardResponse(w http.ResponseWriter) error
 }
 
-type PlaceBet201JSONResponse struct {
-	Data struct {
-		// Price Effective price paid per share (cost / shares).
-		Price float64 `json:"price"`
-
-		// Shares Shares received (each pays 1 if the outcome wins).
-		Shares float64 `json:"shares"`
-	} `json:"data"`
-	Status string `json:"status"`
+type GetArenaLeaderboard200JSONResponse struct {
+	Data   []ArenaLeaderboardEntry `json:"data"`
+	Status string                  `json:"status"`
 }
 
-func (response PlaceBet201JSONResponse) VisitPlaceBetResponse(w http.ResponseWriter) error {
+func (response GetArenaLeaderboard200JSONResponse) VisitGetArenaLeaderboardResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type PlaceBet400JSONResponse ApiError
+type GetArenaLeaderboard404JSONResponse ApiError
 
-func (response PlaceBet400JSONResponse) VisitPlaceBetResponse(w http.ResponseWriter) error {
+func (response GetArenaLeaderboard404JSONResponse) VisitGetArenaLeaderboardResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(404)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type PlaceBet401JSONResponse ApiError
+type GetArenaPlayerHistoryRequestObject struct {
+	Id       string `json:"id"`
+	PlayerId string `json:"playerId"`
+}
 
-func (response PlaceBet401JSONResponse) VisitPlaceBetResponse(w http.ResponseWriter) error {
+type GetArenaPlayerHistoryResponseObject interface {
+	VisitGetArenaPlayerHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetArenaPlayerHistory200JSONResponse struct {
+	Data   []ArenaHistoryEntry `json:"data"`
+	Status string              `json:"status"`
+}
+
+func (response GetArenaPlayerHistory200JSONResponse) VisitGetArenaPlayerHistoryResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(200)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type PlaceBet403JSONResponse ApiError
+type GetArenaPlayerHistory404JSONResponse ApiError
 
-func (response PlaceBet403JSONResponse) VisitPlaceBetResponse(w http.ResponseWriter) error {
+func (response GetArenaPlayerHistory404JSONResponse) VisitGetArenaPlayerHistoryResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type PlaceBet409JSONResponse ApiError
+type ListAuditEntriesRequestObject struct {
+	Params ListAuditEntriesParams
+}
 
-func (response PlaceBet409JSONResponse) VisitPlaceBetResponse(w http.ResponseWriter) error {
+type ListAuditEntriesResponseObject interface {
+	VisitListAuditEntriesResponse(w http.ResponseWriter) error
+}
+
+type ListAuditEntries200JSONResponse AuditPage
+
+func (response ListAuditEntries200JSONResponse) VisitListAuditEntriesResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(200)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type PlaceBet422JSONResponse ApiError
+type ListAuditEntries400JSONResponse ApiError
 
-func (response PlaceBet422JSONResponse) VisitPlaceBetResponse(w http.ResponseWriter) error {
+func (response ListAuditEntries400JSONResponse) VisitListAuditEntriesResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	w.WriteHeader(400)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type GetMarketPriceHistoryRequestObject struct {
-	Id string `json:"id"`
+type AuthLoginRequestObject struct {
 }
 
-type GetMarketPriceHistoryResponseObject interface {
-	VisitGetMarketPriceHistoryResponse(w http.ResponseWriter) error
-}
+type AuthLoginResponseObject interface {
+	VisitAuthLoginResponse(w http.ResponseWriter) error
+}
 
-type GetMarketPriceHistory200JSONResponse struct {
-	Data struct {
-		Points []struct {
-			// Prices Marginal price of every outcome right after the bet; prices sum to 1.
-			Prices []struct {
-				OutcomeId string `json:"outcome_id"`
+type AuthLogin302Response struct {
+}
 
-				// Price Marginal price in (0,1).
-				Price float64 `json:"price"`
-			} `json:"prices"`
+func (response AuthLogin302Response) VisitAuthLoginResponse(w http.ResponseWriter) error {
+	w.WriteHeader(302)
+	return nil
+}
 
-			// T When the bet was placed.
-			T time.Time `json:"t"`
-		} `json:"points"`
-	} `json:"data"`
-	Status string `json:"status"`
+type AuthLogoutRequestObject struct {
 }
 
-func (response GetMarketPriceHistory200JSONResponse) VisitGetMarketPriceHistoryResponse(w http.ResponseWriter) error {
+type AuthLogoutResponseObject interface {
+	VisitAuthLogoutResponse(w http.ResponseWriter) error
+}
+
+type AuthLogout200JSONResponse ApiSuccessMessage
+
+func (response AuthLogout200JSONResponse) VisitAuthLogoutResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4499,218 +5758,242 @@ func (response GetMarketPriceHistory200JSONResponse) VisitGetMarketPriceHistoryR
 	return err
 }
 
-type GetMarketPriceHistory400JSONResponse ApiError
+type GetMeRequestObject struct {
+}
 
-func (response GetMarketPriceHistory400JSONResponse) VisitGetMarketPriceHistoryResponse(w http.ResponseWriter) error {
+type GetMeResponseObject interface {
+	VisitGetMeResponse(w http.ResponseWriter) error
+}
+
+type GetMe200JSONResponse struct {
+	Data   User   `json:"data"`
+	Status string `json:"status"`
+}
+
+func (response GetMe200JSONResponse) VisitGetMeResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type GetMarketPriceHistory404JSONResponse ApiError
+type GetMe401JSONResponse ApiError
 
-func (response GetMarketPriceHistory404JSONResponse) VisitGetMarketPriceHistoryResponse(w http.ResponseWriter) error {
+func (response GetMe401JSONResponse) VisitGetMeResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(401)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type ListMatchesRequestObject struct {
-	Params ListMatchesParams
+type PatchMeRequestObject struct {
+	Body *PatchMeJSONRequestBody
 }
 
-type ListMatchesResponseObject interface {
-	VisitListMatchesResponse(w http.ResponseWriter) error
+type PatchMeResponseObject interface {
+	VisitPatchMeResponse(w http.ResponseWriter) error
 }
 
-type ListMatches200JSONResponse MatchesPage
+type PatchMe204Response struct {
+}
 
-func (response ListMatches200JSONResponse) VisitListMatchesResponse(w http.ResponseWriter) error {
+func (response PatchMe204Response) VisitPatchMeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PatchMe400JSONResponse ApiError
+
+func (response PatchMe400JSONResponse) VisitPatchMeResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(400)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type ListMatches400JSONResponse ApiError
+type PatchMe401JSONResponse ApiError
 
-func (response ListMatches400JSONResponse) VisitListMatchesResponse(w http.ResponseWriter) error {
+func (response PatchMe401JSONResponse) VisitPatchMeResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type AddMatchRequestObject struct {
-	Body *AddMatchJSONRequestBody
-}
-
-type AddMatchResponseObject interface {
-	VisitAddMatchResponse(w http.ResponseWriter) error
-}
-
-type AddMatch200JSONResponse struct {
-	Data struct {
-		Id string `json:"id"`
-	} `json:"data"`
-	Status string `json:"status"`
-}
+type PatchMe409JSONResponse ApiError
 
-func (response AddMatch200JSONResponse) VisitAddMatchResponse(w http.ResponseWriter) error {
+func (response PatchMe409JSONResponse) VisitPatchMeResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(409)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type AddMatch400JSONResponse ApiError
+type AuthOAuth2CallbackRequestObject struct {
+	Params AuthOAuth2CallbackParams
+}
 
-func (response AddMatch400JSONResponse) VisitAddMatchResponse(w http.ResponseWriter) error {
+type AuthOAuth2CallbackResponseObject interface {
+	VisitAuthOAuth2CallbackResponse(w http.ResponseWriter) error
+}
+
+type AuthOAuth2Callback200JSONResponse struct {
+	Status string `json:"status"`
+}
+
+func (response AuthOAuth2Callback200JSONResponse) VisitAuthOAuth2CallbackResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type AddMatch401JSONResponse ApiError
+type ListClubsRequestObject struct {
+}
 
-func (response AddMatch401JSONResponse) VisitAddMatchResponse(w http.ResponseWriter) error {
+type ListClubsResponseObject interface {
+	VisitListClubsResponse(w http.ResponseWriter) error
+}
+
+type ListClubs200JSONResponse struct {
+	Data   []Club `json:"data"`
+	Status string `json:"status"`
+}
+
+func (response ListClubs200JSONResponse) VisitListClubsResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(200)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type AddMatch403JSONResponse ApiError
+type CreateClubRequestObject struct {
+	Body *CreateClubJSONRequestBody
+}
 
-func (response AddMatch403JSONResponse) VisitAddMatchResponse(w http.ResponseWriter) error {
+type CreateClubResponseObject interface {
+	VisitCreateClubResponse(w http.ResponseWriter) error
+}
+
+type CreateClub200JSONResponse struct {
+	Data   Club   `json:"data"`
+	Status string `json:"status"`
+}
+
+func (response CreateClub200JSONResponse) VisitCreateClubResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(200)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type AddMatch409JSONResponse ApiError
+type CreateClub400JSONResponse ApiError
 
-func (response AddMatch409JSONResponse) VisitAddMatchResponse(w http.ResponseWriter) error {
+func (response CreateClub400JSONResponse) VisitCreateClubResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(400)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type GetMatchByIdRequestObject struct {
-	Id string `json:"id"`
-}
-
-type GetMatchByIdResponseObject interface {
-	VisitGetMatchByIdResponse(w http.ResponseWriter) error
-}
-
-type GetMatchById200JSONResponse struct {
-	Data   Match  `json:"data"`
-	Status string `json:"status"`
-}
+type CreateClub401JSONResponse ApiError
 
-func (response GetMatchById200JSONResponse) VisitGetMatchByIdResponse(w http.ResponseWriter) error {
+func (response CreateClub401JSONResponse) VisitCreateClubResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(401)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type GetMatchById400JSONResponse ApiError
+type CreateClub403JSONResponse ApiError
 
-func (response GetMatchById400JSONResponse) VisitGetMatchByIdResponse(w http.ResponseWriter) error {
+func (response CreateClub403JSONResponse) VisitCreateClubResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(403)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type GetMatchById404JSONResponse ApiError
+type CreateClub409JSONResponse ApiError
 
-func (response GetMatchById404JSONResponse) VisitGetMatchByIdResponse(w http.ResponseWriter) error {
+func (response CreateClub409JSONResponse) VisitCreateClubResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(409)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type UpdateMatchRequestObject struct {
-	Id   string `json:"id"`
-	Body *UpdateMatchJSONRequestBody
+type DeleteClubRequestObject struct {
+	Id string `json:"id"`
 }
 
-type UpdateMatchResponseObject interface {
-	VisitUpdateMatchResponse(w http.ResponseWriter) error
+type DeleteClubResponseObject interface {
+	VisitDeleteClubResponse(w http.ResponseWriter) error
 }
 
-type UpdateMatch200JSONResponse ApiSuccessMessage
+type DeleteClub200JSONResponse ApiSuccessMessage
 
-func (response UpdateMatch200JSONResponse) VisitUpdateMatchResponse(w http.ResponseWriter) error {
+func (response DeleteClub200JSONResponse) VisitDeleteClubResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4722,9 +6005,9 @@ func (response UpdateMatch200JSONResponse) VisitUpdateMatchResponse(w http.Respo
 	return err
 }
 
-type UpdateMatch400JSONResponse ApiError
+type DeleteClub400JSONResponse ApiError
 
-func (response UpdateMatch400JSONResponse) VisitUpdateMatchResponse(w http.ResponseWriter) error {
+func (response DeleteClub400JSONResponse) VisitDeleteClubResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4736,9 +6019,9 @@ func (response UpdateMatch400JSONResponse) VisitUpdateMatchResponse(w http.Respo
 	return err
 }
 
-type UpdateMatch401JSONResponse ApiError
+type DeleteClub401JSONResponse ApiError
 
-func (response UpdateMatch401JSONResponse) VisitUpdateMatchResponse(w http.ResponseWriter) error {
+func (response DeleteClub401JSONResponse) VisitDeleteClubResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4750,9 +6033,9 @@ func (response UpdateMatch401JSONResponse) VisitUpdateMatchResponse(w http.Respo
 	return err
 }
 
-type UpdateMatch403JSONResponse ApiError
+type DeleteClub403JSONResponse ApiError
 
-func (response UpdateMatch403JSONResponse) VisitUpdateMatchResponse(w http.ResponseWriter) error {
+func (response DeleteClub403JSONResponse) VisitDeleteClubResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4764,9 +6047,9 @@ func (response UpdateMatch403JSONResponse) VisitUpdateMatchResponse(w http.Respo
 	return err
 }
 
-type UpdateMatch404JSONResponse ApiError
+type DeleteClub404JSONResponse ApiError
 
-func (response UpdateMatch404JSONResponse) VisitUpdateMatchResponse(w http.ResponseWriter) error {
+func (response DeleteClub404JSONResponse) VisitDeleteClubResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4778,9 +6061,9 @@ func (response UpdateMatch404JSONResponse) VisitUpdateMatchResponse(w http.Respo
 	return err
 }
 
-type UpdateMatch409JSONResponse ApiError
+type DeleteClub409JSONResponse ApiError
 
-func (response UpdateMatch409JSONResponse) VisitUpdateMatchResponse(w http.ResponseWriter) error {
+func (response DeleteClub409JSONResponse) VisitDeleteClubResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4792,20 +6075,20 @@ func (response UpdateMatch409JSONResponse) VisitUpdateMatchResponse(w http.Respo
 	return err
 }
 
-type GetMarketsByMatchIdRequestObject struct {
+type GetClubRequestObject struct {
 	Id string `json:"id"`
 }
 
-type GetMarketsByMatchIdResponseObject interface {
-	VisitGetMarketsByMatchIdResponse(w http.ResponseWriter) error
+type GetClubResponseObject interface {
+	VisitGetClubResponse(w http.ResponseWriter) error
 }
 
-type GetMarketsByMatchId200JSONResponse struct {
-	Data   []Market `json:"data"`
-	Status string   `json:"status"`
+type GetClub200JSONResponse struct {
+	Data   Club   `json:"data"`
+	Status string `json:"status"`
 }
 
-func (response GetMarketsByMatchId200JSONResponse) VisitGetMarketsByMatchIdResponse(w http.ResponseWriter) error {
+func (response GetClub200JSONResponse) VisitGetClubResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4817,9 +6100,9 @@ func (response GetMarketsByMatchId200JSONResponse) VisitGetMarketsByMatchIdRespo
 	return err
 }
 
-type GetMarketsByMatchId400JSONResponse ApiError
+type GetClub400JSONResponse ApiError
 
-func (response GetMarketsByMatchId400JSONResponse) VisitGetMarketsByMatchIdResponse(w http.ResponseWriter) error {
+func (response GetClub400JSONResponse) VisitGetClubResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4831,66 +6114,35 @@ func (response GetMarketsByMatchId400JSONResponse) VisitGetMarketsByMatchIdRespo
 	return err
 }
 
-type GetPingRequestObject struct {
-}
-
-type GetPingResponseObject interface {
-	VisitGetPingResponse(w http.ResponseWriter) error
-}
-
-type GetPing200JSONResponse ApiSuccessMessage
+type GetClub404JSONResponse ApiError
 
-func (response GetPing200JSONResponse) VisitGetPingResponse(w http.ResponseWriter) error {
+func (response GetClub404JSONResponse) VisitGetClubResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(404)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type ListPlayersRequestObject struct {
+type PatchClubRequestObject struct {
+	Id   string `json:"id"`
+	Body *PatchClubJSONRequestBody
 }
 
-type ListPlayersResponseObject interface {
-	VisitListPlayersResponse(w http.ResponseWriter) error
+type PatchClubResponseObject interface {
+	VisitPatchClubResponse(w http.ResponseWriter) error
 }
 
-type ListPlayers200JSONResponse struct {
-	Data   []Player `json:"data"`
-	Status string   `json:"status"`
-}
-
-func (response ListPlayers200JSONResponse) VisitListPlayersResponse(w http.ResponseWriter) error {
-
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
-	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
-	return err
-}
-
-type CreatePlayerRequestObject struct {
-	Body *CreatePlayerJSONRequestBody
-}
-
-type CreatePlayerResponseObject interface {
-	VisitCreatePlayerResponse(w http.ResponseWriter) error
-}
-
-type CreatePlayer200JSONResponse struct {
-	// Data Minimal player object returned after create/patch
-	Data   PlayerRef `json:"data"`
-	Status string    `json:"status"`
+type PatchClub200JSONResponse struct {
+	Data   Club   `json:"data"`
+	Status string `json:"status"`
 }
 
-func (response CreatePlayer200JSONResponse) VisitCreatePlayerResponse(w http.ResponseWriter) error {
+func (response PatchClub200JSONResponse) VisitPatchClubResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4902,9 +6154,9 @@ func (response CreatePlayer200JSONResponse) VisitCreatePlayerResponse(w http.Res
 	return err
 }
 
-type CreatePlayer400JSONResponse ApiError
+type PatchClub400JSONResponse ApiError
 
-func (response CreatePlayer400JSONResponse) VisitCreatePlayerResponse(w http.ResponseWriter) error {
+func (response PatchClub400JSONResponse) VisitPatchClubResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4916,9 +6168,9 @@ func (response CreatePlayer400JSONResponse) VisitCreatePlayerResponse(w http.Res
 	return err
 }
 
-type CreatePlayer401JSONResponse ApiError
+type PatchClub401JSONResponse ApiError
 
-func (response CreatePlayer401JSONResponse) VisitCreatePlayerResponse(w http.ResponseWriter) error {
+func (response PatchClub401JSONResponse) VisitPatchClubResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4930,9 +6182,9 @@ func (response CreatePlayer401JSONResponse) VisitCreatePlayerResponse(w http.Res
 	return err
 }
 
-type CreatePlayer403JSONResponse ApiError
+type PatchClub403JSONResponse ApiError
 
-func (response CreatePlayer403JSONResponse) VisitCreatePlayerResponse(w http.ResponseWriter) error {
+func (response PatchClub403JSONResponse) VisitPatchClubResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4944,31 +6196,32 @@ func (response CreatePlayer403JSONResponse) VisitCreatePlayerResponse(w http.Res
 	return err
 }
 
-type CreatePlayer409JSONResponse ApiError
+type PatchClub404JSONResponse ApiError
 
-func (response CreatePlayer409JSONResponse) VisitCreatePlayerResponse(w http.ResponseWriter) error {
+func (response PatchClub404JSONResponse) VisitPatchClubResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(404)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type DeletePlayerRequestObject struct {
-	Id string `json:"id"`
+type AddClubMemberRequestObject struct {
+	Id   string `json:"id"`
+	Body *AddClubMemberJSONRequestBody
 }
 
-type DeletePlayerResponseObject interface {
-	VisitDeletePlayerResponse(w http.ResponseWriter) error
+type AddClubMemberResponseObject interface {
+	VisitAddClubMemberResponse(w http.ResponseWriter) error
 }
 
-type DeletePlayer200JSONResponse ApiSuccessMessage
+type AddClubMember200JSONResponse ApiSuccessMessage
 
-func (response DeletePlayer200JSONResponse) VisitDeletePlayerResponse(w http.ResponseWriter) error {
+func (response AddClubMember200JSONResponse) VisitAddClubMemberResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4980,9 +6233,9 @@ func (response DeletePlayer200JSONResponse) VisitDeletePlayerResponse(w http.Res
 	return err
 }
 
-type DeletePlayer400JSONResponse ApiError
+type AddClubMember400JSONResponse ApiError
 
-func (response DeletePlayer400JSONResponse) VisitDeletePlayerResponse(w http.ResponseWriter) error {
+func (response AddClubMember400JSONResponse) VisitAddClubMemberResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4994,9 +6247,9 @@ func (response DeletePlayer400JSONResponse) VisitDeletePlayerResponse(w http.Res
 	return err
 }
 
-type DeletePlayer401JSONResponse ApiError
+type AddClubMember401JSONResponse ApiError
 
-func (response DeletePlayer401JSONResponse) VisitDeletePlayerResponse(w http.ResponseWriter) error {
+func (response AddClubMember401JSONResponse) VisitAddClubMemberResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5008,9 +6261,9 @@ func (response DeletePlayer401JSONResponse) VisitDeletePlayerResponse(w http.Res
 	return err
 }
 
-type DeletePlayer403JSONResponse ApiError
+type AddClubMember403JSONResponse ApiError
 
-func (response DeletePlayer403JSONResponse) VisitDeletePlayerResponse(w http.ResponseWriter) error {
+func (response AddClubMember403JSONResponse) VisitAddClubMemberResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5022,36 +6275,18 @@ func (response DeletePlayer403JSONResponse) VisitDeletePlayerResponse(w http.Res
 	return err
 }
 
-type DeletePlayer404JSONResponse ApiError
-
-func (response DeletePlayer404JSONResponse) VisitDeletePlayerResponse(w http.ResponseWriter) error {
-
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
-	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-	_, err := buf.WriteTo(w)
-	return err
-}
-
-type PatchPlayerRequestObject struct {
-	Id   string `json:"id"`
-	Body *PatchPlayerJSONRequestBody
+type RemoveClubMemberRequestObject struct {
+	Id       string `json:"id"`
+	PlayerId string `json:"playerId"`
 }
 
-type PatchPlayerResponseObject interface {
-	VisitPatchPlayerResponse(w http.ResponseWriter) error
+type RemoveClubMemberResponseObject interface {
+	VisitRemoveClubMemberResponse(w http.ResponseWriter) error
 }
 
-type PatchPlayer200JSONResponse struct {
-	// Data Minimal player object returned after create/patch
-	Data   PlayerRef `json:"data"`
-	Status string    `json:"status"`
-}
+type RemoveClubMember200JSONResponse ApiSuccessMessage
 
-func (response PatchPlayer200JSONResponse) VisitPatchPlayerResponse(w http.ResponseWriter) error {
+func (response RemoveClubMember200JSONResponse) VisitRemoveClubMemberResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5063,9 +6298,9 @@ func (response PatchPlayer200JSONResponse) VisitPatchPlayerResponse(w http.Respo
 	return err
 }
 
-type PatchPlayer400JSONResponse ApiError
+type RemoveClubMember400JSONResponse ApiError
 
-func (response PatchPlayer400JSONResponse) VisitPatchPlayerResponse(w http.ResponseWriter) error {
+func (response RemoveClubMember400JSONResponse) VisitRemoveClubMemberResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5077,9 +6312,9 @@ func (response PatchPlayer400JSONResponse) VisitPatchPlayerResponse(w http.Respo
 	return err
 }
 
-type PatchPlayer401JSONResponse ApiError
+type RemoveClubMember401JSONResponse ApiError
 
-func (response PatchPlayer401JSONResponse) VisitPatchPlayerResponse(w http.ResponseWriter) error {
+func (response RemoveClubMember401JSONResponse) VisitRemoveClubMemberResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5091,9 +6326,9 @@ func (response PatchPlayer401JSONResponse) VisitPatchPlayerResponse(w http.Respo
 	return err
 }
 
-type PatchPlayer403JSONResponse ApiError
+type RemoveClubMember403JSONResponse ApiError
 
-func (response PatchPlayer403JSONResponse) VisitPatchPlayerResponse(w http.ResponseWriter) error {
+func (response RemoveClubMember403JSONResponse) VisitRemoveClubMemberResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5105,48 +6340,55 @@ func (response PatchPlayer403JSONResponse) VisitPatchPlayerResponse(w http.Respo
 	return err
 }
 
-type PatchPlayer404JSONResponse ApiError
+type ListCorrectionsRequestObject struct {
+	Params ListCorrectionsParams
+}
 
-func (response PatchPlayer404JSONResponse) VisitPatchPlayerResponse(w http.ResponseWriter) error {
+type ListCorrectionsResponseObject interface {
+	VisitListCorrectionsResponse(w http.ResponseWriter) error
+}
+
+type ListCorrections200JSONResponse CorrectionsPage
+
+func (response ListCorrections200JSONResponse) VisitListCorrectionsResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(200)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type PatchPlayer409JSONResponse ApiError
+type ListCorrections400JSONResponse ApiError
 
-func (response PatchPlayer409JSONResponse) VisitPatchPlayerResponse(w http.ResponseWriter) error {
+func (response ListCorrections400JSONResponse) VisitListCorrectionsResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(400)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type GetPlayerStatsRequestObject struct {
-	Id string `json:"id"`
+type ListGamesRequestObject struct {
 }
 
-type GetPlayerStatsResponseObject interface {
-	VisitGetPlayerStatsResponse(w http.ResponseWriter) error
+type ListGamesResponseObject interface {
+	VisitListGamesResponse(w http.ResponseWriter) error
 }
 
-type GetPlayerStats200JSONResponse struct {
-	Data   PlayerStats `json:"data"`
-	Status string      `json:"status"`
+type ListGames200JSONResponse struct {
+	Data   GameList `json:"data"`
+	Status string   `json:"status"`
 }
 
-func (response GetPlayerStats200JSONResponse) VisitGetPlayerStatsResponse(w http.ResponseWriter) error {
+func (response ListGames200JSONResponse) VisitListGamesResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5158,45 +6400,23 @@ func (response GetPlayerStats200JSONResponse) VisitGetPlayerStatsResponse(w http
 	return err
 }
 
-type GetPlayerStats400JSONResponse ApiError
-
-func (response GetPlayerStats400JSONResponse) VisitGetPlayerStatsResponse(w http.ResponseWriter) error {
-
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
-	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-	_, err := buf.WriteTo(w)
-	return err
-}
-
-type GetPlayerStats404JSONResponse ApiError
-
-func (response GetPlayerStats404JSONResponse) VisitGetPlayerStatsResponse(w http.ResponseWriter) error {
-
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
-	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-	_, err := buf.WriteTo(w)
-	return err
+type CreateGameRequestObject struct {
+	Body *CreateGameJSONRequestBody
 }
 
-type DeleteSettingsRequestObject struct {
-	Body *DeleteSettingsJSONRequestBody
+type CreateGameResponseObject interface {
+	VisitCreateGameResponse(w http.ResponseWriter) error
 }
 
-type DeleteSettingsResponseObject interface {
-	VisitDeleteSettingsResponse(w http.ResponseWriter) error
+type CreateGame200JSONResponse struct {
+	Data struct {
+		Id   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"data"`
+	Status string `json:"status"`
 }
 
-type DeleteSettings200JSONResponse ApiSuccessMessage
-
-func (response DeleteSettings200JSONResponse) VisitDeleteSettingsResponse(w http.ResponseWriter) error {
+func (response CreateGame200JSONResponse) VisitCreateGameResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5208,9 +6428,9 @@ func (response DeleteSettings200JSONResponse) VisitDeleteSettingsResponse(w http
 	return err
 }
 
-type DeleteSettings400JSONResponse ApiError
+type CreateGame400JSONResponse ApiError
 
-func (response DeleteSettings400JSONResponse) VisitDeleteSettingsResponse(w http.ResponseWriter) error {
+func (response CreateGame400JSONResponse) VisitCreateGameResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5222,9 +6442,9 @@ func (response DeleteSettings400JSONResponse) VisitDeleteSettingsResponse(w http
 	return err
 }
 
-type DeleteSettings401JSONResponse ApiError
+type CreateGame401JSONResponse ApiError
 
-func (response DeleteSettings401JSONResponse) VisitDeleteSettingsResponse(w http.ResponseWriter) error {
+func (response CreateGame401JSONResponse) VisitCreateGameResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5236,9 +6456,9 @@ func (response DeleteSettings401JSONResponse) VisitDeleteSettingsResponse(w http
 	return err
 }
 
-type DeleteSettings403JSONResponse ApiError
+type CreateGame403JSONResponse ApiError
 
-func (response DeleteSettings403JSONResponse) VisitDeleteSettingsResponse(w http.ResponseWriter) error {
+func (response CreateGame403JSONResponse) VisitCreateGameResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5250,55 +6470,45 @@ func (response DeleteSettings403JSONResponse) VisitDeleteSettingsResponse(w http
 	return err
 }
 
-type GetSettingsRequestObject struct {
-}
-
-type GetSettingsResponseObject interface {
-	VisitGetSettingsResponse(w http.ResponseWriter) error
-}
-
-type GetSettings200JSONResponse struct {
-	Data   Settings `json:"data"`
-	Status string   `json:"status"`
-}
+type CreateGame409JSONResponse ApiError
 
-func (response GetSettings200JSONResponse) VisitGetSettingsResponse(w http.ResponseWriter) error {
+func (response CreateGame409JSONResponse) VisitCreateGameResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(409)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type CreateSettingsRequestObject struct {
-	Body *CreateSettingsJSONRequestBody
+type DeleteGameRequestObject struct {
+	Id string `json:"id"`
 }
 
-type CreateSettingsResponseObject interface {
-	VisitCreateSettingsResponse(w http.ResponseWriter) error
+type DeleteGameResponseObject interface {
+	VisitDeleteGameResponse(w http.ResponseWriter) error
 }
 
-type CreateSettings201JSONResponse ApiSuccessMessage
+type DeleteGame200JSONResponse ApiSuccessMessage
 
-func (response CreateSettings201JSONResponse) VisitCreateSettingsResponse(w http.ResponseWriter) error {
+func (response DeleteGame200JSONResponse) VisitDeleteGameResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type CreateSettings400JSONResponse ApiError
+type DeleteGame400JSONResponse ApiError
 
-func (response CreateSettings400JSONResponse) VisitCreateSettingsResponse(w http.ResponseWriter) error {
+func (response DeleteGame400JSONResponse) VisitDeleteGameResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5310,9 +6520,9 @@ func (response CreateSettings400JSONResponse) VisitCreateSettingsResponse(w http
 	return err
 }
 
-type CreateSettings401JSONResponse ApiError
+type DeleteGame401JSONResponse ApiError
 
-func (response CreateSettings401JSONResponse) VisitCreateSettingsResponse(w http.ResponseWriter) error {
+func (response DeleteGame401JSONResponse) VisitDeleteGameResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5324,9 +6534,9 @@ func (response CreateSettings401JSONResponse) VisitCreateSettingsResponse(w http
 	return err
 }
 
-type CreateSettings403JSONResponse ApiError
+type DeleteGame403JSONResponse ApiError
 
-func (response CreateSettings403JSONResponse) VisitCreateSettingsResponse(w http.ResponseWriter) error {
+func (response DeleteGame403JSONResponse) VisitDeleteGameResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5338,44 +6548,34 @@ func (response CreateSettings403JSONResponse) VisitCreateSettingsResponse(w http
 	return err
 }
 
-type ListAllSettingsRequestObject struct {
-}
+type DeleteGame404JSONResponse ApiError
 
-type ListAllSettingsResponseObject interface {
-	VisitListAllSettingsResponse(w http.ResponseWriter) error
-}
-
-type ListAllSettings200JSONResponse struct {
-	Data   []EloSettingEntry `json:"data"`
-	Status string            `json:"status"`
-}
-
-func (response ListAllSettings200JSONResponse) VisitListAllSettingsResponse(w http.ResponseWriter) error {
+func (response DeleteGame404JSONResponse) VisitDeleteGameResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(404)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type ParseSkullKingCardImageRequestObject struct {
-	Body *ParseSkullKingCardImageJSONRequestBody
+type GetGameRequestObject struct {
+	Id string `json:"id"`
 }
 
-type ParseSkullKingCardImageResponseObject interface {
-	VisitParseSkullKingCardImageResponse(w http.ResponseWriter) error
+type GetGameResponseObject interface {
+	VisitGetGameResponse(w http.ResponseWriter) error
 }
 
-type ParseSkullKingCardImage200JSONResponse struct {
-	Data   SkullKingCardImageResult `json:"data"`
-	Status string                   `json:"status"`
+type GetGame200JSONResponse struct {
+	Data   Game   `json:"data"`
+	Status string `json:"status"`
 }
 
-func (response ParseSkullKingCardImage200JSONResponse) VisitParseSkullKingCardImageResponse(w http.ResponseWriter) error {
+func (response GetGame200JSONResponse) VisitGetGameResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5387,9 +6587,9 @@ func (response ParseSkullKingCardImage200JSONResponse) VisitParseSkullKingCardIm
 	return err
 }
 
-type ParseSkullKingCardImage400JSONResponse ApiError
+type GetGame400JSONResponse ApiError
 
-func (response ParseSkullKingCardImage400JSONResponse) VisitParseSkullKingCardImageResponse(w http.ResponseWriter) error {
+func (response GetGame400JSONResponse) VisitGetGameResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5401,33 +6601,31 @@ func (response ParseSkullKingCardImage400JSONResponse) VisitParseSkullKingCardIm
 	return err
 }
 
-type ParseSkullKingCardImage500JSONResponse ApiError
-
-func (response ParseSkullKingCardImage500JSONResponse) VisitParseSkullKingCardImageResponse(w http.ResponseWriter) error {
-
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
-	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-	_, err := buf.WriteTo(w)
-	return err
+type PatchGameRequestObject struct {
+	Id   string `json:"id"`
+	Body *PatchGameJSONRequestBody
 }
 
-type ListSkullKingTablesRequestObject struct {
+type PatchGameResponseObject interface {
+	VisitPatchGameResponse(w http.ResponseWriter) error
 }
 
-type ListSkullKingTablesResponseObject interface {
-	VisitListSkullKingTablesResponse(w http.ResponseWriter) error
-}
+type PatchGame200JSONResponse struct {
+	Data struct {
+		Id           string             `json:"id"`
+		Name         string             `json:"name"`
+		RatingParams []GameRatingParams `json:"rating_params"`
 
-type ListSkullKingTables200JSONResponse struct {
-	Data   []SkullKingTableSummary `json:"data"`
-	Status string                  `json:"status"`
+		// ResultType score — match scores are the game's points; placement — only the finishing order is recorded and each score is the player's place (1, 2, 2, 4 … — tied players share a place and the next place is skipped). In a team match teammates share their team's place and places count teams (1, 1, 2, 2 for a 2v2).
+		ResultType GameResultType `json:"result_type"`
+
+		// ScoringDirection Whether the highest or the lowest score wins. Ignored for placement results.
+		ScoringDirection GameScoringDirection `json:"scoring_direction"`
+	} `json:"data"`
+	Status string `json:"status"`
 }
 
-func (response ListSkullKingTables200JSONResponse) VisitListSkullKingTablesResponse(w http.ResponseWriter) error {
+func (response PatchGame200JSONResponse) VisitPatchGameResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5439,132 +6637,119 @@ func (response ListSkullKingTables200JSONResponse) VisitListSkullKingTablesRespo
 	return err
 }
 
-type CreateSkullKingTableRequestObject struct {
-	Body *CreateSkullKingTableJSONRequestBody
-}
-
-type CreateSkullKingTableResponseObject interface {
-	VisitCreateSkullKingTableResponse(w http.ResponseWriter) error
-}
-
-type CreateSkullKingTable201JSONResponse struct {
-	Data   SkullKingTableSummary `json:"data"`
-	Status string                `json:"status"`
-}
+type PatchGame400JSONResponse ApiError
 
-func (response CreateSkullKingTable201JSONResponse) VisitCreateSkullKingTableResponse(w http.ResponseWriter) error {
+func (response PatchGame400JSONResponse) VisitPatchGameResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(400)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type CreateSkullKingTable400JSONResponse ApiError
+type PatchGame401JSONResponse ApiError
 
-func (response CreateSkullKingTable400JSONResponse) VisitCreateSkullKingTableResponse(w http.ResponseWriter) error {
+func (response PatchGame401JSONResponse) VisitPatchGameResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type CreateSkullKingTable401JSONResponse ApiError
+type PatchGame403JSONResponse ApiError
 
-func (response CreateSkullKingTable401JSONResponse) VisitCreateSkullKingTableResponse(w http.ResponseWriter) error {
+func (response PatchGame403JSONResponse) VisitPatchGameResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type DeleteSkullKingTableRequestObject struct {
-	Id     string `json:"id"`
-	Params DeleteSkullKingTableParams
-}
-
-type DeleteSkullKingTableResponseObject interface {
-	VisitDeleteSkullKingTableResponse(w http.ResponseWriter) error
-}
-
-type DeleteSkullKingTable204Response struct {
-}
-
-func (response DeleteSkullKingTable204Response) VisitDeleteSkullKingTableResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
-
-type DeleteSkullKingTable401JSONResponse ApiError
+type PatchGame404JSONResponse ApiError
 
-func (response DeleteSkullKingTable401JSONResponse) VisitDeleteSkullKingTableResponse(w http.ResponseWriter) error {
+func (response PatchGame404JSONResponse) VisitPatchGameResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(404)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type DeleteSkullKingTable403JSONResponse ApiError
+type GetGameMatchesRequestObject struct {
+	Id string `json:"id"`
+}
 
-func (response DeleteSkullKingTable403JSONResponse) VisitDeleteSkullKingTableResponse(w http.ResponseWriter) error {
+type GetGameMatchesResponseObject interface {
+	VisitGetGameMatchesResponse(w http.ResponseWriter) error
+}
+
+type GetGameMatches200JSONResponse struct {
+	Data   []GameMatch `json:"data"`
+	Status string      `json:"status"`
+}
+
+func (response GetGameMatches200JSONResponse) VisitGetGameMatchesResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(200)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type DeleteSkullKingTable404JSONResponse ApiError
+type GetGameMatches400JSONResponse ApiError
 
-func (response DeleteSkullKingTable404JSONResponse) VisitDeleteSkullKingTableResponse(w http.ResponseWriter) error {
+func (response GetGameMatches400JSONResponse) VisitGetGameMatchesResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(400)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type GetSkullKingTableRequestObject struct {
-	Id string `json:"id"`
+type MergeGameRequestObject struct {
+	Id   string `json:"id"`
+	Body *MergeGameJSONRequestBody
 }
 
-type GetSkullKingTableResponseObject interface {
-	VisitGetSkullKingTableResponse(w http.ResponseWriter) error
+type MergeGameResponseObject interface {
+	VisitMergeGameResponse(w http.ResponseWriter) error
 }
 
-type GetSkullKingTable200JSONResponse struct {
-	Data   SkullKingTableSummary `json:"data"`
-	Status string                `json:"status"`
+type MergeGame200JSONResponse struct {
+	Data struct {
+		Id   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"data"`
+	Status string `json:"status"`
 }
 
-func (response GetSkullKingTable200JSONResponse) VisitGetSkullKingTableResponse(w http.ResponseWriter) error {
+func (response MergeGame200JSONResponse) VisitMergeGameResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5576,63 +6761,51 @@ func (response GetSkullKingTable200JSONResponse) VisitGetSkullKingTableResponse(
 	return err
 }
 
-type GetSkullKingTable404JSONResponse ApiError
+type MergeGame400JSONResponse ApiError
 
-func (response GetSkullKingTable404JSONResponse) VisitGetSkullKingTableResponse(w http.ResponseWriter) error {
+func (response MergeGame400JSONResponse) VisitMergeGameResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(400)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type SubmitSkullKingBidRequestObject struct {
-	Id   string `json:"id"`
-	Body *SubmitSkullKingBidJSONRequestBody
-}
-
-type SubmitSkullKingBidResponseObject interface {
-	VisitSubmitSkullKingBidResponse(w http.ResponseWriter) error
-}
-
-type SubmitSkullKingBid200JSONResponse struct {
-	Data   SkullKingTableSummary `json:"data"`
-	Status string                `json:"status"`
-}
+type MergeGame401JSONResponse ApiError
 
-func (response SubmitSkullKingBid200JSONResponse) VisitSubmitSkullKingBidResponse(w http.ResponseWriter) error {
+func (response MergeGame401JSONResponse) VisitMergeGameResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(401)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type SubmitSkullKingBid401JSONResponse ApiError
+type MergeGame403JSONResponse ApiError
 
-func (response SubmitSkullKingBid401JSONResponse) VisitSubmitSkullKingBidResponse(w http.ResponseWriter) error {
+func (response MergeGame403JSONResponse) VisitMergeGameResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type SubmitSkullKingBid404JSONResponse ApiError
+type MergeGame404JSONResponse ApiError
 
-func (response SubmitSkullKingBid404JSONResponse) VisitSubmitSkullKingBidResponse(w http.ResponseWriter) error {
+func (response MergeGame404JSONResponse) VisitMergeGameResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5644,9 +6817,9 @@ func (response SubmitSkullKingBid404JSONResponse) VisitSubmitSkullKingBidRespons
 	return err
 }
 
-type SubmitSkullKingBid409JSONResponse ApiError
+type MergeGame409JSONResponse ApiError
 
-func (response SubmitSkullKingBid409JSONResponse) VisitSubmitSkullKingBidResponse(w http.ResponseWriter) error {
+func (response MergeGame409JSONResponse) VisitMergeGameResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5658,20 +6831,20 @@ func (response SubmitSkullKingBid409JSONResponse) VisitSubmitSkullKingBidRespons
 	return err
 }
 
-type JoinSkullKingTableRequestObject struct {
+type GetGameRecordsRequestObject struct {
 	Id string `json:"id"`
 }
 
-type JoinSkullKingTableResponseObject interface {
-	VisitJoinSkullKingTableResponse(w http.ResponseWriter) error
+type GetGameRecordsResponseObject interface {
+	VisitGetGameRecordsResponse(w http.ResponseWriter) error
 }
 
-type JoinSkullKingTable200JSONResponse struct {
-	Data   SkullKingTableSummary `json:"data"`
-	Status string                `json:"status"`
+type GetGameRecords200JSONResponse struct {
+	Data   GameRecords `json:"data"`
+	Status string      `json:"status"`
 }
 
-func (response JoinSkullKingTable200JSONResponse) VisitJoinSkullKingTableResponse(w http.ResponseWriter) error {
+func (response GetGameRecords200JSONResponse) VisitGetGameRecordsResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5683,23 +6856,9 @@ func (response JoinSkullKingTable200JSONResponse) VisitJoinSkullKingTableRespons
 	return err
 }
 
-type JoinSkullKingTable401JSONResponse ApiError
-
-func (response JoinSkullKingTable401JSONResponse) VisitJoinSkullKingTableResponse(w http.ResponseWriter) error {
-
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
-	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
-	_, err := buf.WriteTo(w)
-	return err
-}
-
-type JoinSkullKingTable404JSONResponse ApiError
+type GetGameRecords404JSONResponse ApiError
 
-func (response JoinSkullKingTable404JSONResponse) VisitJoinSkullKingTableResponse(w http.ResponseWriter) error {
+func (response GetGameRecords404JSONResponse) VisitGetGameRecordsResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5711,21 +6870,20 @@ func (response JoinSkullKingTable404JSONResponse) VisitJoinSkullKingTableRespons
 	return err
 }
 
-type SubmitSkullKingResultRequestObject struct {
-	Id   string `json:"id"`
-	Body *SubmitSkullKingResultJSONRequestBody
+type GetLeaderboardRequestObject struct {
+	Params GetLeaderboardParams
 }
 
-type SubmitSkullKingResultResponseObject interface {
-	VisitSubmitSkullKingResultResponse(w http.ResponseWriter) error
+type GetLeaderboardResponseObject interface {
+	VisitGetLeaderboardResponse(w http.ResponseWriter) error
 }
 
-type SubmitSkullKingResult200JSONResponse struct {
-	Data   SkullKingTableSummary `json:"data"`
-	Status string                `json:"status"`
+type GetLeaderboard200JSONResponse struct {
+	Data   []LeaderboardEntry `json:"data"`
+	Status string             `json:"status"`
 }
 
-func (response SubmitSkullKingResult200JSONResponse) VisitSubmitSkullKingResultResponse(w http.ResponseWriter) error {
+func (response GetLeaderboard200JSONResponse) VisitGetLeaderboardResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5737,23 +6895,23 @@ func (response SubmitSkullKingResult200JSONResponse) VisitSubmitSkullKingResultR
 	return err
 }
 
-type SubmitSkullKingResult401JSONResponse ApiError
+type GetLeaderboard400JSONResponse ApiError
 
-func (response SubmitSkullKingResult401JSONResponse) VisitSubmitSkullKingResultResponse(w http.ResponseWriter) error {
+func (response GetLeaderboard400JSONResponse) VisitGetLeaderboardResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(400)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type SubmitSkullKingResult404JSONResponse ApiError
+type GetLeaderboard404JSONResponse ApiError
 
-func (response SubmitSkullKingResult404JSONResponse) VisitSubmitSkullKingResultResponse(w http.ResponseWriter) error {
+func (response GetLeaderboard404JSONResponse) VisitGetLeaderboardResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5765,35 +6923,22 @@ func (response SubmitSkullKingResult404JSONResponse) VisitSubmitSkullKingResultR
 	return err
 }
 
-type SubmitSkullKingResult409JSONResponse ApiError
-
-func (response SubmitSkullKingResult409JSONResponse) VisitSubmitSkullKingResultResponse(w http.ResponseWriter) error {
-
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
-	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
-	_, err := buf.WriteTo(w)
-	return err
-}
-
-type UpdateSkullKingTableStateRequestObject struct {
-	Id   string `json:"id"`
-	Body *UpdateSkullKingTableStateJSONRequestBody
+type ListMarketsRequestObject struct {
 }
 
-type UpdateSkullKingTableStateResponseObject interface {
-	VisitUpdateSkullKingTableStateResponse(w http.ResponseWriter) error
+type ListMarketsResponseObject interface {
+	VisitListMarketsResponse(w http.ResponseWriter) error
 }
 
-type UpdateSkullKingTableState200JSONResponse struct {
-	Data   SkullKingTableSummary `json:"data"`
-	Status string                `json:"status"`
+type ListMarkets200JSONResponse struct {
+	Data struct {
+		Active []Market `json:"active"`
+		Closed []Market `json:"closed"`
+	} `json:"data"`
+	Status string `json:"status"`
 }
 
-func (response UpdateSkullKingTableState200JSONResponse) VisitUpdateSkullKingTableStateResponse(w http.ResponseWriter) error {
+func (response ListMarkets200JSONResponse) VisitListMarketsResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5805,86 +6950,86 @@ func (response UpdateSkullKingTableState200JSONResponse) VisitUpdateSkullKingTab
 	return err
 }
 
-type UpdateSkullKingTableState401JSONResponse ApiError
-
-func (response UpdateSkullKingTableState401JSONResponse) VisitUpdateSkullKingTableStateResponse(w http.ResponseWriter) error {
+type CreateMarketRequestObject struct {
+	Body *CreateMarketJSONRequestBody
+}
+
+type CreateMarketResponseObject interface {
+	VisitCreateMarketResponse(w http.ResponseWriter) error
+}
+
+type CreateMarket201JSONResponse struct {
+	Data struct {
+		Id string `json:"id"`
+	} `json:"data"`
+	Status string `json:"status"`
+}
+
+func (response CreateMarket201JSONResponse) VisitCreateMarketResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(201)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type UpdateSkullKingTableState403JSONResponse ApiError
+type CreateMarket400JSONResponse ApiError
 
-func (response UpdateSkullKingTableState403JSONResponse) VisitUpdateSkullKingTableStateResponse(w http.ResponseWriter) error {
+func (response CreateMarket400JSONResponse) VisitCreateMarketResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(400)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type UpdateSkullKingTableState404JSONResponse ApiError
+type CreateMarket401JSONResponse ApiError
 
-func (response UpdateSkullKingTableState404JSONResponse) VisitUpdateSkullKingTableStateResponse(w http.ResponseWriter) error {
+func (response CreateMarket401JSONResponse) VisitCreateMarketResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(401)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type ListTournamentsRequestObject struct {
-}
-
-type ListTournamentsResponseObject interface {
-	VisitListTournamentsResponse(w http.ResponseWriter) error
-}
-
-type ListTournaments200JSONResponse struct {
-	Data   []Tournament `json:"data"`
-	Status string       `json:"status"`
-}
+type CreateMarket403JSONResponse ApiError
 
-func (response ListTournaments200JSONResponse) VisitListTournamentsResponse(w http.ResponseWriter) error {
+func (response CreateMarket403JSONResponse) VisitCreateMarketResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(403)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type CreateTournamentRequestObject struct {
-	Body *CreateTournamentJSONRequestBody
+type DeleteMarketRequestObject struct {
+	Id string `json:"id"`
 }
 
-type CreateTournamentResponseObject interface {
-	VisitCreateTournamentResponse(w http.ResponseWriter) error
+type DeleteMarketResponseObject interface {
+	VisitDeleteMarketResponse(w http.ResponseWriter) error
 }
 
-type CreateTournament200JSONResponse struct {
-	Data   Tournament `json:"data"`
-	Status string     `json:"status"`
-}
+type DeleteMarket200JSONResponse ApiSuccessMessage
 
-func (response CreateTournament200JSONResponse) VisitCreateTournamentResponse(w http.ResponseWriter) error {
+func (response DeleteMarket200JSONResponse) VisitDeleteMarketResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5896,9 +7041,9 @@ func (response CreateTournament200JSONResponse) VisitCreateTournamentResponse(w
 	return err
 }
 
-type CreateTournament400JSONResponse ApiError
+type DeleteMarket400JSONResponse ApiError
 
-func (response CreateTournament400JSONResponse) VisitCreateTournamentResponse(w http.ResponseWriter) error {
+func (response DeleteMarket400JSONResponse) VisitDeleteMarketResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5910,9 +7055,9 @@ func (response CreateTournament400JSONResponse) VisitCreateTournamentResponse(w
 	return err
 }
 
-type CreateTournament401JSONResponse ApiError
+type DeleteMarket401JSONResponse ApiError
 
-func (response CreateTournament401JSONResponse) VisitCreateTournamentResponse(w http.ResponseWriter) error {
+func (response DeleteMarket401JSONResponse) VisitDeleteMarketResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5924,9 +7069,9 @@ func (response CreateTournament401JSONResponse) VisitCreateTournamentResponse(w
 	return err
 }
 
-type CreateTournament403JSONResponse ApiError
+type DeleteMarket403JSONResponse ApiError
 
-func (response CreateTournament403JSONResponse) VisitCreateTournamentResponse(w http.ResponseWriter) error {
+func (response DeleteMarket403JSONResponse) VisitDeleteMarketResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5938,9 +7083,9 @@ func (response CreateTournament403JSONResponse) VisitCreateTournamentResponse(w
 	return err
 }
 
-type CreateTournament409JSONResponse ApiError
+type DeleteMarket409JSONResponse ApiError
 
-func (response CreateTournament409JSONResponse) VisitCreateTournamentResponse(w http.ResponseWriter) error {
+func (response DeleteMarket409JSONResponse) VisitDeleteMarketResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5952,17 +7097,20 @@ func (response CreateTournament409JSONResponse) VisitCreateTournamentResponse(w
 	return err
 }
 
-type DeleteTournamentRequestObject struct {
+type GetMarketRequestObject struct {
 	Id string `json:"id"`
 }
 
-type DeleteTournamentResponseObject interface {
-	VisitDeleteTournamentResponse(w http.ResponseWriter) error
+type GetMarketResponseObject interface {
+	VisitGetMarketResponse(w http.ResponseWriter) error
 }
 
-type DeleteTournament200JSONResponse ApiSuccessMessage
+type GetMarket200JSONResponse struct {
+	Data   MarketDetail `json:"data"`
+	Status string       `json:"status"`
+}
 
-func (response DeleteTournament200JSONResponse) VisitDeleteTournamentResponse(w http.ResponseWriter) error {
+func (response GetMarket200JSONResponse) VisitGetMarketResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5974,9 +7122,9 @@ func (response DeleteTournament200JSONResponse) VisitDeleteTournamentResponse(w
 	return err
 }
 
-type DeleteTournament400JSONResponse ApiError
+type GetMarket400JSONResponse ApiError
 
-func (response DeleteTournament400JSONResponse) VisitDeleteTournamentResponse(w http.ResponseWriter) error {
+func (response GetMarket400JSONResponse) VisitGetMarketResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -5988,144 +7136,134 @@ func (response DeleteTournament400JSONResponse) VisitDeleteTournamentResponse(w
 	return err
 }
 
-type DeleteTournament401JSONResponse ApiError
+type GetMarket404JSONResponse ApiError
 
-func (response DeleteTournament401JSONResponse) VisitDeleteTournamentResponse(w http.ResponseWriter) error {
+func (response GetMarket404JSONResponse) VisitGetMarketResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(404)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type DeleteTournament403JSONResponse ApiError
-
-func (response DeleteTournament403JSONResponse) VisitDeleteTournamentResponse(w http.ResponseWriter) error {
+type PatchMarketRequestObject struct {
+	Id   string `json:"id"`
+	Body *PatchMarketJSONRequestBody
+}
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
-	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
-	_, err := buf.WriteTo(w)
-	return err
+type PatchMarketResponseObject interface {
+	VisitPatchMarketResponse(w http.ResponseWriter) error
 }
 
-type DeleteTournament404JSONResponse ApiError
+type PatchMarket200JSONResponse ApiSuccessMessage
 
-func (response DeleteTournament404JSONResponse) VisitDeleteTournamentResponse(w http.ResponseWriter) error {
+func (response PatchMarket200JSONResponse) VisitPatchMarketResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(200)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type DeleteTournament409JSONResponse ApiError
+type PatchMarket400JSONResponse ApiError
 
-func (response DeleteTournament409JSONResponse) VisitDeleteTournamentResponse(w http.ResponseWriter) error {
+func (response PatchMarket400JSONResponse) VisitPatchMarketResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(400)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type GetTournamentRequestObject struct {
-	Id string `json:"id"`
-}
-
-type GetTournamentResponseObject interface {
-	VisitGetTournamentResponse(w http.ResponseWriter) error
-}
-
-type GetTournament200JSONResponse struct {
-	Data   Tournament `json:"data"`
-	Status string     `json:"status"`
-}
+type PatchMarket401JSONResponse ApiError
 
-func (response GetTournament200JSONResponse) VisitGetTournamentResponse(w http.ResponseWriter) error {
+func (response PatchMarket401JSONResponse) VisitPatchMarketResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(401)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type GetTournament400JSONResponse ApiError
+type PatchMarket403JSONResponse ApiError
 
-func (response GetTournament400JSONResponse) VisitGetTournamentResponse(w http.ResponseWriter) error {
+func (response PatchMarket403JSONResponse) VisitPatchMarketResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(403)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type GetTournament404JSONResponse ApiError
+type PatchMarket409JSONResponse ApiError
 
-func (response GetTournament404JSONResponse) VisitGetTournamentResponse(w http.ResponseWriter) error {
+func (response PatchMarket409JSONResponse) VisitPatchMarketResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(409)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type UpdateTournamentRequestObject struct {
+type PlaceBetRequestObject struct {
 	Id   string `json:"id"`
-	Body *UpdateTournamentJSONRequestBody
+	Body *PlaceBetJSONRequestBody
 }
 
-type UpdateTournamentResponseObject interface {
-	VisitUpdateTournamentResponse(w http.ResponseWriter) error
+type PlaceBetResponseObject interface {
+	VisitPlaceBetResponse(w http.ResponseWriter) error
 }
 
-type UpdateTournament200JSONResponse struct {
-	Data   Tournament `json:"data"`
-	Status string     `json:"status"`
+type PlaceBet201JSONResponse struct {
+	Data struct {
+		// Price Effective price paid per share (cost / shares).
+		Price float64 `json:"price"`
+
+		// Shares Shares received (each pays 1 if the outcome wins).
+		Shares float64 `json:"shares"`
+	} `json:"data"`
+	Status string `json:"status"`
 }
 
-func (response UpdateTournament200JSONResponse) VisitUpdateTournamentResponse(w http.ResponseWriter) error {
+func (response PlaceBet201JSONResponse) VisitPlaceBetResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type UpdateTournament400JSONResponse ApiError
+type PlaceBet400JSONResponse ApiError
 
-func (response UpdateTournament400JSONResponse) VisitUpdateTournamentResponse(w http.ResponseWriter) error {
+func (response PlaceBet400JSONResponse) VisitPlaceBetResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -6137,9 +7275,9 @@ func (response UpdateTournament400JSONResponse) VisitUpdateTournamentResponse(w
 	return err
 }
 
-type UpdateTournament401JSONResponse ApiError
+type PlaceBet401JSONResponse ApiError
 
-func (response UpdateTournament401JSONResponse) VisitUpdateTournamentResponse(w http.ResponseWriter) error {
+func (response PlaceBet401JSONResponse) VisitPlaceBetResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -6151,9 +7289,9 @@ func (response UpdateTournament401JSONResponse) VisitUpdateTournamentResponse(w
 	return err
 }
 
-type UpdateTournament403JSONResponse ApiError
+type PlaceBet403JSONResponse ApiError
 
-func (response UpdateTournament403JSONResponse) VisitUpdateTournamentResponse(w http.ResponseWriter) error {
+func (response PlaceBet403JSONResponse) VisitPlaceBetResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -6165,48 +7303,61 @@ func (response UpdateTournament403JSONResponse) VisitUpdateTournamentResponse(w
 	return err
 }
 
-type UpdateTournament404JSONResponse ApiError
+type PlaceBet409JSONResponse ApiError
 
-func (response UpdateTournament404JSONResponse) VisitUpdateTournamentResponse(w http.ResponseWriter) error {
+func (response PlaceBet409JSONResponse) VisitPlaceBetResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(409)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type UpdateTournament409JSONResponse ApiError
+type PlaceBet422JSONResponse ApiError
 
-func (response UpdateTournament409JSONResponse) VisitUpdateTournamentResponse(w http.ResponseWriter) error {
+func (response PlaceBet422JSONResponse) VisitPlaceBetResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(422)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type GetTournamentStatsRequestObject struct {
+type GetMarketPriceHistoryRequestObject struct {
 	Id string `json:"id"`
 }
 
-type GetTournamentStatsResponseObject interface {
-	VisitGetTournamentStatsResponse(w http.ResponseWriter) error
+type GetMarketPriceHistoryResponseObject interface {
+	VisitGetMarketPriceHistoryResponse(w http.ResponseWriter) error
 }
 
-type GetTournamentStats200JSONResponse struct {
-	Data   TournamentStats `json:"data"`
-	Status string          `json:"status"`
+type GetMarketPriceHistory200JSONResponse struct {
+	Data struct {
+		Points []struct {
+			// Prices Marginal price of every outcome right after the bet; prices sum to 1.
+			Prices []struct {
+				OutcomeId string `json:"outcome_id"`
+
+				// Price Marginal price in (0,1).
+				Price float64 `json:"price"`
+			} `json:"prices"`
+
+			// T When the bet was placed.
+			T time.Time `json:"t"`
+		} `json:"points"`
+	} `json:"data"`
+	Status string `json:"status"`
 }
 
-func (response GetTournamentStats200JSONResponse) VisitGetTournamentStatsResponse(w http.ResponseWriter) error {
+func (response GetMarketPriceHistory200JSONResponse) VisitGetMarketPriceHistoryResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -6218,9 +7369,9 @@ func (response GetTournamentStats200JSONResponse) VisitGetTournamentStatsRespons
 	return err
 }
 
-type GetTournamentStats400JSONResponse ApiError
+type GetMarketPriceHistory400JSONResponse ApiError
 
-func (response GetTournamentStats400JSONResponse) VisitGetTournamentStatsResponse(w http.ResponseWriter) error {
+func (response GetMarketPriceHistory400JSONResponse) VisitGetMarketPriceHistoryResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -6232,45 +7383,72 @@ func (response GetTournamentStats400JSONResponse) VisitGetTournamentStatsRespons
 	return err
 }
 
-type ListUsersRequestObject struct {
-}
-
-type ListUsersResponseObject interface {
-	VisitListUsersResponse(w http.ResponseWriter) error
-}
-
-type ListUsers200JSONResponse struct {
-	Data   []User `json:"data"`
-	Status string `json:"status"`
-}
+type GetMarketPriceHistory404JSONResponse ApiError
 
-func (response ListUsers200JSONResponse) VisitListUsersResponse(w http.ResponseWriter) error {
+func (response GetMarketPriceHistory404JSONResponse) VisitGetMarketPriceHistoryResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(404)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type PatchUserRequestObject struct {
-	UserId string `json:"userId"`
-	Body   *PatchUserJSONRequestBody
+type ListMatchesRequestObject struct {
+	Params ListMatchesParams
 }
 
-type PatchUserResponseObject interface {
-	VisitPatchUserResponse(w http.ResponseWriter) error
+type ListMatchesResponseObject interface {
+	VisitListMatchesResponse(w http.ResponseWriter) error
 }
 
-type PatchUser200JSONResponse struct {
-	Data   User   `json:"data"`
+type ListMatches200JSONResponse MatchesPage
+
+func (response ListMatches200JSONResponse) VisitListMatchesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListMatches400JSONResponse ApiError
+
+func (response ListMatches400JSONResponse) VisitListMatchesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type AddMatchRequestObject struct {
+	Body *AddMatchJSONRequestBody
+}
+
+type AddMatchResponseObject interface {
+	VisitAddMatchResponse(w http.ResponseWriter) error
+}
+
+type AddMatch200JSONResponse struct {
+	Data struct {
+		Id string `json:"id"`
+	} `json:"data"`
 	Status string `json:"status"`
 }
 
-func (response PatchUser200JSONResponse) VisitPatchUserResponse(w http.ResponseWriter) error {
+func (response AddMatch200JSONResponse) VisitAddMatchResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -6282,9 +7460,9 @@ func (response PatchUser200JSONResponse) VisitPatchUserResponse(w http.ResponseW
 	return err
 }
 
-type PatchUser400JSONResponse ApiError
+type AddMatch400JSONResponse ApiError
 
-func (response PatchUser400JSONResponse) VisitPatchUserResponse(w http.ResponseWriter) error {
+func (response AddMatch400JSONResponse) VisitAddMatchResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -6296,9 +7474,9 @@ func (response PatchUser400JSONResponse) VisitPatchUserResponse(w http.ResponseW
 	return err
 }
 
-type PatchUser401JSONResponse ApiError
+type AddMatch401JSONResponse ApiError
 
-func (response PatchUser401JSONResponse) VisitPatchUserResponse(w http.ResponseWriter) error {
+func (response AddMatch401JSONResponse) VisitAddMatchResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -6310,9 +7488,9 @@ func (response PatchUser401JSONResponse) VisitPatchUserResponse(w http.ResponseW
 	return err
 }
 
-type PatchUser403JSONResponse ApiError
+type AddMatch403JSONResponse ApiError
 
-func (response PatchUser403JSONResponse) VisitPatchUserResponse(w http.ResponseWriter) error {
+func (response AddMatch403JSONResponse) VisitAddMatchResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -6324,34 +7502,34 @@ func (response PatchUser403JSONResponse) VisitPatchUserResponse(w http.ResponseW
 	return err
 }
 
-type PatchUser404JSONResponse ApiError
+type AddMatch409JSONResponse ApiError
 
-func (response PatchUser404JSONResponse) VisitPatchUserResponse(w http.ResponseWriter) error {
+func (response AddMatch409JSONResponse) VisitAddMatchResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(409)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type ParseVoiceInputRequestObject struct {
-	Body *ParseVoiceInputJSONRequestBody
+type PreviewMatchRequestObject struct {
+	Body *PreviewMatchJSONRequestBody
 }
 
-type ParseVoiceInputResponseObject interface {
-	VisitParseVoiceInputResponse(w http.ResponseWriter) error
+type PreviewMatchResponseObject interface {
+	VisitPreviewMatchResponse(w http.ResponseWriter) error
 }
 
-type ParseVoiceInput200JSONResponse struct {
-	Data   VoiceParseResult `json:"data"`
-	Status string           `json:"status"`
+type PreviewMatch200JSONResponse struct {
+	Data   MatchPreview `json:"data"`
+	Status string       `json:"status"`
 }
 
-func (response ParseVoiceInput200JSONResponse) VisitParseVoiceInputResponse(w http.ResponseWriter) error {
+func (response PreviewMatch200JSONResponse) VisitPreviewMatchResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -6363,9 +7541,9 @@ func (response ParseVoiceInput200JSONResponse) VisitParseVoiceInputResponse(w ht
 	return err
 }
 
-type ParseVoiceInput400JSONResponse ApiError
+type PreviewMatch400JSONResponse ApiError
 
-func (response ParseVoiceInput400JSONResponse) VisitParseVoiceInputResponse(w http.ResponseWriter) error {
+func (response PreviewMatch400JSONResponse) VisitPreviewMatchResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -6377,9 +7555,9 @@ func (response ParseVoiceInput400JSONResponse) VisitParseVoiceInputResponse(w ht
 	return err
 }
 
-type ParseVoiceInput401JSONResponse ApiError
+type PreviewMatch401JSONResponse ApiError
 
-func (response ParseVoiceInput401JSONResponse) VisitParseVoiceInputResponse(w http.ResponseWriter) error {
+func (response PreviewMatch401JSONResponse) VisitPreviewMatchResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -6391,9 +7569,9 @@ func (response ParseVoiceInput401JSONResponse) VisitParseVoiceInputResponse(w ht
 	return err
 }
 
-type ParseVoiceInput403JSONResponse ApiError
+type PreviewMatch403JSONResponse ApiError
 
-func (response ParseVoiceInput403JSONResponse) VisitParseVoiceInputResponse(w http.ResponseWriter) error {
+func (response PreviewMatch403JSONResponse) VisitPreviewMatchResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -6405,191 +7583,2751 @@ func (response ParseVoiceInput403JSONResponse) VisitParseVoiceInputResponse(w ht
 	return err
 }
 
-type ParseVoiceInput500JSONResponse ApiError
+type PreviewMatch409JSONResponse ApiError
 
-func (response ParseVoiceInput500JSONResponse) VisitParseVoiceInputResponse(w http.ResponseWriter) error {
+func (response PreviewMatch409JSONResponse) VisitPreviewMatchResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(409)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// CreatePlayerCorrection Apply a manual rating correction for a player
-	// (POST /admin/players/{id}/corrections)
-	CreatePlayerCorrection(ctx context.Context, request CreatePlayerCorrectionRequestObject) (CreatePlayerCorrectionResponseObject, error)
-	// RecalculateGameElo Recalculate all game-specific Elo ratings
-	// (POST /admin/recalculate-game-elo)
-	RecalculateGameElo(ctx context.Context, request RecalculateGameEloRequestObject) (RecalculateGameEloResponseObject, error)
-	// AuthLogin Initiate Google OAuth2 login flow
-	// (GET /auth/login)
-	AuthLogin(ctx context.Context, request AuthLoginRequestObject) (AuthLoginResponseObject, error)
-	// AuthLogout Logout (clears session cookie)
-	// (POST /auth/logout)
-	AuthLogout(ctx context.Context, request AuthLogoutRequestObject) (AuthLogoutResponseObject, error)
-	// GetMe Get current authenticated user
-	// (GET /auth/me)
-	GetMe(ctx context.Context, request GetMeRequestObject) (GetMeResponseObject, error)
-	// PatchMe Update current user's linked player
-	// (PATCH /auth/me)
-	PatchMe(ctx context.Context, request PatchMeRequestObject) (PatchMeResponseObject, error)
-	// AuthOAuth2Callback Google OAuth2 callback
-	// (GET /auth/oauth2-callback)
-	AuthOAuth2Callback(ctx context.Context, request AuthOAuth2CallbackRequestObject) (AuthOAuth2CallbackResponseObject, error)
-	// ListClubs List all clubs
-	// (GET /clubs)
-	ListClubs(ctx context.Context, request ListClubsRequestObject) (ListClubsResponseObject, error)
-	// CreateClub Create a new club
-	// (POST /clubs)
-	CreateClub(ctx context.Context, request CreateClubRequestObject) (CreateClubResponseObject, error)
-	// DeleteClub Delete a club
-	// (DELETE /clubs/{id})
-	DeleteClub(ctx context.Context, request DeleteClubRequestObject) (DeleteClubResponseObject, error)
-	// GetClub Get a club by ID
-	// (GET /clubs/{id})
-	GetClub(ctx context.Context, request GetClubRequestObject) (GetClubResponseObject, error)
-	// PatchClub Update a club (name and/or icon)
-	// (PATCH /clubs/{id})
-	PatchClub(ctx context.Context, request PatchClubRequestObject) (PatchClubResponseObject, error)
-	// AddClubMember Add a player to a club
-	// (POST /clubs/{id}/members)
-	AddClubMember(ctx context.Context, request AddClubMemberRequestObject) (AddClubMemberResponseObject, error)
-	// RemoveClubMember Remove a player from a club
-	// (DELETE /clubs/{id}/members/{playerId})
-	RemoveClubMember(ctx context.Context, request RemoveClubMemberRequestObject) (RemoveClubMemberResponseObject, error)
-	// ListCorrections List corrections with cursor-based pagination
-	// (GET /corrections)
-	ListCorrections(ctx context.Context, request ListCorrectionsRequestObject) (ListCorrectionsResponseObject, error)
-	// ListGames List all games ordered by last played
-	// (GET /games)
-	ListGames(ctx context.Context, request ListGamesRequestObject) (ListGamesResponseObject, error)
-	// CreateGame Create a new game
-	// (POST /games)
-	CreateGame(ctx context.Context, request CreateGameRequestObject) (CreateGameResponseObject, error)
-	// DeleteGame Delete a game
-	// (DELETE /games/{id})
-	DeleteGame(ctx context.Context, request DeleteGameRequestObject) (DeleteGameResponseObject, error)
-	// GetGame Get game details and player Elo rankings
-	// (GET /games/{id})
-	GetGame(ctx context.Context, request GetGameRequestObject) (GetGameResponseObject, error)
-	// PatchGame Update game name
-	// (PATCH /games/{id})
-	PatchGame(ctx context.Context, request PatchGameRequestObject) (PatchGameResponseObject, error)
-	// GetGameMatches Get all matches for a game
-	// (GET /games/{id}/matches)
-	GetGameMatches(ctx context.Context, request GetGameMatchesRequestObject) (GetGameMatchesResponseObject, error)
-	// ListMarkets List active and closed markets
-	// (GET /markets)
-	ListMarkets(ctx context.Context, request ListMarketsRequestObject) (ListMarketsResponseObject, error)
-	// CreateMarket Create a new betting market
-	// (POST /markets)
-	CreateMarket(ctx context.Context, request CreateMarketRequestObject) (CreateMarketResponseObject, error)
-	// DeleteMarket Delete a market (only if status is open)
-	// (DELETE /markets/{id})
-	DeleteMarket(ctx context.Context, request DeleteMarketRequestObject) (DeleteMarketResponseObject, error)
-	// GetMarket Get market details (includes user-specific projections if authenticated)
-	// (GET /markets/{id})
-	GetMarket(ctx context.Context, request GetMarketRequestObject) (GetMarketResponseObject, error)
-	// PatchMarket Update market status (e.g. close betting)
-	// (PATCH /markets/{id})
-	PatchMarket(ctx context.Context, request PatchMarketRequestObject) (PatchMarketResponseObject, error)
-	// PlaceBet Place a bet on a market
-	// (POST /markets/{id}/bets)
-	PlaceBet(ctx context.Context, request PlaceBetRequestObject) (PlaceBetResponseObject, error)
-	// GetMarketPriceHistory Reconstructed per-outcome price history of a market
-	// (GET /markets/{id}/price-history)
-	GetMarketPriceHistory(ctx context.Context, request GetMarketPriceHistoryRequestObject) (GetMarketPriceHistoryResponseObject, error)
-	// ListMatches List matches with cursor-based pagination
-	// (GET /matches)
-	ListMatches(ctx context.Context, request ListMatchesRequestObject) (ListMatchesResponseObject, error)
-	// AddMatch Add a new match
-	// (POST /matches)
-	AddMatch(ctx context.Context, request AddMatchRequestObject) (AddMatchResponseObject, error)
-	// GetMatchById Get a match by ID
-	// (GET /matches/{id})
-	GetMatchById(ctx context.Context, request GetMatchByIdRequestObject) (GetMatchByIdResponseObject, error)
-	// UpdateMatch Update a match (scores and date)
-	// (PUT /matches/{id})
-	UpdateMatch(ctx context.Context, request UpdateMatchRequestObject) (UpdateMatchResponseObject, error)
-	// GetMarketsByMatchId Get markets associated with a match
-	// (GET /matches/{id}/markets)
-	GetMarketsByMatchId(ctx context.Context, request GetMarketsByMatchIdRequestObject) (GetMarketsByMatchIdResponseObject, error)
-	// GetPing Health check
-	// (GET /ping)
-	GetPing(ctx context.Context, request GetPingRequestObject) (GetPingResponseObject, error)
-	// ListPlayers List all players with Elo rankings
-	// (GET /players)
-	ListPlayers(ctx context.Context, request ListPlayersRequestObject) (ListPlayersResponseObject, error)
-	// CreatePlayer Create a new player
-	// (POST /players)
-	CreatePlayer(ctx context.Context, request CreatePlayerRequestObject) (CreatePlayerResponseObject, error)
-	// DeletePlayer Delete a player
-	// (DELETE /players/{id})
-	DeletePlayer(ctx context.Context, request DeletePlayerRequestObject) (DeletePlayerResponseObject, error)
-	// PatchPlayer Update player name
-	// (PATCH /players/{id})
-	PatchPlayer(ctx context.Context, request PatchPlayerRequestObject) (PatchPlayerResponseObject, error)
-	// GetPlayerStats Get player rating history and game statistics
-	// (GET /players/{id}/stats)
-	GetPlayerStats(ctx context.Context, request GetPlayerStatsRequestObject) (GetPlayerStatsResponseObject, error)
-	// DeleteSettings Delete future Elo settings
-	// (DELETE /settings)
-	DeleteSettings(ctx context.Context, request DeleteSettingsRequestObject) (DeleteSettingsResponseObject, error)
-	// GetSettings Get current active Elo settings
-	// (GET /settings)
-	GetSettings(ctx context.Context, request GetSettingsRequestObject) (GetSettingsResponseObject, error)
-	// CreateSettings Create new Elo settings (effective from a future date)
-	// (POST /settings)
-	CreateSettings(ctx context.Context, request CreateSettingsRequestObject) (CreateSettingsResponseObject, error)
-	// ListAllSettings Get all Elo settings entries (historical and future)
-	// (GET /settings/all)
-	ListAllSettings(ctx context.Context, request ListAllSettingsRequestObject) (ListAllSettingsResponseObject, error)
-	// ParseSkullKingCardImage Identify a Skull King card from a base64-encoded image
-	// (POST /skull-king/parse-card-image)
-	ParseSkullKingCardImage(ctx context.Context, request ParseSkullKingCardImageRequestObject) (ParseSkullKingCardImageResponseObject, error)
-	// ListSkullKingTables List all active Skull King tables
-	// (GET /skull-king/tables)
-	ListSkullKingTables(ctx context.Context, request ListSkullKingTablesRequestObject) (ListSkullKingTablesResponseObject, error)
-	// CreateSkullKingTable Create a new Skull King table
-	// (POST /skull-king/tables)
-	CreateSkullKingTable(ctx context.Context, request CreateSkullKingTableRequestObject) (CreateSkullKingTableResponseObject, error)
-	// DeleteSkullKingTable Delete a Skull King table (host only)
-	// (DELETE /skull-king/tables/{id})
-	DeleteSkullKingTable(ctx context.Context, request DeleteSkullKingTableRequestObject) (DeleteSkullKingTableResponseObject, error)
-	// GetSkullKingTable Get a Skull King table by ID
-	// (GET /skull-king/tables/{id})
-	GetSkullKingTable(ctx context.Context, request GetSkullKingTableRequestObject) (GetSkullKingTableResponseObject, error)
-	// SubmitSkullKingBid Submit a bid for the current round
-	// (POST /skull-king/tables/{id}/bid)
-	SubmitSkullKingBid(ctx context.Context, request SubmitSkullKingBidRequestObject) (SubmitSkullKingBidResponseObject, error)
-	// JoinSkullKingTable Join a Skull King table as a player
-	// (POST /skull-king/tables/{id}/join)
-	JoinSkullKingTable(ctx context.Context, request JoinSkullKingTableRequestObject) (JoinSkullKingTableResponseObject, error)
-	// SubmitSkullKingResult Submit actual tricks taken for the current round
-	// (POST /skull-king/tables/{id}/result)
-	SubmitSkullKingResult(ctx context.Context, request SubmitSkullKingResultRequestObject) (SubmitSkullKingResultResponseObject, error)
-	// UpdateSkullKingTableState Update game state (host only)
-	// (PATCH /skull-king/tables/{id}/state)
-	UpdateSkullKingTableState(ctx context.Context, request UpdateSkullKingTableStateRequestObject) (UpdateSkullKingTableStateResponseObject, error)
-	// ListTournaments List all tournaments (newest start date first)
-	// (GET /tournaments)
-	ListTournaments(ctx context.Context, request ListTournamentsRequestObject) (ListTournamentsResponseObject, error)
-	// CreateTournament Create a new tournament
-	// (POST /tournaments)
-	CreateTournament(ctx context.Context, request CreateTournamentRequestObject) (CreateTournamentResponseObject, error)
-	// DeleteTournament Delete a tournament (only when it has no participants)
-	// (DELETE /tournaments/{id})
-	DeleteTournament(ctx context.Context, request DeleteTournamentRequestObject) (DeleteTournamentResponseObject, error)
-	// GetTournament Get a tournament by ID
-	// (GET /tournaments/{id})
-	GetTournament(ctx context.Context, request GetTournamentRequestObject) (GetTournamentResponseObject, error)
-	// UpdateTournament Update a tournament's name, dates and participants
+type DeleteMatchRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteMatchResponseObject interface {
+	VisitDeleteMatchResponse(w http.ResponseWriter) error
+}
+
+type DeleteMatch200JSONResponse ApiSuccessMessage
+
+func (response DeleteMatch200JSONResponse) VisitDeleteMatchResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteMatch401JSONResponse ApiError
+
+func (response DeleteMatch401JSONResponse) VisitDeleteMatchResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteMatch403JSONResponse ApiError
+
+func (response DeleteMatch403JSONResponse) VisitDeleteMatchResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteMatch404JSONResponse ApiError
+
+func (response DeleteMatch404JSONResponse) VisitDeleteMatchResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteMatch409JSONResponse ApiError
+
+func (response DeleteMatch409JSONResponse) VisitDeleteMatchResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetMatchByIdRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetMatchByIdResponseObject interface {
+	VisitGetMatchByIdResponse(w http.ResponseWriter) error
+}
+
+type GetMatchById200JSONResponse struct {
+	Data   Match  `json:"data"`
+	Status string `json:"status"`
+}
+
+func (response GetMatchById200JSONResponse) VisitGetMatchByIdResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetMatchById400JSONResponse ApiError
+
+func (response GetMatchById400JSONResponse) VisitGetMatchByIdResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetMatchById404JSONResponse ApiError
+
+func (response GetMatchById404JSONResponse) VisitGetMatchByIdResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdateMatchRequestObject struct {
+	Id   string `json:"id"`
+	Body *UpdateMatchJSONRequestBody
+}
+
+type UpdateMatchResponseObject interface {
+	VisitUpdateMatchResponse(w http.ResponseWriter) error
+}
+
+type UpdateMatch200JSONResponse ApiSuccessMessage
+
+func (response UpdateMatch200JSONResponse) VisitUpdateMatchResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdateMatch400JSONResponse ApiError
+
+func (response UpdateMatch400JSONResponse) VisitUpdateMatchResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdateMatch401JSONResponse ApiError
+
+func (response UpdateMatch401JSONResponse) VisitUpdateMatchResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdateMatch403JSONResponse ApiError
+
+func (response UpdateMatch403JSONResponse) VisitUpdateMatchResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdateMatch404JSONResponse ApiError
+
+func (response UpdateMatch404JSONResponse) VisitUpdateMatchResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdateMatch409JSONResponse ApiError
+
+func (response UpdateMatch409JSONResponse) VisitUpdateMatchResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetMarketsByMatchIdRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetMarketsByMatchIdResponseObject interface {
+	VisitGetMarketsByMatchIdResponse(w http.ResponseWriter) error
+}
+
+type GetMarketsByMatchId200JSONResponse struct {
+	Data   []Market `json:"data"`
+	Status string   `json:"status"`
+}
+
+func (response GetMarketsByMatchId200JSONResponse) VisitGetMarketsByMatchIdResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetMarketsByMatchId400JSONResponse ApiError
+
+func (response GetMarketsByMatchId400JSONResponse) VisitGetMarketsByMatchIdResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type SuggestTablesRequestObject struct {
+	Body *SuggestTablesJSONRequestBody
+}
+
+type SuggestTablesResponseObject interface {
+	VisitSuggestTablesResponse(w http.ResponseWriter) error
+}
+
+type SuggestTables200JSONResponse struct {
+	Data   []TableSuggestion `json:"data"`
+	Status string            `json:"status"`
+}
+
+func (response SuggestTables200JSONResponse) VisitSuggestTablesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type SuggestTables400JSONResponse ApiError
+
+func (response SuggestTables400JSONResponse) VisitSuggestTablesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type SuggestTables404JSONResponse ApiError
+
+func (response SuggestTables404JSONResponse) VisitSuggestTablesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPingRequestObject struct {
+}
+
+type GetPingResponseObject interface {
+	VisitGetPingResponse(w http.ResponseWriter) error
+}
+
+type GetPing200JSONResponse ApiSuccessMessage
+
+func (response GetPing200JSONResponse) VisitGetPingResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListPlayersRequestObject struct {
+	Params ListPlayersParams
+}
+
+type ListPlayersResponseObject interface {
+	VisitListPlayersResponse(w http.ResponseWriter) error
+}
+
+type ListPlayers200JSONResponse struct {
+	Data   []Player `json:"data"`
+	Status string   `json:"status"`
+}
+
+func (response ListPlayers200JSONResponse) VisitListPlayersResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreatePlayerRequestObject struct {
+	Body *CreatePlayerJSONRequestBody
+}
+
+type CreatePlayerResponseObject interface {
+	VisitCreatePlayerResponse(w http.ResponseWriter) error
+}
+
+type CreatePlayer200JSONResponse struct {
+	// Data Minimal player object returned after create/patch
+	Data   PlayerRef `json:"data"`
+	Status string    `json:"status"`
+}
+
+func (response CreatePlayer200JSONResponse) VisitCreatePlayerResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreatePlayer400JSONResponse ApiError
+
+func (response CreatePlayer400JSONResponse) VisitCreatePlayerResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreatePlayer401JSONResponse ApiError
+
+func (response CreatePlayer401JSONResponse) VisitCreatePlayerResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreatePlayer403JSONResponse ApiError
+
+func (response CreatePlayer403JSONResponse) VisitCreatePlayerResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreatePlayer409JSONResponse ApiError
+
+func (response CreatePlayer409JSONResponse) VisitCreatePlayerResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeletePlayerRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeletePlayerResponseObject interface {
+	VisitDeletePlayerResponse(w http.ResponseWriter) error
+}
+
+type DeletePlayer200JSONResponse ApiSuccessMessage
+
+func (response DeletePlayer200JSONResponse) VisitDeletePlayerResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeletePlayer400JSONResponse ApiError
+
+func (response DeletePlayer400JSONResponse) VisitDeletePlayerResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeletePlayer401JSONResponse ApiError
+
+func (response DeletePlayer401JSONResponse) VisitDeletePlayerResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeletePlayer403JSONResponse ApiError
+
+func (response DeletePlayer403JSONResponse) VisitDeletePlayerResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeletePlayer404JSONResponse ApiError
+
+func (response DeletePlayer404JSONResponse) VisitDeletePlayerResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PatchPlayerRequestObject struct {
+	Id   string `json:"id"`
+	Body *PatchPlayerJSONRequestBody
+}
+
+type PatchPlayerResponseObject interface {
+	VisitPatchPlayerResponse(w http.ResponseWriter) error
+}
+
+type PatchPlayer200JSONResponse struct {
+	// Data Minimal player object returned after create/patch
+	Data   PlayerRef `json:"data"`
+	Status string    `json:"status"`
+}
+
+func (response PatchPlayer200JSONResponse) VisitPatchPlayerResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PatchPlayer400JSONResponse ApiError
+
+func (response PatchPlayer400JSONResponse) VisitPatchPlayerResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PatchPlayer401JSONResponse ApiError
+
+func (response PatchPlayer401JSONResponse) VisitPatchPlayerResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PatchPlayer403JSONResponse ApiError
+
+func (response PatchPlayer403JSONResponse) VisitPatchPlayerResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PatchPlayer404JSONResponse ApiError
+
+func (response PatchPlayer404JSONResponse) VisitPatchPlayerResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PatchPlayer409JSONResponse ApiError
+
+func (response PatchPlayer409JSONResponse) VisitPatchPlayerResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPlayerHeadToHeadRequestObject struct {
+	Id      string `json:"id"`
+	OtherId string `json:"otherId"`
+}
+
+type GetPlayerHeadToHeadResponseObject interface {
+	VisitGetPlayerHeadToHeadResponse(w http.ResponseWriter) error
+}
+
+type GetPlayerHeadToHead200JSONResponse struct {
+	Data   HeadToHead `json:"data"`
+	Status string     `json:"status"`
+}
+
+func (response GetPlayerHeadToHead200JSONResponse) VisitGetPlayerHeadToHeadResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPlayerHeadToHead400JSONResponse ApiError
+
+func (response GetPlayerHeadToHead400JSONResponse) VisitGetPlayerHeadToHeadResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPlayerHeadToHead404JSONResponse ApiError
+
+func (response GetPlayerHeadToHead404JSONResponse) VisitGetPlayerHeadToHeadResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type MergePlayerRequestObject struct {
+	Id   string `json:"id"`
+	Body *MergePlayerJSONRequestBody
+}
+
+type MergePlayerResponseObject interface {
+	VisitMergePlayerResponse(w http.ResponseWriter) error
+}
+
+type MergePlayer200JSONResponse struct {
+	// Data Minimal player object returned after create/patch
+	Data   PlayerRef `json:"data"`
+	Status string    `json:"status"`
+}
+
+func (response MergePlayer200JSONResponse) VisitMergePlayerResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type MergePlayer400JSONResponse ApiError
+
+func (response MergePlayer400JSONResponse) VisitMergePlayerResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type MergePlayer401JSONResponse ApiError
+
+func (response MergePlayer401JSONResponse) VisitMergePlayerResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type MergePlayer403JSONResponse ApiError
+
+func (response MergePlayer403JSONResponse) VisitMergePlayerResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type MergePlayer404JSONResponse ApiError
+
+func (response MergePlayer404JSONResponse) VisitMergePlayerResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type MergePlayer409JSONResponse ApiError
+
+func (response MergePlayer409JSONResponse) VisitMergePlayerResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPlayerRivalsRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetPlayerRivalsResponseObject interface {
+	VisitGetPlayerRivalsResponse(w http.ResponseWriter) error
+}
+
+type GetPlayerRivals200JSONResponse struct {
+	Data   Rivals `json:"data"`
+	Status string `json:"status"`
+}
+
+func (response GetPlayerRivals200JSONResponse) VisitGetPlayerRivalsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPlayerRivals404JSONResponse ApiError
+
+func (response GetPlayerRivals404JSONResponse) VisitGetPlayerRivalsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPlayerStatsRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetPlayerStatsResponseObject interface {
+	VisitGetPlayerStatsResponse(w http.ResponseWriter) error
+}
+
+type GetPlayerStats200JSONResponse struct {
+	Data   PlayerStats `json:"data"`
+	Status string      `json:"status"`
+}
+
+func (response GetPlayerStats200JSONResponse) VisitGetPlayerStatsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPlayerStats400JSONResponse ApiError
+
+func (response GetPlayerStats400JSONResponse) VisitGetPlayerStatsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPlayerStats404JSONResponse ApiError
+
+func (response GetPlayerStats404JSONResponse) VisitGetPlayerStatsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PredictMatchRequestObject struct {
+	Body *PredictMatchJSONRequestBody
+}
+
+type PredictMatchResponseObject interface {
+	VisitPredictMatchResponse(w http.ResponseWriter) error
+}
+
+type PredictMatch200JSONResponse struct {
+	Data   []PlayerPrediction `json:"data"`
+	Status string             `json:"status"`
+}
+
+func (response PredictMatch200JSONResponse) VisitPredictMatchResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PredictMatch400JSONResponse ApiError
+
+func (response PredictMatch400JSONResponse) VisitPredictMatchResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PredictMatch404JSONResponse ApiError
+
+func (response PredictMatch404JSONResponse) VisitPredictMatchResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetRecordsRequestObject struct {
+}
+
+type GetRecordsResponseObject interface {
+	VisitGetRecordsResponse(w http.ResponseWriter) error
+}
+
+type GetRecords200JSONResponse struct {
+	Data   Records `json:"data"`
+	Status string  `json:"status"`
+}
+
+func (response GetRecords200JSONResponse) VisitGetRecordsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListSeasonsRequestObject struct {
+}
+
+type ListSeasonsResponseObject interface {
+	VisitListSeasonsResponse(w http.ResponseWriter) error
+}
+
+type ListSeasons200JSONResponse struct {
+	Data   []Season `json:"data"`
+	Status string   `json:"status"`
+}
+
+func (response ListSeasons200JSONResponse) VisitListSeasonsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateSeasonRequestObject struct {
+	Body *CreateSeasonJSONRequestBody
+}
+
+type CreateSeasonResponseObject interface {
+	VisitCreateSeasonResponse(w http.ResponseWriter) error
+}
+
+type CreateSeason200JSONResponse struct {
+	Data   Season `json:"data"`
+	Status string `json:"status"`
+}
+
+func (response CreateSeason200JSONResponse) VisitCreateSeasonResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateSeason400JSONResponse ApiError
+
+func (response CreateSeason400JSONResponse) VisitCreateSeasonResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateSeason401JSONResponse ApiError
+
+func (response CreateSeason401JSONResponse) VisitCreateSeasonResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateSeason403JSONResponse ApiError
+
+func (response CreateSeason403JSONResponse) VisitCreateSeasonResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateSeason409JSONResponse ApiError
+
+func (response CreateSeason409JSONResponse) VisitCreateSeasonResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteSeasonRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteSeasonResponseObject interface {
+	VisitDeleteSeasonResponse(w http.ResponseWriter) error
+}
+
+type DeleteSeason200JSONResponse ApiSuccessMessage
+
+func (response DeleteSeason200JSONResponse) VisitDeleteSeasonResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteSeason401JSONResponse ApiError
+
+func (response DeleteSeason401JSONResponse) VisitDeleteSeasonResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteSeason403JSONResponse ApiError
+
+func (response DeleteSeason403JSONResponse) VisitDeleteSeasonResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteSeason404JSONResponse ApiError
+
+func (response DeleteSeason404JSONResponse) VisitDeleteSeasonResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteSeason409JSONResponse ApiError
+
+func (response DeleteSeason409JSONResponse) VisitDeleteSeasonResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ArchiveSeasonRequestObject struct {
+	Id string `json:"id"`
+}
+
+type ArchiveSeasonResponseObject interface {
+	VisitArchiveSeasonResponse(w http.ResponseWriter) error
+}
+
+type ArchiveSeason200JSONResponse struct {
+	Data   Season `json:"data"`
+	Status string `json:"status"`
+}
+
+func (response ArchiveSeason200JSONResponse) VisitArchiveSeasonResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ArchiveSeason401JSONResponse ApiError
+
+func (response ArchiveSeason401JSONResponse) VisitArchiveSeasonResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ArchiveSeason403JSONResponse ApiError
+
+func (response ArchiveSeason403JSONResponse) VisitArchiveSeasonResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ArchiveSeason404JSONResponse ApiError
+
+func (response ArchiveSeason404JSONResponse) VisitArchiveSeasonResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ArchiveSeason409JSONResponse ApiError
+
+func (response ArchiveSeason409JSONResponse) VisitArchiveSeasonResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetSeasonLeaderboardRequestObject struct {
+	Id     string `json:"id"`
+	Params GetSeasonLeaderboardParams
+}
+
+type GetSeasonLeaderboardResponseObject interface {
+	VisitGetSeasonLeaderboardResponse(w http.ResponseWriter) error
+}
+
+type GetSeasonLeaderboard200JSONResponse struct {
+	Data   SeasonLeaderboard `json:"data"`
+	Status string            `json:"status"`
+}
+
+func (response GetSeasonLeaderboard200JSONResponse) VisitGetSeasonLeaderboardResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetSeasonLeaderboard404JSONResponse ApiError
+
+func (response GetSeasonLeaderboard404JSONResponse) VisitGetSeasonLeaderboardResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteSettingsRequestObject struct {
+	Body *DeleteSettingsJSONRequestBody
+}
+
+type DeleteSettingsResponseObject interface {
+	VisitDeleteSettingsResponse(w http.ResponseWriter) error
+}
+
+type DeleteSettings200JSONResponse ApiSuccessMessage
+
+func (response DeleteSettings200JSONResponse) VisitDeleteSettingsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteSettings400JSONResponse ApiError
+
+func (response DeleteSettings400JSONResponse) VisitDeleteSettingsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteSettings401JSONResponse ApiError
+
+func (response DeleteSettings401JSONResponse) VisitDeleteSettingsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteSettings403JSONResponse ApiError
+
+func (response DeleteSettings403JSONResponse) VisitDeleteSettingsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetSettingsRequestObject struct {
+}
+
+type GetSettingsResponseObject interface {
+	VisitGetSettingsResponse(w http.ResponseWriter) error
+}
+
+type GetSettings200JSONResponse struct {
+	Data   Settings `json:"data"`
+	Status string   `json:"status"`
+}
+
+func (response GetSettings200JSONResponse) VisitGetSettingsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateSettingsRequestObject struct {
+	Body *CreateSettingsJSONRequestBody
+}
+
+type CreateSettingsResponseObject interface {
+	VisitCreateSettingsResponse(w http.ResponseWriter) error
+}
+
+type CreateSettings201JSONResponse ApiSuccessMessage
+
+func (response CreateSettings201JSONResponse) VisitCreateSettingsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateSettings400JSONResponse ApiError
+
+func (response CreateSettings400JSONResponse) VisitCreateSettingsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateSettings401JSONResponse ApiError
+
+func (response CreateSettings401JSONResponse) VisitCreateSettingsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateSettings403JSONResponse ApiError
+
+func (response CreateSettings403JSONResponse) VisitCreateSettingsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListAllSettingsRequestObject struct {
+}
+
+type ListAllSettingsResponseObject interface {
+	VisitListAllSettingsResponse(w http.ResponseWriter) error
+}
+
+type ListAllSettings200JSONResponse struct {
+	Data   []EloSettingEntry `json:"data"`
+	Status string            `json:"status"`
+}
+
+func (response ListAllSettings200JSONResponse) VisitListAllSettingsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ParseSkullKingCardImageRequestObject struct {
+	Body *ParseSkullKingCardImageJSONRequestBody
+}
+
+type ParseSkullKingCardImageResponseObject interface {
+	VisitParseSkullKingCardImageResponse(w http.ResponseWriter) error
+}
+
+type ParseSkullKingCardImage200JSONResponse struct {
+	Data   SkullKingCardImageResult `json:"data"`
+	Status string                   `json:"status"`
+}
+
+func (response ParseSkullKingCardImage200JSONResponse) VisitParseSkullKingCardImageResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ParseSkullKingCardImage400JSONResponse ApiError
+
+func (response ParseSkullKingCardImage400JSONResponse) VisitParseSkullKingCardImageResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ParseSkullKingCardImage500JSONResponse ApiError
+
+func (response ParseSkullKingCardImage500JSONResponse) VisitParseSkullKingCardImageResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListSkullKingTablesRequestObject struct {
+}
+
+type ListSkullKingTablesResponseObject interface {
+	VisitListSkullKingTablesResponse(w http.ResponseWriter) error
+}
+
+type ListSkullKingTables200JSONResponse struct {
+	Data   []SkullKingTableSummary `json:"data"`
+	Status string                  `json:"status"`
+}
+
+func (response ListSkullKingTables200JSONResponse) VisitListSkullKingTablesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateSkullKingTableRequestObject struct {
+	Body *CreateSkullKingTableJSONRequestBody
+}
+
+type CreateSkullKingTableResponseObject interface {
+	VisitCreateSkullKingTableResponse(w http.ResponseWriter) error
+}
+
+type CreateSkullKingTable201JSONResponse struct {
+	Data   SkullKingTableSummary `json:"data"`
+	Status string                `json:"status"`
+}
+
+func (response CreateSkullKingTable201JSONResponse) VisitCreateSkullKingTableResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateSkullKingTable400JSONResponse ApiError
+
+func (response CreateSkullKingTable400JSONResponse) VisitCreateSkullKingTableResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateSkullKingTable401JSONResponse ApiError
+
+func (response CreateSkullKingTable401JSONResponse) VisitCreateSkullKingTableResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteSkullKingTableRequestObject struct {
+	Id     string `json:"id"`
+	Params DeleteSkullKingTableParams
+}
+
+type DeleteSkullKingTableResponseObject interface {
+	VisitDeleteSkullKingTableResponse(w http.ResponseWriter) error
+}
+
+type DeleteSkullKingTable204Response struct {
+}
+
+func (response DeleteSkullKingTable204Response) VisitDeleteSkullKingTableResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteSkullKingTable401JSONResponse ApiError
+
+func (response DeleteSkullKingTable401JSONResponse) VisitDeleteSkullKingTableResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteSkullKingTable403JSONResponse ApiError
+
+func (response DeleteSkullKingTable403JSONResponse) VisitDeleteSkullKingTableResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteSkullKingTable404JSONResponse ApiError
+
+func (response DeleteSkullKingTable404JSONResponse) VisitDeleteSkullKingTableResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetSkullKingTableRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetSkullKingTableResponseObject interface {
+	VisitGetSkullKingTableResponse(w http.ResponseWriter) error
+}
+
+type GetSkullKingTable200JSONResponse struct {
+	Data   SkullKingTableSummary `json:"data"`
+	Status string                `json:"status"`
+}
+
+func (response GetSkullKingTable200JSONResponse) VisitGetSkullKingTableResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetSkullKingTable404JSONResponse ApiError
+
+func (response GetSkullKingTable404JSONResponse) VisitGetSkullKingTableResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type SubmitSkullKingBidRequestObject struct {
+	Id   string `json:"id"`
+	Body *SubmitSkullKingBidJSONRequestBody
+}
+
+type SubmitSkullKingBidResponseObject interface {
+	VisitSubmitSkullKingBidResponse(w http.ResponseWriter) error
+}
+
+type SubmitSkullKingBid200JSONResponse struct {
+	Data   SkullKingTableSummary `json:"data"`
+	Status string                `json:"status"`
+}
+
+func (response SubmitSkullKingBid200JSONResponse) VisitSubmitSkullKingBidResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type SubmitSkullKingBid401JSONResponse ApiError
+
+func (response SubmitSkullKingBid401JSONResponse) VisitSubmitSkullKingBidResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type SubmitSkullKingBid404JSONResponse ApiError
+
+func (response SubmitSkullKingBid404JSONResponse) VisitSubmitSkullKingBidResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type SubmitSkullKingBid409JSONResponse ApiError
+
+func (response SubmitSkullKingBid409JSONResponse) VisitSubmitSkullKingBidResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type JoinSkullKingTableRequestObject struct {
+	Id string `json:"id"`
+}
+
+type JoinSkullKingTableResponseObject interface {
+	VisitJoinSkullKingTableResponse(w http.ResponseWriter) error
+}
+
+type JoinSkullKingTable200JSONResponse struct {
+	Data   SkullKingTableSummary `json:"data"`
+	Status string                `json:"status"`
+}
+
+func (response JoinSkullKingTable200JSONResponse) VisitJoinSkullKingTableResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type JoinSkullKingTable401JSONResponse ApiError
+
+func (response JoinSkullKingTable401JSONResponse) VisitJoinSkullKingTableResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type JoinSkullKingTable404JSONResponse ApiError
+
+func (response JoinSkullKingTable404JSONResponse) VisitJoinSkullKingTableResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type SubmitSkullKingResultRequestObject struct {
+	Id   string `json:"id"`
+	Body *SubmitSkullKingResultJSONRequestBody
+}
+
+type SubmitSkullKingResultResponseObject interface {
+	VisitSubmitSkullKingResultResponse(w http.ResponseWriter) error
+}
+
+type SubmitSkullKingResult200JSONResponse struct {
+	Data   SkullKingTableSummary `json:"data"`
+	Status string                `json:"status"`
+}
+
+func (response SubmitSkullKingResult200JSONResponse) VisitSubmitSkullKingResultResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type SubmitSkullKingResult401JSONResponse ApiError
+
+func (response SubmitSkullKingResult401JSONResponse) VisitSubmitSkullKingResultResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type SubmitSkullKingResult404JSONResponse ApiError
+
+func (response SubmitSkullKingResult404JSONResponse) VisitSubmitSkullKingResultResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type SubmitSkullKingResult409JSONResponse ApiError
+
+func (response SubmitSkullKingResult409JSONResponse) VisitSubmitSkullKingResultResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdateSkullKingTableStateRequestObject struct {
+	Id   string `json:"id"`
+	Body *UpdateSkullKingTableStateJSONRequestBody
+}
+
+type UpdateSkullKingTableStateResponseObject interface {
+	VisitUpdateSkullKingTableStateResponse(w http.ResponseWriter) error
+}
+
+type UpdateSkullKingTableState200JSONResponse struct {
+	Data   SkullKingTableSummary `json:"data"`
+	Status string                `json:"status"`
+}
+
+func (response UpdateSkullKingTableState200JSONResponse) VisitUpdateSkullKingTableStateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdateSkullKingTableState401JSONResponse ApiError
+
+func (response UpdateSkullKingTableState401JSONResponse) VisitUpdateSkullKingTableStateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdateSkullKingTableState403JSONResponse ApiError
+
+func (response UpdateSkullKingTableState403JSONResponse) VisitUpdateSkullKingTableStateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdateSkullKingTableState404JSONResponse ApiError
+
+func (response UpdateSkullKingTableState404JSONResponse) VisitUpdateSkullKingTableStateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListTournamentsRequestObject struct {
+}
+
+type ListTournamentsResponseObject interface {
+	VisitListTournamentsResponse(w http.ResponseWriter) error
+}
+
+type ListTournaments200JSONResponse struct {
+	Data   []Tournament `json:"data"`
+	Status string       `json:"status"`
+}
+
+func (response ListTournaments200JSONResponse) VisitListTournamentsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateTournamentRequestObject struct {
+	Body *CreateTournamentJSONRequestBody
+}
+
+type CreateTournamentResponseObject interface {
+	VisitCreateTournamentResponse(w http.ResponseWriter) error
+}
+
+type CreateTournament200JSONResponse struct {
+	Data   Tournament `json:"data"`
+	Status string     `json:"status"`
+}
+
+func (response CreateTournament200JSONResponse) VisitCreateTournamentResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateTournament400JSONResponse ApiError
+
+func (response CreateTournament400JSONResponse) VisitCreateTournamentResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateTournament401JSONResponse ApiError
+
+func (response CreateTournament401JSONResponse) VisitCreateTournamentResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateTournament403JSONResponse ApiError
+
+func (response CreateTournament403JSONResponse) VisitCreateTournamentResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateTournament409JSONResponse ApiError
+
+func (response CreateTournament409JSONResponse) VisitCreateTournamentResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteTournamentRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteTournamentResponseObject interface {
+	VisitDeleteTournamentResponse(w http.ResponseWriter) error
+}
+
+type DeleteTournament200JSONResponse ApiSuccessMessage
+
+func (response DeleteTournament200JSONResponse) VisitDeleteTournamentResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteTournament400JSONResponse ApiError
+
+func (response DeleteTournament400JSONResponse) VisitDeleteTournamentResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteTournament401JSONResponse ApiError
+
+func (response DeleteTournament401JSONResponse) VisitDeleteTournamentResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteTournament403JSONResponse ApiError
+
+func (response DeleteTournament403JSONResponse) VisitDeleteTournamentResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteTournament404JSONResponse ApiError
+
+func (response DeleteTournament404JSONResponse) VisitDeleteTournamentResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteTournament409JSONResponse ApiError
+
+func (response DeleteTournament409JSONResponse) VisitDeleteTournamentResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetTournamentRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetTournamentResponseObject interface {
+	VisitGetTournamentResponse(w http.ResponseWriter) error
+}
+
+type GetTournament200JSONResponse struct {
+	Data   Tournament `json:"data"`
+	Status string     `json:"status"`
+}
+
+func (response GetTournament200JSONResponse) VisitGetTournamentResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetTournament400JSONResponse ApiError
+
+func (response GetTournament400JSONResponse) VisitGetTournamentResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetTournament404JSONResponse ApiError
+
+func (response GetTournament404JSONResponse) VisitGetTournamentResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdateTournamentRequestObject struct {
+	Id   string `json:"id"`
+	Body *UpdateTournamentJSONRequestBody
+}
+
+type UpdateTournamentResponseObject interface {
+	VisitUpdateTournamentResponse(w http.ResponseWriter) error
+}
+
+type UpdateTournament200JSONResponse struct {
+	Data   Tournament `json:"data"`
+	Status string     `json:"status"`
+}
+
+func (response UpdateTournament200JSONResponse) VisitUpdateTournamentResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdateTournament400JSONResponse ApiError
+
+func (response UpdateTournament400JSONResponse) VisitUpdateTournamentResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdateTournament401JSONResponse ApiError
+
+func (response UpdateTournament401JSONResponse) VisitUpdateTournamentResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdateTournament403JSONResponse ApiError
+
+func (response UpdateTournament403JSONResponse) VisitUpdateTournamentResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdateTournament404JSONResponse ApiError
+
+func (response UpdateTournament404JSONResponse) VisitUpdateTournamentResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdateTournament409JSONResponse ApiError
+
+func (response UpdateTournament409JSONResponse) VisitUpdateTournamentResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetTournamentStatsRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetTournamentStatsResponseObject interface {
+	VisitGetTournamentStatsResponse(w http.ResponseWriter) error
+}
+
+type GetTournamentStats200JSONResponse struct {
+	Data   TournamentStats `json:"data"`
+	Status string          `json:"status"`
+}
+
+func (response GetTournamentStats200JSONResponse) VisitGetTournamentStatsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetTournamentStats400JSONResponse ApiError
+
+func (response GetTournamentStats400JSONResponse) VisitGetTournamentStatsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListUsersRequestObject struct {
+}
+
+type ListUsersResponseObject interface {
+	VisitListUsersResponse(w http.ResponseWriter) error
+}
+
+type ListUsers200JSONResponse struct {
+	Data   []User `json:"data"`
+	Status string `json:"status"`
+}
+
+func (response ListUsers200JSONResponse) VisitListUsersResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PatchUserRequestObject struct {
+	UserId string `json:"userId"`
+	Body   *PatchUserJSONRequestBody
+}
+
+type PatchUserResponseObject interface {
+	VisitPatchUserResponse(w http.ResponseWriter) error
+}
+
+type PatchUser200JSONResponse struct {
+	Data   User   `json:"data"`
+	Status string `json:"status"`
+}
+
+func (response PatchUser200JSONResponse) VisitPatchUserResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PatchUser400JSONResponse ApiError
+
+func (response PatchUser400JSONResponse) VisitPatchUserResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PatchUser401JSONResponse ApiError
+
+func (response PatchUser401JSONResponse) VisitPatchUserResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PatchUser403JSONResponse ApiError
+
+func (response PatchUser403JSONResponse) VisitPatchUserResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PatchUser404JSONResponse ApiError
+
+func (response PatchUser404JSONResponse) VisitPatchUserResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ParseVoiceInputRequestObject struct {
+	Body *ParseVoiceInputJSONRequestBody
+}
+
+type ParseVoiceInputResponseObject interface {
+	VisitParseVoiceInputResponse(w http.ResponseWriter) error
+}
+
+type ParseVoiceInput200JSONResponse struct {
+	Data   VoiceParseResult `json:"data"`
+	Status string           `json:"status"`
+}
+
+func (response ParseVoiceInput200JSONResponse) VisitParseVoiceInputResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ParseVoiceInput400JSONResponse ApiError
+
+func (response ParseVoiceInput400JSONResponse) VisitParseVoiceInputResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ParseVoiceInput401JSONResponse ApiError
+
+func (response ParseVoiceInput401JSONResponse) VisitParseVoiceInputResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ParseVoiceInput403JSONResponse ApiError
+
+func (response ParseVoiceInput403JSONResponse) VisitParseVoiceInputResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ParseVoiceInput500JSONResponse ApiError
+
+func (response ParseVoiceInput500JSONResponse) VisitParseVoiceInputResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// VerifySettlementCheckpoints Check stored settlement checkpoints against history recomputed from scratch
+	// (POST /admin/checkpoints/verify)
+	VerifySettlementCheckpoints(ctx context.Context, request VerifySettlementCheckpointsRequestObject) (VerifySettlementCheckpointsResponseObject, error)
+	// AdminAddMatch Add a match at any past date (admin override)
+	// (POST /admin/matches)
+	AdminAddMatch(ctx context.Context, request AdminAddMatchRequestObject) (AdminAddMatchResponseObject, error)
+	// AdminUpdateMatch Update a match moving its date arbitrarily (admin override)
+	// (PUT /admin/matches/{id})
+	AdminUpdateMatch(ctx context.Context, request AdminUpdateMatchRequestObject) (AdminUpdateMatchResponseObject, error)
+	// CreatePlayerCorrection Apply a manual rating correction for a player
+	// (POST /admin/players/{id}/corrections)
+	CreatePlayerCorrection(ctx context.Context, request CreatePlayerCorrectionRequestObject) (CreatePlayerCorrectionResponseObject, error)
+	// RecalculateGameElo Recalculate all game-specific Elo ratings
+	// (POST /admin/recalculate-game-elo)
+	RecalculateGameElo(ctx context.Context, request RecalculateGameEloRequestObject) (RecalculateGameEloResponseObject, error)
+	// SimulateSettings Replay the whole history under proposed Elo settings without saving
+	// (POST /admin/settings/simulate)
+	SimulateSettings(ctx context.Context, request SimulateSettingsRequestObject) (SimulateSettingsResponseObject, error)
+	// ListArenas List custom arenas (by name)
+	// (GET /arenas)
+	ListArenas(ctx context.Context, request ListArenasRequestObject) (ListArenasResponseObject, error)
+	// CreateArena Create a custom arena and settle every past match that passes its filters
+	// (POST /arenas)
+	CreateArena(ctx context.Context, request CreateArenaRequestObject) (CreateArenaResponseObject, error)
+	// DeleteArena Delete a custom arena together with its ratings
+	// (DELETE /arenas/{id})
+	DeleteArena(ctx context.Context, request DeleteArenaRequestObject) (DeleteArenaResponseObject, error)
+	// GetArena Get a custom arena by ID
+	// (GET /arenas/{id})
+	GetArena(ctx context.Context, request GetArenaRequestObject) (GetArenaResponseObject, error)
+	// UpdateArena Replace an arena's name and filters and rebuild its ratings
+	// (PUT /arenas/{id})
+	UpdateArena(ctx context.Context, request UpdateArenaRequestObject) (UpdateArenaResponseObject, error)
+	// GetArenaLeaderboard Players of an arena by their latest arena Elo (highest first)
+	// (GET /arenas/{id}/leaderboard)
+	GetArenaLeaderboard(ctx context.Context, request GetArenaLeaderboardRequestObject) (GetArenaLeaderboardResponseObject, error)
+	// GetArenaPlayerHistory A player's arena settlements (newest first)
+	// (GET /arenas/{id}/players/{playerId}/history)
+	GetArenaPlayerHistory(ctx context.Context, request GetArenaPlayerHistoryRequestObject) (GetArenaPlayerHistoryResponseObject, error)
+	// ListAuditEntries List audit log entries with cursor-based pagination
+	// (GET /audit)
+	ListAuditEntries(ctx context.Context, request ListAuditEntriesRequestObject) (ListAuditEntriesResponseObject, error)
+	// AuthLogin Initiate Google OAuth2 login flow
+	// (GET /auth/login)
+	AuthLogin(ctx context.Context, request AuthLoginRequestObject) (AuthLoginResponseObject, error)
+	// AuthLogout Logout (clears session cookie)
+	// (POST /auth/logout)
+	AuthLogout(ctx context.Context, request AuthLogoutRequestObject) (AuthLogoutResponseObject, error)
+	// GetMe Get current authenticated user
+	// (GET /auth/me)
+	GetMe(ctx context.Context, request GetMeRequestObject) (GetMeResponseObject, error)
+	// PatchMe Update current user's linked player
+	// (PATCH /auth/me)
+	PatchMe(ctx context.Context, request PatchMeRequestObject) (PatchMeResponseObject, error)
+	// AuthOAuth2Callback Google OAuth2 callback
+	// (GET /auth/oauth2-callback)
+	AuthOAuth2Callback(ctx context.Context, request AuthOAuth2CallbackRequestObject) (AuthOAuth2CallbackResponseObject, error)
+	// ListClubs List all clubs
+	// (GET /clubs)
+	ListClubs(ctx context.Context, request ListClubsRequestObject) (ListClubsResponseObject, error)
+	// CreateClub Create a new club
+	// (POST /clubs)
+	CreateClub(ctx context.Context, request CreateClubRequestObject) (CreateClubResponseObject, error)
+	// DeleteClub Delete a club
+	// (DELETE /clubs/{id})
+	DeleteClub(ctx context.Context, request DeleteClubRequestObject) (DeleteClubResponseObject, error)
+	// GetClub Get a club by ID
+	// (GET /clubs/{id})
+	GetClub(ctx context.Context, request GetClubRequestObject) (GetClubResponseObject, error)
+	// PatchClub Update a club (name and/or icon)
+	// (PATCH /clubs/{id})
+	PatchClub(ctx context.Context, request PatchClubRequestObject) (PatchClubResponseObject, error)
+	// AddClubMember Add a player to a club
+	// (POST /clubs/{id}/members)
+	AddClubMember(ctx context.Context, request AddClubMemberRequestObject) (AddClubMemberResponseObject, error)
+	// RemoveClubMember Remove a player from a club
+	// (DELETE /clubs/{id}/members/{playerId})
+	RemoveClubMember(ctx context.Context, request RemoveClubMemberRequestObject) (RemoveClubMemberResponseObject, error)
+	// ListCorrections List corrections with cursor-based pagination
+	// (GET /corrections)
+	ListCorrections(ctx context.Context, request ListCorrectionsRequestObject) (ListCorrectionsResponseObject, error)
+	// ListGames List all games ordered by last played
+	// (GET /games)
+	ListGames(ctx context.Context, request ListGamesRequestObject) (ListGamesResponseObject, error)
+	// CreateGame Create a new game
+	// (POST /games)
+	CreateGame(ctx context.Context, request CreateGameRequestObject) (CreateGameResponseObject, error)
+	// DeleteGame Delete a game
+	// (DELETE /games/{id})
+	DeleteGame(ctx context.Context, request DeleteGameRequestObject) (DeleteGameResponseObject, error)
+	// GetGame Get game details and player Elo rankings
+	// (GET /games/{id})
+	GetGame(ctx context.Context, request GetGameRequestObject) (GetGameResponseObject, error)
+	// PatchGame Update game name and scoring rules
+	// (PATCH /games/{id})
+	PatchGame(ctx context.Context, request PatchGameRequestObject) (PatchGameResponseObject, error)
+	// GetGameMatches Get all matches for a game
+	// (GET /games/{id}/matches)
+	GetGameMatches(ctx context.Context, request GetGameMatchesRequestObject) (GetGameMatchesResponseObject, error)
+	// MergeGame Merge a duplicate game into this one
+	// (POST /games/{id}/merge)
+	MergeGame(ctx context.Context, request MergeGameRequestObject) (MergeGameResponseObject, error)
+	// GetGameRecords Hall of fame of one game
+	// (GET /games/{id}/records)
+	GetGameRecords(ctx context.Context, request GetGameRecordsRequestObject) (GetGameRecordsResponseObject, error)
+	// GetLeaderboard Ranked leaderboard as of a date, or its changes over a period
+	// (GET /leaderboard)
+	GetLeaderboard(ctx context.Context, request GetLeaderboardRequestObject) (GetLeaderboardResponseObject, error)
+	// ListMarkets List active and closed markets
+	// (GET /markets)
+	ListMarkets(ctx context.Context, request ListMarketsRequestObject) (ListMarketsResponseObject, error)
+	// CreateMarket Create a new betting market
+	// (POST /markets)
+	CreateMarket(ctx context.Context, request CreateMarketRequestObject) (CreateMarketResponseObject, error)
+	// DeleteMarket Delete a market (only if status is open)
+	// (DELETE /markets/{id})
+	DeleteMarket(ctx context.Context, request DeleteMarketRequestObject) (DeleteMarketResponseObject, error)
+	// GetMarket Get market details (includes user-specific projections if authenticated)
+	// (GET /markets/{id})
+	GetMarket(ctx context.Context, request GetMarketRequestObject) (GetMarketResponseObject, error)
+	// PatchMarket Update market status (e.g. close betting)
+	// (PATCH /markets/{id})
+	PatchMarket(ctx context.Context, request PatchMarketRequestObject) (PatchMarketResponseObject, error)
+	// PlaceBet Place a bet on a market
+	// (POST /markets/{id}/bets)
+	PlaceBet(ctx context.Context, request PlaceBetRequestObject) (PlaceBetResponseObject, error)
+	// GetMarketPriceHistory Reconstructed per-outcome price history of a market
+	// (GET /markets/{id}/price-history)
+	GetMarketPriceHistory(ctx context.Context, request GetMarketPriceHistoryRequestObject) (GetMarketPriceHistoryResponseObject, error)
+	// ListMatches List matches with cursor-based pagination
+	// (GET /matches)
+	ListMatches(ctx context.Context, request ListMatchesRequestObject) (ListMatchesResponseObject, error)
+	// AddMatch Add a new match
+	// (POST /matches)
+	AddMatch(ctx context.Context, request AddMatchRequestObject) (AddMatchResponseObject, error)
+	// PreviewMatch Preview what adding a match would settle
+	// (POST /matches/preview)
+	PreviewMatch(ctx context.Context, request PreviewMatchRequestObject) (PreviewMatchResponseObject, error)
+	// DeleteMatch Delete a match and recalculate history from its date
+	// (DELETE /matches/{id})
+	DeleteMatch(ctx context.Context, request DeleteMatchRequestObject) (DeleteMatchResponseObject, error)
+	// GetMatchById Get a match by ID
+	// (GET /matches/{id})
+	GetMatchById(ctx context.Context, request GetMatchByIdRequestObject) (GetMatchByIdResponseObject, error)
+	// UpdateMatch Update a match (scores and date)
+	// (PUT /matches/{id})
+	UpdateMatch(ctx context.Context, request UpdateMatchRequestObject) (UpdateMatchResponseObject, error)
+	// GetMarketsByMatchId Get markets associated with a match
+	// (GET /matches/{id}/markets)
+	GetMarketsByMatchId(ctx context.Context, request GetMarketsByMatchIdRequestObject) (GetMarketsByMatchIdResponseObject, error)
+	// SuggestTables Split the attendees of a game night across tables
+	// (POST /matchmaking)
+	SuggestTables(ctx context.Context, request SuggestTablesRequestObject) (SuggestTablesResponseObject, error)
+	// GetPing Health check
+	// (GET /ping)
+	GetPing(ctx context.Context, request GetPingRequestObject) (GetPingResponseObject, error)
+	// ListPlayers List all players with Elo rankings
+	// (GET /players)
+	ListPlayers(ctx context.Context, request ListPlayersRequestObject) (ListPlayersResponseObject, error)
+	// CreatePlayer Create a new player
+	// (POST /players)
+	CreatePlayer(ctx context.Context, request CreatePlayerRequestObject) (CreatePlayerResponseObject, error)
+	// DeletePlayer Delete a player
+	// (DELETE /players/{id})
+	DeletePlayer(ctx context.Context, request DeletePlayerRequestObject) (DeletePlayerResponseObject, error)
+	// PatchPlayer Update player name and guest flag
+	// (PATCH /players/{id})
+	PatchPlayer(ctx context.Context, request PatchPlayerRequestObject) (PatchPlayerResponseObject, error)
+	// GetPlayerHeadToHead Compare two players over the matches they both played
+	// (GET /players/{id}/head-to-head/{otherId})
+	GetPlayerHeadToHead(ctx context.Context, request GetPlayerHeadToHeadRequestObject) (GetPlayerHeadToHeadResponseObject, error)
+	// MergePlayer Merge a duplicate player into this one
+	// (POST /players/{id}/merge)
+	MergePlayer(ctx context.Context, request MergePlayerRequestObject) (MergePlayerResponseObject, error)
+	// GetPlayerRivals Most common opponents and the nemesis of a player
+	// (GET /players/{id}/rivals)
+	GetPlayerRivals(ctx context.Context, request GetPlayerRivalsRequestObject) (GetPlayerRivalsResponseObject, error)
+	// GetPlayerStats Get player rating history and game statistics
+	// (GET /players/{id}/stats)
+	GetPlayerStats(ctx context.Context, request GetPlayerStatsRequestObject) (GetPlayerStatsResponseObject, error)
+	// PredictMatch Predict the outcome of a proposed lineup
+	// (POST /predictions)
+	PredictMatch(ctx context.Context, request PredictMatchRequestObject) (PredictMatchResponseObject, error)
+	// GetRecords Hall of fame across all games
+	// (GET /records)
+	GetRecords(ctx context.Context, request GetRecordsRequestObject) (GetRecordsResponseObject, error)
+	// ListSeasons List seasons, newest first, with the champions of archived ones
+	// (GET /seasons)
+	ListSeasons(ctx context.Context, request ListSeasonsRequestObject) (ListSeasonsResponseObject, error)
+	// CreateSeason Create a season
+	// (POST /seasons)
+	CreateSeason(ctx context.Context, request CreateSeasonRequestObject) (CreateSeasonResponseObject, error)
+	// DeleteSeason Delete a season that is not archived, undoing its reset
+	// (DELETE /seasons/{id})
+	DeleteSeason(ctx context.Context, request DeleteSeasonRequestObject) (DeleteSeasonResponseObject, error)
+	// ArchiveSeason Freeze the final standings of an ended season
+	// (POST /seasons/{id}/archive)
+	ArchiveSeason(ctx context.Context, request ArchiveSeasonRequestObject) (ArchiveSeasonResponseObject, error)
+	// GetSeasonLeaderboard Season table of the global arena or of a game's arena
+	// (GET /seasons/{id}/leaderboard)
+	GetSeasonLeaderboard(ctx context.Context, request GetSeasonLeaderboardRequestObject) (GetSeasonLeaderboardResponseObject, error)
+	// DeleteSettings Delete future Elo settings
+	// (DELETE /settings)
+	DeleteSettings(ctx context.Context, request DeleteSettingsRequestObject) (DeleteSettingsResponseObject, error)
+	// GetSettings Get current active Elo settings
+	// (GET /settings)
+	GetSettings(ctx context.Context, request GetSettingsRequestObject) (GetSettingsResponseObject, error)
+	// CreateSettings Create new Elo settings (effective from a future date)
+	// (POST /settings)
+	CreateSettings(ctx context.Context, request CreateSettingsRequestObject) (CreateSettingsResponseObject, error)
+	// ListAllSettings Get all Elo settings entries (historical and future)
+	// (GET /settings/all)
+	ListAllSettings(ctx context.Context, request ListAllSettingsRequestObject) (ListAllSettingsResponseObject, error)
+	// ParseSkullKingCardImage Identify a Skull King card from a base64-encoded image
+	// (POST /skull-king/parse-card-image)
+	ParseSkullKingCardImage(ctx context.Context, request ParseSkullKingCardImageRequestObject) (ParseSkullKingCardImageResponseObject, error)
+	// ListSkullKingTables List all active Skull King tables
+	// (GET /skull-king/tables)
+	ListSkullKingTables(ctx context.Context, request ListSkullKingTablesRequestObject) (ListSkullKingTablesResponseObject, error)
+	// CreateSkullKingTable Create a new Skull King table
+	// (POST /skull-king/tables)
+	CreateSkullKingTable(ctx context.Context, request CreateSkullKingTableRequestObject) (CreateSkullKingTableResponseObject, error)
+	// DeleteSkullKingTable Delete a Skull King table (host only)
+	// (DELETE /skull-king/tables/{id})
+	DeleteSkullKingTable(ctx context.Context, request DeleteSkullKingTableRequestObject) (DeleteSkullKingTableResponseObject, error)
+	// GetSkullKingTable Get a Skull King table by ID
+	// (GET /skull-king/tables/{id})
+	GetSkullKingTable(ctx context.Context, request GetSkullKingTableRequestObject) (GetSkullKingTableResponseObject, error)
+	// SubmitSkullKingBid Submit a bid for the current round
+	// (POST /skull-king/tables/{id}/bid)
+	SubmitSkullKingBid(ctx context.Context, request SubmitSkullKingBidRequestObject) (SubmitSkullKingBidResponseObject, error)
+	// JoinSkullKingTable Join a Skull King table as a player
+	// (POST /skull-king/tables/{id}/join)
+	JoinSkullKingTable(ctx context.Context, request JoinSkullKingTableRequestObject) (JoinSkullKingTableResponseObject, error)
+	// SubmitSkullKingResult Submit actual tricks taken for the current round
+	// (POST /skull-king/tables/{id}/result)
+	SubmitSkullKingResult(ctx context.Context, request SubmitSkullKingResultRequestObject) (SubmitSkullKingResultResponseObject, error)
+	// UpdateSkullKingTableState Update game state (host only)
+	// (PATCH /skull-king/tables/{id}/state)
+	UpdateSkullKingTableState(ctx context.Context, request UpdateSkullKingTableStateRequestObject) (UpdateSkullKingTableStateResponseObject, error)
+	// ListTournaments List all tournaments (newest start date first)
+	// (GET /tournaments)
+	ListTournaments(ctx context.Context, request ListTournamentsRequestObject) (ListTournamentsResponseObject, error)
+	// CreateTournament Create a new tournament
+	// (POST /tournaments)
+	CreateTournament(ctx context.Context, request CreateTournamentRequestObject) (CreateTournamentResponseObject, error)
+	// DeleteTournament Delete a tournament (only when it has no participants)
+	// (DELETE /tournaments/{id})
+	DeleteTournament(ctx context.Context, request DeleteTournamentRequestObject) (DeleteTournamentResponseObject, error)
+	// GetTournament Get a tournament by ID
+	// (GET /tournaments/{id})
+	GetTournament(ctx context.Context, request GetTournamentRequestObject) (GetTournamentResponseObject, error)
+	// UpdateTournament Update a tournament's name, dates and participants
 	// (PUT /tournaments/{id})
 	UpdateTournament(ctx context.Context, request UpdateTournamentRequestObject) (UpdateTournamentResponseObject, error)
 	// GetTournamentStats Per-player medal statistics for a tournament
@@ -6606,70 +10344,842 @@ type StrictServerInterface interface {
 	ParseVoiceInput(ctx context.Context, request ParseVoiceInputRequestObject) (ParseVoiceInputResponseObject, error)
 }
 
-type StrictHandlerFunc func(ctx *gin.Context, request any) (any, error)
-type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+type StrictHandlerFunc func(ctx *gin.Context, request any) (any, error)
+type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+
+type StrictGinServerOptions struct {
+	// RequestErrorHandlerFunc is called when a request cannot be parsed or
+	// decoded. It is invoked for JSON bind failures, form parse/bind errors,
+	// multipart reader errors, media type parse errors, missing multipart
+	// boundaries, and request body read errors. The default returns 400.
+	RequestErrorHandlerFunc func(ctx *gin.Context, err error)
+	// HandlerErrorFunc is called when the application handler (or any
+	// middleware wrapping it) returns a non-nil error. The default returns 500.
+	HandlerErrorFunc func(ctx *gin.Context, err error)
+	// ResponseErrorHandlerFunc is called when the response object fails to
+	// serialize (Visit*Response returns an error) or when the handler returns
+	// an unexpected response type. The default returns 500.
+	ResponseErrorHandlerFunc func(ctx *gin.Context, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictGinServerOptions{
+		RequestErrorHandlerFunc: func(ctx *gin.Context, err error) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"msg": err.Error()})
+		},
+		HandlerErrorFunc: func(ctx *gin.Context, err error) {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"msg": err.Error()})
+		},
+		ResponseErrorHandlerFunc: func(ctx *gin.Context, err error) {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"msg": err.Error()})
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictGinServerOptions) ServerInterface {
+	if options.RequestErrorHandlerFunc == nil {
+		options.RequestErrorHandlerFunc = func(ctx *gin.Context, err error) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"msg": err.Error()})
+		}
+	}
+	if options.HandlerErrorFunc == nil {
+		options.HandlerErrorFunc = func(ctx *gin.Context, err error) {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"msg": err.Error()})
+		}
+	}
+	if options.ResponseErrorHandlerFunc == nil {
+		options.ResponseErrorHandlerFunc = func(ctx *gin.Context, err error) {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"msg": err.Error()})
+		}
+	}
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictGinServerOptions
+}
+
+// VerifySettlementCheckpoints operation middleware
+func (sh *strictHandler) VerifySettlementCheckpoints(ctx *gin.Context) {
+	var request VerifySettlementCheckpointsRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.VerifySettlementCheckpoints(ctx, request.(VerifySettlementCheckpointsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "VerifySettlementCheckpoints")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(VerifySettlementCheckpointsResponseObject); ok {
+		if err := validResponse.VisitVerifySettlementCheckpointsResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AdminAddMatch operation middleware
+func (sh *strictHandler) AdminAddMatch(ctx *gin.Context) {
+	var request AdminAddMatchRequestObject
+
+	var body AdminAddMatchJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(ctx, err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminAddMatch(ctx, request.(AdminAddMatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminAddMatch")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(AdminAddMatchResponseObject); ok {
+		if err := validResponse.VisitAdminAddMatchResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AdminUpdateMatch operation middleware
+func (sh *strictHandler) AdminUpdateMatch(ctx *gin.Context, id string) {
+	var request AdminUpdateMatchRequestObject
+
+	request.Id = id
+
+	var body AdminUpdateMatchJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(ctx, err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminUpdateMatch(ctx, request.(AdminUpdateMatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminUpdateMatch")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(AdminUpdateMatchResponseObject); ok {
+		if err := validResponse.VisitAdminUpdateMatchResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreatePlayerCorrection operation middleware
+func (sh *strictHandler) CreatePlayerCorrection(ctx *gin.Context, id string) {
+	var request CreatePlayerCorrectionRequestObject
+
+	request.Id = id
+
+	var body CreatePlayerCorrectionJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(ctx, err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePlayerCorrection(ctx, request.(CreatePlayerCorrectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePlayerCorrection")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(CreatePlayerCorrectionResponseObject); ok {
+		if err := validResponse.VisitCreatePlayerCorrectionResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RecalculateGameElo operation middleware
+func (sh *strictHandler) RecalculateGameElo(ctx *gin.Context) {
+	var request RecalculateGameEloRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RecalculateGameElo(ctx, request.(RecalculateGameEloRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RecalculateGameElo")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(RecalculateGameEloResponseObject); ok {
+		if err := validResponse.VisitRecalculateGameEloResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SimulateSettings operation middleware
+func (sh *strictHandler) SimulateSettings(ctx *gin.Context) {
+	var request SimulateSettingsRequestObject
+
+	var body SimulateSettingsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(ctx, err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SimulateSettings(ctx, request.(SimulateSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SimulateSettings")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(SimulateSettingsResponseObject); ok {
+		if err := validResponse.VisitSimulateSettingsResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListArenas operation middleware
+func (sh *strictHandler) ListArenas(ctx *gin.Context) {
+	var request ListArenasRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListArenas(ctx, request.(ListArenasRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListArenas")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(ListArenasResponseObject); ok {
+		if err := validResponse.VisitListArenasResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateArena operation middleware
+func (sh *strictHandler) CreateArena(ctx *gin.Context) {
+	var request CreateArenaRequestObject
+
+	var body CreateArenaJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(ctx, err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateArena(ctx, request.(CreateArenaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateArena")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(CreateArenaResponseObject); ok {
+		if err := validResponse.VisitCreateArenaResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteArena operation middleware
+func (sh *strictHandler) DeleteArena(ctx *gin.Context, id string) {
+	var request DeleteArenaRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteArena(ctx, request.(DeleteArenaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteArena")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(DeleteArenaResponseObject); ok {
+		if err := validResponse.VisitDeleteArenaResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetArena operation middleware
+func (sh *strictHandler) GetArena(ctx *gin.Context, id string) {
+	var request GetArenaRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArena(ctx, request.(GetArenaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArena")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(GetArenaResponseObject); ok {
+		if err := validResponse.VisitGetArenaResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateArena operation middleware
+func (sh *strictHandler) UpdateArena(ctx *gin.Context, id string) {
+	var request UpdateArenaRequestObject
+
+	request.Id = id
+
+	var body UpdateArenaJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(ctx, err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateArena(ctx, request.(UpdateArenaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateArena")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(UpdateArenaResponseObject); ok {
+		if err := validResponse.VisitUpdateArenaResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetArenaLeaderboard operation middleware
+func (sh *strictHandler) GetArenaLeaderboard(ctx *gin.Context, id string) {
+	var request GetArenaLeaderboardRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArenaLeaderboard(ctx, request.(GetArenaLeaderboardRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArenaLeaderboard")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(GetArenaLeaderboardResponseObject); ok {
+		if err := validResponse.VisitGetArenaLeaderboardResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetArenaPlayerHistory operation middleware
+func (sh *strictHandler) GetArenaPlayerHistory(ctx *gin.Context, id string, playerId string) {
+	var request GetArenaPlayerHistoryRequestObject
+
+	request.Id = id
+	request.PlayerId = playerId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArenaPlayerHistory(ctx, request.(GetArenaPlayerHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArenaPlayerHistory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(GetArenaPlayerHistoryResponseObject); ok {
+		if err := validResponse.VisitGetArenaPlayerHistoryResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListAuditEntries operation middleware
+func (sh *strictHandler) ListAuditEntries(ctx *gin.Context, params ListAuditEntriesParams) {
+	var request ListAuditEntriesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAuditEntries(ctx, request.(ListAuditEntriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAuditEntries")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(ListAuditEntriesResponseObject); ok {
+		if err := validResponse.VisitListAuditEntriesResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AuthLogin operation middleware
+func (sh *strictHandler) AuthLogin(ctx *gin.Context) {
+	var request AuthLoginRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthLogin(ctx, request.(AuthLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthLogin")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(AuthLoginResponseObject); ok {
+		if err := validResponse.VisitAuthLoginResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AuthLogout operation middleware
+func (sh *strictHandler) AuthLogout(ctx *gin.Context) {
+	var request AuthLogoutRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthLogout(ctx, request.(AuthLogoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthLogout")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(AuthLogoutResponseObject); ok {
+		if err := validResponse.VisitAuthLogoutResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetMe operation middleware
+func (sh *strictHandler) GetMe(ctx *gin.Context) {
+	var request GetMeRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMe(ctx, request.(GetMeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMe")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(GetMeResponseObject); ok {
+		if err := validResponse.VisitGetMeResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchMe operation middleware
+func (sh *strictHandler) PatchMe(ctx *gin.Context) {
+	var request PatchMeRequestObject
+
+	var body PatchMeJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(ctx, err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchMe(ctx, request.(PatchMeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchMe")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(PatchMeResponseObject); ok {
+		if err := validResponse.VisitPatchMeResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AuthOAuth2Callback operation middleware
+func (sh *strictHandler) AuthOAuth2Callback(ctx *gin.Context, params AuthOAuth2CallbackParams) {
+	var request AuthOAuth2CallbackRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthOAuth2Callback(ctx, request.(AuthOAuth2CallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthOAuth2Callback")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(AuthOAuth2CallbackResponseObject); ok {
+		if err := validResponse.VisitAuthOAuth2CallbackResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListClubs operation middleware
+func (sh *strictHandler) ListClubs(ctx *gin.Context) {
+	var request ListClubsRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListClubs(ctx, request.(ListClubsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListClubs")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(ListClubsResponseObject); ok {
+		if err := validResponse.VisitListClubsResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateClub operation middleware
+func (sh *strictHandler) CreateClub(ctx *gin.Context) {
+	var request CreateClubRequestObject
+
+	var body CreateClubJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(ctx, err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateClub(ctx, request.(CreateClubRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateClub")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(CreateClubResponseObject); ok {
+		if err := validResponse.VisitCreateClubResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteClub operation middleware
+func (sh *strictHandler) DeleteClub(ctx *gin.Context, id string) {
+	var request DeleteClubRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteClub(ctx, request.(DeleteClubRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteClub")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(DeleteClubResponseObject); ok {
+		if err := validResponse.VisitDeleteClubResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetClub operation middleware
+func (sh *strictHandler) GetClub(ctx *gin.Context, id string) {
+	var request GetClubRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetClub(ctx, request.(GetClubRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetClub")
+	}
+
+	response, err := handler(ctx, request)
 
-type StrictGinServerOptions struct {
-	// RequestErrorHandlerFunc is called when a request cannot be parsed or
-	// decoded. It is invoked for JSON bind failures, form parse/bind errors,
-	// multipart reader errors, media type parse errors, missing multipart
-	// boundaries, and request body read errors. The default returns 400.
-	RequestErrorHandlerFunc func(ctx *gin.Context, err error)
-	// HandlerErrorFunc is called when the application handler (or any
-	// middleware wrapping it) returns a non-nil error. The default returns 500.
-	HandlerErrorFunc func(ctx *gin.Context, err error)
-	// ResponseErrorHandlerFunc is called when the response object fails to
-	// serialize (Visit*Response returns an error) or when the handler returns
-	// an unexpected response type. The default returns 500.
-	ResponseErrorHandlerFunc func(ctx *gin.Context, err error)
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(GetClubResponseObject); ok {
+		if err := validResponse.VisitGetClubResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictGinServerOptions{
-		RequestErrorHandlerFunc: func(ctx *gin.Context, err error) {
-			ctx.JSON(http.StatusBadRequest, gin.H{"msg": err.Error()})
-		},
-		HandlerErrorFunc: func(ctx *gin.Context, err error) {
-			ctx.JSON(http.StatusInternalServerError, gin.H{"msg": err.Error()})
-		},
-		ResponseErrorHandlerFunc: func(ctx *gin.Context, err error) {
-			ctx.JSON(http.StatusInternalServerError, gin.H{"msg": err.Error()})
-		},
-	}}
-}
+// PatchClub operation middleware
+func (sh *strictHandler) PatchClub(ctx *gin.Context, id string) {
+	var request PatchClubRequestObject
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictGinServerOptions) ServerInterface {
-	if options.RequestErrorHandlerFunc == nil {
-		options.RequestErrorHandlerFunc = func(ctx *gin.Context, err error) {
-			ctx.JSON(http.StatusBadRequest, gin.H{"msg": err.Error()})
+	request.Id = id
+
+	var body PatchClubJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(ctx, err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchClub(ctx, request.(PatchClubRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchClub")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(PatchClubResponseObject); ok {
+		if err := validResponse.VisitPatchClubResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
 	}
-	if options.HandlerErrorFunc == nil {
-		options.HandlerErrorFunc = func(ctx *gin.Context, err error) {
-			ctx.JSON(http.StatusInternalServerError, gin.H{"msg": err.Error()})
+}
+
+// AddClubMember operation middleware
+func (sh *strictHandler) AddClubMember(ctx *gin.Context, id string) {
+	var request AddClubMemberRequestObject
+
+	request.Id = id
+
+	var body AddClubMemberJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(ctx, err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AddClubMember(ctx, request.(AddClubMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddClubMember")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(AddClubMemberResponseObject); ok {
+		if err := validResponse.VisitAddClubMemberResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
 	}
-	if options.ResponseErrorHandlerFunc == nil {
-		options.ResponseErrorHandlerFunc = func(ctx *gin.Context, err error) {
-			ctx.JSON(http.StatusInternalServerError, gin.H{"msg": err.Error()})
+}
+
+// RemoveClubMember operation middleware
+func (sh *strictHandler) RemoveClubMember(ctx *gin.Context, id string, playerId string) {
+	var request RemoveClubMemberRequestObject
+
+	request.Id = id
+	request.PlayerId = playerId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RemoveClubMember(ctx, request.(RemoveClubMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemoveClubMember")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(RemoveClubMemberResponseObject); ok {
+		if err := validResponse.VisitRemoveClubMemberResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
 	}
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictGinServerOptions
+// ListCorrections operation middleware
+func (sh *strictHandler) ListCorrections(ctx *gin.Context, params ListCorrectionsParams) {
+	var request ListCorrectionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCorrections(ctx, request.(ListCorrectionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCorrections")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(ListCorrectionsResponseObject); ok {
+		if err := validResponse.VisitListCorrectionsResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// CreatePlayerCorrection operation middleware
-func (sh *strictHandler) CreatePlayerCorrection(ctx *gin.Context, id string) {
-	var request CreatePlayerCorrectionRequestObject
+// ListGames operation middleware
+func (sh *strictHandler) ListGames(ctx *gin.Context) {
+	var request ListGamesRequestObject
 
-	request.Id = id
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListGames(ctx, request.(ListGamesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListGames")
+	}
 
-	var body CreatePlayerCorrectionJSONRequestBody
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(ListGamesResponseObject); ok {
+		if err := validResponse.VisitListGamesResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateGame operation middleware
+func (sh *strictHandler) CreateGame(ctx *gin.Context) {
+	var request CreateGameRequestObject
+
+	var body CreateGameJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(ctx, err)
 		return
@@ -6677,18 +11187,18 @@ func (sh *strictHandler) CreatePlayerCorrection(ctx *gin.Context, id string) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CreatePlayerCorrection(ctx, request.(CreatePlayerCorrectionRequestObject))
+		return sh.ssi.CreateGame(ctx, request.(CreateGameRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreatePlayerCorrection")
+		handler = middleware(handler, "CreateGame")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(CreatePlayerCorrectionResponseObject); ok {
-		if err := validResponse.VisitCreatePlayerCorrectionResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(CreateGameResponseObject); ok {
+		if err := validResponse.VisitCreateGameResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -6696,23 +11206,25 @@ func (sh *strictHandler) CreatePlayerCorrection(ctx *gin.Context, id string) {
 	}
 }
 
-// RecalculateGameElo operation middleware
-func (sh *strictHandler) RecalculateGameElo(ctx *gin.Context) {
-	var request RecalculateGameEloRequestObject
+// DeleteGame operation middleware
+func (sh *strictHandler) DeleteGame(ctx *gin.Context, id string) {
+	var request DeleteGameRequestObject
+
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.RecalculateGameElo(ctx, request.(RecalculateGameEloRequestObject))
+		return sh.ssi.DeleteGame(ctx, request.(DeleteGameRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RecalculateGameElo")
+		handler = middleware(handler, "DeleteGame")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(RecalculateGameEloResponseObject); ok {
-		if err := validResponse.VisitRecalculateGameEloResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(DeleteGameResponseObject); ok {
+		if err := validResponse.VisitDeleteGameResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -6720,23 +11232,58 @@ func (sh *strictHandler) RecalculateGameElo(ctx *gin.Context) {
 	}
 }
 
-// AuthLogin operation middleware
-func (sh *strictHandler) AuthLogin(ctx *gin.Context) {
-	var request AuthLoginRequestObject
+// GetGame operation middleware
+func (sh *strictHandler) GetGame(ctx *gin.Context, id string) {
+	var request GetGameRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetGame(ctx, request.(GetGameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetGame")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		sh.options.HandlerErrorFunc(ctx, err)
+	} else if validResponse, ok := response.(GetGameResponseObject); ok {
+		if err := validResponse.VisitGetGameResponse(ctx.Writer); err != nil {
+			sh.options.ResponseErrorHandlerFunc(ctx, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchGame operation middleware
+func (sh *strictHandler) PatchGame(ctx *gin.Context, id string) {
+	var request PatchGameRequestObject
+
+	request.Id = id
+
+	var body PatchGameJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(ctx, err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AuthLogin(ctx, request.(AuthLoginRequestObject))
+		return sh.ssi.PatchGame(ctx, request.(PatchGameRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AuthLogin")
+		handler = middleware(handler, "PatchGame")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(AuthLoginResponseObject); ok {
-		if err := validResponse.VisitAuthLoginResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PatchGameResponseObject); ok {
+		if err := validResponse.VisitPatchGameResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -6744,23 +11291,25 @@ func (sh *strictHandler) AuthLogin(ctx *gin.Context) {
 	}
 }
 
-// AuthLogout operation middleware
-func (sh *strictHandler) AuthLogout(ctx *gin.Context) {
-	var request AuthLogoutRequestObject
+// GetGameMatches operation middleware
+func (sh *strictHandler) GetGameMatches(ctx *gin.Context, id string) {
+	var request GetGameMatchesRequestObject
+
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AuthLogout(ctx, request.(AuthLogoutRequestObject))
+		return sh.ssi.GetGameMatches(ctx, request.(GetGameMatchesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AuthLogout")
+		handler = middleware(handler, "GetGameMatches")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(AuthLogoutResponseObject); ok {
-		if err := validResponse.VisitAuthLogoutResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetGameMatchesResponseObject); ok {
+		if err := validResponse.VisitGetGameMatchesResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -6768,23 +11317,32 @@ func (sh *strictHandler) AuthLogout(ctx *gin.Context) {
 	}
 }
 
-// GetMe operation middleware
-func (sh *strictHandler) GetMe(ctx *gin.Context) {
-	var request GetMeRequestObject
+// MergeGame operation middleware
+func (sh *strictHandler) MergeGame(ctx *gin.Context, id string) {
+	var request MergeGameRequestObject
+
+	request.Id = id
+
+	var body MergeGameJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(ctx, err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetMe(ctx, request.(GetMeRequestObject))
+		return sh.ssi.MergeGame(ctx, request.(MergeGameRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetMe")
+		handler = middleware(handler, "MergeGame")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(GetMeResponseObject); ok {
-		if err := validResponse.VisitGetMeResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(MergeGameResponseObject); ok {
+		if err := validResponse.VisitMergeGameResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -6792,30 +11350,25 @@ func (sh *strictHandler) GetMe(ctx *gin.Context) {
 	}
 }
 
-// PatchMe operation middleware
-func (sh *strictHandler) PatchMe(ctx *gin.Context) {
-	var request PatchMeRequestObject
+// GetGameRecords operation middleware
+func (sh *strictHandler) GetGameRecords(ctx *gin.Context, id string) {
+	var request GetGameRecordsRequestObject
 
-	var body PatchMeJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(ctx, err)
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchMe(ctx, request.(PatchMeRequestObject))
+		return sh.ssi.GetGameRecords(ctx, request.(GetGameRecordsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchMe")
+		handler = middleware(handler, "GetGameRecords")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(PatchMeResponseObject); ok {
-		if err := validResponse.VisitPatchMeResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetGameRecordsResponseObject); ok {
+		if err := validResponse.VisitGetGameRecordsResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -6823,25 +11376,25 @@ func (sh *strictHandler) PatchMe(ctx *gin.Context) {
 	}
 }
 
-// AuthOAuth2Callback operation middleware
-func (sh *strictHandler) AuthOAuth2Callback(ctx *gin.Context, params AuthOAuth2CallbackParams) {
-	var request AuthOAuth2CallbackRequestObject
+// GetLeaderboard operation middleware
+func (sh *strictHandler) GetLeaderboard(ctx *gin.Context, params GetLeaderboardParams) {
+	var request GetLeaderboardRequestObject
 
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AuthOAuth2Callback(ctx, request.(AuthOAuth2CallbackRequestObject))
+		return sh.ssi.GetLeaderboard(ctx, request.(GetLeaderboardRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AuthOAuth2Callback")
+		handler = middleware(handler, "GetLeaderboard")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(AuthOAuth2CallbackResponseObject); ok {
-		if err := validResponse.VisitAuthOAuth2CallbackResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetLeaderboardResponseObject); ok {
+		if err := validResponse.VisitGetLeaderboardResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -6849,23 +11402,23 @@ func (sh *strictHandler) AuthOAuth2Callback(ctx *gin.Context, params AuthOAuth2C
 	}
 }
 
-// ListClubs operation middleware
-func (sh *strictHandler) ListClubs(ctx *gin.Context) {
-	var request ListClubsRequestObject
+// ListMarkets operation middleware
+func (sh *strictHandler) ListMarkets(ctx *gin.Context) {
+	var request ListMarketsRequestObject
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListClubs(ctx, request.(ListClubsRequestObject))
+		return sh.ssi.ListMarkets(ctx, request.(ListMarketsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListClubs")
+		handler = middleware(handler, "ListMarkets")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(ListClubsResponseObject); ok {
-		if err := validResponse.VisitListClubsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ListMarketsResponseObject); ok {
+		if err := validResponse.VisitListMarketsResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -6873,11 +11426,11 @@ func (sh *strictHandler) ListClubs(ctx *gin.Context) {
 	}
 }
 
-// CreateClub operation middleware
-func (sh *strictHandler) CreateClub(ctx *gin.Context) {
-	var request CreateClubRequestObject
+// CreateMarket operation middleware
+func (sh *strictHandler) CreateMarket(ctx *gin.Context) {
+	var request CreateMarketRequestObject
 
-	var body CreateClubJSONRequestBody
+	var body CreateMarketJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(ctx, err)
 		return
@@ -6885,18 +11438,18 @@ func (sh *strictHandler) CreateClub(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateClub(ctx, request.(CreateClubRequestObject))
+		return sh.ssi.CreateMarket(ctx, request.(CreateMarketRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateClub")
+		handler = middleware(handler, "CreateMarket")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(CreateClubResponseObject); ok {
-		if err := validResponse.VisitCreateClubResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(CreateMarketResponseObject); ok {
+		if err := validResponse.VisitCreateMarketResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -6904,25 +11457,25 @@ func (sh *strictHandler) CreateClub(ctx *gin.Context) {
 	}
 }
 
-// DeleteClub operation middleware
-func (sh *strictHandler) DeleteClub(ctx *gin.Context, id string) {
-	var request DeleteClubRequestObject
+// DeleteMarket operation middleware
+func (sh *strictHandler) DeleteMarket(ctx *gin.Context, id string) {
+	var request DeleteMarketRequestObject
 
 	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteClub(ctx, request.(DeleteClubRequestObject))
+		return sh.ssi.DeleteMarket(ctx, request.(DeleteMarketRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteClub")
+		handler = middleware(handler, "DeleteMarket")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(DeleteClubResponseObject); ok {
-		if err := validResponse.VisitDeleteClubResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(DeleteMarketResponseObject); ok {
+		if err := validResponse.VisitDeleteMarketResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -6930,25 +11483,25 @@ func (sh *strictHandler) DeleteClub(ctx *gin.Context, id string) {
 	}
 }
 
-// GetClub operation middleware
-func (sh *strictHandler) GetClub(ctx *gin.Context, id string) {
-	var request GetClubRequestObject
+// GetMarket operation middleware
+func (sh *strictHandler) GetMarket(ctx *gin.Context, id string) {
+	var request GetMarketRequestObject
 
 	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetClub(ctx, request.(GetClubRequestObject))
+		return sh.ssi.GetMarket(ctx, request.(GetMarketRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetClub")
+		handler = middleware(handler, "GetMarket")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(GetClubResponseObject); ok {
-		if err := validResponse.VisitGetClubResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetMarketResponseObject); ok {
+		if err := validResponse.VisitGetMarketResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -6956,13 +11509,13 @@ func (sh *strictHandler) GetClub(ctx *gin.Context, id string) {
 	}
 }
 
-// PatchClub operation middleware
-func (sh *strictHandler) PatchClub(ctx *gin.Context, id string) {
-	var request PatchClubRequestObject
+// PatchMarket operation middleware
+func (sh *strictHandler) PatchMarket(ctx *gin.Context, id string) {
+	var request PatchMarketRequestObject
 
 	request.Id = id
 
-	var body PatchClubJSONRequestBody
+	var body PatchMarketJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(ctx, err)
 		return
@@ -6970,18 +11523,18 @@ func (sh *strictHandler) PatchClub(ctx *gin.Context, id string) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchClub(ctx, request.(PatchClubRequestObject))
+		return sh.ssi.PatchMarket(ctx, request.(PatchMarketRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchClub")
+		handler = middleware(handler, "PatchMarket")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(PatchClubResponseObject); ok {
-		if err := validResponse.VisitPatchClubResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PatchMarketResponseObject); ok {
+		if err := validResponse.VisitPatchMarketResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -6989,13 +11542,13 @@ func (sh *strictHandler) PatchClub(ctx *gin.Context, id string) {
 	}
 }
 
-// AddClubMember operation middleware
-func (sh *strictHandler) AddClubMember(ctx *gin.Context, id string) {
-	var request AddClubMemberRequestObject
+// PlaceBet operation middleware
+func (sh *strictHandler) PlaceBet(ctx *gin.Context, id string) {
+	var request PlaceBetRequestObject
 
 	request.Id = id
 
-	var body AddClubMemberJSONRequestBody
+	var body PlaceBetJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(ctx, err)
 		return
@@ -7003,18 +11556,18 @@ func (sh *strictHandler) AddClubMember(ctx *gin.Context, id string) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AddClubMember(ctx, request.(AddClubMemberRequestObject))
+		return sh.ssi.PlaceBet(ctx, request.(PlaceBetRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AddClubMember")
+		handler = middleware(handler, "PlaceBet")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(AddClubMemberResponseObject); ok {
-		if err := validResponse.VisitAddClubMemberResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PlaceBetResponseObject); ok {
+		if err := validResponse.VisitPlaceBetResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -7022,26 +11575,25 @@ func (sh *strictHandler) AddClubMember(ctx *gin.Context, id string) {
 	}
 }
 
-// RemoveClubMember operation middleware
-func (sh *strictHandler) RemoveClubMember(ctx *gin.Context, id string, playerId string) {
-	var request RemoveClubMemberRequestObject
+// GetMarketPriceHistory operation middleware
+func (sh *strictHandler) GetMarketPriceHistory(ctx *gin.Context, id string) {
+	var request GetMarketPriceHistoryRequestObject
 
 	request.Id = id
-	request.PlayerId = playerId
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.RemoveClubMember(ctx, request.(RemoveClubMemberRequestObject))
+		return sh.ssi.GetMarketPriceHistory(ctx, request.(GetMarketPriceHistoryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RemoveClubMember")
+		handler = middleware(handler, "GetMarketPriceHistory")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(RemoveClubMemberResponseObject); ok {
-		if err := validResponse.VisitRemoveClubMemberResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetMarketPriceHistoryResponseObject); ok {
+		if err := validResponse.VisitGetMarketPriceHistoryResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -7049,49 +11601,25 @@ func (sh *strictHandler) RemoveClubMember(ctx *gin.Context, id string, playerId
 	}
 }
 
-// ListCorrections operation middleware
-func (sh *strictHandler) ListCorrections(ctx *gin.Context, params ListCorrectionsParams) {
-	var request ListCorrectionsRequestObject
+// ListMatches operation middleware
+func (sh *strictHandler) ListMatches(ctx *gin.Context, params ListMatchesParams) {
+	var request ListMatchesRequestObject
 
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListCorrections(ctx, request.(ListCorrectionsRequestObject))
-	}
-	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListCorrections")
-	}
-
-	response, err := handler(ctx, request)
-
-	if err != nil {
-		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(ListCorrectionsResponseObject); ok {
-		if err := validResponse.VisitListCorrectionsResponse(ctx.Writer); err != nil {
-			sh.options.ResponseErrorHandlerFunc(ctx, err)
-		}
-	} else if response != nil {
-		sh.options.ResponseErrorHandlerFunc(ctx, fmt.Errorf("unexpected response type: %T", response))
-	}
-}
-
-// ListGames operation middleware
-func (sh *strictHandler) ListGames(ctx *gin.Context) {
-	var request ListGamesRequestObject
-
-	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListGames(ctx, request.(ListGamesRequestObject))
+		return sh.ssi.ListMatches(ctx, request.(ListMatchesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListGames")
+		handler = middleware(handler, "ListMatches")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(ListGamesResponseObject); ok {
-		if err := validResponse.VisitListGamesResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ListMatchesResponseObject); ok {
+		if err := validResponse.VisitListMatchesResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -7099,11 +11627,11 @@ func (sh *strictHandler) ListGames(ctx *gin.Context) {
 	}
 }
 
-// CreateGame operation middleware
-func (sh *strictHandler) CreateGame(ctx *gin.Context) {
-	var request CreateGameRequestObject
+// AddMatch operation middleware
+func (sh *strictHandler) AddMatch(ctx *gin.Context) {
+	var request AddMatchRequestObject
 
-	var body CreateGameJSONRequestBody
+	var body AddMatchJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(ctx, err)
 		return
@@ -7111,18 +11639,18 @@ func (sh *strictHandler) CreateGame(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateGame(ctx, request.(CreateGameRequestObject))
+		return sh.ssi.AddMatch(ctx, request.(AddMatchRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateGame")
+		handler = middleware(handler, "AddMatch")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(CreateGameResponseObject); ok {
-		if err := validResponse.VisitCreateGameResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(AddMatchResponseObject); ok {
+		if err := validResponse.VisitAddMatchResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -7130,25 +11658,30 @@ func (sh *strictHandler) CreateGame(ctx *gin.Context) {
 	}
 }
 
-// DeleteGame operation middleware
-func (sh *strictHandler) DeleteGame(ctx *gin.Context, id string) {
-	var request DeleteGameRequestObject
+// PreviewMatch operation middleware
+func (sh *strictHandler) PreviewMatch(ctx *gin.Context) {
+	var request PreviewMatchRequestObject
 
-	request.Id = id
+	var body PreviewMatchJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(ctx, err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteGame(ctx, request.(DeleteGameRequestObject))
+		return sh.ssi.PreviewMatch(ctx, request.(PreviewMatchRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteGame")
+		handler = middleware(handler, "PreviewMatch")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(DeleteGameResponseObject); ok {
-		if err := validResponse.VisitDeleteGameResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PreviewMatchResponseObject); ok {
+		if err := validResponse.VisitPreviewMatchResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -7156,25 +11689,25 @@ func (sh *strictHandler) DeleteGame(ctx *gin.Context, id string) {
 	}
 }
 
-// GetGame operation middleware
-func (sh *strictHandler) GetGame(ctx *gin.Context, id string) {
-	var request GetGameRequestObject
+// DeleteMatch operation middleware
+func (sh *strictHandler) DeleteMatch(ctx *gin.Context, id string) {
+	var request DeleteMatchRequestObject
 
 	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetGame(ctx, request.(GetGameRequestObject))
+		return sh.ssi.DeleteMatch(ctx, request.(DeleteMatchRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetGame")
+		handler = middleware(handler, "DeleteMatch")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(GetGameResponseObject); ok {
-		if err := validResponse.VisitGetGameResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(DeleteMatchResponseObject); ok {
+		if err := validResponse.VisitDeleteMatchResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -7182,32 +11715,25 @@ func (sh *strictHandler) GetGame(ctx *gin.Context, id string) {
 	}
 }
 
-// PatchGame operation middleware
-func (sh *strictHandler) PatchGame(ctx *gin.Context, id string) {
-	var request PatchGameRequestObject
-
-	request.Id = id
-
-	var body PatchGameJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(ctx, err)
-		return
-	}
-	request.Body = &body
+// GetMatchById operation middleware
+func (sh *strictHandler) GetMatchById(ctx *gin.Context, id string) {
+	var request GetMatchByIdRequestObject
+
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchGame(ctx, request.(PatchGameRequestObject))
+		return sh.ssi.GetMatchById(ctx, request.(GetMatchByIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchGame")
+		handler = middleware(handler, "GetMatchById")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(PatchGameResponseObject); ok {
-		if err := validResponse.VisitPatchGameResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetMatchByIdResponseObject); ok {
+		if err := validResponse.VisitGetMatchByIdResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -7215,25 +11741,32 @@ func (sh *strictHandler) PatchGame(ctx *gin.Context, id string) {
 	}
 }
 
-// GetGameMatches operation middleware
-func (sh *strictHandler) GetGameMatches(ctx *gin.Context, id string) {
-	var request GetGameMatchesRequestObject
+// UpdateMatch operation middleware
+func (sh *strictHandler) UpdateMatch(ctx *gin.Context, id string) {
+	var request UpdateMatchRequestObject
 
 	request.Id = id
 
+	var body UpdateMatchJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(ctx, err)
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetGameMatches(ctx, request.(GetGameMatchesRequestObject))
+		return sh.ssi.UpdateMatch(ctx, request.(UpdateMatchRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetGameMatches")
+		handler = middleware(handler, "UpdateMatch")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(GetGameMatchesResponseObject); ok {
-		if err := validResponse.VisitGetGameMatchesResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(UpdateMatchResponseObject); ok {
+		if err := validResponse.VisitUpdateMatchResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -7241,23 +11774,25 @@ func (sh *strictHandler) GetGameMatches(ctx *gin.Context, id string) {
 	}
 }
 
-// ListMarkets operation middleware
-func (sh *strictHandler) ListMarkets(ctx *gin.Context) {
-	var request ListMarketsRequestObject
+// GetMarketsByMatchId operation middleware
+func (sh *strictHandler) GetMarketsByMatchId(ctx *gin.Context, id string) {
+	var request GetMarketsByMatchIdRequestObject
+
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListMarkets(ctx, request.(ListMarketsRequestObject))
+		return sh.ssi.GetMarketsByMatchId(ctx, request.(GetMarketsByMatchIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListMarkets")
+		handler = middleware(handler, "GetMarketsByMatchId")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(ListMarketsResponseObject); ok {
-		if err := validResponse.VisitListMarketsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetMarketsByMatchIdResponseObject); ok {
+		if err := validResponse.VisitGetMarketsByMatchIdResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -7265,11 +11800,11 @@ func (sh *strictHandler) ListMarkets(ctx *gin.Context) {
 	}
 }
 
-// CreateMarket operation middleware
-func (sh *strictHandler) CreateMarket(ctx *gin.Context) {
-	var request CreateMarketRequestObject
+// SuggestTables operation middleware
+func (sh *strictHandler) SuggestTables(ctx *gin.Context) {
+	var request SuggestTablesRequestObject
 
-	var body CreateMarketJSONRequestBody
+	var body SuggestTablesJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(ctx, err)
 		return
@@ -7277,18 +11812,18 @@ func (sh *strictHandler) CreateMarket(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateMarket(ctx, request.(CreateMarketRequestObject))
+		return sh.ssi.SuggestTables(ctx, request.(SuggestTablesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateMarket")
+		handler = middleware(handler, "SuggestTables")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(CreateMarketResponseObject); ok {
-		if err := validResponse.VisitCreateMarketResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(SuggestTablesResponseObject); ok {
+		if err := validResponse.VisitSuggestTablesResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -7296,25 +11831,23 @@ func (sh *strictHandler) CreateMarket(ctx *gin.Context) {
 	}
 }
 
-// DeleteMarket operation middleware
-func (sh *strictHandler) DeleteMarket(ctx *gin.Context, id string) {
-	var request DeleteMarketRequestObject
-
-	request.Id = id
+// GetPing operation middleware
+func (sh *strictHandler) GetPing(ctx *gin.Context) {
+	var request GetPingRequestObject
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteMarket(ctx, request.(DeleteMarketRequestObject))
+		return sh.ssi.GetPing(ctx, request.(GetPingRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteMarket")
+		handler = middleware(handler, "GetPing")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(DeleteMarketResponseObject); ok {
-		if err := validResponse.VisitDeleteMarketResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetPingResponseObject); ok {
+		if err := validResponse.VisitGetPingResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -7322,25 +11855,25 @@ func (sh *strictHandler) DeleteMarket(ctx *gin.Context, id string) {
 	}
 }
 
-// GetMarket operation middleware
-func (sh *strictHandler) GetMarket(ctx *gin.Context, id string) {
-	var request GetMarketRequestObject
+// ListPlayers operation middleware
+func (sh *strictHandler) ListPlayers(ctx *gin.Context, params ListPlayersParams) {
+	var request ListPlayersRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetMarket(ctx, request.(GetMarketRequestObject))
+		return sh.ssi.ListPlayers(ctx, request.(ListPlayersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetMarket")
+		handler = middleware(handler, "ListPlayers")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(GetMarketResponseObject); ok {
-		if err := validResponse.VisitGetMarketResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ListPlayersResponseObject); ok {
+		if err := validResponse.VisitListPlayersResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -7348,13 +11881,11 @@ func (sh *strictHandler) GetMarket(ctx *gin.Context, id string) {
 	}
 }
 
-// PatchMarket operation middleware
-func (sh *strictHandler) PatchMarket(ctx *gin.Context, id string) {
-	var request PatchMarketRequestObject
-
-	request.Id = id
+// CreatePlayer operation middleware
+func (sh *strictHandler) CreatePlayer(ctx *gin.Context) {
+	var request CreatePlayerRequestObject
 
-	var body PatchMarketJSONRequestBody
+	var body CreatePlayerJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(ctx, err)
 		return
@@ -7362,18 +11893,18 @@ func (sh *strictHandler) PatchMarket(ctx *gin.Context, id string) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchMarket(ctx, request.(PatchMarketRequestObject))
+		return sh.ssi.CreatePlayer(ctx, request.(CreatePlayerRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchMarket")
+		handler = middleware(handler, "CreatePlayer")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(PatchMarketResponseObject); ok {
-		if err := validResponse.VisitPatchMarketResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(CreatePlayerResponseObject); ok {
+		if err := validResponse.VisitCreatePlayerResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -7381,32 +11912,25 @@ func (sh *strictHandler) PatchMarket(ctx *gin.Context, id string) {
 	}
 }
 
-// PlaceBet operation middleware
-func (sh *strictHandler) PlaceBet(ctx *gin.Context, id string) {
-	var request PlaceBetRequestObject
+// DeletePlayer operation middleware
+func (sh *strictHandler) DeletePlayer(ctx *gin.Context, id string) {
+	var request DeletePlayerRequestObject
 
 	request.Id = id
 
-	var body PlaceBetJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(ctx, err)
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PlaceBet(ctx, request.(PlaceBetRequestObject))
+		return sh.ssi.DeletePlayer(ctx, request.(DeletePlayerRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PlaceBet")
+		handler = middleware(handler, "DeletePlayer")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(PlaceBetResponseObject); ok {
-		if err := validResponse.VisitPlaceBetResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(DeletePlayerResponseObject); ok {
+		if err := validResponse.VisitDeletePlayerResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -7414,25 +11938,32 @@ func (sh *strictHandler) PlaceBet(ctx *gin.Context, id string) {
 	}
 }
 
-// GetMarketPriceHistory operation middleware
-func (sh *strictHandler) GetMarketPriceHistory(ctx *gin.Context, id string) {
-	var request GetMarketPriceHistoryRequestObject
+// PatchPlayer operation middleware
+func (sh *strictHandler) PatchPlayer(ctx *gin.Context, id string) {
+	var request PatchPlayerRequestObject
 
 	request.Id = id
 
+	var body PatchPlayerJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(ctx, err)
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetMarketPriceHistory(ctx, request.(GetMarketPriceHistoryRequestObject))
+		return sh.ssi.PatchPlayer(ctx, request.(PatchPlayerRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetMarketPriceHistory")
+		handler = middleware(handler, "PatchPlayer")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(GetMarketPriceHistoryResponseObject); ok {
-		if err := validResponse.VisitGetMarketPriceHistoryResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PatchPlayerResponseObject); ok {
+		if err := validResponse.VisitPatchPlayerResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -7440,25 +11971,26 @@ func (sh *strictHandler) GetMarketPriceHistory(ctx *gin.Context, id string) {
 	}
 }
 
-// ListMatches operation middleware
-func (sh *strictHandler) ListMatches(ctx *gin.Context, params ListMatchesParams) {
-	var request ListMatchesRequestObject
+// GetPlayerHeadToHead operation middleware
+func (sh *strictHandler) GetPlayerHeadToHead(ctx *gin.Context, id string, otherId string) {
+	var request GetPlayerHeadToHeadRequestObject
 
-	request.Params = params
+	request.Id = id
+	request.OtherId = otherId
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListMatches(ctx, request.(ListMatchesRequestObject))
+		return sh.ssi.GetPlayerHeadToHead(ctx, request.(GetPlayerHeadToHeadRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListMatches")
+		handler = middleware(handler, "GetPlayerHeadToHead")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(ListMatchesResponseObject); ok {
-		if err := validResponse.VisitListMatchesResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetPlayerHeadToHeadResponseObject); ok {
+		if err := validResponse.VisitGetPlayerHeadToHeadResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -7466,11 +11998,13 @@ func (sh *strictHandler) ListMatches(ctx *gin.Context, params ListMatchesParams)
 	}
 }
 
-// AddMatch operation middleware
-func (sh *strictHandler) AddMatch(ctx *gin.Context) {
-	var request AddMatchRequestObject
+// MergePlayer operation middleware
+func (sh *strictHandler) MergePlayer(ctx *gin.Context, id string) {
+	var request MergePlayerRequestObject
 
-	var body AddMatchJSONRequestBody
+	request.Id = id
+
+	var body MergePlayerJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(ctx, err)
 		return
@@ -7478,18 +12012,18 @@ func (sh *strictHandler) AddMatch(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AddMatch(ctx, request.(AddMatchRequestObject))
+		return sh.ssi.MergePlayer(ctx, request.(MergePlayerRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AddMatch")
+		handler = middleware(handler, "MergePlayer")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(AddMatchResponseObject); ok {
-		if err := validResponse.VisitAddMatchResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(MergePlayerResponseObject); ok {
+		if err := validResponse.VisitMergePlayerResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -7497,25 +12031,25 @@ func (sh *strictHandler) AddMatch(ctx *gin.Context) {
 	}
 }
 
-// GetMatchById operation middleware
-func (sh *strictHandler) GetMatchById(ctx *gin.Context, id string) {
-	var request GetMatchByIdRequestObject
+// GetPlayerRivals operation middleware
+func (sh *strictHandler) GetPlayerRivals(ctx *gin.Context, id string) {
+	var request GetPlayerRivalsRequestObject
 
 	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetMatchById(ctx, request.(GetMatchByIdRequestObject))
+		return sh.ssi.GetPlayerRivals(ctx, request.(GetPlayerRivalsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetMatchById")
+		handler = middleware(handler, "GetPlayerRivals")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(GetMatchByIdResponseObject); ok {
-		if err := validResponse.VisitGetMatchByIdResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetPlayerRivalsResponseObject); ok {
+		if err := validResponse.VisitGetPlayerRivalsResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -7523,32 +12057,25 @@ func (sh *strictHandler) GetMatchById(ctx *gin.Context, id string) {
 	}
 }
 
-// UpdateMatch operation middleware
-func (sh *strictHandler) UpdateMatch(ctx *gin.Context, id string) {
-	var request UpdateMatchRequestObject
+// GetPlayerStats operation middleware
+func (sh *strictHandler) GetPlayerStats(ctx *gin.Context, id string) {
+	var request GetPlayerStatsRequestObject
 
 	request.Id = id
 
-	var body UpdateMatchJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(ctx, err)
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateMatch(ctx, request.(UpdateMatchRequestObject))
+		return sh.ssi.GetPlayerStats(ctx, request.(GetPlayerStatsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateMatch")
+		handler = middleware(handler, "GetPlayerStats")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(UpdateMatchResponseObject); ok {
-		if err := validResponse.VisitUpdateMatchResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetPlayerStatsResponseObject); ok {
+		if err := validResponse.VisitGetPlayerStatsResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -7556,25 +12083,30 @@ func (sh *strictHandler) UpdateMatch(ctx *gin.Context, id string) {
 	}
 }
 
-// GetMarketsByMatchId operation middleware
-func (sh *strictHandler) GetMarketsByMatchId(ctx *gin.Context, id string) {
-	var request GetMarketsByMatchIdRequestObject
+// PredictMatch operation middleware
+func (sh *strictHandler) PredictMatch(ctx *gin.Context) {
+	var request PredictMatchRequestObject
 
-	request.Id = id
+	var body PredictMatchJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(ctx, err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetMarketsByMatchId(ctx, request.(GetMarketsByMatchIdRequestObject))
+		return sh.ssi.PredictMatch(ctx, request.(PredictMatchRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetMarketsByMatchId")
+		handler = middleware(handler, "PredictMatch")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(GetMarketsByMatchIdResponseObject); ok {
-		if err := validResponse.VisitGetMarketsByMatchIdResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PredictMatchResponseObject); ok {
+		if err := validResponse.VisitPredictMatchResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -7582,23 +12114,23 @@ func (sh *strictHandler) GetMarketsByMatchId(ctx *gin.Context, id string) {
 	}
 }
 
-// GetPing operation middleware
-func (sh *strictHandler) GetPing(ctx *gin.Context) {
-	var request GetPingRequestObject
+// GetRecords operation middleware
+func (sh *strictHandler) GetRecords(ctx *gin.Context) {
+	var request GetRecordsRequestObject
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPing(ctx, request.(GetPingRequestObject))
+		return sh.ssi.GetRecords(ctx, request.(GetRecordsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPing")
+		handler = middleware(handler, "GetRecords")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(GetPingResponseObject); ok {
-		if err := validResponse.VisitGetPingResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetRecordsResponseObject); ok {
+		if err := validResponse.VisitGetRecordsResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -7606,23 +12138,23 @@ func (sh *strictHandler) GetPing(ctx *gin.Context) {
 	}
 }
 
-// ListPlayers operation middleware
-func (sh *strictHandler) ListPlayers(ctx *gin.Context) {
-	var request ListPlayersRequestObject
+// ListSeasons operation middleware
+func (sh *strictHandler) ListSeasons(ctx *gin.Context) {
+	var request ListSeasonsRequestObject
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListPlayers(ctx, request.(ListPlayersRequestObject))
+		return sh.ssi.ListSeasons(ctx, request.(ListSeasonsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListPlayers")
+		handler = middleware(handler, "ListSeasons")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(ListPlayersResponseObject); ok {
-		if err := validResponse.VisitListPlayersResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ListSeasonsResponseObject); ok {
+		if err := validResponse.VisitListSeasonsResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -7630,11 +12162,11 @@ func (sh *strictHandler) ListPlayers(ctx *gin.Context) {
 	}
 }
 
-// CreatePlayer operation middleware
-func (sh *strictHandler) CreatePlayer(ctx *gin.Context) {
-	var request CreatePlayerRequestObject
+// CreateSeason operation middleware
+func (sh *strictHandler) CreateSeason(ctx *gin.Context) {
+	var request CreateSeasonRequestObject
 
-	var body CreatePlayerJSONRequestBody
+	var body CreateSeasonJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(ctx, err)
 		return
@@ -7642,18 +12174,18 @@ func (sh *strictHandler) CreatePlayer(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CreatePlayer(ctx, request.(CreatePlayerRequestObject))
+		return sh.ssi.CreateSeason(ctx, request.(CreateSeasonRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreatePlayer")
+		handler = middleware(handler, "CreateSeason")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(CreatePlayerResponseObject); ok {
-		if err := validResponse.VisitCreatePlayerResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(CreateSeasonResponseObject); ok {
+		if err := validResponse.VisitCreateSeasonResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -7661,25 +12193,25 @@ func (sh *strictHandler) CreatePlayer(ctx *gin.Context) {
 	}
 }
 
-// DeletePlayer operation middleware
-func (sh *strictHandler) DeletePlayer(ctx *gin.Context, id string) {
-	var request DeletePlayerRequestObject
+// DeleteSeason operation middleware
+func (sh *strictHandler) DeleteSeason(ctx *gin.Context, id string) {
+	var request DeleteSeasonRequestObject
 
 	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeletePlayer(ctx, request.(DeletePlayerRequestObject))
+		return sh.ssi.DeleteSeason(ctx, request.(DeleteSeasonRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeletePlayer")
+		handler = middleware(handler, "DeleteSeason")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(DeletePlayerResponseObject); ok {
-		if err := validResponse.VisitDeletePlayerResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(DeleteSeasonResponseObject); ok {
+		if err := validResponse.VisitDeleteSeasonResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -7687,32 +12219,25 @@ func (sh *strictHandler) DeletePlayer(ctx *gin.Context, id string) {
 	}
 }
 
-// PatchPlayer operation middleware
-func (sh *strictHandler) PatchPlayer(ctx *gin.Context, id string) {
-	var request PatchPlayerRequestObject
+// ArchiveSeason operation middleware
+func (sh *strictHandler) ArchiveSeason(ctx *gin.Context, id string) {
+	var request ArchiveSeasonRequestObject
 
 	request.Id = id
 
-	var body PatchPlayerJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(ctx, err)
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchPlayer(ctx, request.(PatchPlayerRequestObject))
+		return sh.ssi.ArchiveSeason(ctx, request.(ArchiveSeasonRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchPlayer")
+		handler = middleware(handler, "ArchiveSeason")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(PatchPlayerResponseObject); ok {
-		if err := validResponse.VisitPatchPlayerResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ArchiveSeasonResponseObject); ok {
+		if err := validResponse.VisitArchiveSeasonResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -7720,25 +12245,26 @@ func (sh *strictHandler) PatchPlayer(ctx *gin.Context, id string) {
 	}
 }
 
-// GetPlayerStats operation middleware
-func (sh *strictHandler) GetPlayerStats(ctx *gin.Context, id string) {
-	var request GetPlayerStatsRequestObject
+// GetSeasonLeaderboard operation middleware
+func (sh *strictHandler) GetSeasonLeaderboard(ctx *gin.Context, id string, params GetSeasonLeaderboardParams) {
+	var request GetSeasonLeaderboardRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPlayerStats(ctx, request.(GetPlayerStatsRequestObject))
+		return sh.ssi.GetSeasonLeaderboard(ctx, request.(GetSeasonLeaderboardRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPlayerStats")
+		handler = middleware(handler, "GetSeasonLeaderboard")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		sh.options.HandlerErrorFunc(ctx, err)
-	} else if validResponse, ok := response.(GetPlayerStatsResponseObject); ok {
-		if err := validResponse.VisitGetPlayerStatsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetSeasonLeaderboardResponseObject); ok {
+		if err := validResponse.VisitGetSeasonLeaderboardResponse(ctx.Writer); err != nil {
 			sh.options.ResponseErrorHandlerFunc(ctx, err)
 		}
 	} else if response != nil {
@@ -122,6 +122,25 @@ func (a *API) RequireEditor() gin.HandlerFunc {
 	}
 }
 
+// RequireAdmin is a Gin middleware that aborts with 403 if the authenticated
+// user is not an admin. Admins may override the limits on match dates.
+func (a *API) RequireAdmin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, err := MustGetCurrentUser(c, a.UserService)
+		if err != nil {
+			ErrorResponse(c, http.StatusInternalServerError, err)
+			c.Abort()
+			return
+		}
+		if !user.IsAdmin {
+			ErrorResponse(c, http.StatusForbidden, "You are not authorized to perform this action")
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
 // tryGetCurrentUserID returns the user ID from context if present (extracted from
 // the former markets.go). Used by handlers behind OptionalDeserializeUser.
 func tryGetCurrentUserID(ctx *gin.Context) (string, bool) {
@@ -136,6 +155,17 @@ func tryGetCurrentUserID(ctx *gin.Context) (string, bool) {
 	return id, true
 }
 
+// auditCtx attributes the changes a service makes to the signed-in user in
+// the audit log. The gin context itself is left as it is for the handler.
+func auditCtx(ctx context.Context) context.Context {
+	if ginCtx := ginCtxFromContext(ctx); ginCtx != nil {
+		if userID, ok := tryGetCurrentUserID(ginCtx); ok {
+			return elo.WithActor(ctx, userID)
+		}
+	}
+	return ctx
+}
+
 // ---------------------------------------------------------------------------
 // Match helpers (extracted from the former matches.go).
 // ---------------------------------------------------------------------------
@@ -145,6 +175,7 @@ type matchPlayerJson struct {
 	RatingEarned float64 `json:"rating_earned"`
 	Score        float64 `json:"score"`
 	RatingAfter  float64 `json:"rating_after"`
+	Team         *string `json:"team,omitempty"`
 }
 
 type matchJson struct {
@@ -154,6 +185,7 @@ type matchJson struct {
 	Date           time.Time                  `json:"date"`
 	Players        map[string]matchPlayerJson `json:"score"`
 	HasMarkets     bool                       `json:"has_markets"`
+	Cooperative    *MatchCooperative          `json:"cooperative,omitempty"`
 	CalculatorKind pgtype.Text                `json:"-"`
 	// CalculatorData is omitted on the list path (the paginated query does not
 	// select it to avoid pulling large JSONB for every list row).
@@ -175,6 +207,65 @@ func parseMatchScores(gameIDStr string, scores map[string]float64) (string, map[
 	return gameIDStr, playerScores, nil
 }
 
+// parseMatchTeams converts the optional teams list into a player_id → team map:
+// nil when the list is absent, empty when it is sent empty, which an update
+// reads as clearing the teams. Structural checks only (names, duplicates); the service validates the map
+// against the scores (every player assigned, teammates share a score).
+func parseMatchTeams(teams *[]MatchTeamInput) (map[string]string, error) {
+	if teams == nil {
+		return nil, nil
+	}
+	playerTeams := make(map[string]string)
+	seenNames := make(map[string]bool, len(*teams))
+	for _, t := range *teams {
+		if t.Name == "" {
+			return nil, fmt.Errorf("team name is required")
+		}
+		if seenNames[t.Name] {
+			return nil, fmt.Errorf("duplicate team name: %s", t.Name)
+		}
+		seenNames[t.Name] = true
+		if len(t.PlayerIds) == 0 {
+			return nil, fmt.Errorf("team %s has no players", t.Name)
+		}
+		for _, pid := range t.PlayerIds {
+			if _, dup := playerTeams[pid]; dup {
+				return nil, fmt.Errorf("player %s is assigned to more than one team", pid)
+			}
+			playerTeams[pid] = t.Name
+		}
+	}
+	return playerTeams, nil
+}
+
+// parseMatchCooperative converts the optional cooperative block of a match body
+// into the service's Cooperative; nil for a competitive match.
+func parseMatchCooperative(c *MatchCooperative) (*elo.Cooperative, error) {
+	if c == nil {
+		return nil, nil
+	}
+	if c.Result != Won && c.Result != Lost {
+		return nil, fmt.Errorf("invalid cooperative result: %s", c.Result)
+	}
+	coop := &elo.Cooperative{Won: c.Result == Won}
+	if c.GlobalArena != nil {
+		coop.GlobalArena = *c.GlobalArena
+	}
+	return coop, nil
+}
+
+// matchCooperative builds the response block from the matches columns; nil for
+// a competitive match.
+func matchCooperative(result pgtype.Text, globalArena bool) *MatchCooperative {
+	if !result.Valid {
+		return nil
+	}
+	return &MatchCooperative{
+		Result:      MatchCooperativeResult(result.String),
+		GlobalArena: &globalArena,
+	}
+}
+
 // matchCursor is the continuation token encoded as base64 JSON.
 // It embeds all search parameters so the client doesn't need to repeat them.
 type matchCursor struct {
@@ -220,6 +311,7 @@ type tempMatch struct {
 	Date           time.Time
 	Players        map[string]matchPlayerJson
 	HasMarkets     bool
+	Cooperative    *MatchCooperative
 	CalculatorKind pgtype.Text
 	// CalculatorData is only populated on the GetMatchById path; the paginated
 	// list query deliberately omits the (potentially large) JSONB column.
@@ -237,6 +329,7 @@ func buildMatchesResponse(matchesMap map[string]*tempMatch, order []string) []ma
 			Date:           tm.Date,
 			Players:        make(map[string]matchPlayerJson, len(tm.Players)),
 			HasMarkets:     tm.HasMarkets,
+			Cooperative:    tm.Cooperative,
 			CalculatorKind: tm.CalculatorKind,
 			CalculatorData: tm.CalculatorData,
 		}
@@ -278,3 +371,27 @@ func (s *StrictServer) marketGuarantors(ctx context.Context, marketID string) *[
 	}
 	return &out
 }
+
+// derefStrings returns the dereferenced slice, or nil for an omitted field.
+func derefStrings(p *[]string) []string {
+	if p == nil {
+		return nil
+	}
+	return *p
+}
+
+// float8Ptr maps a nullable FLOAT column to an optional API number.
+func float8Ptr(f pgtype.Float8) *float64 {
+	if !f.Valid {
+		return nil
+	}
+	return &f.Float64
+}
+
+// timestamptzPtr maps a nullable timestamp column to an optional API date-time.
+func timestamptzPtr(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
@@ -28,6 +28,7 @@ var idPathParams = map[string]bool{
 	"id":       true,
 	"userId":   true,
 	"playerId": true,
+	"otherId":  true,
 }
 
 // isSingleIDKey reports whether a JSON key holds one id string (id, *_id).
@@ -45,7 +46,7 @@ func isPluralIDKey(k string) bool {
 // ids pass through untouched.
 func DecodeIDsMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// Path params: rewrite id/userId/playerId in place.
+		// Path params: rewrite id/userId/playerId/otherId in place.
 		for i, p := range c.Params {
 			if idPathParams[p.Key] {
 				c.Params[i].Value = shortid.ToCanonical(p.Value)
@@ -13,6 +13,7 @@ type userJson struct {
 	Id       string  `json:"id"`
 	Name     string  `json:"name"`
 	CanEdit  bool    `json:"can_edit"`
+	IsAdmin  bool    `json:"is_admin"`
 	PlayerID *string `json:"player_id"`
 }
 
@@ -34,6 +35,7 @@ func (a *OAUTH2) GetMe(ctx *gin.Context) {
 		Id:       user.ID,
 		Name:     user.GoogleOauthUserName,
 		CanEdit:  user.AllowEditing,
+		IsAdmin:  user.IsAdmin,
 		PlayerID: playerID,
 	})
 }
@@ -2,7 +2,11 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+
+	"github.com/tolyandre/elo-web-service/pkg/elo"
 )
 
 func (s *StrictServer) CreatePlayerCorrection(ctx context.Context, request CreatePlayerCorrectionRequestObject) (CreatePlayerCorrectionResponseObject, error) {
@@ -21,9 +25,154 @@ func (s *StrictServer) CreatePlayerCorrection(ctx context.Context, request Creat
 		return CreatePlayerCorrection400JSONResponse{Status: "fail", Message: "request body required"}, nil
 	}
 
-	if err := s.api.CorrectionService.CreateGlobalArenaRatingCorrection(ctx, request.Body.Id, request.Id, float64(request.Body.Diff)); err != nil {
+	if err := s.api.CorrectionService.CreateGlobalArenaRatingCorrection(auditCtx(ctx), request.Body.Id, request.Id, float64(request.Body.Diff)); err != nil {
+		if errors.Is(err, elo.ErrGuestNotRated) {
+			return CreatePlayerCorrection400JSONResponse{Status: "fail", Message: err.Error()}, nil
+		}
 		return nil, err
 	}
 
 	return CreatePlayerCorrection200JSONResponse{Status: "success", Message: "Correction applied"}, nil
 }
+
+func (s *StrictServer) VerifySettlementCheckpoints(ctx context.Context, _ VerifySettlementCheckpointsRequestObject) (VerifySettlementCheckpointsResponseObject, error) {
+	result, err := s.api.MatchService.VerifySettlementCheckpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mismatches := make([]CheckpointMismatch, 0, len(result.Mismatches))
+	for _, m := range result.Mismatches {
+		mismatches = append(mismatches, CheckpointMismatch{
+			CheckpointId: m.CheckpointID,
+			Date:         m.Date,
+			Scope:        CheckpointMismatchScope(m.Scope),
+			PlayerId:     nonEmpty(m.PlayerID),
+			GameId:       nonEmpty(m.GameID),
+			MarketId:     nonEmpty(m.MarketID),
+			Field:        m.Field,
+			Stored:       m.Stored,
+			Recomputed:   m.Recomputed,
+		})
+	}
+	return VerifySettlementCheckpoints200JSONResponse{
+		Status: "success",
+		Data:   CheckpointVerification{Checkpoints: result.Checkpoints, Mismatches: mismatches},
+	}, nil
+}
+
+// nonEmpty maps "" to an omitted optional field.
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func (s *StrictServer) AdminAddMatch(ctx context.Context, request AdminAddMatchRequestObject) (AdminAddMatchResponseObject, error) {
+	gameID, playerScores, err := parseMatchScores(request.Body.GameId, request.Body.Score)
+	if err != nil {
+		return AdminAddMatch400JSONResponse{Status: "fail", Message: err.Error()}, nil
+	}
+	teams, err := parseMatchTeams(request.Body.Teams)
+	if err != nil {
+		return AdminAddMatch400JSONResponse{Status: "fail", Message: err.Error()}, nil
+	}
+	coop, err := parseMatchCooperative(request.Body.Cooperative)
+	if err != nil {
+		return AdminAddMatch400JSONResponse{Status: "fail", Message: err.Error()}, nil
+	}
+
+	opts := elo.AddMatchOpts{
+		TournamentIDs: derefStringSlice(request.Body.TournamentIds),
+		Teams:         teams,
+		Cooperative:   coop,
+	}
+	if request.Body.Id != nil {
+		opts.ID = *request.Body.Id
+	}
+
+	match, change, err := s.api.MatchService.AddMatchWithOverride(auditCtx(ctx), gameID, playerScores, request.Body.Date, request.Body.Reason, opts)
+	if err != nil {
+		switch domainStatusCode(err) {
+		case http.StatusBadRequest:
+			return AdminAddMatch400JSONResponse{Status: "fail", Message: err.Error()}, nil
+		case http.StatusConflict:
+			return AdminAddMatch409JSONResponse{Status: "fail", Message: err.Error()}, nil
+		default:
+			return nil, err
+		}
+	}
+	return AdminAddMatch200JSONResponse{Status: "success", Data: adminMatchResult(match.ID, change)}, nil
+}
+
+func (s *StrictServer) AdminUpdateMatch(ctx context.Context, request AdminUpdateMatchRequestObject) (AdminUpdateMatchResponseObject, error) {
+	gameID, playerScores, err := parseMatchScores(request.Body.GameId, request.Body.Score)
+	if err != nil {
+		return AdminUpdateMatch400JSONResponse{Status: "fail", Message: err.Error()}, nil
+	}
+	teams, err := parseMatchTeams(request.Body.Teams)
+	if err != nil {
+		return AdminUpdateMatch400JSONResponse{Status: "fail", Message: err.Error()}, nil
+	}
+	coop, err := parseMatchCooperative(request.Body.Cooperative)
+	if err != nil {
+		return AdminUpdateMatch400JSONResponse{Status: "fail", Message: err.Error()}, nil
+	}
+
+	opts := elo.UpdateMatchOpts{
+		TournamentIDs: derefStringSlice(request.Body.TournamentIds),
+		Teams:         teams,
+		Cooperative:   coop,
+	}
+	match, change, err := s.api.MatchService.UpdateMatchWithOverride(auditCtx(ctx), request.Id, gameID, playerScores, request.Body.Date, request.Body.Reason, opts)
+	if err != nil {
+		switch domainStatusCode(err) {
+		case http.StatusBadRequest:
+			return AdminUpdateMatch400JSONResponse{Status: "fail", Message: err.Error()}, nil
+		case http.StatusNotFound:
+			return AdminUpdateMatch404JSONResponse{Status: "fail", Message: err.Error()}, nil
+		case http.StatusConflict:
+			return AdminUpdateMatch409JSONResponse{Status: "fail", Message: err.Error()}, nil
+		default:
+			return nil, err
+		}
+	}
+	return AdminUpdateMatch200JSONResponse{Status: "success", Data: adminMatchResult(match.ID, change)}, nil
+}
+
+func adminMatchResult(matchID string, change elo.HistoryChange) AdminMatchResult {
+	out := AdminMatchResult{
+		MatchId: matchID,
+		Players: make([]PlayerStandingChange, 0, len(change.Players)),
+		Markets: make([]MarketOutcomeChange, 0, len(change.Markets)),
+	}
+	for _, p := range change.Players {
+		out.Players = append(out.Players, PlayerStandingChange{
+			PlayerId:     p.PlayerID,
+			Name:         p.Name,
+			RatingBefore: p.RatingBefore,
+			RatingAfter:  p.RatingAfter,
+			LeagueBefore: p.LeagueBefore,
+			LeagueAfter:  p.LeagueAfter,
+			RankBefore:   p.RankBefore,
+			RankAfter:    p.RankAfter,
+		})
+	}
+	for _, m := range change.Markets {
+		out.Markets = append(out.Markets, MarketOutcomeChange{
+			MarketId: m.MarketID,
+			Before:   marketStateToAPI(m.Before),
+			After:    marketStateToAPI(m.After),
+		})
+	}
+	return out
+}
+
+func marketStateToAPI(m elo.MarketState) MarketState {
+	return MarketState{
+		Status:              m.Status,
+		ResolutionOutcomeId: m.ResolutionOutcome,
+		ResolutionMatchId:   m.ResolutionMatchID,
+	}
+}
@@ -0,0 +1,232 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/tolyandre/elo-web-service/pkg/db"
+	"github.com/tolyandre/elo-web-service/pkg/elo"
+)
+
+func (s *StrictServer) ListArenas(ctx context.Context, _ ListArenasRequestObject) (ListArenasResponseObject, error) {
+	arenas, err := s.api.ArenaService.ListArenas(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Arena, 0, len(arenas))
+	for _, a := range arenas {
+		result = append(result, arenaToAPI(a))
+	}
+	return ListArenas200JSONResponse{Status: "success", Data: result}, nil
+}
+
+func (s *StrictServer) GetArena(ctx context.Context, request GetArenaRequestObject) (GetArenaResponseObject, error) {
+	arena, err := s.api.ArenaService.GetArena(ctx, request.Id)
+	if err != nil {
+		if db.IsNoRows(err) {
+			return GetArena404JSONResponse{Status: "fail", Message: "arena not found"}, nil
+		}
+		return nil, err
+	}
+	return GetArena200JSONResponse{Status: "success", Data: arenaToAPI(arena)}, nil
+}
+
+func (s *StrictServer) CreateArena(ctx context.Context, request CreateArenaRequestObject) (CreateArenaResponseObject, error) {
+	if msg := validateArenaInput(*request.Body); msg != "" {
+		return CreateArena400JSONResponse{Status: "fail", Message: msg}, nil
+	}
+
+	arena, err := s.api.ArenaService.CreateArena(ctx, request.Body.Id, request.Body.Name, arenaFilters(*request.Body), leagueLadderConfig(request.Body.LeagueLadder))
+	if err != nil {
+		switch domainStatusCode(err) {
+		case http.StatusBadRequest:
+			if errors.Is(err, elo.ErrInvalidLeagueLadder) {
+				return CreateArena400JSONResponse{Status: "fail", Message: err.Error()}, nil
+			}
+			return CreateArena400JSONResponse{Status: "fail", Message: "unknown game, player, club or tournament"}, nil
+		case http.StatusConflict:
+			return CreateArena409JSONResponse{Status: "fail", Message: "arena with this name already exists"}, nil
+		default:
+			return nil, err
+		}
+	}
+	return CreateArena200JSONResponse{Status: "success", Data: arenaToAPI(arena)}, nil
+}
+
+func (s *StrictServer) UpdateArena(ctx context.Context, request UpdateArenaRequestObject) (UpdateArenaResponseObject, error) {
+	if msg := validateArenaInput(*request.Body); msg != "" {
+		return UpdateArena400JSONResponse{Status: "fail", Message: msg}, nil
+	}
+
+	arena, err := s.api.ArenaService.UpdateArena(ctx, request.Id, request.Body.Name, arenaFilters(*request.Body), leagueLadderConfig(request.Body.LeagueLadder))
+	if err != nil {
+		switch domainStatusCode(err) {
+		case http.StatusBadRequest:
+			if errors.Is(err, elo.ErrInvalidLeagueLadder) {
+				return UpdateArena400JSONResponse{Status: "fail", Message: err.Error()}, nil
+			}
+			return UpdateArena400JSONResponse{Status: "fail", Message: "unknown game, player, club or tournament"}, nil
+		case http.StatusNotFound:
+			return UpdateArena404JSONResponse{Status: "fail", Message: "arena not found"}, nil
+		case http.StatusConflict:
+			return UpdateArena409JSONResponse{Status: "fail", Message: "arena with this name already exists"}, nil
+		default:
+			return nil, err
+		}
+	}
+	return UpdateArena200JSONResponse{Status: "success", Data: arenaToAPI(arena)}, nil
+}
+
+func (s *StrictServer) DeleteArena(ctx context.Context, request DeleteArenaRequestObject) (DeleteArenaResponseObject, error) {
+	if _, err := s.api.ArenaService.DeleteArena(ctx, request.Id); err != nil {
+		if db.IsNoRows(err) {
+			return DeleteArena404JSONResponse{Status: "fail", Message: "arena not found"}, nil
+		}
+		return nil, err
+	}
+	return DeleteArena200JSONResponse{Status: "success", Message: "Arena deleted"}, nil
+}
+
+func (s *StrictServer) GetArenaLeaderboard(ctx context.Context, request GetArenaLeaderboardRequestObject) (GetArenaLeaderboardResponseObject, error) {
+	rows, err := s.api.ArenaService.GetLeaderboard(ctx, request.Id)
+	if err != nil {
+		if db.IsNoRows(err) {
+			return GetArenaLeaderboard404JSONResponse{Status: "fail", Message: "arena not found"}, nil
+		}
+		return nil, err
+	}
+
+	entries := make([]ArenaLeaderboardEntry, 0, len(rows))
+	for _, r := range rows {
+		entries = append(entries, ArenaLeaderboardEntry{
+			PlayerId:      r.PlayerID,
+			PlayerName:    r.PlayerName,
+			Elo:           r.EloAfter,
+			Deviation:     float8Ptr(r.DeviationAfter),
+			League:        textPtr(r.League),
+			Cubes:         r.Cubes,
+			Meeples:       r.Meeples,
+			MatchesPlayed: int(r.MatchesPlayed),
+			LastMatchDate: r.LastMatchDate.Time,
+		})
+	}
+	return GetArenaLeaderboard200JSONResponse{Status: "success", Data: entries}, nil
+}
+
+func (s *StrictServer) GetArenaPlayerHistory(ctx context.Context, request GetArenaPlayerHistoryRequestObject) (GetArenaPlayerHistoryResponseObject, error) {
+	rows, err := s.api.ArenaService.GetPlayerHistory(ctx, request.Id, request.PlayerId)
+	if err != nil {
+		if db.IsNoRows(err) {
+			return GetArenaPlayerHistory404JSONResponse{Status: "fail", Message: "arena not found"}, nil
+		}
+		return nil, err
+	}
+
+	entries := make([]ArenaHistoryEntry, 0, len(rows))
+	for _, r := range rows {
+		entries = append(entries, ArenaHistoryEntry{
+			MatchId:        r.MatchID,
+			Date:           r.Date.Time,
+			GameId:         r.GameID,
+			EloAfter:       r.EloAfter,
+			EloStaked:      r.EloStaked,
+			EloEarned:      r.EloEarned,
+			DeviationAfter: float8Ptr(r.DeviationAfter),
+			League:         textPtr(r.League),
+			Cubes:          r.Cubes,
+			Meeples:        r.Meeples,
+		})
+	}
+	return GetArenaPlayerHistory200JSONResponse{Status: "success", Data: entries}, nil
+}
+
+// validateArenaInput returns a client-facing message for an invalid arena, or "".
+func validateArenaInput(in ArenaInput) string {
+	if in.Name == "" {
+		return "name is required"
+	}
+	if in.StartsAt != nil && in.EndsAt != nil && !in.EndsAt.After(*in.StartsAt) {
+		return "ends_at must be after starts_at"
+	}
+	return ""
+}
+
+func arenaFilters(in ArenaInput) elo.ArenaFilters {
+	return elo.ArenaFilters{
+		GameIDs:      derefStrings(in.GameIds),
+		PlayerIDs:    derefStrings(in.PlayerIds),
+		ClubID:       in.ClubId,
+		TournamentID: in.TournamentId,
+		StartsAt:     in.StartsAt,
+		EndsAt:       in.EndsAt,
+	}
+}
+
+// leagueLadderConfig converts the API ladder; rules are validated by the service.
+func leagueLadderConfig(in *LeagueLadder) *elo.LeagueLadderConfig {
+	if in == nil {
+		return nil
+	}
+	cfg := &elo.LeagueLadderConfig{Leagues: make([]elo.LeagueConfig, 0, len(in.Leagues))}
+	for _, l := range in.Leagues {
+		league := elo.LeagueConfig{Name: l.Name}
+		if l.Keep != nil {
+			league.Keep = *l.Keep
+		}
+		if l.InactivityDays != nil {
+			league.InactivityDays = *l.InactivityDays
+		}
+		if l.Requires != nil {
+			for _, r := range *l.Requires {
+				rule := elo.LeagueRuleConfig{Kind: string(r.Kind), Min: r.Min}
+				if r.Days != nil {
+					rule.Days = *r.Days
+				}
+				league.Requires = append(league.Requires, rule)
+			}
+		}
+		cfg.Leagues = append(cfg.Leagues, league)
+	}
+	return cfg
+}
+
+// leagueLadderToAPI returns nil for an arena without leagues or with a
+// document that no longer parses.
+func leagueLadderToAPI(raw json.RawMessage) *LeagueLadder {
+	ladder, err := elo.ParseLeagueLadder(raw)
+	if err != nil || ladder == nil {
+		return nil
+	}
+	out := &LeagueLadder{Leagues: make([]League, 0, len(ladder.Config().Leagues))}
+	for _, l := range ladder.Config().Leagues {
+		league := League{Name: l.Name, Keep: &l.Keep, InactivityDays: &l.InactivityDays}
+		rules := make([]LeagueRule, 0, len(l.Requires))
+		for _, r := range l.Requires {
+			rule := LeagueRule{Kind: LeagueRuleKind(r.Kind), Min: r.Min}
+			if r.Days > 0 {
+				rule.Days = &r.Days
+			}
+			rules = append(rules, rule)
+		}
+		league.Requires = &rules
+		out.Leagues = append(out.Leagues, league)
+	}
+	return out
+}
+
+func arenaToAPI(a db.Arena) Arena {
+	return Arena{
+		Id:           a.ID,
+		Name:         a.Name,
+		GameIds:      a.GameIds,
+		PlayerIds:    a.PlayerIds,
+		ClubId:       a.ClubID,
+		TournamentId: a.TournamentID,
+		StartsAt:     timestamptzPtr(a.StartsAt),
+		EndsAt:       timestamptzPtr(a.EndsAt),
+		LeagueLadder: leagueLadderToAPI(a.LeagueLadder),
+	}
+}
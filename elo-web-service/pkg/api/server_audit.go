@@ -0,0 +1,132 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/tolyandre/elo-web-service/pkg/api/shortid"
+	"github.com/tolyandre/elo-web-service/pkg/elo"
+)
+
+// auditCursor carries the filters of the first page along with the id of the
+// last entry returned, like the correction cursor.
+type auditCursor struct {
+	EntityType string `json:"entity_type,omitempty"`
+	EntityID   string `json:"entity_id,omitempty"`
+	UserID     string `json:"user_id,omitempty"`
+	LastID     string `json:"last_id"`
+}
+
+func encodeAuditCursor(c auditCursor) string {
+	b, _ := json.Marshal(c)
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+func decodeAuditCursor(token string) (auditCursor, error) {
+	var c auditCursor
+	b, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return c, err
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, err
+	}
+	if c.LastID == "" {
+		return c, fmt.Errorf("cursor without last_id")
+	}
+	return c, nil
+}
+
+func (s *StrictServer) ListAuditEntries(ctx context.Context, request ListAuditEntriesRequestObject) (ListAuditEntriesResponseObject, error) {
+	params := request.Params
+	var filter elo.AuditFilter
+
+	if params.Next != nil && *params.Next != "" {
+		c, err := decodeAuditCursor(*params.Next)
+		if err != nil {
+			return ListAuditEntries400JSONResponse{Status: "fail", Message: "Invalid cursor"}, nil
+		}
+		filter = elo.AuditFilter{EntityType: c.EntityType, EntityID: c.EntityID, UserID: c.UserID, CursorID: c.LastID}
+	} else {
+		if params.EntityType != nil {
+			if !params.EntityType.Valid() {
+				return ListAuditEntries400JSONResponse{Status: "fail", Message: "Invalid entity_type"}, nil
+			}
+			filter.EntityType = string(*params.EntityType)
+		}
+		if params.EntityId != nil {
+			filter.EntityID = *params.EntityId
+		}
+		if params.UserId != nil {
+			filter.UserID = *params.UserId
+		}
+	}
+
+	filter.Limit = 30
+	if params.Limit != nil && *params.Limit > 0 && *params.Limit <= 100 {
+		filter.Limit = int32(*params.Limit)
+	}
+
+	rows, err := s.api.AuditService.ListAuditEntries(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]AuditEntry, 0, len(rows))
+	for _, r := range rows {
+		entry := AuditEntry{
+			Id:         r.ID,
+			CreatedAt:  r.CreatedAt,
+			UserId:     r.UserID,
+			UserName:   textPtr(r.UserName),
+			EntityType: AuditEntityType(r.EntityType),
+			EntityId:   r.EntityID,
+			Action:     AuditEntryAction(r.Action),
+			Reason:     textPtr(r.Reason),
+		}
+		if entry.Before, err = auditPayloadObject(r.Before); err != nil {
+			return nil, err
+		}
+		if entry.After, err = auditPayloadObject(r.After); err != nil {
+			return nil, err
+		}
+		data = append(data, entry)
+	}
+
+	var next *string
+	if int32(len(rows)) == filter.Limit {
+		token := encodeAuditCursor(auditCursor{
+			EntityType: filter.EntityType,
+			EntityID:   filter.EntityID,
+			UserID:     filter.UserID,
+			LastID:     rows[len(rows)-1].ID,
+		})
+		next = &token
+	}
+
+	return ListAuditEntries200JSONResponse{Status: "success", Data: data, Next: next}, nil
+}
+
+func auditPayloadObject(raw json.RawMessage) (*map[string]interface{}, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return nil, fmt.Errorf("decode audit payload: %w", err)
+	}
+	return &obj, nil
+}
+
+// editHistory links an entity to its audit entries.
+func (s *StrictServer) editHistory(ctx context.Context, entityType AuditEntityType, entityID string) (*EditHistory, error) {
+	count, err := s.api.AuditService.CountEntityEntries(ctx, string(entityType), entityID)
+	if err != nil {
+		return nil, err
+	}
+	query := url.Values{"entity_type": {string(entityType)}, "entity_id": {shortid.FromCanonical(entityID)}}
+	return &EditHistory{Entries: int(count), Href: "/audit?" + query.Encode()}, nil
+}
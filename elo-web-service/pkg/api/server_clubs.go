@@ -92,7 +92,7 @@ func (s *StrictServer) CreateClub(ctx context.Context, request CreateClubRequest
 		return CreateClub400JSONResponse{Status: "fail", Message: "name is required"}, nil
 	}
 
-	club, err := s.api.ClubService.CreateClub(ctx, request.Body.Id, name)
+	club, err := s.api.ClubService.CreateClub(auditCtx(ctx), request.Body.Id, name)
 	if err != nil {
 		if domainStatusCode(err) == http.StatusConflict {
 			return CreateClub409JSONResponse{Status: "fail", Message: "club with this name already exists"}, nil
@@ -146,7 +146,7 @@ func (s *StrictServer) PatchClub(ctx context.Context, request PatchClubRequestOb
 	}
 
 	if updateName {
-		if _, err := s.api.ClubService.UpdateClub(ctx, request.Id, *request.Body.Name); err != nil {
+		if _, err := s.api.ClubService.UpdateClub(auditCtx(ctx), request.Id, *request.Body.Name); err != nil {
 			if domainStatusCode(err) == http.StatusNotFound {
 				return PatchClub404JSONResponse{Status: "fail", Message: "club not found"}, nil
 			}
@@ -158,7 +158,7 @@ func (s *StrictServer) PatchClub(ctx context.Context, request PatchClubRequestOb
 	}
 
 	if updateIcon {
-		if _, err := s.api.ClubService.UpdateClubIcon(ctx, request.Id, iconArg); err != nil {
+		if _, err := s.api.ClubService.UpdateClubIcon(auditCtx(ctx), request.Id, iconArg); err != nil {
 			if domainStatusCode(err) == http.StatusNotFound {
 				return PatchClub404JSONResponse{Status: "fail", Message: "club not found"}, nil
 			}
@@ -178,13 +178,15 @@ func (s *StrictServer) PatchClub(ctx context.Context, request PatchClubRequestOb
 }
 
 func (s *StrictServer) DeleteClub(ctx context.Context, request DeleteClubRequestObject) (DeleteClubResponseObject, error) {
-	_, err := s.api.ClubService.DeleteClub(ctx, request.Id)
+	_, err := s.api.ClubService.DeleteClub(auditCtx(ctx), request.Id)
 	switch {
 	case err == nil:
 	case domainStatusCode(err) == http.StatusNotFound:
 		return DeleteClub404JSONResponse{Status: "fail", Message: "club not found"}, nil
 	case domainStatusCode(err) == http.StatusBadRequest:
 		return DeleteClub400JSONResponse{Status: "fail", Message: "cannot delete club with members"}, nil
+	case domainStatusCode(err) == http.StatusConflict:
+		return DeleteClub409JSONResponse{Status: "fail", Message: err.Error()}, nil
 	default:
 		return nil, err
 	}
@@ -198,7 +200,7 @@ func (s *StrictServer) AddClubMember(ctx context.Context, request AddClubMemberR
 		return AddClubMember400JSONResponse{Status: "fail", Message: "player_id is required"}, nil
 	}
 
-	err := s.api.ClubService.AddMember(ctx, request.Id, playerID)
+	err := s.api.ClubService.AddMember(auditCtx(ctx), request.Id, playerID)
 	if err != nil {
 		// OpenAPI only defines 200/400/401/403 for AddClubMember, so a duplicate
 		// (club_id, player_id) membership (unique violation) has no 409 in the
@@ -213,7 +215,7 @@ func (s *StrictServer) AddClubMember(ctx context.Context, request AddClubMemberR
 }
 
 func (s *StrictServer) RemoveClubMember(ctx context.Context, request RemoveClubMemberRequestObject) (RemoveClubMemberResponseObject, error) {
-	err := s.api.ClubService.RemoveMember(ctx, request.Id, request.PlayerId)
+	err := s.api.ClubService.RemoveMember(auditCtx(ctx), request.Id, request.PlayerId)
 	if err != nil {
 		return nil, err
 	}
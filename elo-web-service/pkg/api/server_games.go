@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tolyandre/elo-web-service/pkg/elo"
 )
 
 func (s *StrictServer) RecalculateGameElo(ctx context.Context, _ RecalculateGameEloRequestObject) (RecalculateGameEloResponseObject, error) {
@@ -61,13 +62,22 @@ func (s *StrictServer) GetGame(ctx context.Context, request GetGameRequestObject
 		})
 	}
 
+	ratingParams, err := s.gameRatingParams(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
 	return GetGame200JSONResponse{
 		Status: "success",
 		Data: Game{
-			Id:           request.Id,
-			Name:         gameStatistics.Name,
-			TotalMatches: gameStatistics.TotalMatches,
-			Players:      players,
+			Id:                 request.Id,
+			Name:               gameStatistics.Name,
+			TotalMatches:       gameStatistics.TotalMatches,
+			Players:            players,
+			ScoringDirection:   GameScoringDirection(gameStatistics.Scoring.Direction),
+			ResultType:         GameResultType(gameStatistics.Scoring.ResultType),
+			VirtualOpponentElo: gameStatistics.VirtualOpponentElo,
+			RatingParams:       ratingParams,
 		},
 	}, nil
 }
@@ -93,7 +103,7 @@ func (s *StrictServer) CreateGame(ctx context.Context, request CreateGameRequest
 }
 
 func (s *StrictServer) PatchGame(ctx context.Context, request PatchGameRequestObject) (PatchGameResponseObject, error) {
-	game, err := s.api.GameService.UpdateGameName(ctx, request.Id, request.Body.Name)
+	game, err := s.api.GameService.GetGameByID(ctx, request.Id)
 	if err != nil {
 		if domainStatusCode(err) == http.StatusNotFound {
 			return PatchGame404JSONResponse{Status: "fail", Message: "game not found"}, nil
@@ -101,12 +111,84 @@ func (s *StrictServer) PatchGame(ctx context.Context, request PatchGameRequestOb
 		return nil, err
 	}
 
+	if request.Body.Name != nil {
+		if *request.Body.Name == "" {
+			return PatchGame400JSONResponse{Status: "fail", Message: "name is required"}, nil
+		}
+		game, err = s.api.GameService.UpdateGameName(ctx, request.Id, *request.Body.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Scoring rules: unspecified fields keep their current value. The service
+	// replays Elo from the game's first match when the rules change.
+	if request.Body.ScoringDirection != nil || request.Body.ResultType != nil {
+		scoring := elo.GameScoringOf(*game)
+		if request.Body.ScoringDirection != nil {
+			scoring.Direction = string(*request.Body.ScoringDirection)
+		}
+		if request.Body.ResultType != nil {
+			scoring.ResultType = string(*request.Body.ResultType)
+		}
+		updated, err := s.api.MatchService.UpdateGameScoring(ctx, request.Id, scoring)
+		if err != nil {
+			switch domainStatusCode(err) {
+			case http.StatusBadRequest:
+				return PatchGame400JSONResponse{Status: "fail", Message: err.Error()}, nil
+			case http.StatusNotFound:
+				return PatchGame404JSONResponse{Status: "fail", Message: "game not found"}, nil
+			default:
+				return nil, err
+			}
+		}
+		game = &updated
+	}
+
+	if p := request.Body.RatingParams; p != nil {
+		params := elo.GameRatingParams{EffectiveDate: time.Now(), K: p.K, D: p.D, WinReward: p.WinReward}
+		if p.EffectiveDate != nil {
+			params.EffectiveDate = *p.EffectiveDate
+		}
+		if err := s.api.MatchService.SetGameRatingParams(auditCtx(ctx), request.Id, params); err != nil {
+			switch domainStatusCode(err) {
+			case http.StatusBadRequest:
+				return PatchGame400JSONResponse{Status: "fail", Message: err.Error()}, nil
+			case http.StatusNotFound:
+				return PatchGame404JSONResponse{Status: "fail", Message: "game not found"}, nil
+			default:
+				return nil, err
+			}
+		}
+	}
+
+	ratingParams, err := s.gameRatingParams(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
 	resp := PatchGame200JSONResponse{Status: "success"}
 	resp.Data.Id = game.ID
 	resp.Data.Name = game.Name
+	resp.Data.ScoringDirection = GameScoringDirection(game.ScoringDirection)
+	resp.Data.ResultType = GameResultType(game.ResultType)
+	resp.Data.RatingParams = ratingParams
 	return resp, nil
 }
 
+// gameRatingParams lists the versions of a game's rating parameters.
+func (s *StrictServer) gameRatingParams(ctx context.Context, gameID string) ([]GameRatingParams, error) {
+	versions, err := s.api.GameService.ListGameRatingParams(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]GameRatingParams, 0, len(versions))
+	for _, v := range versions {
+		out = append(out, GameRatingParams{EffectiveDate: v.EffectiveDate, K: v.K, D: v.D, WinReward: v.WinReward})
+	}
+	return out, nil
+}
+
 func (s *StrictServer) DeleteGame(ctx context.Context, request DeleteGameRequestObject) (DeleteGameResponseObject, error) {
 	_, err := s.api.GameService.DeleteGame(ctx, request.Id)
 	switch {
@@ -122,6 +204,30 @@ func (s *StrictServer) DeleteGame(ctx context.Context, request DeleteGameRequest
 	return DeleteGame200JSONResponse{Status: "success", Message: "Game deleted"}, nil
 }
 
+func (s *StrictServer) MergeGame(ctx context.Context, request MergeGameRequestObject) (MergeGameResponseObject, error) {
+	if request.Body.DuplicateId == "" {
+		return MergeGame400JSONResponse{Status: "fail", Message: "duplicate_id is required"}, nil
+	}
+
+	game, err := s.api.MatchService.MergeGames(auditCtx(ctx), request.Id, request.Body.DuplicateId)
+	switch {
+	case err == nil:
+	case domainStatusCode(err) == http.StatusBadRequest:
+		return MergeGame400JSONResponse{Status: "fail", Message: err.Error()}, nil
+	case domainStatusCode(err) == http.StatusNotFound:
+		return MergeGame404JSONResponse{Status: "fail", Message: "game not found"}, nil
+	case domainStatusCode(err) == http.StatusConflict:
+		return MergeGame409JSONResponse{Status: "fail", Message: err.Error()}, nil
+	default:
+		return nil, err
+	}
+
+	resp := MergeGame200JSONResponse{Status: "success"}
+	resp.Data.Id = game.ID
+	resp.Data.Name = game.Name
+	return resp, nil
+}
+
 func (s *StrictServer) GetGameMatches(ctx context.Context, request GetGameMatchesRequestObject) (GetGameMatchesResponseObject, error) {
 	matches, err := s.api.GameService.GetGameMatches(ctx, request.Id)
 	if err != nil {
@@ -588,9 +588,9 @@ func (s *StrictServer) CreateMarket(ctx context.Context, request CreateMarketReq
 		return CreateMarket400JSONResponse{Status: "fail", Message: "unknown market_type: " + string(body.MarketType)}, nil
 	}
 
-	market, err := s.api.MarketService.CreateMarket(ctx, params)
+	market, err := s.api.MarketService.CreateMarket(auditCtx(ctx), params)
 	if err != nil {
-		if errors.Is(err, elo.ErrMarketNeedsGuarantor) {
+		if errors.Is(err, elo.ErrMarketNeedsGuarantor) || errors.Is(err, elo.ErrGuestNotRated) {
 			return CreateMarket400JSONResponse{Status: "fail", Message: err.Error()}, nil
 		}
 		return nil, err
@@ -604,7 +604,7 @@ func (s *StrictServer) CreateMarket(ctx context.Context, request CreateMarketReq
 func (s *StrictServer) PatchMarket(ctx context.Context, request PatchMarketRequestObject) (PatchMarketResponseObject, error) {
 	switch string(request.Body.Status) {
 	case "betting_closed":
-		if err := s.api.MarketService.LockMarketBetting(ctx, request.Id); err != nil {
+		if err := s.api.MarketService.LockMarketBetting(auditCtx(ctx), request.Id); err != nil {
 			if errors.Is(err, elo.ErrMarketNotOpen) {
 				return PatchMarket409JSONResponse{Status: "fail", Message: err.Error()}, nil
 			}
@@ -617,7 +617,7 @@ func (s *StrictServer) PatchMarket(ctx context.Context, request PatchMarketReque
 }
 
 func (s *StrictServer) DeleteMarket(ctx context.Context, request DeleteMarketRequestObject) (DeleteMarketResponseObject, error) {
-	if err := s.api.MatchService.DeleteMarketAndRecalculate(ctx, request.Id); err != nil {
+	if err := s.api.MatchService.DeleteMarketAndRecalculate(auditCtx(ctx), request.Id); err != nil {
 		if errors.Is(err, elo.ErrMarketNotOpen) {
 			return DeleteMarket409JSONResponse{Status: "fail", Message: err.Error()}, nil
 		}
@@ -661,6 +661,8 @@ func (s *StrictServer) PlaceBet(ctx context.Context, request PlaceBetRequestObje
 			return PlaceBet400JSONResponse{Status: "fail", Message: err.Error()}, nil
 		case errors.Is(err, elo.ErrMarketNotOpen), errors.Is(err, elo.ErrPriceChanged):
 			return PlaceBet409JSONResponse{Status: "fail", Message: err.Error()}, nil
+		case errors.Is(err, elo.ErrGuestCannotBet):
+			return PlaceBet403JSONResponse{Status: "fail", Message: err.Error()}, nil
 		default:
 			return nil, err
 		}
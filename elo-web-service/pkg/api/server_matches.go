@@ -66,6 +66,7 @@ func (s *StrictServer) ListMatches(ctx context.Context, request ListMatchesReque
 				Date:           r.Date.Time,
 				Players:        make(map[string]matchPlayerJson),
 				HasMarkets:     r.HasMarkets,
+				Cooperative:    matchCooperative(r.CooperativeResult, r.CooperativeGlobalArena),
 				CalculatorKind: r.CalculatorKind,
 			}
 			order = append(order, r.MatchID)
@@ -79,6 +80,7 @@ func (s *StrictServer) ListMatches(ctx context.Context, request ListMatchesReque
 			RatingStaked: r.RatingStaked.Float64,
 			RatingEarned: r.RatingEarned.Float64,
 			RatingAfter:  ratingAfter,
+			Team:         textPtr(r.Team),
 		}
 	}
 
@@ -97,15 +99,17 @@ func (s *StrictServer) ListMatches(ctx context.Context, request ListMatchesReque
 				RatingEarned: p.RatingEarned,
 				Score:        p.Score,
 				RatingAfter:  p.RatingAfter,
+				Team:         p.Team,
 			}
 		}
 		match := Match{
-			Id:         m.Id,
-			GameId:     m.GameId,
-			GameName:   m.GameName,
-			Date:       m.Date,
-			Score:      score,
-			HasMarkets: m.HasMarkets,
+			Id:          m.Id,
+			GameId:      m.GameId,
+			GameName:    m.GameName,
+			Date:        m.Date,
+			Score:       score,
+			HasMarkets:  m.HasMarkets,
+			Cooperative: m.Cooperative,
 		}
 		if ts := tournamentsByMatch[m.Id]; len(ts) > 0 {
 			match.Tournaments = &ts
@@ -136,11 +140,21 @@ func (s *StrictServer) AddMatch(ctx context.Context, request AddMatchRequestObje
 	if err != nil {
 		return AddMatch400JSONResponse{Status: "fail", Message: err.Error()}, nil
 	}
+	teams, err := parseMatchTeams(request.Body.Teams)
+	if err != nil {
+		return AddMatch400JSONResponse{Status: "fail", Message: err.Error()}, nil
+	}
+	coop, err := parseMatchCooperative(request.Body.Cooperative)
+	if err != nil {
+		return AddMatch400JSONResponse{Status: "fail", Message: err.Error()}, nil
+	}
 
 	date := time.Now()
 	opts := elo.AddMatchOpts{
 		ID:            request.Body.Id,
 		TournamentIDs: derefStringSlice(request.Body.TournamentIds),
+		Teams:         teams,
+		Cooperative:   coop,
 	}
 	if request.Body.Date != nil {
 		date = *request.Body.Date
@@ -157,7 +171,7 @@ func (s *StrictServer) AddMatch(ctx context.Context, request AddMatchRequestObje
 		opts.Calculator = calc
 	}
 
-	match, err := s.api.MatchService.AddMatch(ctx, gameID, playerScores, date, opts)
+	match, err := s.api.MatchService.AddMatch(auditCtx(ctx), gameID, playerScores, date, opts)
 	if err != nil {
 		return addMatchError(err)
 	}
@@ -181,6 +195,128 @@ func addMatchError(err error) (AddMatchResponseObject, error) {
 	}
 }
 
+func (s *StrictServer) PreviewMatch(ctx context.Context, request PreviewMatchRequestObject) (PreviewMatchResponseObject, error) {
+	gameID, playerScores, err := parseMatchScores(request.Body.GameId, request.Body.Score)
+	if err != nil {
+		return PreviewMatch400JSONResponse{Status: "fail", Message: err.Error()}, nil
+	}
+	teams, err := parseMatchTeams(request.Body.Teams)
+	if err != nil {
+		return PreviewMatch400JSONResponse{Status: "fail", Message: err.Error()}, nil
+	}
+	coop, err := parseMatchCooperative(request.Body.Cooperative)
+	if err != nil {
+		return PreviewMatch400JSONResponse{Status: "fail", Message: err.Error()}, nil
+	}
+
+	date := time.Now()
+	opts := elo.AddMatchOpts{
+		TournamentIDs: derefStringSlice(request.Body.TournamentIds),
+		Teams:         teams,
+		Cooperative:   coop,
+	}
+	if request.Body.Date != nil {
+		date = *request.Body.Date
+		opts.ClientDate = true
+	}
+
+	preview, err := s.api.MatchService.PreviewMatch(ctx, gameID, playerScores, date, opts)
+	if err != nil {
+		switch domainStatusCode(err) {
+		case http.StatusBadRequest:
+			return PreviewMatch400JSONResponse{Status: "fail", Message: err.Error()}, nil
+		case http.StatusConflict:
+			return PreviewMatch409JSONResponse{Status: "fail", Message: err.Error()}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return PreviewMatch200JSONResponse{Status: "success", Data: matchPreviewToAPI(preview)}, nil
+}
+
+func (s *StrictServer) PredictMatch(ctx context.Context, request PredictMatchRequestObject) (PredictMatchResponseObject, error) {
+	predictions, err := s.api.MatchService.PredictMatch(ctx, request.Body.GameId, request.Body.PlayerIds)
+	if err != nil {
+		switch domainStatusCode(err) {
+		case http.StatusBadRequest:
+			return PredictMatch400JSONResponse{Status: "fail", Message: err.Error()}, nil
+		case http.StatusNotFound:
+			return PredictMatch404JSONResponse{Status: "fail", Message: "game or player not found"}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	data := make([]PlayerPrediction, 0, len(predictions))
+	for _, p := range predictions {
+		data = append(data, playerPredictionToAPI(p))
+	}
+	return PredictMatch200JSONResponse{Status: "success", Data: data}, nil
+}
+
+func playerPredictionToAPI(p elo.PlayerPrediction) PlayerPrediction {
+	return PlayerPrediction{
+		PlayerId: p.PlayerID,
+		Global:   arenaPredictionToAPI(p.Global),
+		Game:     arenaPredictionToAPI(p.Game),
+	}
+}
+
+func arenaPredictionToAPI(p elo.ArenaPrediction) ArenaPrediction {
+	return ArenaPrediction{Elo: p.Elo, ExpectedScore: p.ExpectedScore, WinProbability: p.WinProbability}
+}
+
+func matchPreviewToAPI(p elo.MatchPreview) MatchPreview {
+	out := MatchPreview{
+		Players: make([]MatchPreviewPlayer, 0, len(p.Players)),
+		Markets: make([]MatchPreviewMarket, 0, len(p.Markets)),
+	}
+	for _, pl := range p.Players {
+		out.Players = append(out.Players, MatchPreviewPlayer{
+			PlayerId: pl.PlayerID,
+			Global:   arenaDeltaToAPI(pl.Global),
+			Game:     arenaDeltaToAPI(pl.Game),
+		})
+	}
+	for _, m := range p.Markets {
+		payouts := make([]MatchPreviewPayout, 0, len(m.Payouts))
+		for _, po := range m.Payouts {
+			payouts = append(payouts, MatchPreviewPayout{
+				PlayerId: po.PlayerID,
+				Role:     MatchPreviewPayoutRole(po.Role),
+				Staked:   po.Staked,
+				Earned:   po.Earned,
+			})
+		}
+		out.Markets = append(out.Markets, MatchPreviewMarket{
+			MarketId:            m.MarketID,
+			Status:              m.Status,
+			ResolutionOutcomeId: m.ResolutionOutcome,
+			Payouts:             payouts,
+		})
+	}
+	return out
+}
+
+func arenaDeltaToAPI(d *elo.ArenaDelta) *MatchArenaDelta {
+	if d == nil {
+		return nil
+	}
+	out := &MatchArenaDelta{
+		RatingBefore: d.RatingBefore,
+		RatingAfter:  d.RatingAfter,
+		EloBefore:    d.EloBefore,
+		EloAfter:     d.EloAfter,
+		LeagueAfter:  d.LeagueAfter,
+	}
+	if d.LeagueBefore != "" {
+		league := d.LeagueBefore
+		out.LeagueBefore = &league
+	}
+	return out
+}
+
 // derefStringSlice returns the pointed-to slice, or nil if the pointer is nil.
 func derefStringSlice(s *[]string) []string {
 	if s == nil {
@@ -227,6 +363,7 @@ func (s *StrictServer) GetMatchById(ctx context.Context, request GetMatchByIdReq
 				GameName:       r.GameName,
 				Date:           r.Date.Time,
 				Players:        make(map[string]matchPlayerJson),
+				Cooperative:    matchCooperative(r.CooperativeResult, r.CooperativeGlobalArena),
 				CalculatorKind: r.CalculatorKind,
 				CalculatorData: r.CalculatorData,
 			}
@@ -241,6 +378,7 @@ func (s *StrictServer) GetMatchById(ctx context.Context, request GetMatchByIdReq
 			RatingStaked: r.RatingStaked.Float64,
 			RatingEarned: r.RatingEarned.Float64,
 			RatingAfter:  ratingAfter,
+			Team:         textPtr(r.Team),
 		}
 	}
 
@@ -258,16 +396,18 @@ func (s *StrictServer) GetMatchById(ctx context.Context, request GetMatchByIdReq
 			RatingEarned: p.RatingEarned,
 			Score:        p.Score,
 			RatingAfter:  p.RatingAfter,
+			Team:         p.Team,
 		}
 	}
 
 	match := Match{
-		Id:         m.Id,
-		GameId:     m.GameId,
-		GameName:   m.GameName,
-		Date:       m.Date,
-		Score:      score,
-		HasMarkets: m.HasMarkets,
+		Id:          m.Id,
+		GameId:      m.GameId,
+		GameName:    m.GameName,
+		Date:        m.Date,
+		Score:       score,
+		HasMarkets:  m.HasMarkets,
+		Cooperative: m.Cooperative,
 	}
 	if ts := tournamentsByMatch[m.Id]; len(ts) > 0 {
 		match.Tournaments = &ts
@@ -283,6 +423,10 @@ func (s *StrictServer) GetMatchById(ctx context.Context, request GetMatchByIdReq
 		}
 	}
 
+	if match.EditHistory, err = s.editHistory(ctx, AuditEntityTypeMatch, m.Id); err != nil {
+		return nil, err
+	}
+
 	return GetMatchById200JSONResponse{Status: "success", Data: match}, nil
 }
 
@@ -291,9 +435,19 @@ func (s *StrictServer) UpdateMatch(ctx context.Context, request UpdateMatchReque
 	if err != nil {
 		return UpdateMatch400JSONResponse{Status: "fail", Message: err.Error()}, nil
 	}
+	teams, err := parseMatchTeams(request.Body.Teams)
+	if err != nil {
+		return UpdateMatch400JSONResponse{Status: "fail", Message: err.Error()}, nil
+	}
+	coop, err := parseMatchCooperative(request.Body.Cooperative)
+	if err != nil {
+		return UpdateMatch400JSONResponse{Status: "fail", Message: err.Error()}, nil
+	}
 
 	opts := elo.UpdateMatchOpts{
 		TournamentIDs: derefStringSlice(request.Body.TournamentIds),
+		Teams:         teams,
+		Cooperative:   coop,
 	}
 	// A non-nil calculator_kind in the body means "set/replace"; a body that
 	// explicitly sends calculator_kind: null means "clear". Because the field
@@ -309,7 +463,7 @@ func (s *StrictServer) UpdateMatch(ctx context.Context, request UpdateMatchReque
 		opts.Calculator = calc
 	}
 
-	_, err = s.api.MatchService.UpdateMatch(ctx, request.Id, gameID, playerScores, request.Body.Date, opts)
+	_, err = s.api.MatchService.UpdateMatch(auditCtx(ctx), request.Id, gameID, playerScores, request.Body.Date, opts)
 	if err != nil {
 		switch domainStatusCode(err) {
 		case http.StatusBadRequest:
@@ -325,3 +479,17 @@ func (s *StrictServer) UpdateMatch(ctx context.Context, request UpdateMatchReque
 
 	return UpdateMatch200JSONResponse{Status: "success", Message: "Match is updated"}, nil
 }
+
+func (s *StrictServer) DeleteMatch(ctx context.Context, request DeleteMatchRequestObject) (DeleteMatchResponseObject, error) {
+	if err := s.api.MatchService.DeleteMatch(auditCtx(ctx), request.Id); err != nil {
+		switch domainStatusCode(err) {
+		case http.StatusNotFound:
+			return DeleteMatch404JSONResponse{Status: "fail", Message: err.Error()}, nil
+		case http.StatusConflict:
+			return DeleteMatch409JSONResponse{Status: "fail", Message: err.Error()}, nil
+		default:
+			return nil, err
+		}
+	}
+	return DeleteMatch200JSONResponse{Status: "success", Message: "Match is deleted"}, nil
+}
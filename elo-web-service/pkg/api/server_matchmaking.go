@@ -0,0 +1,49 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/tolyandre/elo-web-service/pkg/elo"
+)
+
+func (s *StrictServer) SuggestTables(ctx context.Context, request SuggestTablesRequestObject) (SuggestTablesResponseObject, error) {
+	body := request.Body
+	req := elo.MatchmakingRequest{
+		PlayerIDs:    body.PlayerIds,
+		Objective:    elo.MatchmakingObjective(body.Objective),
+		ClubID:       body.ClubId,
+		TournamentID: body.TournamentId,
+	}
+	for _, t := range body.Tables {
+		req.Tables = append(req.Tables, elo.MatchmakingTable{GameID: t.GameId, MinPlayers: t.MinPlayers, MaxPlayers: t.MaxPlayers})
+	}
+
+	suggestions, err := s.api.MatchmakingService.SuggestTables(ctx, req)
+	if err != nil {
+		switch domainStatusCode(err) {
+		case http.StatusBadRequest:
+			return SuggestTables400JSONResponse{Status: "fail", Message: err.Error()}, nil
+		case http.StatusNotFound:
+			return SuggestTables404JSONResponse{Status: "fail", Message: "player, game, club or tournament not found"}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	data := make([]TableSuggestion, 0, len(suggestions))
+	for _, t := range suggestions {
+		players := make([]PlayerPrediction, 0, len(t.Players))
+		for _, p := range t.Players {
+			players = append(players, playerPredictionToAPI(p))
+		}
+		data = append(data, TableSuggestion{
+			GameId:         t.GameID,
+			Players:        players,
+			Balance:        t.Balance,
+			NewPairings:    t.NewPairings,
+			RepeatPairings: t.RepeatPairings,
+		})
+	}
+	return SuggestTables200JSONResponse{Status: "success", Data: data}, nil
+}
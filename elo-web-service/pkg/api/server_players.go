@@ -2,11 +2,16 @@ package api
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"time"
+
+	"github.com/tolyandre/elo-web-service/pkg/elo"
 )
 
-func (s *StrictServer) ListPlayers(ctx context.Context, _ ListPlayersRequestObject) (ListPlayersResponseObject, error) {
+func (s *StrictServer) ListPlayers(ctx context.Context, request ListPlayersRequestObject) (ListPlayersResponseObject, error) {
+	includeGuests := request.Params.IncludeGuests != nil && *request.Params.IncludeGuests
+
 	now := time.Now()
 	tDay := now.Add(-time.Hour * 12)
 	tWeek := now.Add(-time.Hour * (24*7 - 12))
@@ -48,6 +53,9 @@ func (s *StrictServer) ListPlayers(ctx context.Context, _ ListPlayersRequestObje
 
 	result := make([]Player, 0, len(actualPlayers))
 	for _, p := range actualPlayers {
+		if p.Guest && !includeGuests {
+			continue
+		}
 		dayAgo := findPlayer(dayAgoPlayers, p.ID)
 		weekAgo := findPlayer(weekAgoPlayers, p.ID)
 
@@ -81,6 +89,7 @@ func (s *StrictServer) ListPlayers(ctx context.Context, _ ListPlayersRequestObje
 			Name:          p.Name,
 			GeologistName: geologistName,
 			UserId:        userID,
+			IsGuest:       p.Guest,
 			Rank: HistoryRank{
 				Now: EloRank{
 					Rating:                    p.Elo,
@@ -113,7 +122,8 @@ func (s *StrictServer) CreatePlayer(ctx context.Context, request CreatePlayerReq
 		return CreatePlayer400JSONResponse{Status: "fail", Message: "name is required"}, nil
 	}
 
-	player, err := s.api.PlayerService.CreatePlayer(ctx, request.Body.Id, name)
+	guest := request.Body.IsGuest != nil && *request.Body.IsGuest
+	player, err := s.api.PlayerService.CreatePlayer(ctx, request.Body.Id, name, guest)
 	if err != nil {
 		if domainStatusCode(err) == http.StatusConflict {
 			return CreatePlayer409JSONResponse{Status: "fail", Message: "player with this name already exists"}, nil
@@ -136,9 +146,11 @@ func (s *StrictServer) PatchPlayer(ctx context.Context, request PatchPlayerReque
 		return PatchPlayer400JSONResponse{Status: "fail", Message: "name is required"}, nil
 	}
 
-	player, err := s.api.PlayerService.UpdatePlayer(ctx, request.Id, name)
+	player, err := s.api.MatchService.UpdatePlayer(auditCtx(ctx), request.Id, name, request.Body.IsGuest)
 	switch {
 	case err == nil:
+	case errors.Is(err, elo.ErrGuestHasStakes):
+		return PatchPlayer409JSONResponse{Status: "fail", Message: err.Error()}, nil
 	case domainStatusCode(err) == http.StatusNotFound:
 		return PatchPlayer404JSONResponse{Status: "fail", Message: "player not found"}, nil
 	case domainStatusCode(err) == http.StatusConflict:
@@ -171,6 +183,33 @@ func (s *StrictServer) DeletePlayer(ctx context.Context, request DeletePlayerReq
 	return DeletePlayer200JSONResponse{Status: "success", Message: "Player deleted"}, nil
 }
 
+func (s *StrictServer) MergePlayer(ctx context.Context, request MergePlayerRequestObject) (MergePlayerResponseObject, error) {
+	if request.Body.DuplicateId == "" {
+		return MergePlayer400JSONResponse{Status: "fail", Message: "duplicate_id is required"}, nil
+	}
+
+	player, err := s.api.MatchService.MergePlayers(auditCtx(ctx), request.Id, request.Body.DuplicateId)
+	switch {
+	case err == nil:
+	case domainStatusCode(err) == http.StatusBadRequest:
+		return MergePlayer400JSONResponse{Status: "fail", Message: err.Error()}, nil
+	case domainStatusCode(err) == http.StatusNotFound:
+		return MergePlayer404JSONResponse{Status: "fail", Message: "player not found"}, nil
+	case domainStatusCode(err) == http.StatusConflict:
+		return MergePlayer409JSONResponse{Status: "fail", Message: err.Error()}, nil
+	default:
+		return nil, err
+	}
+
+	return MergePlayer200JSONResponse{
+		Status: "success",
+		Data: PlayerRef{
+			Id:   player.ID,
+			Name: player.Name,
+		},
+	}, nil
+}
+
 func (s *StrictServer) GetPlayerStats(ctx context.Context, request GetPlayerStatsRequestObject) (GetPlayerStatsResponseObject, error) {
 	playerID := request.Id
 
@@ -246,6 +285,52 @@ func (s *StrictServer) GetPlayerStats(ctx context.Context, request GetPlayerStat
 		})
 	}
 
+	standings, err := s.api.ArenaService.GetPlayerStandings(ctx, playerID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	arenaLeagues := make([]PlayerArenaLeague, 0, len(standings))
+	for _, st := range standings {
+		entry := PlayerArenaLeague{
+			ArenaId:                st.ArenaID,
+			ArenaName:              st.ArenaName,
+			Elo:                    st.Elo,
+			Cubes:                  st.Cubes,
+			Meeples:                st.Meeples,
+			MatchesPlayed:          st.MatchesPlayed,
+			LastMatchDate:          st.LastMatchDate,
+			NextLeagueRequirements: make([]LeagueRequirement, 0, len(st.Requirements)),
+		}
+		if st.League != "" {
+			entry.League = &st.League
+		}
+		if st.NextLeague != "" {
+			entry.NextLeague = &st.NextLeague
+		}
+		for _, r := range st.Requirements {
+			req := LeagueRequirement{Kind: r.Kind, Min: r.Min, Current: r.Current, Met: r.Met}
+			if r.Days > 0 {
+				req.Days = &r.Days
+			}
+			entry.NextLeagueRequirements = append(entry.NextLeagueRequirements, req)
+		}
+		arenaLeagues = append(arenaLeagues, entry)
+	}
+
+	achievementRows, err := s.api.PlayerService.ListAchievements(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	achievements := make([]PlayerAchievement, 0, len(achievementRows))
+	for _, a := range achievementRows {
+		achievements = append(achievements, PlayerAchievement{
+			Kind:       PlayerAchievementKind(a.Kind),
+			UnlockedAt: a.UnlockedAt,
+			MatchId:    a.MatchID,
+			MarketId:   a.MarketID,
+		})
+	}
+
 	return GetPlayerStats200JSONResponse{
 		Status: "success",
 		Data: PlayerStats{
@@ -254,6 +339,129 @@ func (s *StrictServer) GetPlayerStats(ctx context.Context, request GetPlayerStat
 			TopGamesByMatches:     topGamesByMatches,
 			TopGamesByEloEarned:   topGamesByElo,
 			WorstGamesByEloEarned: worstGamesByElo,
+			ArenaLeagues:          arenaLeagues,
+			Achievements:          achievements,
 		},
 	}, nil
 }
+
+func (s *StrictServer) GetPlayerHeadToHead(ctx context.Context, request GetPlayerHeadToHeadRequestObject) (GetPlayerHeadToHeadResponseObject, error) {
+	h, err := s.api.PlayerService.HeadToHead(ctx, request.Id, request.OtherId)
+	switch {
+	case err == nil:
+	case domainStatusCode(err) == http.StatusBadRequest:
+		return GetPlayerHeadToHead400JSONResponse{Status: "fail", Message: err.Error()}, nil
+	case domainStatusCode(err) == http.StatusNotFound:
+		return GetPlayerHeadToHead404JSONResponse{Status: "fail", Message: "player not found"}, nil
+	default:
+		return nil, err
+	}
+
+	data := HeadToHead{
+		PlayerId:        h.PlayerID,
+		OtherId:         h.OtherID,
+		Matches:         h.Matches,
+		PlayerAbove:     h.PlayerAbove,
+		OtherAbove:      h.OtherAbove,
+		Ties:            h.Ties,
+		RatingExchanged: h.RatingExchanged,
+		Streak:          HeadToHeadStreak{LeaderId: h.Streak.LeaderID, Length: h.Streak.Length},
+		Games:           make([]HeadToHeadGame, 0, len(h.Games)),
+	}
+	for _, g := range h.Games {
+		data.Games = append(data.Games, HeadToHeadGame{
+			GameId:          g.GameID,
+			GameName:        g.GameName,
+			Matches:         g.Matches,
+			PlayerAbove:     g.PlayerAbove,
+			OtherAbove:      g.OtherAbove,
+			Ties:            g.Ties,
+			RatingExchanged: g.RatingExchanged,
+			Streak:          HeadToHeadStreak{LeaderId: g.Streak.LeaderID, Length: g.Streak.Length},
+		})
+	}
+	return GetPlayerHeadToHead200JSONResponse{Status: "success", Data: data}, nil
+}
+
+func (s *StrictServer) GetPlayerRivals(ctx context.Context, request GetPlayerRivalsRequestObject) (GetPlayerRivalsResponseObject, error) {
+	rivals, err := s.api.PlayerService.Rivals(ctx, request.Id)
+	switch {
+	case err == nil:
+	case domainStatusCode(err) == http.StatusNotFound:
+		return GetPlayerRivals404JSONResponse{Status: "fail", Message: "player not found"}, nil
+	default:
+		return nil, err
+	}
+
+	data := Rivals{Opponents: make([]Opponent, 0, len(rivals.Opponents))}
+	for _, o := range rivals.Opponents {
+		data.Opponents = append(data.Opponents, opponentToAPI(o))
+	}
+	if rivals.Nemesis != nil {
+		nemesis := opponentToAPI(*rivals.Nemesis)
+		data.Nemesis = &nemesis
+	}
+	return GetPlayerRivals200JSONResponse{Status: "success", Data: data}, nil
+}
+
+func opponentToAPI(o elo.Opponent) Opponent {
+	return Opponent{
+		PlayerId:      o.PlayerID,
+		Name:          o.Name,
+		Matches:       o.Matches,
+		PlayerAbove:   o.PlayerAbove,
+		OpponentAbove: o.OpponentAbove,
+	}
+}
+
+func (s *StrictServer) GetLeaderboard(ctx context.Context, request GetLeaderboardRequestObject) (GetLeaderboardResponseObject, error) {
+	params := request.Params
+	at := time.Now()
+	if params.At != nil {
+		at = *params.At
+	}
+	query := elo.LeaderboardQuery{ClubID: params.ClubId}
+	if params.GameId != nil {
+		query.GameID = *params.GameId
+	}
+
+	var entries []elo.LeaderboardEntry
+	var err error
+	if params.Since != nil {
+		entries, err = s.api.PlayerService.LeaderboardDiff(ctx, *params.Since, at, query)
+	} else {
+		entries, err = s.api.PlayerService.Leaderboard(ctx, at, query)
+	}
+	switch {
+	case err == nil:
+	case domainStatusCode(err) == http.StatusBadRequest:
+		return GetLeaderboard400JSONResponse{Status: "fail", Message: err.Error()}, nil
+	case domainStatusCode(err) == http.StatusNotFound:
+		return GetLeaderboard404JSONResponse{Status: "fail", Message: "game or club not found"}, nil
+	default:
+		return nil, err
+	}
+
+	return GetLeaderboard200JSONResponse{Status: "success", Data: leaderboardEntries(entries)}, nil
+}
+
+func leaderboardEntries(entries []elo.LeaderboardEntry) []LeaderboardEntry {
+	result := make([]LeaderboardEntry, 0, len(entries))
+	for _, e := range entries {
+		entry := LeaderboardEntry{
+			PlayerId:       e.PlayerID,
+			Name:           e.Name,
+			Rank:           e.Rank,
+			Rating:         e.Rating,
+			League:         e.League,
+			PreviousRank:   e.PreviousRank,
+			PreviousRating: e.PreviousRating,
+		}
+		if e.PreviousRating != nil {
+			diff := e.Rating - *e.PreviousRating
+			entry.RatingDiff = &diff
+		}
+		result = append(result, entry)
+	}
+	return result
+}
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/tolyandre/elo-web-service/pkg/elo"
+)
+
+func (s *StrictServer) GetRecords(ctx context.Context, request GetRecordsRequestObject) (GetRecordsResponseObject, error) {
+	records, err := s.api.RecordsService.Records(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scores := make([]GameScoreRecords, 0, len(records.Scores))
+	for _, g := range records.Scores {
+		scores = append(scores, gameScoreRecordsToAPI(g))
+	}
+	return GetRecords200JSONResponse{Status: "success", Data: Records{
+		Scores:              scores,
+		LargestRatingGain:   recordToAPI(records.LargestRatingGain),
+		LongestWinStreak:    winStreakRecordToAPI(records.LongestWinStreak),
+		MostMatchesInDay:    recordToAPI(records.MostMatchesInDay),
+		BiggestMarketPayout: recordToAPI(records.BiggestMarketPayout),
+	}}, nil
+}
+
+func (s *StrictServer) GetGameRecords(ctx context.Context, request GetGameRecordsRequestObject) (GetGameRecordsResponseObject, error) {
+	records, err := s.api.RecordsService.GameRecords(ctx, request.Id)
+	if err != nil {
+		if domainStatusCode(err) == http.StatusNotFound {
+			return GetGameRecords404JSONResponse{Status: "fail", Message: "game not found"}, nil
+		}
+		return nil, err
+	}
+
+	data := GameRecords{
+		GameId:            records.GameID,
+		GameName:          records.GameName,
+		LargestRatingGain: recordToAPI(records.LargestRatingGain),
+		LongestWinStreak:  winStreakRecordToAPI(records.LongestWinStreak),
+		MostMatchesInDay:  recordToAPI(records.MostMatchesInDay),
+	}
+	if records.Scores != nil {
+		scores := gameScoreRecordsToAPI(*records.Scores)
+		data.Scores = &scores
+	}
+	return GetGameRecords200JSONResponse{Status: "success", Data: data}, nil
+}
+
+func gameScoreRecordsToAPI(g elo.GameScoreRecords) GameScoreRecords {
+	return GameScoreRecords{
+		GameId:    g.GameID,
+		GameName:  g.GameName,
+		HighScore: *recordToAPI(&g.HighScore),
+		LowScore:  *recordToAPI(&g.LowScore),
+	}
+}
+
+func recordToAPI(r *elo.Record) *Record {
+	if r == nil {
+		return nil
+	}
+	return &Record{
+		PlayerId:   r.PlayerID,
+		PlayerName: r.PlayerName,
+		Value:      r.Value,
+		Date:       r.Date,
+		MatchId:    r.MatchID,
+		MarketId:   r.MarketID,
+	}
+}
+
+func winStreakRecordToAPI(r *elo.WinStreakRecord) *WinStreakRecord {
+	if r == nil {
+		return nil
+	}
+	return &WinStreakRecord{
+		PlayerId:    r.PlayerID,
+		PlayerName:  r.PlayerName,
+		Length:      r.Length,
+		StartedAt:   r.StartedAt,
+		EndedAt:     r.EndedAt,
+		LastMatchId: r.LastMatchID,
+	}
+}
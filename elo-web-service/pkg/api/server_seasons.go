@@ -0,0 +1,136 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+func seasonFromDB(season db.Season, champions []db.ListSeasonChampionsRow) Season {
+	s := Season{
+		Id:        season.ID,
+		Name:      season.Name,
+		StartsAt:  season.StartsAt,
+		EndsAt:    season.EndsAt,
+		Champions: []SeasonChampion{},
+	}
+	if season.ResetFactor.Valid {
+		f := season.ResetFactor.Float64
+		s.ResetFactor = &f
+	}
+	if season.ArchivedAt.Valid {
+		t := season.ArchivedAt.Time
+		s.ArchivedAt = &t
+	}
+	for _, c := range champions {
+		if c.SeasonID != season.ID {
+			continue
+		}
+		s.Champions = append(s.Champions, SeasonChampion{
+			GameId:   c.GameID,
+			GameName: textPtr(c.GameName),
+			PlayerId: c.PlayerID,
+			Name:     c.PlayerName,
+			Rating:   c.Rating,
+		})
+	}
+	return s
+}
+
+func (s *StrictServer) ListSeasons(ctx context.Context, _ ListSeasonsRequestObject) (ListSeasonsResponseObject, error) {
+	seasons, err := s.api.SeasonService.ListSeasons(ctx)
+	if err != nil {
+		return nil, err
+	}
+	champions, err := s.api.SeasonService.ListSeasonChampions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Season, 0, len(seasons))
+	for _, season := range seasons {
+		result = append(result, seasonFromDB(season, champions))
+	}
+	return ListSeasons200JSONResponse{Status: "success", Data: result}, nil
+}
+
+func (s *StrictServer) CreateSeason(ctx context.Context, request CreateSeasonRequestObject) (CreateSeasonResponseObject, error) {
+	body := request.Body
+	if body.Name == "" {
+		return CreateSeason400JSONResponse{Status: "fail", Message: "name is required"}, nil
+	}
+	arg := db.CreateSeasonParams{
+		ID:       body.Id,
+		Name:     body.Name,
+		StartsAt: body.StartsAt,
+		EndsAt:   body.EndsAt,
+	}
+	if body.ResetFactor != nil {
+		arg.ResetFactor = pgtype.Float8{Float64: *body.ResetFactor, Valid: true}
+	}
+
+	season, err := s.api.SeasonService.CreateSeason(auditCtx(ctx), arg)
+	switch {
+	case err == nil:
+	case domainStatusCode(err) == http.StatusBadRequest:
+		return CreateSeason400JSONResponse{Status: "fail", Message: err.Error()}, nil
+	case domainStatusCode(err) == http.StatusConflict:
+		return CreateSeason409JSONResponse{Status: "fail", Message: "season overlaps another one or its name is taken"}, nil
+	default:
+		return nil, err
+	}
+	return CreateSeason200JSONResponse{Status: "success", Data: seasonFromDB(season, nil)}, nil
+}
+
+func (s *StrictServer) DeleteSeason(ctx context.Context, request DeleteSeasonRequestObject) (DeleteSeasonResponseObject, error) {
+	_, err := s.api.SeasonService.DeleteSeason(auditCtx(ctx), request.Id)
+	switch {
+	case err == nil:
+	case domainStatusCode(err) == http.StatusNotFound:
+		return DeleteSeason404JSONResponse{Status: "fail", Message: "season not found"}, nil
+	case domainStatusCode(err) == http.StatusConflict:
+		return DeleteSeason409JSONResponse{Status: "fail", Message: err.Error()}, nil
+	default:
+		return nil, err
+	}
+	return DeleteSeason200JSONResponse{Status: "success", Message: "Season deleted"}, nil
+}
+
+func (s *StrictServer) GetSeasonLeaderboard(ctx context.Context, request GetSeasonLeaderboardRequestObject) (GetSeasonLeaderboardResponseObject, error) {
+	gameID := ""
+	if request.Params.GameId != nil {
+		gameID = *request.Params.GameId
+	}
+	entries, archived, err := s.api.SeasonService.SeasonLeaderboard(ctx, request.Id, gameID)
+	switch {
+	case err == nil:
+	case domainStatusCode(err) == http.StatusNotFound:
+		return GetSeasonLeaderboard404JSONResponse{Status: "fail", Message: "season or game not found"}, nil
+	default:
+		return nil, err
+	}
+	return GetSeasonLeaderboard200JSONResponse{
+		Status: "success",
+		Data:   SeasonLeaderboard{Archived: archived, Entries: leaderboardEntries(entries)},
+	}, nil
+}
+
+func (s *StrictServer) ArchiveSeason(ctx context.Context, request ArchiveSeasonRequestObject) (ArchiveSeasonResponseObject, error) {
+	season, err := s.api.SeasonService.ArchiveSeason(auditCtx(ctx), request.Id)
+	switch {
+	case err == nil:
+	case domainStatusCode(err) == http.StatusNotFound:
+		return ArchiveSeason404JSONResponse{Status: "fail", Message: "season not found"}, nil
+	case domainStatusCode(err) == http.StatusConflict:
+		return ArchiveSeason409JSONResponse{Status: "fail", Message: err.Error()}, nil
+	default:
+		return nil, err
+	}
+	champions, err := s.api.SeasonService.ListSeasonChampions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ArchiveSeason200JSONResponse{Status: "success", Data: seasonFromDB(season, champions)}, nil
+}
@@ -2,10 +2,12 @@ package api
 
 import (
 	"context"
+	"net/http"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/tolyandre/elo-web-service/pkg/db"
+	"github.com/tolyandre/elo-web-service/pkg/elo"
 )
 
 func (s *StrictServer) GetSettings(ctx context.Context, _ GetSettingsRequestObject) (GetSettingsResponseObject, error) {
@@ -28,6 +30,10 @@ func (s *StrictServer) GetSettings(ctx context.Context, _ GetSettingsRequestObje
 			StartingRatingGameArena:   settings.StartingRatingGameArena,
 			EliteLeagueMatches6months: int(settings.EliteLeagueMatches6months),
 			EliteLeagueMatches2months: int(settings.EliteLeagueMatches2months),
+			GlobalArenaAlgorithm:      RatingAlgorithm(settings.GlobalArenaAlgorithm),
+			GameArenaAlgorithm:        RatingAlgorithm(settings.GameArenaAlgorithm),
+			InactivityDecayDays:       int(settings.InactivityDecayDays),
+			InactivityDecayRate:       settings.InactivityDecayRate,
 		},
 	}, nil
 }
@@ -52,6 +58,11 @@ func (s *StrictServer) ListAllSettings(ctx context.Context, _ ListAllSettingsReq
 			EloConstD:     r.EloConstD,
 			StartingElo:   r.StartingElo,
 			WinReward:     r.WinReward,
+
+			GlobalArenaAlgorithm: RatingAlgorithm(r.GlobalArenaAlgorithm),
+			GameArenaAlgorithm:   RatingAlgorithm(r.GameArenaAlgorithm),
+			InactivityDecayDays:  int(r.InactivityDecayDays),
+			InactivityDecayRate:  r.InactivityDecayRate,
 		})
 	}
 
@@ -68,14 +79,42 @@ func (s *StrictServer) CreateSettings(ctx context.Context, request CreateSetting
 		return CreateSettings400JSONResponse{Status: "fail", Message: "effective_date must be in the future"}, nil
 	}
 
-	// Preserve league-related fields not exposed in the admin UI by copying from the newest
+	// Preserve league and rating-algorithm parameters not exposed in the admin UI by copying from the newest
 	// settings row overall (including any future-scheduled entry).
 	latest, err := s.api.EloSettingsService.GetLatest(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.api.EloSettingsService.Create(ctx, db.CreateEloSettingsParams{
+	// Algorithms not given are kept from the newest entry as well.
+	globalAlgorithm, gameAlgorithm := latest.GlobalArenaAlgorithm, latest.GameArenaAlgorithm
+	if payload.GlobalArenaAlgorithm != nil {
+		globalAlgorithm = string(*payload.GlobalArenaAlgorithm)
+	}
+	if payload.GameArenaAlgorithm != nil {
+		gameAlgorithm = string(*payload.GameArenaAlgorithm)
+	}
+	for _, name := range []string{globalAlgorithm, gameAlgorithm} {
+		if err := elo.ValidateRatingAlgorithm(name); err != nil {
+			return CreateSettings400JSONResponse{Status: "fail", Message: err.Error()}, nil
+		}
+	}
+
+	decayDays, decayRate := latest.InactivityDecayDays, latest.InactivityDecayRate
+	if payload.InactivityDecayDays != nil {
+		if *payload.InactivityDecayDays < 0 {
+			return CreateSettings400JSONResponse{Status: "fail", Message: "inactivity_decay_days must not be negative"}, nil
+		}
+		decayDays = int32(*payload.InactivityDecayDays)
+	}
+	if payload.InactivityDecayRate != nil {
+		if *payload.InactivityDecayRate < 0 || *payload.InactivityDecayRate > 1 {
+			return CreateSettings400JSONResponse{Status: "fail", Message: "inactivity_decay_rate must be between 0 and 1"}, nil
+		}
+		decayRate = *payload.InactivityDecayRate
+	}
+
+	err = s.api.EloSettingsService.Create(auditCtx(ctx), db.CreateEloSettingsParams{
 		EffectiveDate:             pgtype.Timestamptz{Time: payload.EffectiveDate, Valid: true},
 		EloConstK:                 payload.EloConstK,
 		EloConstD:                 payload.EloConstD,
@@ -89,6 +128,17 @@ func (s *StrictServer) CreateSettings(ctx context.Context, request CreateSetting
 		StartingRatingGameArena:   latest.StartingRatingGameArena,
 		EliteLeagueMatches6months: latest.EliteLeagueMatches6months,
 		EliteLeagueMatches2months: latest.EliteLeagueMatches2months,
+		GlobalArenaAlgorithm:      globalAlgorithm,
+		GameArenaAlgorithm:        gameAlgorithm,
+		Glicko2StartingDeviation:  latest.Glicko2StartingDeviation,
+		Glicko2StartingVolatility: latest.Glicko2StartingVolatility,
+		Glicko2Tau:                latest.Glicko2Tau,
+		TrueskillStartingSigma:    latest.TrueskillStartingSigma,
+		TrueskillBeta:             latest.TrueskillBeta,
+		TrueskillTau:              latest.TrueskillTau,
+		TrueskillDrawProbability:  latest.TrueskillDrawProbability,
+		InactivityDecayDays:       decayDays,
+		InactivityDecayRate:       decayRate,
 	})
 	if err != nil {
 		return nil, err
@@ -103,10 +153,97 @@ func (s *StrictServer) DeleteSettings(ctx context.Context, request DeleteSetting
 		return DeleteSettings400JSONResponse{Status: "fail", Message: "can only delete future settings"}, nil
 	}
 
-	err := s.api.EloSettingsService.Delete(ctx, pgtype.Timestamptz{Time: effectiveDate, Valid: true})
+	err := s.api.EloSettingsService.Delete(auditCtx(ctx), pgtype.Timestamptz{Time: effectiveDate, Valid: true})
 	if err != nil {
 		return nil, err
 	}
 
 	return DeleteSettings200JSONResponse{Status: "success", Message: "Settings deleted"}, nil
 }
+
+func (s *StrictServer) SimulateSettings(ctx context.Context, request SimulateSettingsRequestObject) (SimulateSettingsResponseObject, error) {
+	row, err := s.api.EloSettingsService.GetForDate(ctx, pgtype.Timestamptz{Time: time.Now(), Valid: true})
+	if err != nil {
+		return nil, err
+	}
+	proposed := proposedEloSettings(elo.EloSettingsFromDB(row), *request.Body)
+
+	sim, err := s.api.MatchService.SimulateEloSettings(ctx, proposed)
+	if err != nil {
+		switch domainStatusCode(err) {
+		case http.StatusBadRequest:
+			return SimulateSettings400JSONResponse{Status: "fail", Message: err.Error()}, nil
+		case http.StatusConflict:
+			return SimulateSettings409JSONResponse{Status: "fail", Message: err.Error()}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	leaderboard := make([]SimulatedLeaderboardEntry, 0, len(sim.Leaderboard))
+	for _, p := range sim.Leaderboard {
+		leaderboard = append(leaderboard, SimulatedLeaderboardEntry{
+			PlayerId:      p.PlayerID,
+			Name:          p.Name,
+			Rank:          p.Rank,
+			Rating:        p.Rating,
+			League:        p.League,
+			CurrentRank:   p.CurrentRank,
+			CurrentRating: p.CurrentRating,
+			CurrentLeague: p.CurrentLeague,
+			RatingDiff:    p.Rating - p.CurrentRating,
+		})
+	}
+	return SimulateSettings200JSONResponse{
+		Status: "success",
+		Data: SettingsSimulation{
+			Leaderboard:     leaderboard,
+			CurrentMetrics:  predictionMetricsToAPI(sim.Current),
+			ProposedMetrics: predictionMetricsToAPI(sim.Proposed),
+		},
+	}, nil
+}
+
+// proposedEloSettings overlays the fields given in a proposal on base.
+func proposedEloSettings(base elo.EloSettings, p SettingsProposal) elo.EloSettings {
+	setFloat := func(dst *float64, v *float64) {
+		if v != nil {
+			*dst = *v
+		}
+	}
+	setInt := func(dst *int, v *int) {
+		if v != nil {
+			*dst = *v
+		}
+	}
+	setFloat(&base.K, p.EloConstK)
+	setFloat(&base.D, p.EloConstD)
+	setFloat(&base.StartingElo, p.StartingElo)
+	setFloat(&base.WinReward, p.WinReward)
+	setFloat(&base.NewbieLeagueEarnedMin, p.NewbieLeagueEarnedMin)
+	setFloat(&base.NewbieLeagueEarnedMax, p.NewbieLeagueEarnedMax)
+	setFloat(&base.NewbieLeagueEarnedTau, p.NewbieLeagueEarnedTau)
+	setFloat(&base.NewbieLeagueGoalGap, p.NewbieLeagueGoalGap)
+	setFloat(&base.StartingRatingGlobal, p.StartingRatingGlobalArena)
+	setFloat(&base.StartingRatingGame, p.StartingRatingGameArena)
+	setInt(&base.EliteMatches6M, p.EliteLeagueMatches6months)
+	setInt(&base.EliteMatches2M, p.EliteLeagueMatches2months)
+	setInt(&base.InactivityDecayDays, p.InactivityDecayDays)
+	setFloat(&base.InactivityDecayRate, p.InactivityDecayRate)
+	if p.GlobalArenaAlgorithm != nil {
+		base.GlobalAlgorithm = string(*p.GlobalArenaAlgorithm)
+	}
+	if p.GameArenaAlgorithm != nil {
+		base.GameAlgorithm = string(*p.GameArenaAlgorithm)
+	}
+	return base
+}
+
+func predictionMetricsToAPI(m elo.PredictionMetrics) PredictionMetrics {
+	return PredictionMetrics{
+		Matches:          m.Matches,
+		Pairs:            m.Pairs,
+		PairwiseAccuracy: m.PairwiseAccuracy,
+		MeanSquaredError: m.MeanSquaredError,
+	}
+}
@@ -77,7 +77,7 @@ func (s *StrictServer) CreateTournament(ctx context.Context, request CreateTourn
 
 	playerIDs := tournamentPlayerIDs(request.Body.PlayerIds)
 
-	tournament, err := s.api.TournamentService.CreateTournament(ctx, request.Body.Id, request.Body.Name, request.Body.StartDate, request.Body.EndDate, playerIDs)
+	tournament, err := s.api.TournamentService.CreateTournament(auditCtx(ctx), request.Body.Id, request.Body.Name, request.Body.StartDate, request.Body.EndDate, playerIDs)
 	if err != nil {
 		if domainStatusCode(err) == http.StatusConflict {
 			return CreateTournament409JSONResponse{Status: "fail", Message: "tournament with this name already exists"}, nil
@@ -98,7 +98,7 @@ func (s *StrictServer) UpdateTournament(ctx context.Context, request UpdateTourn
 
 	playerIDs := tournamentPlayerIDs(request.Body.PlayerIds)
 
-	tournament, err := s.api.TournamentService.UpdateTournament(ctx, request.Id, request.Body.Name, request.Body.StartDate, request.Body.EndDate, playerIDs)
+	tournament, err := s.api.TournamentService.UpdateTournament(auditCtx(ctx), request.Id, request.Body.Name, request.Body.StartDate, request.Body.EndDate, playerIDs)
 	if err != nil {
 		switch domainStatusCode(err) {
 		case http.StatusConflict:
@@ -120,7 +120,7 @@ func (s *StrictServer) UpdateTournament(ctx context.Context, request UpdateTourn
 }
 
 func (s *StrictServer) DeleteTournament(ctx context.Context, request DeleteTournamentRequestObject) (DeleteTournamentResponseObject, error) {
-	_, err := s.api.TournamentService.DeleteTournament(ctx, request.Id)
+	_, err := s.api.TournamentService.DeleteTournament(auditCtx(ctx), request.Id)
 	if err != nil {
 		switch domainStatusCode(err) {
 		case http.StatusConflict:
@@ -17,6 +17,7 @@ func (s *StrictServer) ListUsers(ctx context.Context, _ ListUsersRequestObject)
 			Id:      u.ID,
 			Name:    u.GoogleOauthUserName,
 			CanEdit: u.AllowEditing,
+			IsAdmin: u.IsAdmin,
 		}
 		if u.PlayerID != nil {
 			pid := *u.PlayerID
@@ -45,6 +46,7 @@ func (s *StrictServer) PatchUser(ctx context.Context, request PatchUserRequestOb
 		Id:      user.ID,
 		Name:    user.GoogleOauthUserName,
 		CanEdit: user.AllowEditing,
+		IsAdmin: user.IsAdmin,
 	}
 	if user.PlayerID != nil {
 		pid := *user.PlayerID
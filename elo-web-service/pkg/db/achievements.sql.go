@@ -0,0 +1,373 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: achievements.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deletePlayerAchievement = `-- name: DeletePlayerAchievement :exec
+DELETE FROM player_achievements WHERE player_id = $1 AND kind = $2
+`
+
+type DeletePlayerAchievementParams struct {
+	PlayerID string `json:"player_id"`
+	Kind     string `json:"kind"`
+}
+
+func (q *Queries) DeletePlayerAchievement(ctx context.Context, arg DeletePlayerAchievementParams) error {
+	_, err := q.db.Exec(ctx, deletePlayerAchievement, arg.PlayerID, arg.Kind)
+	return err
+}
+
+const listAchievementGamesBefore = `-- name: ListAchievementGamesBefore :many
+SELECT DISTINCT ms.player_id, m.game_id
+FROM match_scores ms
+JOIN matches m ON m.id = ms.match_id
+WHERE m.date < $1
+  AND ms.player_id IN (
+      SELECT ls.player_id
+      FROM match_scores ls
+      JOIN matches lm ON lm.id = ls.match_id
+      WHERE lm.date >= $1)
+`
+
+type ListAchievementGamesBeforeRow struct {
+	PlayerID string `json:"player_id"`
+	GameID   string `json:"game_id"`
+}
+
+// The games played before @from_date by every player who plays again from
+// @from_date on.
+func (q *Queries) ListAchievementGamesBefore(ctx context.Context, fromDate pgtype.Timestamptz) ([]ListAchievementGamesBeforeRow, error) {
+	rows, err := q.db.Query(ctx, listAchievementGamesBefore, fromDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAchievementGamesBeforeRow{}
+	for rows.Next() {
+		var i ListAchievementGamesBeforeRow
+		if err := rows.Scan(&i.PlayerID, &i.GameID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAchievementGlobalSettlements = `-- name: ListAchievementGlobalSettlements :many
+SELECT gas.player_id, gas.date, gas.rating_after,
+       COALESCE(gas.match_id, mk.resolution_match_id) AS match_id
+FROM global_arena_settlement gas
+LEFT JOIN markets mk ON mk.id = gas.market_id
+WHERE gas.date >= $1
+ORDER BY gas.date, gas.id
+`
+
+type ListAchievementGlobalSettlementsRow struct {
+	PlayerID    string             `json:"player_id"`
+	Date        pgtype.Timestamptz `json:"date"`
+	RatingAfter float64            `json:"rating_after"`
+	MatchID     *string            `json:"match_id"`
+}
+
+// Global arena settlements from @from_date on in event order, for the
+// standing before every evaluated match. A market settlement carries the
+// match that resolved the market, if any.
+func (q *Queries) ListAchievementGlobalSettlements(ctx context.Context, fromDate pgtype.Timestamptz) ([]ListAchievementGlobalSettlementsRow, error) {
+	rows, err := q.db.Query(ctx, listAchievementGlobalSettlements, fromDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAchievementGlobalSettlementsRow{}
+	for rows.Next() {
+		var i ListAchievementGlobalSettlementsRow
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.Date,
+			&i.RatingAfter,
+			&i.MatchID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAchievementMatchScores = `-- name: ListAchievementMatchScores :many
+SELECT ms.match_id, m.date, m.game_id, g.scoring_direction, g.result_type,
+       m.cooperative_result, ms.player_id, ms.score, ms.team, p.is_guest
+FROM match_scores ms
+JOIN matches m ON m.id = ms.match_id
+JOIN games g ON g.id = m.game_id
+JOIN players p ON p.id = ms.player_id
+WHERE ($1::uuid IS NULL OR m.game_id = $1::uuid)
+  AND (m.date >= $2
+       OR ms.match_id IN (
+       SELECT recent.match_id
+       FROM (
+           SELECT rs.match_id,
+                  ROW_NUMBER() OVER (PARTITION BY rs.player_id ORDER BY rm.date DESC, rm.id DESC) AS n
+           FROM match_scores rs
+           JOIN matches rm ON rm.id = rs.match_id
+           WHERE rm.date < $2
+             AND rm.cooperative_result IS NULL
+             AND rs.player_id IN (
+                 SELECT ls.player_id
+                 FROM match_scores ls
+                 JOIN matches lm ON lm.id = ls.match_id
+                 WHERE lm.date >= $2)
+       ) recent
+       WHERE recent.n <= $3::int))
+ORDER BY m.date, m.id, ms.player_id
+`
+
+type ListAchievementMatchScoresParams struct {
+	GameID       *string            `json:"game_id"`
+	FromDate     pgtype.Timestamptz `json:"from_date"`
+	StreakWindow int32              `json:"streak_window"`
+}
+
+type ListAchievementMatchScoresRow struct {
+	MatchID           string             `json:"match_id"`
+	Date              pgtype.Timestamptz `json:"date"`
+	GameID            string             `json:"game_id"`
+	ScoringDirection  string             `json:"scoring_direction"`
+	ResultType        string             `json:"result_type"`
+	CooperativeResult pgtype.Text        `json:"cooperative_result"`
+	PlayerID          string             `json:"player_id"`
+	Score             float64            `json:"score"`
+	Team              pgtype.Text        `json:"team"`
+	IsGuest           bool               `json:"is_guest"`
+}
+
+// Every score of the matches from @from_date on in event order, with the
+// game's scoring rules, the teams and the cooperative result. Earlier
+// competitive matches come along when they are among the last @streak_window
+// of a player who plays again from @from_date on: they carry the win streaks
+// into the evaluated matches. When @game_id is set only that game's
+// matches are listed.
+func (q *Queries) ListAchievementMatchScores(ctx context.Context, arg ListAchievementMatchScoresParams) ([]ListAchievementMatchScoresRow, error) {
+	rows, err := q.db.Query(ctx, listAchievementMatchScores, arg.GameID, arg.FromDate, arg.StreakWindow)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAchievementMatchScoresRow{}
+	for rows.Next() {
+		var i ListAchievementMatchScoresRow
+		if err := rows.Scan(
+			&i.MatchID,
+			&i.Date,
+			&i.GameID,
+			&i.ScoringDirection,
+			&i.ResultType,
+			&i.CooperativeResult,
+			&i.PlayerID,
+			&i.Score,
+			&i.Team,
+			&i.IsGuest,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAchievementRatingsBefore = `-- name: ListAchievementRatingsBefore :many
+SELECT DISTINCT ON (player_id) player_id, rating_after
+FROM global_arena_settlement
+WHERE date < $1
+ORDER BY player_id, date DESC, id DESC
+`
+
+type ListAchievementRatingsBeforeRow struct {
+	PlayerID    string  `json:"player_id"`
+	RatingAfter float64 `json:"rating_after"`
+}
+
+// The latest global rating of every player before @from_date, the standing
+// the evaluated matches start from.
+func (q *Queries) ListAchievementRatingsBefore(ctx context.Context, fromDate pgtype.Timestamptz) ([]ListAchievementRatingsBeforeRow, error) {
+	rows, err := q.db.Query(ctx, listAchievementRatingsBefore, fromDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAchievementRatingsBeforeRow{}
+	for rows.Next() {
+		var i ListAchievementRatingsBeforeRow
+		if err := rows.Scan(&i.PlayerID, &i.RatingAfter); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAllPlayerAchievements = `-- name: ListAllPlayerAchievements :many
+SELECT player_id, kind, unlocked_at, match_id, market_id FROM player_achievements ORDER BY player_id, kind
+`
+
+func (q *Queries) ListAllPlayerAchievements(ctx context.Context) ([]PlayerAchievement, error) {
+	rows, err := q.db.Query(ctx, listAllPlayerAchievements)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PlayerAchievement{}
+	for rows.Next() {
+		var i PlayerAchievement
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.Kind,
+			&i.UnlockedAt,
+			&i.MatchID,
+			&i.MarketID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPlayerAchievements = `-- name: ListPlayerAchievements :many
+SELECT player_id, kind, unlocked_at, match_id, market_id FROM player_achievements WHERE player_id = $1 ORDER BY unlocked_at, kind
+`
+
+func (q *Queries) ListPlayerAchievements(ctx context.Context, playerID string) ([]PlayerAchievement, error) {
+	rows, err := q.db.Query(ctx, listPlayerAchievements, playerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PlayerAchievement{}
+	for rows.Next() {
+		var i PlayerAchievement
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.Kind,
+			&i.UnlockedAt,
+			&i.MatchID,
+			&i.MarketID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSettlementAchievements = `-- name: ListSettlementAchievements :many
+SELECT elite.player_id, 'elite_league'::text AS kind, elite.date, elite.match_id, elite.market_id
+FROM (
+    SELECT DISTINCT ON (gas.player_id) gas.player_id, gas.date,
+           COALESCE(gas.match_id, mk.resolution_match_id) AS match_id, gas.market_id
+    FROM global_arena_settlement gas
+    LEFT JOIN markets mk ON mk.id = gas.market_id
+    WHERE gas.league = 'elite'
+    ORDER BY gas.player_id, gas.date, gas.id
+) elite
+UNION ALL
+SELECT won.player_id, 'market_win'::text AS kind, won.date, won.match_id, won.market_id
+FROM (
+    SELECT DISTINCT ON (gas.player_id) gas.player_id, gas.date,
+           COALESCE(gas.match_id, mk.resolution_match_id) AS match_id, gas.market_id
+    FROM global_arena_settlement gas
+    LEFT JOIN markets mk ON mk.id = gas.market_id
+    WHERE gas.discriminator = 'market' AND gas.rating_earned + gas.rating_staked > 0
+    ORDER BY gas.player_id, gas.date, gas.id
+) won
+`
+
+type ListSettlementAchievementsRow struct {
+	PlayerID string             `json:"player_id"`
+	Kind     string             `json:"kind"`
+	Date     pgtype.Timestamptz `json:"date"`
+	MatchID  *string            `json:"match_id"`
+	MarketID *string            `json:"market_id"`
+}
+
+// The first settlement of every player that reached the elite league and
+// the first market settlement with a net rating gain. A market can expire by
+// time between two matches, so these are read over the whole history.
+func (q *Queries) ListSettlementAchievements(ctx context.Context) ([]ListSettlementAchievementsRow, error) {
+	rows, err := q.db.Query(ctx, listSettlementAchievements)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListSettlementAchievementsRow{}
+	for rows.Next() {
+		var i ListSettlementAchievementsRow
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.Kind,
+			&i.Date,
+			&i.MatchID,
+			&i.MarketID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertPlayerAchievement = `-- name: UpsertPlayerAchievement :exec
+INSERT INTO player_achievements (player_id, kind, unlocked_at, match_id, market_id)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (player_id, kind) DO UPDATE
+SET unlocked_at = EXCLUDED.unlocked_at, match_id = EXCLUDED.match_id, market_id = EXCLUDED.market_id
+`
+
+type UpsertPlayerAchievementParams struct {
+	PlayerID   string    `json:"player_id"`
+	Kind       string    `json:"kind"`
+	UnlockedAt time.Time `json:"unlocked_at"`
+	MatchID    *string   `json:"match_id"`
+	MarketID   *string   `json:"market_id"`
+}
+
+func (q *Queries) UpsertPlayerAchievement(ctx context.Context, arg UpsertPlayerAchievementParams) error {
+	_, err := q.db.Exec(ctx, upsertPlayerAchievement,
+		arg.PlayerID,
+		arg.Kind,
+		arg.UnlockedAt,
+		arg.MatchID,
+		arg.MarketID,
+	)
+	return err
+}
@@ -0,0 +1,738 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: arenas.sql
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countPlayerArenaMatchesBeforeMatch = `-- name: CountPlayerArenaMatchesBeforeMatch :one
+SELECT COUNT(*)::int
+FROM arena_settlement s
+WHERE s.arena_id = $1
+  AND s.player_id = $2
+  AND s.date >= $3
+  AND (s.date < $4 OR (s.date = $4 AND s.match_id < $5))
+`
+
+type CountPlayerArenaMatchesBeforeMatchParams struct {
+	ArenaID  string             `json:"arena_id"`
+	PlayerID string             `json:"player_id"`
+	Since    pgtype.Timestamptz `json:"since"`
+	Date     pgtype.Timestamptz `json:"date"`
+	MatchID  string             `json:"match_id"`
+}
+
+// Arena matches of the player since @since and before the match.
+func (q *Queries) CountPlayerArenaMatchesBeforeMatch(ctx context.Context, arg CountPlayerArenaMatchesBeforeMatchParams) (int32, error) {
+	row := q.db.QueryRow(ctx, countPlayerArenaMatchesBeforeMatch,
+		arg.ArenaID,
+		arg.PlayerID,
+		arg.Since,
+		arg.Date,
+		arg.MatchID,
+	)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const countPlayerArenaMatchesSince = `-- name: CountPlayerArenaMatchesSince :one
+SELECT COUNT(*)::int
+FROM arena_settlement s
+WHERE s.arena_id = $1 AND s.player_id = $2 AND s.date >= $3
+`
+
+type CountPlayerArenaMatchesSinceParams struct {
+	ArenaID  string             `json:"arena_id"`
+	PlayerID string             `json:"player_id"`
+	Since    pgtype.Timestamptz `json:"since"`
+}
+
+func (q *Queries) CountPlayerArenaMatchesSince(ctx context.Context, arg CountPlayerArenaMatchesSinceParams) (int32, error) {
+	row := q.db.QueryRow(ctx, countPlayerArenaMatchesSince, arg.ArenaID, arg.PlayerID, arg.Since)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const createArena = `-- name: CreateArena :one
+INSERT INTO arenas (id, name, game_ids, player_ids, club_id, tournament_id, starts_at, ends_at, league_ladder)
+VALUES (
+    $1,
+    $2,
+    $3::uuid[],
+    $4::uuid[],
+    $5,
+    $6,
+    $7,
+    $8,
+    $9
+)
+RETURNING id, name, game_ids, player_ids, club_id, tournament_id, starts_at, ends_at, league_ladder
+`
+
+type CreateArenaParams struct {
+	ID           string             `json:"id"`
+	Name         string             `json:"name"`
+	GameIds      []string           `json:"game_ids"`
+	PlayerIds    []string           `json:"player_ids"`
+	ClubID       *string            `json:"club_id"`
+	TournamentID *string            `json:"tournament_id"`
+	StartsAt     pgtype.Timestamptz `json:"starts_at"`
+	EndsAt       pgtype.Timestamptz `json:"ends_at"`
+	LeagueLadder json.RawMessage    `json:"league_ladder"`
+}
+
+func (q *Queries) CreateArena(ctx context.Context, arg CreateArenaParams) (Arena, error) {
+	row := q.db.QueryRow(ctx, createArena,
+		arg.ID,
+		arg.Name,
+		arg.GameIds,
+		arg.PlayerIds,
+		arg.ClubID,
+		arg.TournamentID,
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.LeagueLadder,
+	)
+	var i Arena
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.GameIds,
+		&i.PlayerIds,
+		&i.ClubID,
+		&i.TournamentID,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.LeagueLadder,
+	)
+	return i, err
+}
+
+const deleteArena = `-- name: DeleteArena :one
+DELETE FROM arenas
+WHERE id = $1
+RETURNING id, name, game_ids, player_ids, club_id, tournament_id, starts_at, ends_at, league_ladder
+`
+
+func (q *Queries) DeleteArena(ctx context.Context, id string) (Arena, error) {
+	row := q.db.QueryRow(ctx, deleteArena, id)
+	var i Arena
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.GameIds,
+		&i.PlayerIds,
+		&i.ClubID,
+		&i.TournamentID,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.LeagueLadder,
+	)
+	return i, err
+}
+
+const deleteArenaSettlementsByArena = `-- name: DeleteArenaSettlementsByArena :exec
+DELETE FROM arena_settlement
+WHERE arena_id = $1
+`
+
+func (q *Queries) DeleteArenaSettlementsByArena(ctx context.Context, arenaID string) error {
+	_, err := q.db.Exec(ctx, deleteArenaSettlementsByArena, arenaID)
+	return err
+}
+
+const deleteArenaSettlementsByMatch = `-- name: DeleteArenaSettlementsByMatch :exec
+DELETE FROM arena_settlement
+WHERE match_id = $1
+`
+
+func (q *Queries) DeleteArenaSettlementsByMatch(ctx context.Context, matchID string) error {
+	_, err := q.db.Exec(ctx, deleteArenaSettlementsByMatch, matchID)
+	return err
+}
+
+const deleteArenaSettlementsFromDate = `-- name: DeleteArenaSettlementsFromDate :exec
+DELETE FROM arena_settlement
+WHERE date >= $1
+`
+
+// Called next to DeleteAllSettlementsFromDate at the start of RecalculateFrom,
+// so rows of matches moved later in time do not linger in the replayed range.
+func (q *Queries) DeleteArenaSettlementsFromDate(ctx context.Context, date pgtype.Timestamptz) error {
+	_, err := q.db.Exec(ctx, deleteArenaSettlementsFromDate, date)
+	return err
+}
+
+const getArena = `-- name: GetArena :one
+SELECT id, name, game_ids, player_ids, club_id, tournament_id, starts_at, ends_at, league_ladder
+FROM arenas
+WHERE id = $1
+`
+
+func (q *Queries) GetArena(ctx context.Context, id string) (Arena, error) {
+	row := q.db.QueryRow(ctx, getArena, id)
+	var i Arena
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.GameIds,
+		&i.PlayerIds,
+		&i.ClubID,
+		&i.TournamentID,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.LeagueLadder,
+	)
+	return i, err
+}
+
+const getArenaLeaderboard = `-- name: GetArenaLeaderboard :many
+SELECT
+    latest.player_id,
+    p.name AS player_name,
+    latest.elo_after,
+    latest.deviation_after,
+    latest.league,
+    latest.cubes,
+    latest.meeples,
+    latest.date AS last_match_date,
+    counts.matches_played
+FROM (
+    SELECT DISTINCT ON (s.player_id)
+        s.player_id, s.elo_after, s.deviation_after, s.league, s.cubes, s.meeples, s.date
+    FROM arena_settlement s
+    WHERE s.arena_id = $1
+    ORDER BY s.player_id, s.date DESC, s.match_id DESC
+) latest
+JOIN (
+    SELECT s.player_id, COUNT(*)::int AS matches_played
+    FROM arena_settlement s
+    WHERE s.arena_id = $1
+    GROUP BY s.player_id
+) counts ON counts.player_id = latest.player_id
+JOIN players p ON p.id = latest.player_id
+ORDER BY latest.elo_after DESC, latest.player_id
+`
+
+type GetArenaLeaderboardRow struct {
+	PlayerID       string             `json:"player_id"`
+	PlayerName     string             `json:"player_name"`
+	EloAfter       float64            `json:"elo_after"`
+	DeviationAfter pgtype.Float8      `json:"deviation_after"`
+	League         pgtype.Text        `json:"league"`
+	Cubes          float64            `json:"cubes"`
+	Meeples        float64            `json:"meeples"`
+	LastMatchDate  pgtype.Timestamptz `json:"last_match_date"`
+	MatchesPlayed  int32              `json:"matches_played"`
+}
+
+// Every player's latest arena state with the number of arena matches played.
+func (q *Queries) GetArenaLeaderboard(ctx context.Context, arenaID string) ([]GetArenaLeaderboardRow, error) {
+	rows, err := q.db.Query(ctx, getArenaLeaderboard, arenaID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetArenaLeaderboardRow{}
+	for rows.Next() {
+		var i GetArenaLeaderboardRow
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.PlayerName,
+			&i.EloAfter,
+			&i.DeviationAfter,
+			&i.League,
+			&i.Cubes,
+			&i.Meeples,
+			&i.LastMatchDate,
+			&i.MatchesPlayed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getArenaOpponentMeeplesBeforeMatch = `-- name: GetArenaOpponentMeeplesBeforeMatch :many
+SELECT o.player_id AS opponent_id, SUM(1.0 / (n.players - 1))::float8 AS meeples
+FROM arena_settlement s
+JOIN arena_settlement o
+  ON o.arena_id = s.arena_id AND o.match_id = s.match_id AND o.player_id <> s.player_id
+JOIN LATERAL (
+    SELECT COUNT(*) AS players
+    FROM arena_settlement c
+    WHERE c.arena_id = s.arena_id AND c.match_id = s.match_id
+) n ON TRUE
+WHERE s.arena_id = $1
+  AND s.player_id = $2
+  AND (s.date < $3 OR (s.date = $3 AND s.match_id < $4))
+GROUP BY o.player_id
+`
+
+type GetArenaOpponentMeeplesBeforeMatchParams struct {
+	ArenaID  string             `json:"arena_id"`
+	PlayerID string             `json:"player_id"`
+	Date     pgtype.Timestamptz `json:"date"`
+	MatchID  string             `json:"match_id"`
+}
+
+type GetArenaOpponentMeeplesBeforeMatchRow struct {
+	OpponentID string  `json:"opponent_id"`
+	Meeples    float64 `json:"meeples"`
+}
+
+// Meeples each opponent has given the player in the arena before the match,
+// uncapped: 1/(n−1) per shared match, n being the rated players of that match.
+func (q *Queries) GetArenaOpponentMeeplesBeforeMatch(ctx context.Context, arg GetArenaOpponentMeeplesBeforeMatchParams) ([]GetArenaOpponentMeeplesBeforeMatchRow, error) {
+	rows, err := q.db.Query(ctx, getArenaOpponentMeeplesBeforeMatch,
+		arg.ArenaID,
+		arg.PlayerID,
+		arg.Date,
+		arg.MatchID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetArenaOpponentMeeplesBeforeMatchRow{}
+	for rows.Next() {
+		var i GetArenaOpponentMeeplesBeforeMatchRow
+		if err := rows.Scan(&i.OpponentID, &i.Meeples); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getArenaPlayerHistory = `-- name: GetArenaPlayerHistory :many
+SELECT s.match_id, s.date, m.game_id, s.elo_after, s.elo_staked, s.elo_earned, s.deviation_after,
+       s.league, s.cubes, s.meeples
+FROM arena_settlement s
+JOIN matches m ON m.id = s.match_id
+WHERE s.arena_id = $1 AND s.player_id = $2
+ORDER BY s.date DESC, s.match_id DESC
+`
+
+type GetArenaPlayerHistoryParams struct {
+	ArenaID  string `json:"arena_id"`
+	PlayerID string `json:"player_id"`
+}
+
+type GetArenaPlayerHistoryRow struct {
+	MatchID        string             `json:"match_id"`
+	Date           pgtype.Timestamptz `json:"date"`
+	GameID         string             `json:"game_id"`
+	EloAfter       float64            `json:"elo_after"`
+	EloStaked      float64            `json:"elo_staked"`
+	EloEarned      float64            `json:"elo_earned"`
+	DeviationAfter pgtype.Float8      `json:"deviation_after"`
+	League         pgtype.Text        `json:"league"`
+	Cubes          float64            `json:"cubes"`
+	Meeples        float64            `json:"meeples"`
+}
+
+func (q *Queries) GetArenaPlayerHistory(ctx context.Context, arg GetArenaPlayerHistoryParams) ([]GetArenaPlayerHistoryRow, error) {
+	rows, err := q.db.Query(ctx, getArenaPlayerHistory, arg.ArenaID, arg.PlayerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetArenaPlayerHistoryRow{}
+	for rows.Next() {
+		var i GetArenaPlayerHistoryRow
+		if err := rows.Scan(
+			&i.MatchID,
+			&i.Date,
+			&i.GameID,
+			&i.EloAfter,
+			&i.EloStaked,
+			&i.EloEarned,
+			&i.DeviationAfter,
+			&i.League,
+			&i.Cubes,
+			&i.Meeples,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPlayerArenaStandings = `-- name: GetPlayerArenaStandings :many
+SELECT
+    a.id AS arena_id,
+    a.name AS arena_name,
+    a.league_ladder,
+    latest.elo_after,
+    latest.league,
+    latest.cubes,
+    latest.meeples,
+    latest.date AS last_match_date,
+    (SELECT COUNT(*) FROM arena_settlement c
+     WHERE c.arena_id = a.id AND c.player_id = $1)::int AS matches_played
+FROM arenas a
+JOIN LATERAL (
+    SELECT s.elo_after, s.league, s.cubes, s.meeples, s.date
+    FROM arena_settlement s
+    WHERE s.arena_id = a.id AND s.player_id = $1
+    ORDER BY s.date DESC, s.match_id DESC
+    LIMIT 1
+) latest ON TRUE
+ORDER BY a.name
+`
+
+type GetPlayerArenaStandingsRow struct {
+	ArenaID       string             `json:"arena_id"`
+	ArenaName     string             `json:"arena_name"`
+	LeagueLadder  json.RawMessage    `json:"league_ladder"`
+	EloAfter      float64            `json:"elo_after"`
+	League        pgtype.Text        `json:"league"`
+	Cubes         float64            `json:"cubes"`
+	Meeples       float64            `json:"meeples"`
+	LastMatchDate pgtype.Timestamptz `json:"last_match_date"`
+	MatchesPlayed int32              `json:"matches_played"`
+}
+
+// The player's latest state in every custom arena they have played in.
+func (q *Queries) GetPlayerArenaStandings(ctx context.Context, playerID string) ([]GetPlayerArenaStandingsRow, error) {
+	rows, err := q.db.Query(ctx, getPlayerArenaStandings, playerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetPlayerArenaStandingsRow{}
+	for rows.Next() {
+		var i GetPlayerArenaStandingsRow
+		if err := rows.Scan(
+			&i.ArenaID,
+			&i.ArenaName,
+			&i.LeagueLadder,
+			&i.EloAfter,
+			&i.League,
+			&i.Cubes,
+			&i.Meeples,
+			&i.LastMatchDate,
+			&i.MatchesPlayed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPlayerArenaStateBeforeMatch = `-- name: GetPlayerArenaStateBeforeMatch :one
+SELECT s.date, s.elo_after, s.deviation_after, s.volatility_after, s.league, s.cubes
+FROM arena_settlement s
+WHERE s.arena_id = $1
+  AND s.player_id = $2
+  AND (s.date < $3 OR (s.date = $3 AND s.match_id < $4))
+ORDER BY s.date DESC, s.match_id DESC
+LIMIT 1
+`
+
+type GetPlayerArenaStateBeforeMatchParams struct {
+	ArenaID  string             `json:"arena_id"`
+	PlayerID string             `json:"player_id"`
+	Date     pgtype.Timestamptz `json:"date"`
+	MatchID  string             `json:"match_id"`
+}
+
+type GetPlayerArenaStateBeforeMatchRow struct {
+	Date            pgtype.Timestamptz `json:"date"`
+	EloAfter        float64            `json:"elo_after"`
+	DeviationAfter  pgtype.Float8      `json:"deviation_after"`
+	VolatilityAfter pgtype.Float8      `json:"volatility_after"`
+	League          pgtype.Text        `json:"league"`
+	Cubes           float64            `json:"cubes"`
+}
+
+// The player's arena state from the latest arena settlement before the match;
+// same-date matches are ordered by id, as in the built-in arenas.
+func (q *Queries) GetPlayerArenaStateBeforeMatch(ctx context.Context, arg GetPlayerArenaStateBeforeMatchParams) (GetPlayerArenaStateBeforeMatchRow, error) {
+	row := q.db.QueryRow(ctx, getPlayerArenaStateBeforeMatch,
+		arg.ArenaID,
+		arg.PlayerID,
+		arg.Date,
+		arg.MatchID,
+	)
+	var i GetPlayerArenaStateBeforeMatchRow
+	err := row.Scan(
+		&i.Date,
+		&i.EloAfter,
+		&i.DeviationAfter,
+		&i.VolatilityAfter,
+		&i.League,
+		&i.Cubes,
+	)
+	return i, err
+}
+
+const insertArenaSettlement = `-- name: InsertArenaSettlement :exec
+INSERT INTO arena_settlement (
+    id, arena_id, player_id, match_id, date,
+    elo_after, elo_staked, elo_earned, deviation_after, volatility_after,
+    league, cubes, meeples
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+`
+
+type InsertArenaSettlementParams struct {
+	ID              string             `json:"id"`
+	ArenaID         string             `json:"arena_id"`
+	PlayerID        string             `json:"player_id"`
+	MatchID         string             `json:"match_id"`
+	Date            pgtype.Timestamptz `json:"date"`
+	EloAfter        float64            `json:"elo_after"`
+	EloStaked       float64            `json:"elo_staked"`
+	EloEarned       float64            `json:"elo_earned"`
+	DeviationAfter  pgtype.Float8      `json:"deviation_after"`
+	VolatilityAfter pgtype.Float8      `json:"volatility_after"`
+	League          pgtype.Text        `json:"league"`
+	Cubes           float64            `json:"cubes"`
+	Meeples         float64            `json:"meeples"`
+}
+
+func (q *Queries) InsertArenaSettlement(ctx context.Context, arg InsertArenaSettlementParams) error {
+	_, err := q.db.Exec(ctx, insertArenaSettlement,
+		arg.ID,
+		arg.ArenaID,
+		arg.PlayerID,
+		arg.MatchID,
+		arg.Date,
+		arg.EloAfter,
+		arg.EloStaked,
+		arg.EloEarned,
+		arg.DeviationAfter,
+		arg.VolatilityAfter,
+		arg.League,
+		arg.Cubes,
+		arg.Meeples,
+	)
+	return err
+}
+
+const listArenas = `-- name: ListArenas :many
+SELECT id, name, game_ids, player_ids, club_id, tournament_id, starts_at, ends_at, league_ladder
+FROM arenas
+ORDER BY name
+`
+
+func (q *Queries) ListArenas(ctx context.Context) ([]Arena, error) {
+	rows, err := q.db.Query(ctx, listArenas)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Arena{}
+	for rows.Next() {
+		var i Arena
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.GameIds,
+			&i.PlayerIds,
+			&i.ClubID,
+			&i.TournamentID,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.LeagueLadder,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listClubArenas = `-- name: ListClubArenas :many
+SELECT id, name, game_ids, player_ids, club_id, tournament_id, starts_at, ends_at, league_ladder FROM arenas WHERE club_id = $1 ORDER BY name
+`
+
+// Arenas filtered by the club. They read its current members, so a change of
+// members rebuilds them.
+func (q *Queries) ListClubArenas(ctx context.Context, clubID *string) ([]Arena, error) {
+	rows, err := q.db.Query(ctx, listClubArenas, clubID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Arena{}
+	for rows.Next() {
+		var i Arena
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.GameIds,
+			&i.PlayerIds,
+			&i.ClubID,
+			&i.TournamentID,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.LeagueLadder,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listClubMemberIDs = `-- name: ListClubMemberIDs :many
+SELECT player_id
+FROM player_club_membership
+WHERE club_id = $1
+`
+
+func (q *Queries) ListClubMemberIDs(ctx context.Context, clubID string) ([]string, error) {
+	rows, err := q.db.Query(ctx, listClubMemberIDs, clubID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var player_id string
+		if err := rows.Scan(&player_id); err != nil {
+			return nil, err
+		}
+		items = append(items, player_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPlayerClubArenas = `-- name: ListPlayerClubArenas :many
+SELECT a.id, a.name, a.game_ids, a.player_ids, a.club_id, a.tournament_id, a.starts_at, a.ends_at, a.league_ladder
+FROM arenas a
+JOIN player_club_membership m ON m.club_id = a.club_id
+WHERE m.player_id = $1
+ORDER BY a.name
+`
+
+// Arenas filtered by a club the player is a member of.
+func (q *Queries) ListPlayerClubArenas(ctx context.Context, playerID string) ([]Arena, error) {
+	rows, err := q.db.Query(ctx, listPlayerClubArenas, playerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Arena{}
+	for rows.Next() {
+		var i Arena
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.GameIds,
+			&i.PlayerIds,
+			&i.ClubID,
+			&i.TournamentID,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.LeagueLadder,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const tournamentHasArenas = `-- name: TournamentHasArenas :one
+SELECT EXISTS (SELECT 1 FROM arenas WHERE tournament_id = $1)
+`
+
+func (q *Queries) TournamentHasArenas(ctx context.Context, tournamentID *string) (bool, error) {
+	row := q.db.QueryRow(ctx, tournamentHasArenas, tournamentID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const updateArena = `-- name: UpdateArena :one
+UPDATE arenas
+SET name          = $1,
+    game_ids      = $2::uuid[],
+    player_ids    = $3::uuid[],
+    club_id       = $4,
+    tournament_id = $5,
+    starts_at     = $6,
+    ends_at       = $7,
+    league_ladder = $8
+WHERE id = $9
+RETURNING id, name, game_ids, player_ids, club_id, tournament_id, starts_at, ends_at, league_ladder
+`
+
+type UpdateArenaParams struct {
+	Name         string             `json:"name"`
+	GameIds      []string           `json:"game_ids"`
+	PlayerIds    []string           `json:"player_ids"`
+	ClubID       *string            `json:"club_id"`
+	TournamentID *string            `json:"tournament_id"`
+	StartsAt     pgtype.Timestamptz `json:"starts_at"`
+	EndsAt       pgtype.Timestamptz `json:"ends_at"`
+	LeagueLadder json.RawMessage    `json:"league_ladder"`
+	ID           string             `json:"id"`
+}
+
+func (q *Queries) UpdateArena(ctx context.Context, arg UpdateArenaParams) (Arena, error) {
+	row := q.db.QueryRow(ctx, updateArena,
+		arg.Name,
+		arg.GameIds,
+		arg.PlayerIds,
+		arg.ClubID,
+		arg.TournamentID,
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.LeagueLadder,
+		arg.ID,
+	)
+	var i Arena
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.GameIds,
+		&i.PlayerIds,
+		&i.ClubID,
+		&i.TournamentID,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.LeagueLadder,
+	)
+	return i, err
+}
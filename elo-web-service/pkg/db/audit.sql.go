@@ -0,0 +1,133 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: audit.sql
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countAuditEntriesForEntity = `-- name: CountAuditEntriesForEntity :one
+SELECT COUNT(*) FROM audit_log WHERE entity_type = $1 AND entity_id = $2
+`
+
+type CountAuditEntriesForEntityParams struct {
+	EntityType string `json:"entity_type"`
+	EntityID   string `json:"entity_id"`
+}
+
+func (q *Queries) CountAuditEntriesForEntity(ctx context.Context, arg CountAuditEntriesForEntityParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countAuditEntriesForEntity, arg.EntityType, arg.EntityID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAuditEntry = `-- name: CreateAuditEntry :exec
+INSERT INTO audit_log (id, user_id, entity_type, entity_id, action, before, after, reason)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type CreateAuditEntryParams struct {
+	ID         string          `json:"id"`
+	UserID     *string         `json:"user_id"`
+	EntityType string          `json:"entity_type"`
+	EntityID   string          `json:"entity_id"`
+	Action     string          `json:"action"`
+	Before     json.RawMessage `json:"before"`
+	After      json.RawMessage `json:"after"`
+	Reason     pgtype.Text     `json:"reason"`
+}
+
+func (q *Queries) CreateAuditEntry(ctx context.Context, arg CreateAuditEntryParams) error {
+	_, err := q.db.Exec(ctx, createAuditEntry,
+		arg.ID,
+		arg.UserID,
+		arg.EntityType,
+		arg.EntityID,
+		arg.Action,
+		arg.Before,
+		arg.After,
+		arg.Reason,
+	)
+	return err
+}
+
+const listAuditEntriesPaginated = `-- name: ListAuditEntriesPaginated :many
+SELECT a.id, a.created_at, a.user_id, u.google_oauth_user_name AS user_name,
+       a.entity_type, a.entity_id, a.action, a.before, a.after, a.reason
+FROM audit_log a
+LEFT JOIN users u ON u.id = a.user_id
+WHERE
+  ($1::text IS NULL OR a.entity_type = $1::text)
+  AND ($2::text IS NULL OR a.entity_id = $2::text)
+  AND ($3::uuid IS NULL OR a.user_id = $3::uuid)
+  AND ($4::uuid IS NULL OR a.id < $4::uuid)
+ORDER BY a.id DESC
+LIMIT $5
+`
+
+type ListAuditEntriesPaginatedParams struct {
+	EntityType pgtype.Text `json:"entity_type"`
+	EntityID   pgtype.Text `json:"entity_id"`
+	UserID     *string     `json:"user_id"`
+	CursorID   *string     `json:"cursor_id"`
+	Limit      int32       `json:"limit"`
+}
+
+type ListAuditEntriesPaginatedRow struct {
+	ID         string          `json:"id"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UserID     *string         `json:"user_id"`
+	UserName   pgtype.Text     `json:"user_name"`
+	EntityType string          `json:"entity_type"`
+	EntityID   string          `json:"entity_id"`
+	Action     string          `json:"action"`
+	Before     json.RawMessage `json:"before"`
+	After      json.RawMessage `json:"after"`
+	Reason     pgtype.Text     `json:"reason"`
+}
+
+// Newest first; cursor_id is the id of the last entry of the previous page.
+func (q *Queries) ListAuditEntriesPaginated(ctx context.Context, arg ListAuditEntriesPaginatedParams) ([]ListAuditEntriesPaginatedRow, error) {
+	rows, err := q.db.Query(ctx, listAuditEntriesPaginated,
+		arg.EntityType,
+		arg.EntityID,
+		arg.UserID,
+		arg.CursorID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAuditEntriesPaginatedRow{}
+	for rows.Next() {
+		var i ListAuditEntriesPaginatedRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UserID,
+			&i.UserName,
+			&i.EntityType,
+			&i.EntityID,
+			&i.Action,
+			&i.Before,
+			&i.After,
+			&i.Reason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
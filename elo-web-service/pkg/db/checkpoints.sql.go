@@ -0,0 +1,325 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: checkpoints.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type CopyCheckpointGameStatesParams struct {
+	CheckpointID    string        `json:"checkpoint_id"`
+	PlayerID        string        `json:"player_id"`
+	GameID          string        `json:"game_id"`
+	EloAfter        float64       `json:"elo_after"`
+	RatingAfter     float64       `json:"rating_after"`
+	League          string        `json:"league"`
+	DeviationAfter  pgtype.Float8 `json:"deviation_after"`
+	VolatilityAfter pgtype.Float8 `json:"volatility_after"`
+}
+
+type CopyCheckpointGlobalStatesParams struct {
+	CheckpointID    string        `json:"checkpoint_id"`
+	PlayerID        string        `json:"player_id"`
+	EloAfter        float64       `json:"elo_after"`
+	RatingAfter     float64       `json:"rating_after"`
+	League          string        `json:"league"`
+	DeviationAfter  pgtype.Float8 `json:"deviation_after"`
+	VolatilityAfter pgtype.Float8 `json:"volatility_after"`
+}
+
+type CopyCheckpointMarketsParams struct {
+	CheckpointID  string `json:"checkpoint_id"`
+	MarketID      string `json:"market_id"`
+	BettingClosed bool   `json:"betting_closed"`
+}
+
+type CopyCheckpointVirtualOpponentStatesParams struct {
+	CheckpointID    string        `json:"checkpoint_id"`
+	GameID          string        `json:"game_id"`
+	EloAfter        float64       `json:"elo_after"`
+	DeviationAfter  pgtype.Float8 `json:"deviation_after"`
+	VolatilityAfter pgtype.Float8 `json:"volatility_after"`
+}
+
+const createSettlementCheckpoint = `-- name: CreateSettlementCheckpoint :exec
+INSERT INTO settlement_checkpoints (id, date) VALUES ($1, $2)
+`
+
+type CreateSettlementCheckpointParams struct {
+	ID   string    `json:"id"`
+	Date time.Time `json:"date"`
+}
+
+func (q *Queries) CreateSettlementCheckpoint(ctx context.Context, arg CreateSettlementCheckpointParams) error {
+	_, err := q.db.Exec(ctx, createSettlementCheckpoint, arg.ID, arg.Date)
+	return err
+}
+
+const deleteSettlementCheckpointsAfter = `-- name: DeleteSettlementCheckpointsAfter :exec
+DELETE FROM settlement_checkpoints WHERE date > $1
+`
+
+// A checkpoint dated after a recalculation start includes settlements the
+// recalculation rewrites.
+func (q *Queries) DeleteSettlementCheckpointsAfter(ctx context.Context, date time.Time) error {
+	_, err := q.db.Exec(ctx, deleteSettlementCheckpointsAfter, date)
+	return err
+}
+
+const getLatestSettlementCheckpoint = `-- name: GetLatestSettlementCheckpoint :one
+SELECT id, date, created_at
+FROM settlement_checkpoints
+ORDER BY date DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLatestSettlementCheckpoint(ctx context.Context) (SettlementCheckpoint, error) {
+	row := q.db.QueryRow(ctx, getLatestSettlementCheckpoint)
+	var i SettlementCheckpoint
+	err := row.Scan(&i.ID, &i.Date, &i.CreatedAt)
+	return i, err
+}
+
+const getLatestSettlementCheckpointAtOrBefore = `-- name: GetLatestSettlementCheckpointAtOrBefore :one
+SELECT id, date, created_at
+FROM settlement_checkpoints
+WHERE date <= $1
+ORDER BY date DESC
+LIMIT 1
+`
+
+// The checkpoint RecalculateFrom starts from: the latest one that covers
+// nothing at or after the window start.
+func (q *Queries) GetLatestSettlementCheckpointAtOrBefore(ctx context.Context, date time.Time) (SettlementCheckpoint, error) {
+	row := q.db.QueryRow(ctx, getLatestSettlementCheckpointAtOrBefore, date)
+	var i SettlementCheckpoint
+	err := row.Scan(&i.ID, &i.Date, &i.CreatedAt)
+	return i, err
+}
+
+const listCheckpointGameStates = `-- name: ListCheckpointGameStates :many
+SELECT player_id, game_id, elo_after, rating_after, league, deviation_after, volatility_after
+FROM checkpoint_game_state
+WHERE checkpoint_id = $1
+ORDER BY player_id, game_id
+`
+
+type ListCheckpointGameStatesRow struct {
+	PlayerID        string        `json:"player_id"`
+	GameID          string        `json:"game_id"`
+	EloAfter        float64       `json:"elo_after"`
+	RatingAfter     float64       `json:"rating_after"`
+	League          string        `json:"league"`
+	DeviationAfter  pgtype.Float8 `json:"deviation_after"`
+	VolatilityAfter pgtype.Float8 `json:"volatility_after"`
+}
+
+func (q *Queries) ListCheckpointGameStates(ctx context.Context, checkpointID string) ([]ListCheckpointGameStatesRow, error) {
+	rows, err := q.db.Query(ctx, listCheckpointGameStates, checkpointID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListCheckpointGameStatesRow{}
+	for rows.Next() {
+		var i ListCheckpointGameStatesRow
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.GameID,
+			&i.EloAfter,
+			&i.RatingAfter,
+			&i.League,
+			&i.DeviationAfter,
+			&i.VolatilityAfter,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCheckpointGlobalStates = `-- name: ListCheckpointGlobalStates :many
+SELECT player_id, elo_after, rating_after, league, deviation_after, volatility_after
+FROM checkpoint_global_state
+WHERE checkpoint_id = $1
+ORDER BY player_id
+`
+
+type ListCheckpointGlobalStatesRow struct {
+	PlayerID        string        `json:"player_id"`
+	EloAfter        float64       `json:"elo_after"`
+	RatingAfter     float64       `json:"rating_after"`
+	League          string        `json:"league"`
+	DeviationAfter  pgtype.Float8 `json:"deviation_after"`
+	VolatilityAfter pgtype.Float8 `json:"volatility_after"`
+}
+
+func (q *Queries) ListCheckpointGlobalStates(ctx context.Context, checkpointID string) ([]ListCheckpointGlobalStatesRow, error) {
+	rows, err := q.db.Query(ctx, listCheckpointGlobalStates, checkpointID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListCheckpointGlobalStatesRow{}
+	for rows.Next() {
+		var i ListCheckpointGlobalStatesRow
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.EloAfter,
+			&i.RatingAfter,
+			&i.League,
+			&i.DeviationAfter,
+			&i.VolatilityAfter,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCheckpointMarkets = `-- name: ListCheckpointMarkets :many
+SELECT market_id, betting_closed
+FROM checkpoint_markets
+WHERE checkpoint_id = $1
+ORDER BY market_id
+`
+
+type ListCheckpointMarketsRow struct {
+	MarketID      string `json:"market_id"`
+	BettingClosed bool   `json:"betting_closed"`
+}
+
+func (q *Queries) ListCheckpointMarkets(ctx context.Context, checkpointID string) ([]ListCheckpointMarketsRow, error) {
+	rows, err := q.db.Query(ctx, listCheckpointMarkets, checkpointID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListCheckpointMarketsRow{}
+	for rows.Next() {
+		var i ListCheckpointMarketsRow
+		if err := rows.Scan(&i.MarketID, &i.BettingClosed); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCheckpointVirtualOpponentStates = `-- name: ListCheckpointVirtualOpponentStates :many
+SELECT game_id, elo_after, deviation_after, volatility_after
+FROM checkpoint_virtual_opponent_state
+WHERE checkpoint_id = $1
+ORDER BY game_id
+`
+
+type ListCheckpointVirtualOpponentStatesRow struct {
+	GameID          string        `json:"game_id"`
+	EloAfter        float64       `json:"elo_after"`
+	DeviationAfter  pgtype.Float8 `json:"deviation_after"`
+	VolatilityAfter pgtype.Float8 `json:"volatility_after"`
+}
+
+func (q *Queries) ListCheckpointVirtualOpponentStates(ctx context.Context, checkpointID string) ([]ListCheckpointVirtualOpponentStatesRow, error) {
+	rows, err := q.db.Query(ctx, listCheckpointVirtualOpponentStates, checkpointID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListCheckpointVirtualOpponentStatesRow{}
+	for rows.Next() {
+		var i ListCheckpointVirtualOpponentStatesRow
+		if err := rows.Scan(
+			&i.GameID,
+			&i.EloAfter,
+			&i.DeviationAfter,
+			&i.VolatilityAfter,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMarketsOpenAt = `-- name: ListMarketsOpenAt :many
+SELECT m.id AS market_id,
+       (m.betting_closed_at IS NOT NULL AND m.betting_closed_at < $1)::bool AS betting_closed
+FROM markets m
+WHERE m.starts_at < $1
+  AND (m.resolved_at IS NULL OR m.resolved_at >= $1)
+ORDER BY m.id
+`
+
+type ListMarketsOpenAtRow struct {
+	MarketID      string `json:"market_id"`
+	BettingClosed bool   `json:"betting_closed"`
+}
+
+// Markets whose window had begun at @at and that were not resolved or
+// cancelled before it.
+func (q *Queries) ListMarketsOpenAt(ctx context.Context, at pgtype.Timestamptz) ([]ListMarketsOpenAtRow, error) {
+	rows, err := q.db.Query(ctx, listMarketsOpenAt, at)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListMarketsOpenAtRow{}
+	for rows.Next() {
+		var i ListMarketsOpenAtRow
+		if err := rows.Scan(&i.MarketID, &i.BettingClosed); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSettlementCheckpoints = `-- name: ListSettlementCheckpoints :many
+SELECT id, date, created_at
+FROM settlement_checkpoints
+ORDER BY date
+`
+
+func (q *Queries) ListSettlementCheckpoints(ctx context.Context) ([]SettlementCheckpoint, error) {
+	rows, err := q.db.Query(ctx, listSettlementCheckpoints)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SettlementCheckpoint{}
+	for rows.Next() {
+		var i SettlementCheckpoint
+		if err := rows.Scan(&i.ID, &i.Date, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
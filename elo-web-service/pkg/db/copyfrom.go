@@ -0,0 +1,288 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: copyfrom.go
+
+package db
+
+import (
+	"context"
+)
+
+// iteratorForCopyCheckpointGameStates implements pgx.CopyFromSource.
+type iteratorForCopyCheckpointGameStates struct {
+	rows                 []CopyCheckpointGameStatesParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCopyCheckpointGameStates) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCopyCheckpointGameStates) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].CheckpointID,
+		r.rows[0].PlayerID,
+		r.rows[0].GameID,
+		r.rows[0].EloAfter,
+		r.rows[0].RatingAfter,
+		r.rows[0].League,
+		r.rows[0].DeviationAfter,
+		r.rows[0].VolatilityAfter,
+	}, nil
+}
+
+func (r iteratorForCopyCheckpointGameStates) Err() error {
+	return nil
+}
+
+func (q *Queries) CopyCheckpointGameStates(ctx context.Context, arg []CopyCheckpointGameStatesParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"checkpoint_game_state"}, []string{"checkpoint_id", "player_id", "game_id", "elo_after", "rating_after", "league", "deviation_after", "volatility_after"}, &iteratorForCopyCheckpointGameStates{rows: arg})
+}
+
+// iteratorForCopyCheckpointGlobalStates implements pgx.CopyFromSource.
+type iteratorForCopyCheckpointGlobalStates struct {
+	rows                 []CopyCheckpointGlobalStatesParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCopyCheckpointGlobalStates) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCopyCheckpointGlobalStates) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].CheckpointID,
+		r.rows[0].PlayerID,
+		r.rows[0].EloAfter,
+		r.rows[0].RatingAfter,
+		r.rows[0].League,
+		r.rows[0].DeviationAfter,
+		r.rows[0].VolatilityAfter,
+	}, nil
+}
+
+func (r iteratorForCopyCheckpointGlobalStates) Err() error {
+	return nil
+}
+
+func (q *Queries) CopyCheckpointGlobalStates(ctx context.Context, arg []CopyCheckpointGlobalStatesParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"checkpoint_global_state"}, []string{"checkpoint_id", "player_id", "elo_after", "rating_after", "league", "deviation_after", "volatility_after"}, &iteratorForCopyCheckpointGlobalStates{rows: arg})
+}
+
+// iteratorForCopyCheckpointMarkets implements pgx.CopyFromSource.
+type iteratorForCopyCheckpointMarkets struct {
+	rows                 []CopyCheckpointMarketsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCopyCheckpointMarkets) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCopyCheckpointMarkets) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].CheckpointID,
+		r.rows[0].MarketID,
+		r.rows[0].BettingClosed,
+	}, nil
+}
+
+func (r iteratorForCopyCheckpointMarkets) Err() error {
+	return nil
+}
+
+func (q *Queries) CopyCheckpointMarkets(ctx context.Context, arg []CopyCheckpointMarketsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"checkpoint_markets"}, []string{"checkpoint_id", "market_id", "betting_closed"}, &iteratorForCopyCheckpointMarkets{rows: arg})
+}
+
+// iteratorForCopyCheckpointVirtualOpponentStates implements pgx.CopyFromSource.
+type iteratorForCopyCheckpointVirtualOpponentStates struct {
+	rows                 []CopyCheckpointVirtualOpponentStatesParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCopyCheckpointVirtualOpponentStates) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCopyCheckpointVirtualOpponentStates) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].CheckpointID,
+		r.rows[0].GameID,
+		r.rows[0].EloAfter,
+		r.rows[0].DeviationAfter,
+		r.rows[0].VolatilityAfter,
+	}, nil
+}
+
+func (r iteratorForCopyCheckpointVirtualOpponentStates) Err() error {
+	return nil
+}
+
+func (q *Queries) CopyCheckpointVirtualOpponentStates(ctx context.Context, arg []CopyCheckpointVirtualOpponentStatesParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"checkpoint_virtual_opponent_state"}, []string{"checkpoint_id", "game_id", "elo_after", "deviation_after", "volatility_after"}, &iteratorForCopyCheckpointVirtualOpponentStates{rows: arg})
+}
+
+// iteratorForCopyGameArenaSettlements implements pgx.CopyFromSource.
+type iteratorForCopyGameArenaSettlements struct {
+	rows                 []CopyGameArenaSettlementsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCopyGameArenaSettlements) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCopyGameArenaSettlements) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].GameID,
+		r.rows[0].PlayerID,
+		r.rows[0].Date,
+		r.rows[0].RatingAfter,
+		r.rows[0].EloAfter,
+		r.rows[0].Discriminator,
+		r.rows[0].MatchID,
+		r.rows[0].EloStaked,
+		r.rows[0].EloEarned,
+		r.rows[0].RatingStaked,
+		r.rows[0].RatingEarned,
+		r.rows[0].League,
+		r.rows[0].DeviationAfter,
+		r.rows[0].VolatilityAfter,
+	}, nil
+}
+
+func (r iteratorForCopyGameArenaSettlements) Err() error {
+	return nil
+}
+
+func (q *Queries) CopyGameArenaSettlements(ctx context.Context, arg []CopyGameArenaSettlementsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"game_arena_settlement"}, []string{"id", "game_id", "player_id", "date", "rating_after", "elo_after", "discriminator", "match_id", "elo_staked", "elo_earned", "rating_staked", "rating_earned", "league", "deviation_after", "volatility_after"}, &iteratorForCopyGameArenaSettlements{rows: arg})
+}
+
+// iteratorForCopyGameVirtualOpponentSettlements implements pgx.CopyFromSource.
+type iteratorForCopyGameVirtualOpponentSettlements struct {
+	rows                 []CopyGameVirtualOpponentSettlementsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCopyGameVirtualOpponentSettlements) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCopyGameVirtualOpponentSettlements) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].GameID,
+		r.rows[0].MatchID,
+		r.rows[0].Date,
+		r.rows[0].EloAfter,
+		r.rows[0].EloStaked,
+		r.rows[0].EloEarned,
+		r.rows[0].DeviationAfter,
+		r.rows[0].VolatilityAfter,
+	}, nil
+}
+
+func (r iteratorForCopyGameVirtualOpponentSettlements) Err() error {
+	return nil
+}
+
+func (q *Queries) CopyGameVirtualOpponentSettlements(ctx context.Context, arg []CopyGameVirtualOpponentSettlementsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"game_virtual_opponent_settlement"}, []string{"id", "game_id", "match_id", "date", "elo_after", "elo_staked", "elo_earned", "deviation_after", "volatility_after"}, &iteratorForCopyGameVirtualOpponentSettlements{rows: arg})
+}
+
+// iteratorForCopyGlobalArenaMatchSettlements implements pgx.CopyFromSource.
+type iteratorForCopyGlobalArenaMatchSettlements struct {
+	rows                 []CopyGlobalArenaMatchSettlementsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCopyGlobalArenaMatchSettlements) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCopyGlobalArenaMatchSettlements) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].PlayerID,
+		r.rows[0].Date,
+		r.rows[0].RatingAfter,
+		r.rows[0].EloAfter,
+		r.rows[0].Discriminator,
+		r.rows[0].MatchID,
+		r.rows[0].EloStaked,
+		r.rows[0].EloEarned,
+		r.rows[0].RatingStaked,
+		r.rows[0].RatingEarned,
+		r.rows[0].League,
+		r.rows[0].DeviationAfter,
+		r.rows[0].VolatilityAfter,
+	}, nil
+}
+
+func (r iteratorForCopyGlobalArenaMatchSettlements) Err() error {
+	return nil
+}
+
+func (q *Queries) CopyGlobalArenaMatchSettlements(ctx context.Context, arg []CopyGlobalArenaMatchSettlementsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"global_arena_settlement"}, []string{"id", "player_id", "date", "rating_after", "elo_after", "discriminator", "match_id", "elo_staked", "elo_earned", "rating_staked", "rating_earned", "league", "deviation_after", "volatility_after"}, &iteratorForCopyGlobalArenaMatchSettlements{rows: arg})
+}
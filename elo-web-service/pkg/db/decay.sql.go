@@ -0,0 +1,206 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: decay.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteGlobalArenaDecayReversalsByMatch = `-- name: DeleteGlobalArenaDecayReversalsByMatch :exec
+DELETE FROM global_arena_settlement
+WHERE match_id = $1 AND discriminator = 'decay'
+`
+
+func (q *Queries) DeleteGlobalArenaDecayReversalsByMatch(ctx context.Context, matchID *string) error {
+	_, err := q.db.Exec(ctx, deleteGlobalArenaDecayReversalsByMatch, matchID)
+	return err
+}
+
+const deleteGlobalArenaDecaySettlementByMatch = `-- name: DeleteGlobalArenaDecaySettlementByMatch :exec
+DELETE FROM global_arena_settlement
+WHERE match_id = $1 AND player_id = $2 AND discriminator = 'decay'
+`
+
+type DeleteGlobalArenaDecaySettlementByMatchParams struct {
+	MatchID  *string `json:"match_id"`
+	PlayerID string  `json:"player_id"`
+}
+
+func (q *Queries) DeleteGlobalArenaDecaySettlementByMatch(ctx context.Context, arg DeleteGlobalArenaDecaySettlementByMatchParams) error {
+	_, err := q.db.Exec(ctx, deleteGlobalArenaDecaySettlementByMatch, arg.MatchID, arg.PlayerID)
+	return err
+}
+
+const getPlayerPendingDecayBeforeMatch = `-- name: GetPlayerPendingDecayBeforeMatch :one
+SELECT d.id, d.rating_staked
+FROM global_arena_settlement d
+WHERE d.player_id = $1
+  AND d.discriminator = 'decay'
+  AND d.match_id IS NULL
+  AND d.date < $2
+  AND NOT EXISTS (
+      SELECT 1 FROM global_arena_settlement m
+      WHERE m.player_id = d.player_id
+        AND m.discriminator = 'match'
+        AND m.date >= d.date
+        AND (m.date < $2 OR (m.date = $2 AND m.match_id < $3))
+  )
+ORDER BY d.date DESC
+LIMIT 1
+`
+
+type GetPlayerPendingDecayBeforeMatchParams struct {
+	PlayerID string             `json:"player_id"`
+	Date     pgtype.Timestamptz `json:"date"`
+	MatchID  *string            `json:"match_id"`
+}
+
+type GetPlayerPendingDecayBeforeMatchRow struct {
+	ID           string  `json:"id"`
+	RatingStaked float64 `json:"rating_staked"`
+}
+
+// The decay drop of the player's current inactivity spell: the latest drop
+// before the match with no match of the player in between. rating_staked
+// holds the (negative) amount to give back.
+func (q *Queries) GetPlayerPendingDecayBeforeMatch(ctx context.Context, arg GetPlayerPendingDecayBeforeMatchParams) (GetPlayerPendingDecayBeforeMatchRow, error) {
+	row := q.db.QueryRow(ctx, getPlayerPendingDecayBeforeMatch, arg.PlayerID, arg.Date, arg.MatchID)
+	var i GetPlayerPendingDecayBeforeMatchRow
+	err := row.Scan(&i.ID, &i.RatingStaked)
+	return i, err
+}
+
+const insertGlobalArenaDecaySettlement = `-- name: InsertGlobalArenaDecaySettlement :exec
+INSERT INTO global_arena_settlement
+    (id, player_id, date, rating_after, elo_after, discriminator, match_id,
+     elo_staked, elo_earned, rating_staked, rating_earned, league)
+VALUES ($1, $2, $3, $4, $5, 'decay', $9, 0, 0, $6, $7, $8)
+ON CONFLICT (player_id, date) WHERE discriminator = 'decay' AND match_id IS NULL DO NOTHING
+`
+
+type InsertGlobalArenaDecaySettlementParams struct {
+	ID           string             `json:"id"`
+	PlayerID     string             `json:"player_id"`
+	Date         pgtype.Timestamptz `json:"date"`
+	RatingAfter  float64            `json:"rating_after"`
+	EloAfter     float64            `json:"elo_after"`
+	RatingStaked float64            `json:"rating_staked"`
+	RatingEarned float64            `json:"rating_earned"`
+	League       string             `json:"league"`
+	MatchID      *string            `json:"match_id"`
+}
+
+// A decay drop (match_id NULL) or its reversal (match_id of the match that
+// ended the inactivity). Elo is carried over unchanged. A drop another
+// transaction has already written is skipped.
+func (q *Queries) InsertGlobalArenaDecaySettlement(ctx context.Context, arg InsertGlobalArenaDecaySettlementParams) error {
+	_, err := q.db.Exec(ctx, insertGlobalArenaDecaySettlement,
+		arg.ID,
+		arg.PlayerID,
+		arg.Date,
+		arg.RatingAfter,
+		arg.EloAfter,
+		arg.RatingStaked,
+		arg.RatingEarned,
+		arg.League,
+		arg.MatchID,
+	)
+	return err
+}
+
+const listInactivityDecayCandidates = `-- name: ListInactivityDecayCandidates :many
+SELECT last.player_id, last.date::timestamptz AS last_match_date
+FROM (
+    SELECT gas.player_id, MAX(gas.date) AS date
+    FROM global_arena_settlement gas
+    WHERE gas.discriminator = 'match' AND gas.date < $1
+    GROUP BY gas.player_id
+) last
+WHERE NOT EXISTS (
+    SELECT 1 FROM global_arena_settlement d
+    WHERE d.player_id = last.player_id
+      AND d.discriminator = 'decay'
+      AND d.match_id IS NULL
+      AND d.date > last.date
+)
+ORDER BY last.player_id
+`
+
+type ListInactivityDecayCandidatesRow struct {
+	PlayerID      string    `json:"player_id"`
+	LastMatchDate time.Time `json:"last_match_date"`
+}
+
+// Players whose last global match before @until has not been followed by a
+// decay drop yet, with that match's date.
+func (q *Queries) ListInactivityDecayCandidates(ctx context.Context, until pgtype.Timestamptz) ([]ListInactivityDecayCandidatesRow, error) {
+	rows, err := q.db.Query(ctx, listInactivityDecayCandidates, until)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListInactivityDecayCandidatesRow{}
+	for rows.Next() {
+		var i ListInactivityDecayCandidatesRow
+		if err := rows.Scan(&i.PlayerID, &i.LastMatchDate); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInactivityDecayStates = `-- name: ListInactivityDecayStates :many
+SELECT last.player_id, last.date::timestamptz AS last_match_date,
+    EXISTS (
+        SELECT 1 FROM global_arena_settlement d
+        WHERE d.player_id = last.player_id
+          AND d.discriminator = 'decay'
+          AND d.match_id IS NULL
+          AND d.date > last.date
+    )::bool AS decayed
+FROM (
+    SELECT gas.player_id, MAX(gas.date) AS date
+    FROM global_arena_settlement gas
+    WHERE gas.discriminator = 'match'
+    GROUP BY gas.player_id
+) last
+ORDER BY last.player_id
+`
+
+type ListInactivityDecayStatesRow struct {
+	PlayerID      string    `json:"player_id"`
+	LastMatchDate time.Time `json:"last_match_date"`
+	Decayed       bool      `json:"decayed"`
+}
+
+// Every player with a global match: the date of their last one and whether a
+// decay drop has followed it (the drop a next match reverses).
+func (q *Queries) ListInactivityDecayStates(ctx context.Context) ([]ListInactivityDecayStatesRow, error) {
+	rows, err := q.db.Query(ctx, listInactivityDecayStates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListInactivityDecayStatesRow{}
+	for rows.Next() {
+		var i ListInactivityDecayStatesRow
+		if err := rows.Scan(&i.PlayerID, &i.LastMatchDate, &i.Decayed); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
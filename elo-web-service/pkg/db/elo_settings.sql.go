@@ -16,8 +16,12 @@ INSERT INTO elo_settings (effective_date, elo_const_k, elo_const_d, starting_elo
     newbie_league_earned_min, newbie_league_earned_max, newbie_league_earned_tau,
     newbie_league_goal_gap,
     starting_rating_global_arena, starting_rating_game_arena,
-    elite_league_matches_6months, elite_league_matches_2months)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+    elite_league_matches_6months, elite_league_matches_2months,
+    global_arena_algorithm, game_arena_algorithm,
+    glicko2_starting_deviation, glicko2_starting_volatility, glicko2_tau,
+    trueskill_starting_sigma, trueskill_beta, trueskill_tau, trueskill_draw_probability,
+    inactivity_decay_days, inactivity_decay_rate)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24)
 `
 
 type CreateEloSettingsParams struct {
@@ -34,6 +38,17 @@ type CreateEloSettingsParams struct {
 	StartingRatingGameArena   float64            `json:"starting_rating_game_arena"`
 	EliteLeagueMatches6months int32              `json:"elite_league_matches_6months"`
 	EliteLeagueMatches2months int32              `json:"elite_league_matches_2months"`
+	GlobalArenaAlgorithm      string             `json:"global_arena_algorithm"`
+	GameArenaAlgorithm        string             `json:"game_arena_algorithm"`
+	Glicko2StartingDeviation  float64            `json:"glicko2_starting_deviation"`
+	Glicko2StartingVolatility float64            `json:"glicko2_starting_volatility"`
+	Glicko2Tau                float64            `json:"glicko2_tau"`
+	TrueskillStartingSigma    float64            `json:"trueskill_starting_sigma"`
+	TrueskillBeta             float64            `json:"trueskill_beta"`
+	TrueskillTau              float64            `json:"trueskill_tau"`
+	TrueskillDrawProbability  float64            `json:"trueskill_draw_probability"`
+	InactivityDecayDays       int32              `json:"inactivity_decay_days"`
+	InactivityDecayRate       float64            `json:"inactivity_decay_rate"`
 }
 
 func (q *Queries) CreateEloSettings(ctx context.Context, arg CreateEloSettingsParams) error {
@@ -51,10 +66,30 @@ func (q *Queries) CreateEloSettings(ctx context.Context, arg CreateEloSettingsPa
 		arg.StartingRatingGameArena,
 		arg.EliteLeagueMatches6months,
 		arg.EliteLeagueMatches2months,
+		arg.GlobalArenaAlgorithm,
+		arg.GameArenaAlgorithm,
+		arg.Glicko2StartingDeviation,
+		arg.Glicko2StartingVolatility,
+		arg.Glicko2Tau,
+		arg.TrueskillStartingSigma,
+		arg.TrueskillBeta,
+		arg.TrueskillTau,
+		arg.TrueskillDrawProbability,
+		arg.InactivityDecayDays,
+		arg.InactivityDecayRate,
 	)
 	return err
 }
 
+const deleteAllEloSettings = `-- name: DeleteAllEloSettings :exec
+DELETE FROM elo_settings
+`
+
+func (q *Queries) DeleteAllEloSettings(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, deleteAllEloSettings)
+	return err
+}
+
 const deleteEloSettings = `-- name: DeleteEloSettings :exec
 DELETE FROM elo_settings WHERE effective_date = $1
 `
@@ -64,13 +99,55 @@ func (q *Queries) DeleteEloSettings(ctx context.Context, effectiveDate pgtype.Ti
 	return err
 }
 
+const getEloSettingsAt = `-- name: GetEloSettingsAt :one
+SELECT effective_date, elo_const_k, elo_const_d, starting_elo, win_reward, elite_league_matches_6months, elite_league_matches_2months, newbie_league_earned_min, newbie_league_earned_max, newbie_league_earned_tau, newbie_league_goal_gap, starting_rating_global_arena, starting_rating_game_arena, market_default_liquidity_b, global_arena_algorithm, game_arena_algorithm, glicko2_starting_deviation, glicko2_starting_volatility, glicko2_tau, trueskill_starting_sigma, trueskill_beta, trueskill_tau, trueskill_draw_probability, inactivity_decay_days, inactivity_decay_rate FROM elo_settings WHERE effective_date = $1
+`
+
+// The entry effective exactly at the date (audit snapshots).
+func (q *Queries) GetEloSettingsAt(ctx context.Context, effectiveDate pgtype.Timestamptz) (EloSetting, error) {
+	row := q.db.QueryRow(ctx, getEloSettingsAt, effectiveDate)
+	var i EloSetting
+	err := row.Scan(
+		&i.EffectiveDate,
+		&i.EloConstK,
+		&i.EloConstD,
+		&i.StartingElo,
+		&i.WinReward,
+		&i.EliteLeagueMatches6months,
+		&i.EliteLeagueMatches2months,
+		&i.NewbieLeagueEarnedMin,
+		&i.NewbieLeagueEarnedMax,
+		&i.NewbieLeagueEarnedTau,
+		&i.NewbieLeagueGoalGap,
+		&i.StartingRatingGlobalArena,
+		&i.StartingRatingGameArena,
+		&i.MarketDefaultLiquidityB,
+		&i.GlobalArenaAlgorithm,
+		&i.GameArenaAlgorithm,
+		&i.Glicko2StartingDeviation,
+		&i.Glicko2StartingVolatility,
+		&i.Glicko2Tau,
+		&i.TrueskillStartingSigma,
+		&i.TrueskillBeta,
+		&i.TrueskillTau,
+		&i.TrueskillDrawProbability,
+		&i.InactivityDecayDays,
+		&i.InactivityDecayRate,
+	)
+	return i, err
+}
+
 const getEloSettingsForDate = `-- name: GetEloSettingsForDate :one
 SELECT elo_const_k, elo_const_d, starting_elo, win_reward,
        newbie_league_earned_min, newbie_league_earned_max, newbie_league_earned_tau,
        newbie_league_goal_gap,
        starting_rating_global_arena, starting_rating_game_arena,
        elite_league_matches_6months, elite_league_matches_2months,
-       market_default_liquidity_b
+       market_default_liquidity_b,
+       global_arena_algorithm, game_arena_algorithm,
+       glicko2_starting_deviation, glicko2_starting_volatility, glicko2_tau,
+       trueskill_starting_sigma, trueskill_beta, trueskill_tau, trueskill_draw_probability,
+       inactivity_decay_days, inactivity_decay_rate
 FROM elo_settings
 WHERE effective_date <= $1
 ORDER BY effective_date DESC
@@ -91,6 +168,17 @@ type GetEloSettingsForDateRow struct {
 	EliteLeagueMatches6months int32   `json:"elite_league_matches_6months"`
 	EliteLeagueMatches2months int32   `json:"elite_league_matches_2months"`
 	MarketDefaultLiquidityB   float64 `json:"market_default_liquidity_b"`
+	GlobalArenaAlgorithm      string  `json:"global_arena_algorithm"`
+	GameArenaAlgorithm        string  `json:"game_arena_algorithm"`
+	Glicko2StartingDeviation  float64 `json:"glicko2_starting_deviation"`
+	Glicko2StartingVolatility float64 `json:"glicko2_starting_volatility"`
+	Glicko2Tau                float64 `json:"glicko2_tau"`
+	TrueskillStartingSigma    float64 `json:"trueskill_starting_sigma"`
+	TrueskillBeta             float64 `json:"trueskill_beta"`
+	TrueskillTau              float64 `json:"trueskill_tau"`
+	TrueskillDrawProbability  float64 `json:"trueskill_draw_probability"`
+	InactivityDecayDays       int32   `json:"inactivity_decay_days"`
+	InactivityDecayRate       float64 `json:"inactivity_decay_rate"`
 }
 
 func (q *Queries) GetEloSettingsForDate(ctx context.Context, effectiveDate pgtype.Timestamptz) (GetEloSettingsForDateRow, error) {
@@ -110,6 +198,17 @@ func (q *Queries) GetEloSettingsForDate(ctx context.Context, effectiveDate pgtyp
 		&i.EliteLeagueMatches6months,
 		&i.EliteLeagueMatches2months,
 		&i.MarketDefaultLiquidityB,
+		&i.GlobalArenaAlgorithm,
+		&i.GameArenaAlgorithm,
+		&i.Glicko2StartingDeviation,
+		&i.Glicko2StartingVolatility,
+		&i.Glicko2Tau,
+		&i.TrueskillStartingSigma,
+		&i.TrueskillBeta,
+		&i.TrueskillTau,
+		&i.TrueskillDrawProbability,
+		&i.InactivityDecayDays,
+		&i.InactivityDecayRate,
 	)
 	return i, err
 }
@@ -119,7 +218,11 @@ SELECT elo_const_k, elo_const_d, starting_elo, win_reward, effective_date,
        newbie_league_earned_min, newbie_league_earned_max, newbie_league_earned_tau,
        newbie_league_goal_gap,
        starting_rating_global_arena, starting_rating_game_arena,
-       elite_league_matches_6months, elite_league_matches_2months
+       elite_league_matches_6months, elite_league_matches_2months,
+       global_arena_algorithm, game_arena_algorithm,
+       glicko2_starting_deviation, glicko2_starting_volatility, glicko2_tau,
+       trueskill_starting_sigma, trueskill_beta, trueskill_tau, trueskill_draw_probability,
+       inactivity_decay_days, inactivity_decay_rate
 FROM elo_settings
 ORDER BY effective_date DESC
 LIMIT 1
@@ -139,6 +242,17 @@ type GetLatestEloSettingsRow struct {
 	StartingRatingGameArena   float64            `json:"starting_rating_game_arena"`
 	EliteLeagueMatches6months int32              `json:"elite_league_matches_6months"`
 	EliteLeagueMatches2months int32              `json:"elite_league_matches_2months"`
+	GlobalArenaAlgorithm      string             `json:"global_arena_algorithm"`
+	GameArenaAlgorithm        string             `json:"game_arena_algorithm"`
+	Glicko2StartingDeviation  float64            `json:"glicko2_starting_deviation"`
+	Glicko2StartingVolatility float64            `json:"glicko2_starting_volatility"`
+	Glicko2Tau                float64            `json:"glicko2_tau"`
+	TrueskillStartingSigma    float64            `json:"trueskill_starting_sigma"`
+	TrueskillBeta             float64            `json:"trueskill_beta"`
+	TrueskillTau              float64            `json:"trueskill_tau"`
+	TrueskillDrawProbability  float64            `json:"trueskill_draw_probability"`
+	InactivityDecayDays       int32              `json:"inactivity_decay_days"`
+	InactivityDecayRate       float64            `json:"inactivity_decay_rate"`
 }
 
 func (q *Queries) GetLatestEloSettings(ctx context.Context) (GetLatestEloSettingsRow, error) {
@@ -158,6 +272,17 @@ func (q *Queries) GetLatestEloSettings(ctx context.Context) (GetLatestEloSetting
 		&i.StartingRatingGameArena,
 		&i.EliteLeagueMatches6months,
 		&i.EliteLeagueMatches2months,
+		&i.GlobalArenaAlgorithm,
+		&i.GameArenaAlgorithm,
+		&i.Glicko2StartingDeviation,
+		&i.Glicko2StartingVolatility,
+		&i.Glicko2Tau,
+		&i.TrueskillStartingSigma,
+		&i.TrueskillBeta,
+		&i.TrueskillTau,
+		&i.TrueskillDrawProbability,
+		&i.InactivityDecayDays,
+		&i.InactivityDecayRate,
 	)
 	return i, err
 }
@@ -167,7 +292,11 @@ SELECT effective_date, elo_const_k, elo_const_d, starting_elo, win_reward,
        newbie_league_earned_min, newbie_league_earned_max, newbie_league_earned_tau,
        newbie_league_goal_gap,
        starting_rating_global_arena, starting_rating_game_arena,
-       elite_league_matches_6months, elite_league_matches_2months
+       elite_league_matches_6months, elite_league_matches_2months,
+       global_arena_algorithm, game_arena_algorithm,
+       glicko2_starting_deviation, glicko2_starting_volatility, glicko2_tau,
+       trueskill_starting_sigma, trueskill_beta, trueskill_tau, trueskill_draw_probability,
+       inactivity_decay_days, inactivity_decay_rate
 FROM elo_settings
 ORDER BY effective_date DESC
 `
@@ -186,6 +315,17 @@ type ListEloSettingsRow struct {
 	StartingRatingGameArena   float64            `json:"starting_rating_game_arena"`
 	EliteLeagueMatches6months int32              `json:"elite_league_matches_6months"`
 	EliteLeagueMatches2months int32              `json:"elite_league_matches_2months"`
+	GlobalArenaAlgorithm      string             `json:"global_arena_algorithm"`
+	GameArenaAlgorithm        string             `json:"game_arena_algorithm"`
+	Glicko2StartingDeviation  float64            `json:"glicko2_starting_deviation"`
+	Glicko2StartingVolatility float64            `json:"glicko2_starting_volatility"`
+	Glicko2Tau                float64            `json:"glicko2_tau"`
+	TrueskillStartingSigma    float64            `json:"trueskill_starting_sigma"`
+	TrueskillBeta             float64            `json:"trueskill_beta"`
+	TrueskillTau              float64            `json:"trueskill_tau"`
+	TrueskillDrawProbability  float64            `json:"trueskill_draw_probability"`
+	InactivityDecayDays       int32              `json:"inactivity_decay_days"`
+	InactivityDecayRate       float64            `json:"inactivity_decay_rate"`
 }
 
 func (q *Queries) ListEloSettings(ctx context.Context) ([]ListEloSettingsRow, error) {
@@ -211,6 +351,17 @@ func (q *Queries) ListEloSettings(ctx context.Context) ([]ListEloSettingsRow, er
 			&i.StartingRatingGameArena,
 			&i.EliteLeagueMatches6months,
 			&i.EliteLeagueMatches2months,
+			&i.GlobalArenaAlgorithm,
+			&i.GameArenaAlgorithm,
+			&i.Glicko2StartingDeviation,
+			&i.Glicko2StartingVolatility,
+			&i.Glicko2Tau,
+			&i.TrueskillStartingSigma,
+			&i.TrueskillBeta,
+			&i.TrueskillTau,
+			&i.TrueskillDrawProbability,
+			&i.InactivityDecayDays,
+			&i.InactivityDecayRate,
 		); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,139 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: game_rating_params.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getGameRatingParamsForDate = `-- name: GetGameRatingParamsForDate :one
+SELECT game_id, effective_date, elo_const_k, elo_const_d, win_reward FROM game_rating_params
+WHERE game_id = $1 AND effective_date <= $2
+ORDER BY effective_date DESC
+LIMIT 1
+`
+
+type GetGameRatingParamsForDateParams struct {
+	GameID        string    `json:"game_id"`
+	EffectiveDate time.Time `json:"effective_date"`
+}
+
+func (q *Queries) GetGameRatingParamsForDate(ctx context.Context, arg GetGameRatingParamsForDateParams) (GameRatingParam, error) {
+	row := q.db.QueryRow(ctx, getGameRatingParamsForDate, arg.GameID, arg.EffectiveDate)
+	var i GameRatingParam
+	err := row.Scan(
+		&i.GameID,
+		&i.EffectiveDate,
+		&i.EloConstK,
+		&i.EloConstD,
+		&i.WinReward,
+	)
+	return i, err
+}
+
+const listGameRatingParams = `-- name: ListGameRatingParams :many
+SELECT game_id, effective_date, elo_const_k, elo_const_d, win_reward FROM game_rating_params
+ORDER BY game_id, effective_date DESC
+`
+
+// All versions of all games, for the in-memory replay.
+func (q *Queries) ListGameRatingParams(ctx context.Context) ([]GameRatingParam, error) {
+	rows, err := q.db.Query(ctx, listGameRatingParams)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GameRatingParam{}
+	for rows.Next() {
+		var i GameRatingParam
+		if err := rows.Scan(
+			&i.GameID,
+			&i.EffectiveDate,
+			&i.EloConstK,
+			&i.EloConstD,
+			&i.WinReward,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGameRatingParamsByGame = `-- name: ListGameRatingParamsByGame :many
+SELECT game_id, effective_date, elo_const_k, elo_const_d, win_reward FROM game_rating_params
+WHERE game_id = $1
+ORDER BY effective_date DESC
+`
+
+func (q *Queries) ListGameRatingParamsByGame(ctx context.Context, gameID string) ([]GameRatingParam, error) {
+	rows, err := q.db.Query(ctx, listGameRatingParamsByGame, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GameRatingParam{}
+	for rows.Next() {
+		var i GameRatingParam
+		if err := rows.Scan(
+			&i.GameID,
+			&i.EffectiveDate,
+			&i.EloConstK,
+			&i.EloConstD,
+			&i.WinReward,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertGameRatingParams = `-- name: UpsertGameRatingParams :one
+INSERT INTO game_rating_params (game_id, effective_date, elo_const_k, elo_const_d, win_reward)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (game_id, effective_date) DO UPDATE
+    SET elo_const_k = EXCLUDED.elo_const_k,
+        elo_const_d = EXCLUDED.elo_const_d,
+        win_reward  = EXCLUDED.win_reward
+RETURNING game_id, effective_date, elo_const_k, elo_const_d, win_reward
+`
+
+type UpsertGameRatingParamsParams struct {
+	GameID        string        `json:"game_id"`
+	EffectiveDate time.Time     `json:"effective_date"`
+	EloConstK     pgtype.Float8 `json:"elo_const_k"`
+	EloConstD     pgtype.Float8 `json:"elo_const_d"`
+	WinReward     pgtype.Float8 `json:"win_reward"`
+}
+
+func (q *Queries) UpsertGameRatingParams(ctx context.Context, arg UpsertGameRatingParamsParams) (GameRatingParam, error) {
+	row := q.db.QueryRow(ctx, upsertGameRatingParams,
+		arg.GameID,
+		arg.EffectiveDate,
+		arg.EloConstK,
+		arg.EloConstD,
+		arg.WinReward,
+	)
+	var i GameRatingParam
+	err := row.Scan(
+		&i.GameID,
+		&i.EffectiveDate,
+		&i.EloConstK,
+		&i.EloConstD,
+		&i.WinReward,
+	)
+	return i, err
+}
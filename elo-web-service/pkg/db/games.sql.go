@@ -7,13 +7,16 @@ package db
 
 import (
 	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const addGame = `-- name: AddGame :one
 INSERT INTO games (id, name)
 VALUES ($1, $2)
 ON CONFLICT (id) DO UPDATE SET id = EXCLUDED.id
-RETURNING id, name
+RETURNING id, name, scoring_direction, result_type
 `
 
 type AddGameParams struct {
@@ -24,7 +27,12 @@ type AddGameParams struct {
 func (q *Queries) AddGame(ctx context.Context, arg AddGameParams) (Game, error) {
 	row := q.db.QueryRow(ctx, addGame, arg.ID, arg.Name)
 	var i Game
-	err := row.Scan(&i.ID, &i.Name)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.ScoringDirection,
+		&i.ResultType,
+	)
 	return i, err
 }
 
@@ -40,15 +48,20 @@ type AddGamesIfNotExistsParams struct {
 	Column2 []string `json:"column_2"`
 }
 
-func (q *Queries) AddGamesIfNotExists(ctx context.Context, arg AddGamesIfNotExistsParams) ([]Game, error) {
+type AddGamesIfNotExistsRow struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (q *Queries) AddGamesIfNotExists(ctx context.Context, arg AddGamesIfNotExistsParams) ([]AddGamesIfNotExistsRow, error) {
 	rows, err := q.db.Query(ctx, addGamesIfNotExists, arg.Column1, arg.Column2)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []Game{}
+	items := []AddGamesIfNotExistsRow{}
 	for rows.Next() {
-		var i Game
+		var i AddGamesIfNotExistsRow
 		if err := rows.Scan(&i.ID, &i.Name); err != nil {
 			return nil, err
 		}
@@ -63,40 +76,123 @@ func (q *Queries) AddGamesIfNotExists(ctx context.Context, arg AddGamesIfNotExis
 const deleteGame = `-- name: DeleteGame :one
 DELETE FROM games
 WHERE id = $1
-RETURNING id, name
+RETURNING id, name, scoring_direction, result_type
 `
 
 func (q *Queries) DeleteGame(ctx context.Context, id string) (Game, error) {
 	row := q.db.QueryRow(ctx, deleteGame, id)
 	var i Game
-	err := row.Scan(&i.ID, &i.Name)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.ScoringDirection,
+		&i.ResultType,
+	)
 	return i, err
 }
 
+const getFirstMatchDateByGame = `-- name: GetFirstMatchDateByGame :one
+SELECT m.date
+FROM matches m
+WHERE m.game_id = $1
+ORDER BY m.date ASC
+LIMIT 1
+`
+
+func (q *Queries) GetFirstMatchDateByGame(ctx context.Context, gameID string) (pgtype.Timestamptz, error) {
+	row := q.db.QueryRow(ctx, getFirstMatchDateByGame, gameID)
+	var date pgtype.Timestamptz
+	err := row.Scan(&date)
+	return date, err
+}
+
 const getGameByID = `-- name: GetGameByID :one
-SELECT id, name FROM games
+SELECT id, name, scoring_direction, result_type FROM games
 WHERE id = $1
 `
 
 func (q *Queries) GetGameByID(ctx context.Context, id string) (Game, error) {
 	row := q.db.QueryRow(ctx, getGameByID, id)
 	var i Game
-	err := row.Scan(&i.ID, &i.Name)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.ScoringDirection,
+		&i.ResultType,
+	)
 	return i, err
 }
 
 const getGameByName = `-- name: GetGameByName :one
-SELECT id, name FROM games
+SELECT id, name, scoring_direction, result_type FROM games
 WHERE name = $1
 `
 
 func (q *Queries) GetGameByName(ctx context.Context, name string) (Game, error) {
 	row := q.db.QueryRow(ctx, getGameByName, name)
 	var i Game
-	err := row.Scan(&i.ID, &i.Name)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.ScoringDirection,
+		&i.ResultType,
+	)
 	return i, err
 }
 
+const getGameFirstEventDate = `-- name: GetGameFirstEventDate :one
+
+SELECT d::timestamptz AS first_date FROM (
+    SELECT date AS d FROM matches WHERE game_id = $1
+    UNION ALL
+    SELECT mk.starts_at FROM markets mk
+    WHERE mk.id IN (SELECT market_id FROM market_match_winner_params WHERE $1 = ANY(game_ids))
+       OR mk.id IN (SELECT market_id FROM market_win_streak_params WHERE $1 = ANY(game_ids))
+) events
+ORDER BY d
+LIMIT 1
+`
+
+// Merging a duplicate game into a kept one (MergeGames).
+// The earliest date the game affects settlements: its first match or the
+// start of a market filtered by it. No row when there is none.
+func (q *Queries) GetGameFirstEventDate(ctx context.Context, gameID string) (time.Time, error) {
+	row := q.db.QueryRow(ctx, getGameFirstEventDate, gameID)
+	var first_date time.Time
+	err := row.Scan(&first_date)
+	return first_date, err
+}
+
+const listGames = `-- name: ListGames :many
+SELECT id, name, scoring_direction, result_type FROM games
+ORDER BY id
+`
+
+func (q *Queries) ListGames(ctx context.Context) ([]Game, error) {
+	rows, err := q.db.Query(ctx, listGames)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Game{}
+	for rows.Next() {
+		var i Game
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.ScoringDirection,
+			&i.ResultType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listGamesOrderedByLastPlayed = `-- name: ListGamesOrderedByLastPlayed :many
 SELECT
 	g.id AS id,
@@ -134,11 +230,176 @@ func (q *Queries) ListGamesOrderedByLastPlayed(ctx context.Context) ([]ListGames
 	return items, nil
 }
 
+const listMatchScoresByGame = `-- name: ListMatchScoresByGame :many
+SELECT ms.match_id, ms.player_id, ms.score, ms.team
+FROM match_scores ms
+JOIN matches m ON m.id = ms.match_id
+WHERE m.game_id = $1 AND m.cooperative_result IS NULL
+ORDER BY ms.match_id
+`
+
+// Scores and teams of every competitive match of a game; used to check that
+// existing results fit a new result type before it is applied.
+func (q *Queries) ListMatchScoresByGame(ctx context.Context, gameID string) ([]MatchScore, error) {
+	rows, err := q.db.Query(ctx, listMatchScoresByGame, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []MatchScore{}
+	for rows.Next() {
+		var i MatchScore
+		if err := rows.Scan(
+			&i.MatchID,
+			&i.PlayerID,
+			&i.Score,
+			&i.Team,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reassignArenaGames = `-- name: ReassignArenaGames :many
+UPDATE arenas
+SET game_ids = ARRAY(
+    SELECT DISTINCT x FROM unnest(array_replace(game_ids, $1::uuid, $2::uuid)) AS x
+    ORDER BY x)
+WHERE $1::uuid = ANY(game_ids)
+RETURNING id, name, game_ids, player_ids, club_id, tournament_id, starts_at, ends_at, league_ladder
+`
+
+type ReassignArenaGamesParams struct {
+	DuplicateID string `json:"duplicate_id"`
+	TargetID    string `json:"target_id"`
+}
+
+// Returns the arenas whose filter changed; they are rebuilt from scratch.
+func (q *Queries) ReassignArenaGames(ctx context.Context, arg ReassignArenaGamesParams) ([]Arena, error) {
+	rows, err := q.db.Query(ctx, reassignArenaGames, arg.DuplicateID, arg.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Arena{}
+	for rows.Next() {
+		var i Arena
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.GameIds,
+			&i.PlayerIds,
+			&i.ClubID,
+			&i.TournamentID,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.LeagueLadder,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reassignGameMatches = `-- name: ReassignGameMatches :exec
+UPDATE matches
+SET game_id = CASE WHEN game_id = $1::uuid THEN $2::uuid ELSE game_id END,
+    calculator_data = CASE
+        WHEN calculator_data->>'fallback_game_id' = $1::uuid::text
+            THEN jsonb_set(calculator_data, '{fallback_game_id}', to_jsonb($2::uuid::text))
+        ELSE calculator_data
+    END
+WHERE game_id = $1::uuid
+   OR calculator_data->>'fallback_game_id' = $1::uuid::text
+`
+
+type ReassignGameMatchesParams struct {
+	DuplicateID string `json:"duplicate_id"`
+	TargetID    string `json:"target_id"`
+}
+
+// Matches and the fallback game their calculator documents keep.
+func (q *Queries) ReassignGameMatches(ctx context.Context, arg ReassignGameMatchesParams) error {
+	_, err := q.db.Exec(ctx, reassignGameMatches, arg.DuplicateID, arg.TargetID)
+	return err
+}
+
+const reassignGameSeasonStandings = `-- name: ReassignGameSeasonStandings :exec
+UPDATE season_standings ss SET game_id = $1::uuid
+WHERE ss.game_id = $2::uuid
+  AND NOT EXISTS (
+      SELECT 1 FROM season_standings t
+      WHERE t.season_id = ss.season_id AND t.game_id = $1::uuid
+  )
+`
+
+type ReassignGameSeasonStandingsParams struct {
+	TargetID    string `json:"target_id"`
+	DuplicateID string `json:"duplicate_id"`
+}
+
+// Archived tables move only to seasons the kept game has no table in; two
+// frozen tables cannot be merged, so the duplicate's goes with it.
+func (q *Queries) ReassignGameSeasonStandings(ctx context.Context, arg ReassignGameSeasonStandingsParams) error {
+	_, err := q.db.Exec(ctx, reassignGameSeasonStandings, arg.TargetID, arg.DuplicateID)
+	return err
+}
+
+const reassignMarketGameFilters = `-- name: ReassignMarketGameFilters :exec
+WITH winner AS (
+    UPDATE market_match_winner_params
+    SET game_ids = ARRAY(
+        SELECT DISTINCT x FROM unnest(array_replace(game_ids, $1::uuid, $2::uuid)) AS x
+        ORDER BY x)
+    WHERE $1::uuid = ANY(game_ids)
+)
+UPDATE market_win_streak_params
+SET game_ids = ARRAY(
+    SELECT DISTINCT x FROM unnest(array_replace(game_ids, $1::uuid, $2::uuid)) AS x
+    ORDER BY x)
+WHERE $1::uuid = ANY(game_ids)
+`
+
+type ReassignMarketGameFiltersParams struct {
+	DuplicateID string `json:"duplicate_id"`
+	TargetID    string `json:"target_id"`
+}
+
+func (q *Queries) ReassignMarketGameFilters(ctx context.Context, arg ReassignMarketGameFiltersParams) error {
+	_, err := q.db.Exec(ctx, reassignMarketGameFilters, arg.DuplicateID, arg.TargetID)
+	return err
+}
+
+const reassignSkullKingFallbackGame = `-- name: ReassignSkullKingFallbackGame :exec
+UPDATE skull_king_tables
+SET game_state = jsonb_set(game_state, '{fallbackGameId}', to_jsonb($1::text))
+WHERE game_state->>'fallbackGameId' = $2::text
+`
+
+type ReassignSkullKingFallbackGameParams struct {
+	TargetID    string `json:"target_id"`
+	DuplicateID string `json:"duplicate_id"`
+}
+
+func (q *Queries) ReassignSkullKingFallbackGame(ctx context.Context, arg ReassignSkullKingFallbackGameParams) error {
+	_, err := q.db.Exec(ctx, reassignSkullKingFallbackGame, arg.TargetID, arg.DuplicateID)
+	return err
+}
+
 const updateGameName = `-- name: UpdateGameName :one
 UPDATE games
 SET name = $2
 WHERE id = $1
-RETURNING id, name
+RETURNING id, name, scoring_direction, result_type
 `
 
 type UpdateGameNameParams struct {
@@ -149,6 +410,37 @@ type UpdateGameNameParams struct {
 func (q *Queries) UpdateGameName(ctx context.Context, arg UpdateGameNameParams) (Game, error) {
 	row := q.db.QueryRow(ctx, updateGameName, arg.ID, arg.Name)
 	var i Game
-	err := row.Scan(&i.ID, &i.Name)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.ScoringDirection,
+		&i.ResultType,
+	)
+	return i, err
+}
+
+const updateGameScoring = `-- name: UpdateGameScoring :one
+UPDATE games
+SET scoring_direction = $2,
+    result_type = $3
+WHERE id = $1
+RETURNING id, name, scoring_direction, result_type
+`
+
+type UpdateGameScoringParams struct {
+	ID               string `json:"id"`
+	ScoringDirection string `json:"scoring_direction"`
+	ResultType       string `json:"result_type"`
+}
+
+func (q *Queries) UpdateGameScoring(ctx context.Context, arg UpdateGameScoringParams) (Game, error) {
+	row := q.db.QueryRow(ctx, updateGameScoring, arg.ID, arg.ScoringDirection, arg.ResultType)
+	var i Game
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.ScoringDirection,
+		&i.ResultType,
+	)
 	return i, err
 }
@@ -641,15 +641,22 @@ func (q *Queries) GetPlayerReservedAmount(ctx context.Context, playerID string)
 
 const getPlayerStreakStats = `-- name: GetPlayerStreakStats :one
 SELECT
-    COUNT(CASE WHEN ms.score = max_scores.max_score THEN 1 END)::int AS wins,
-    COUNT(CASE WHEN ms.score < max_scores.max_score THEN 1 END)::int AS losses
+    COUNT(CASE WHEN m.cooperative_result = 'won'
+                 OR (m.cooperative_result IS NULL AND ms.score = best.best_score) THEN 1 END)::int AS wins,
+    COUNT(CASE WHEN m.cooperative_result = 'lost'
+                 OR (m.cooperative_result IS NULL AND ms.score <> best.best_score) THEN 1 END)::int AS losses
 FROM match_scores ms
 JOIN matches m ON m.id = ms.match_id
+JOIN games g ON g.id = m.game_id
 JOIN (
-    SELECT match_id, MAX(score) AS max_score
+    SELECT match_id, MAX(score) AS max_score, MIN(score) AS min_score
     FROM match_scores
     GROUP BY match_id
-) max_scores ON max_scores.match_id = ms.match_id
+) scores ON scores.match_id = ms.match_id
+CROSS JOIN LATERAL (
+    SELECT CASE WHEN g.scoring_direction = 'lower_wins' OR g.result_type = 'placement'
+                THEN scores.min_score ELSE scores.max_score END AS best_score
+) best
 WHERE ms.player_id = $1
     AND m.game_id = ANY($2::uuid[])
     AND m.date >= $3
@@ -668,6 +675,9 @@ type GetPlayerStreakStatsRow struct {
 	Losses int32 `json:"losses"`
 }
 
+// A cooperative match is won or lost by the whole group (cooperative_result),
+// regardless of the recorded points. Otherwise the best score wins: the
+// highest one, or the lowest one for lower_wins and placement games.
 func (q *Queries) GetPlayerStreakStats(ctx context.Context, arg GetPlayerStreakStatsParams) (GetPlayerStreakStatsRow, error) {
 	row := q.db.QueryRow(ctx, getPlayerStreakStats,
 		arg.PlayerID,
@@ -13,10 +13,11 @@ import (
 )
 
 const createMatch = `-- name: CreateMatch :one
-INSERT INTO matches (id, date, game_id, calculator_kind, calculator_schema_version, calculator_data)
-VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO matches (id, date, game_id, calculator_kind, calculator_schema_version, calculator_data,
+                     cooperative_result, cooperative_global_arena)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 ON CONFLICT (id) DO UPDATE SET id = EXCLUDED.id
-RETURNING id, date, game_id, calculator_kind, calculator_schema_version, calculator_data
+RETURNING id, date, game_id, calculator_kind, calculator_schema_version, calculator_data, cooperative_result, cooperative_global_arena
 `
 
 type CreateMatchParams struct {
@@ -26,6 +27,8 @@ type CreateMatchParams struct {
 	CalculatorKind          pgtype.Text        `json:"calculator_kind"`
 	CalculatorSchemaVersion pgtype.Int4        `json:"calculator_schema_version"`
 	CalculatorData          json.RawMessage    `json:"calculator_data"`
+	CooperativeResult       pgtype.Text        `json:"cooperative_result"`
+	CooperativeGlobalArena  bool               `json:"cooperative_global_arena"`
 }
 
 func (q *Queries) CreateMatch(ctx context.Context, arg CreateMatchParams) (Match, error) {
@@ -36,6 +39,8 @@ func (q *Queries) CreateMatch(ctx context.Context, arg CreateMatchParams) (Match
 		arg.CalculatorKind,
 		arg.CalculatorSchemaVersion,
 		arg.CalculatorData,
+		arg.CooperativeResult,
+		arg.CooperativeGlobalArena,
 	)
 	var i Match
 	err := row.Scan(
@@ -45,6 +50,8 @@ func (q *Queries) CreateMatch(ctx context.Context, arg CreateMatchParams) (Match
 		&i.CalculatorKind,
 		&i.CalculatorSchemaVersion,
 		&i.CalculatorData,
+		&i.CooperativeResult,
+		&i.CooperativeGlobalArena,
 	)
 	return i, err
 }
@@ -67,6 +74,17 @@ func (q *Queries) DeleteAllMatches(ctx context.Context) error {
 	return err
 }
 
+const deleteMatch = `-- name: DeleteMatch :exec
+DELETE FROM matches WHERE id = $1
+`
+
+// Settlements and scores must be deleted first; tournament links cascade and
+// markets resolved by the match lose their resolution_match_id.
+func (q *Queries) DeleteMatch(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, deleteMatch, id)
+	return err
+}
+
 const deleteMatchScores = `-- name: DeleteMatchScores :exec
 DELETE FROM match_scores
 WHERE match_id = $1
@@ -91,7 +109,7 @@ func (q *Queries) GetCountMatchesByGame(ctx context.Context, gameID string) (int
 }
 
 const getMatch = `-- name: GetMatch :one
-SELECT id, date, game_id, calculator_kind, calculator_schema_version, calculator_data FROM matches
+SELECT id, date, game_id, calculator_kind, calculator_schema_version, calculator_data, cooperative_result, cooperative_global_arena FROM matches
 WHERE id = $1
 FOR UPDATE
 `
@@ -106,19 +124,22 @@ func (q *Queries) GetMatch(ctx context.Context, id string) (Match, error) {
 		&i.CalculatorKind,
 		&i.CalculatorSchemaVersion,
 		&i.CalculatorData,
+		&i.CooperativeResult,
+		&i.CooperativeGlobalArena,
 	)
 	return i, err
 }
 
 const getMatchScoresForMatch = `-- name: GetMatchScoresForMatch :many
-SELECT player_id, score
+SELECT player_id, score, team
 FROM match_scores
 WHERE match_id = $1
 `
 
 type GetMatchScoresForMatchRow struct {
-	PlayerID string  `json:"player_id"`
-	Score    float64 `json:"score"`
+	PlayerID string      `json:"player_id"`
+	Score    float64     `json:"score"`
+	Team     pgtype.Text `json:"team"`
 }
 
 func (q *Queries) GetMatchScoresForMatch(ctx context.Context, matchID string) ([]GetMatchScoresForMatchRow, error) {
@@ -130,7 +151,7 @@ func (q *Queries) GetMatchScoresForMatch(ctx context.Context, matchID string) ([
 	items := []GetMatchScoresForMatchRow{}
 	for rows.Next() {
 		var i GetMatchScoresForMatchRow
-		if err := rows.Scan(&i.PlayerID, &i.Score); err != nil {
+		if err := rows.Scan(&i.PlayerID, &i.Score, &i.Team); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -149,9 +170,12 @@ SELECT
     g.name AS game_name,
     m.calculator_kind AS calculator_kind,
     m.calculator_data AS calculator_data,
+    m.cooperative_result AS cooperative_result,
+    m.cooperative_global_arena AS cooperative_global_arena,
     p.id AS player_id,
     p.name AS player_name,
     s.score,
+    s.team,
     gas.rating_staked,
     gas.rating_earned,
     -- CASE forces sqlc to infer a nullable type (interface{}) so pgx can scan NULL
@@ -175,19 +199,22 @@ ORDER BY s.score DESC
 `
 
 type GetMatchWithPlayersRow struct {
-	MatchID        string             `json:"match_id"`
-	Date           pgtype.Timestamptz `json:"date"`
-	GameID         string             `json:"game_id"`
-	GameName       string             `json:"game_name"`
-	CalculatorKind pgtype.Text        `json:"calculator_kind"`
-	CalculatorData json.RawMessage    `json:"calculator_data"`
-	PlayerID       string             `json:"player_id"`
-	PlayerName     string             `json:"player_name"`
-	Score          float64            `json:"score"`
-	RatingStaked   pgtype.Float8      `json:"rating_staked"`
-	RatingEarned   pgtype.Float8      `json:"rating_earned"`
-	RatingAfter    interface{}        `json:"rating_after"`
-	PrevRating     interface{}        `json:"prev_rating"`
+	MatchID                string             `json:"match_id"`
+	Date                   pgtype.Timestamptz `json:"date"`
+	GameID                 string             `json:"game_id"`
+	GameName               string             `json:"game_name"`
+	CalculatorKind         pgtype.Text        `json:"calculator_kind"`
+	CalculatorData         json.RawMessage    `json:"calculator_data"`
+	CooperativeResult      pgtype.Text        `json:"cooperative_result"`
+	CooperativeGlobalArena bool               `json:"cooperative_global_arena"`
+	PlayerID               string             `json:"player_id"`
+	PlayerName             string             `json:"player_name"`
+	Score                  float64            `json:"score"`
+	Team                   pgtype.Text        `json:"team"`
+	RatingStaked           pgtype.Float8      `json:"rating_staked"`
+	RatingEarned           pgtype.Float8      `json:"rating_earned"`
+	RatingAfter            interface{}        `json:"rating_after"`
+	PrevRating             interface{}        `json:"prev_rating"`
 }
 
 func (q *Queries) GetMatchWithPlayers(ctx context.Context, id string) ([]GetMatchWithPlayersRow, error) {
@@ -206,9 +233,12 @@ func (q *Queries) GetMatchWithPlayers(ctx context.Context, id string) ([]GetMatc
 			&i.GameName,
 			&i.CalculatorKind,
 			&i.CalculatorData,
+			&i.CooperativeResult,
+			&i.CooperativeGlobalArena,
 			&i.PlayerID,
 			&i.PlayerName,
 			&i.Score,
+			&i.Team,
 			&i.RatingStaked,
 			&i.RatingEarned,
 			&i.RatingAfter,
@@ -225,7 +255,7 @@ func (q *Queries) GetMatchWithPlayers(ctx context.Context, id string) ([]GetMatc
 }
 
 const getMatchesFromDate = `-- name: GetMatchesFromDate :many
-SELECT m.id, m.date, m.game_id, m.calculator_kind, m.calculator_schema_version, m.calculator_data
+SELECT m.id, m.date, m.game_id, m.calculator_kind, m.calculator_schema_version, m.calculator_data, m.cooperative_result, m.cooperative_global_arena
 FROM matches m
 WHERE m.date >= $1
 ORDER BY m.date ASC, m.id ASC
@@ -247,6 +277,8 @@ func (q *Queries) GetMatchesFromDate(ctx context.Context, date pgtype.Timestampt
 			&i.CalculatorKind,
 			&i.CalculatorSchemaVersion,
 			&i.CalculatorData,
+			&i.CooperativeResult,
+			&i.CooperativeGlobalArena,
 		); err != nil {
 			return nil, err
 		}
@@ -320,6 +352,50 @@ func (q *Queries) ListMatchResults(ctx context.Context, id string) ([]ListMatchR
 	return items, nil
 }
 
+const listMatchScoresFromDate = `-- name: ListMatchScoresFromDate :many
+SELECT ms.match_id, m.date, ms.player_id, ms.score, ms.team
+FROM match_scores ms
+JOIN matches m ON m.id = ms.match_id
+WHERE m.date >= $1
+ORDER BY m.date ASC, m.id ASC, ms.player_id ASC
+`
+
+type ListMatchScoresFromDateRow struct {
+	MatchID  string             `json:"match_id"`
+	Date     pgtype.Timestamptz `json:"date"`
+	PlayerID string             `json:"player_id"`
+	Score    float64            `json:"score"`
+	Team     pgtype.Text        `json:"team"`
+}
+
+// Scores of every match from a date in replay order (replay.go loads the
+// window plus the league count look-back in one go).
+func (q *Queries) ListMatchScoresFromDate(ctx context.Context, date pgtype.Timestamptz) ([]ListMatchScoresFromDateRow, error) {
+	rows, err := q.db.Query(ctx, listMatchScoresFromDate, date)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListMatchScoresFromDateRow{}
+	for rows.Next() {
+		var i ListMatchScoresFromDateRow
+		if err := rows.Scan(
+			&i.MatchID,
+			&i.Date,
+			&i.PlayerID,
+			&i.Score,
+			&i.Team,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listMatchesWithPlayers = `-- name: ListMatchesWithPlayers :many
 SELECT
     m.id AS match_id,
@@ -496,7 +572,7 @@ func (q *Queries) ListMatchesWithPlayersByGame(ctx context.Context, id string) (
 
 const listMatchesWithPlayersPaginated = `-- name: ListMatchesWithPlayersPaginated :many
 WITH paginated_matches AS (
-    SELECT DISTINCT m.id, m.date, m.game_id, m.calculator_kind
+    SELECT DISTINCT m.id, m.date, m.game_id, m.calculator_kind, m.cooperative_result, m.cooperative_global_arena
     FROM matches m
     JOIN match_scores ms ON ms.match_id = m.id
     WHERE
@@ -530,9 +606,12 @@ SELECT
     g.id AS game_id,
     g.name AS game_name,
     pm.calculator_kind AS calculator_kind,
+    pm.cooperative_result AS cooperative_result,
+    pm.cooperative_global_arena AS cooperative_global_arena,
     p.id AS player_id,
     p.name AS player_name,
     s.score,
+    s.team,
     gas.rating_staked,
     gas.rating_earned,
     -- CASE forces sqlc to infer a nullable type (interface{}) so pgx can scan NULL
@@ -565,19 +644,22 @@ type ListMatchesWithPlayersPaginatedParams struct {
 }
 
 type ListMatchesWithPlayersPaginatedRow struct {
-	MatchID        string             `json:"match_id"`
-	Date           pgtype.Timestamptz `json:"date"`
-	GameID         string             `json:"game_id"`
-	GameName       string             `json:"game_name"`
-	CalculatorKind pgtype.Text        `json:"calculator_kind"`
-	PlayerID       string             `json:"player_id"`
-	PlayerName     string             `json:"player_name"`
-	Score          float64            `json:"score"`
-	RatingStaked   pgtype.Float8      `json:"rating_staked"`
-	RatingEarned   pgtype.Float8      `json:"rating_earned"`
-	RatingAfter    interface{}        `json:"rating_after"`
-	PrevRating     interface{}        `json:"prev_rating"`
-	HasMarkets     bool               `json:"has_markets"`
+	MatchID                string             `json:"match_id"`
+	Date                   pgtype.Timestamptz `json:"date"`
+	GameID                 string             `json:"game_id"`
+	GameName               string             `json:"game_name"`
+	CalculatorKind         pgtype.Text        `json:"calculator_kind"`
+	CooperativeResult      pgtype.Text        `json:"cooperative_result"`
+	CooperativeGlobalArena bool               `json:"cooperative_global_arena"`
+	PlayerID               string             `json:"player_id"`
+	PlayerName             string             `json:"player_name"`
+	Score                  float64            `json:"score"`
+	Team                   pgtype.Text        `json:"team"`
+	RatingStaked           pgtype.Float8      `json:"rating_staked"`
+	RatingEarned           pgtype.Float8      `json:"rating_earned"`
+	RatingAfter            interface{}        `json:"rating_after"`
+	PrevRating             interface{}        `json:"prev_rating"`
+	HasMarkets             bool               `json:"has_markets"`
 }
 
 func (q *Queries) ListMatchesWithPlayersPaginated(ctx context.Context, arg ListMatchesWithPlayersPaginatedParams) ([]ListMatchesWithPlayersPaginatedRow, error) {
@@ -602,9 +684,12 @@ func (q *Queries) ListMatchesWithPlayersPaginated(ctx context.Context, arg ListM
 			&i.GameID,
 			&i.GameName,
 			&i.CalculatorKind,
+			&i.CooperativeResult,
+			&i.CooperativeGlobalArena,
 			&i.PlayerID,
 			&i.PlayerName,
 			&i.Score,
+			&i.Team,
 			&i.RatingStaked,
 			&i.RatingEarned,
 			&i.RatingAfter,
@@ -621,13 +706,61 @@ func (q *Queries) ListMatchesWithPlayersPaginated(ctx context.Context, arg ListM
 	return items, nil
 }
 
+const listPairingCounts = `-- name: ListPairingCounts :many
+SELECT a.player_id, b.player_id AS opponent_id, COUNT(*)::int AS matches
+FROM match_scores a
+JOIN match_scores b ON b.match_id = a.match_id AND a.player_id < b.player_id
+WHERE a.player_id = ANY($1::uuid[])
+  AND b.player_id = ANY($1::uuid[])
+  AND ($2::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM match_tournament mt
+      WHERE mt.match_id = a.match_id AND mt.tournament_id = $2::uuid
+  ))
+GROUP BY a.player_id, b.player_id
+`
+
+type ListPairingCountsParams struct {
+	PlayerIds    []string `json:"player_ids"`
+	TournamentID *string  `json:"tournament_id"`
+}
+
+type ListPairingCountsRow struct {
+	PlayerID   string `json:"player_id"`
+	OpponentID string `json:"opponent_id"`
+	Matches    int32  `json:"matches"`
+}
+
+// How many matches every pair of the given players has played together, with
+// player_id < opponent_id; only the tournament's matches when one is given.
+func (q *Queries) ListPairingCounts(ctx context.Context, arg ListPairingCountsParams) ([]ListPairingCountsRow, error) {
+	rows, err := q.db.Query(ctx, listPairingCounts, arg.PlayerIds, arg.TournamentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPairingCountsRow{}
+	for rows.Next() {
+		var i ListPairingCountsRow
+		if err := rows.Scan(&i.PlayerID, &i.OpponentID, &i.Matches); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateMatch = `-- name: UpdateMatch :exec
 UPDATE matches
 SET date = $2,
     game_id = $3,
     calculator_kind = $4,
     calculator_schema_version = $5,
-    calculator_data = $6
+    calculator_data = $6,
+    cooperative_result = $7,
+    cooperative_global_arena = $8
 WHERE id = $1
 `
 
@@ -638,6 +771,8 @@ type UpdateMatchParams struct {
 	CalculatorKind          pgtype.Text        `json:"calculator_kind"`
 	CalculatorSchemaVersion pgtype.Int4        `json:"calculator_schema_version"`
 	CalculatorData          json.RawMessage    `json:"calculator_data"`
+	CooperativeResult       pgtype.Text        `json:"cooperative_result"`
+	CooperativeGlobalArena  bool               `json:"cooperative_global_arena"`
 }
 
 func (q *Queries) UpdateMatch(ctx context.Context, arg UpdateMatchParams) error {
@@ -648,24 +783,32 @@ func (q *Queries) UpdateMatch(ctx context.Context, arg UpdateMatchParams) error
 		arg.CalculatorKind,
 		arg.CalculatorSchemaVersion,
 		arg.CalculatorData,
+		arg.CooperativeResult,
+		arg.CooperativeGlobalArena,
 	)
 	return err
 }
 
 const upsertMatchScore = `-- name: UpsertMatchScore :exec
-INSERT INTO match_scores (match_id, player_id, score)
-VALUES ($1, $2, $3)
+INSERT INTO match_scores (match_id, player_id, score, team)
+VALUES ($1, $2, $3, $4)
 ON CONFLICT (match_id, player_id)
-DO UPDATE SET score = EXCLUDED.score
+DO UPDATE SET score = EXCLUDED.score, team = EXCLUDED.team
 `
 
 type UpsertMatchScoreParams struct {
-	MatchID  string  `json:"match_id"`
-	PlayerID string  `json:"player_id"`
-	Score    float64 `json:"score"`
+	MatchID  string      `json:"match_id"`
+	PlayerID string      `json:"player_id"`
+	Score    float64     `json:"score"`
+	Team     pgtype.Text `json:"team"`
 }
 
 func (q *Queries) UpsertMatchScore(ctx context.Context, arg UpsertMatchScoreParams) error {
-	_, err := q.db.Exec(ctx, upsertMatchScore, arg.MatchID, arg.PlayerID, arg.Score)
+	_, err := q.db.Exec(ctx, upsertMatchScore,
+		arg.MatchID,
+		arg.PlayerID,
+		arg.Score,
+		arg.Team,
+	)
 	return err
 }
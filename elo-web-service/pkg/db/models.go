@@ -11,6 +11,46 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type Arena struct {
+	ID           string             `json:"id"`
+	Name         string             `json:"name"`
+	GameIds      []string           `json:"game_ids"`
+	PlayerIds    []string           `json:"player_ids"`
+	ClubID       *string            `json:"club_id"`
+	TournamentID *string            `json:"tournament_id"`
+	StartsAt     pgtype.Timestamptz `json:"starts_at"`
+	EndsAt       pgtype.Timestamptz `json:"ends_at"`
+	LeagueLadder json.RawMessage    `json:"league_ladder"`
+}
+
+type ArenaSettlement struct {
+	ID              string             `json:"id"`
+	ArenaID         string             `json:"arena_id"`
+	PlayerID        string             `json:"player_id"`
+	MatchID         string             `json:"match_id"`
+	Date            pgtype.Timestamptz `json:"date"`
+	EloAfter        float64            `json:"elo_after"`
+	EloStaked       float64            `json:"elo_staked"`
+	EloEarned       float64            `json:"elo_earned"`
+	DeviationAfter  pgtype.Float8      `json:"deviation_after"`
+	VolatilityAfter pgtype.Float8      `json:"volatility_after"`
+	League          pgtype.Text        `json:"league"`
+	Cubes           float64            `json:"cubes"`
+	Meeples         float64            `json:"meeples"`
+}
+
+type AuditLog struct {
+	ID         string          `json:"id"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UserID     *string         `json:"user_id"`
+	EntityType string          `json:"entity_type"`
+	EntityID   string          `json:"entity_id"`
+	Action     string          `json:"action"`
+	Before     json.RawMessage `json:"before"`
+	After      json.RawMessage `json:"after"`
+	Reason     pgtype.Text     `json:"reason"`
+}
+
 type Bet struct {
 	ID       string             `json:"id"`
 	MarketID string             `json:"market_id"`
@@ -21,6 +61,41 @@ type Bet struct {
 	Shares   float64            `json:"shares"`
 }
 
+type CheckpointGameState struct {
+	CheckpointID    string        `json:"checkpoint_id"`
+	PlayerID        string        `json:"player_id"`
+	GameID          string        `json:"game_id"`
+	EloAfter        float64       `json:"elo_after"`
+	RatingAfter     float64       `json:"rating_after"`
+	League          string        `json:"league"`
+	DeviationAfter  pgtype.Float8 `json:"deviation_after"`
+	VolatilityAfter pgtype.Float8 `json:"volatility_after"`
+}
+
+type CheckpointGlobalState struct {
+	CheckpointID    string        `json:"checkpoint_id"`
+	PlayerID        string        `json:"player_id"`
+	EloAfter        float64       `json:"elo_after"`
+	RatingAfter     float64       `json:"rating_after"`
+	League          string        `json:"league"`
+	DeviationAfter  pgtype.Float8 `json:"deviation_after"`
+	VolatilityAfter pgtype.Float8 `json:"volatility_after"`
+}
+
+type CheckpointMarket struct {
+	CheckpointID  string `json:"checkpoint_id"`
+	MarketID      string `json:"market_id"`
+	BettingClosed bool   `json:"betting_closed"`
+}
+
+type CheckpointVirtualOpponentState struct {
+	CheckpointID    string        `json:"checkpoint_id"`
+	GameID          string        `json:"game_id"`
+	EloAfter        float64       `json:"elo_after"`
+	DeviationAfter  pgtype.Float8 `json:"deviation_after"`
+	VolatilityAfter pgtype.Float8 `json:"volatility_after"`
+}
+
 type Club struct {
 	ID            string      `json:"id"`
 	Name          string      `json:"name"`
@@ -51,44 +126,81 @@ type EloSetting struct {
 	StartingRatingGlobalArena float64            `json:"starting_rating_global_arena"`
 	StartingRatingGameArena   float64            `json:"starting_rating_game_arena"`
 	MarketDefaultLiquidityB   float64            `json:"market_default_liquidity_b"`
+	GlobalArenaAlgorithm      string             `json:"global_arena_algorithm"`
+	GameArenaAlgorithm        string             `json:"game_arena_algorithm"`
+	Glicko2StartingDeviation  float64            `json:"glicko2_starting_deviation"`
+	Glicko2StartingVolatility float64            `json:"glicko2_starting_volatility"`
+	Glicko2Tau                float64            `json:"glicko2_tau"`
+	TrueskillStartingSigma    float64            `json:"trueskill_starting_sigma"`
+	TrueskillBeta             float64            `json:"trueskill_beta"`
+	TrueskillTau              float64            `json:"trueskill_tau"`
+	TrueskillDrawProbability  float64            `json:"trueskill_draw_probability"`
+	InactivityDecayDays       int32              `json:"inactivity_decay_days"`
+	InactivityDecayRate       float64            `json:"inactivity_decay_rate"`
 }
 
 type Game struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	ScoringDirection string `json:"scoring_direction"`
+	ResultType       string `json:"result_type"`
 }
 
 type GameArenaSettlement struct {
-	ID            string             `json:"id"`
-	GameID        string             `json:"game_id"`
-	PlayerID      string             `json:"player_id"`
-	Date          pgtype.Timestamptz `json:"date"`
-	RatingAfter   float64            `json:"rating_after"`
-	EloAfter      float64            `json:"elo_after"`
-	Discriminator string             `json:"discriminator"`
-	MatchID       *string            `json:"match_id"`
-	EloStaked     float64            `json:"elo_staked"`
-	EloEarned     float64            `json:"elo_earned"`
-	RatingStaked  float64            `json:"rating_staked"`
-	RatingEarned  float64            `json:"rating_earned"`
-	League        string             `json:"league"`
+	ID              string             `json:"id"`
+	GameID          string             `json:"game_id"`
+	PlayerID        string             `json:"player_id"`
+	Date            pgtype.Timestamptz `json:"date"`
+	RatingAfter     float64            `json:"rating_after"`
+	EloAfter        float64            `json:"elo_after"`
+	Discriminator   string             `json:"discriminator"`
+	MatchID         *string            `json:"match_id"`
+	EloStaked       float64            `json:"elo_staked"`
+	EloEarned       float64            `json:"elo_earned"`
+	RatingStaked    float64            `json:"rating_staked"`
+	RatingEarned    float64            `json:"rating_earned"`
+	League          string             `json:"league"`
+	DeviationAfter  pgtype.Float8      `json:"deviation_after"`
+	VolatilityAfter pgtype.Float8      `json:"volatility_after"`
+}
+
+type GameRatingParam struct {
+	GameID        string        `json:"game_id"`
+	EffectiveDate time.Time     `json:"effective_date"`
+	EloConstK     pgtype.Float8 `json:"elo_const_k"`
+	EloConstD     pgtype.Float8 `json:"elo_const_d"`
+	WinReward     pgtype.Float8 `json:"win_reward"`
+}
+
+type GameVirtualOpponentSettlement struct {
+	ID              string             `json:"id"`
+	GameID          string             `json:"game_id"`
+	MatchID         string             `json:"match_id"`
+	Date            pgtype.Timestamptz `json:"date"`
+	EloAfter        float64            `json:"elo_after"`
+	EloStaked       float64            `json:"elo_staked"`
+	EloEarned       float64            `json:"elo_earned"`
+	DeviationAfter  pgtype.Float8      `json:"deviation_after"`
+	VolatilityAfter pgtype.Float8      `json:"volatility_after"`
 }
 
 type GlobalArenaSettlement struct {
-	ID            string             `json:"id"`
-	PlayerID      string             `json:"player_id"`
-	Date          pgtype.Timestamptz `json:"date"`
-	RatingAfter   float64            `json:"rating_after"`
-	EloAfter      float64            `json:"elo_after"`
-	Discriminator string             `json:"discriminator"`
-	MatchID       *string            `json:"match_id"`
-	MarketID      *string            `json:"market_id"`
-	CorrectionID  *string            `json:"correction_id"`
-	EloStaked     float64            `json:"elo_staked"`
-	EloEarned     float64            `json:"elo_earned"`
-	RatingStaked  float64            `json:"rating_staked"`
-	RatingEarned  float64            `json:"rating_earned"`
-	League        string             `json:"league"`
+	ID              string             `json:"id"`
+	PlayerID        string             `json:"player_id"`
+	Date            pgtype.Timestamptz `json:"date"`
+	RatingAfter     float64            `json:"rating_after"`
+	EloAfter        float64            `json:"elo_after"`
+	Discriminator   string             `json:"discriminator"`
+	MatchID         *string            `json:"match_id"`
+	MarketID        *string            `json:"market_id"`
+	CorrectionID    *string            `json:"correction_id"`
+	EloStaked       float64            `json:"elo_staked"`
+	EloEarned       float64            `json:"elo_earned"`
+	RatingStaked    float64            `json:"rating_staked"`
+	RatingEarned    float64            `json:"rating_earned"`
+	League          string             `json:"league"`
+	DeviationAfter  pgtype.Float8      `json:"deviation_after"`
+	VolatilityAfter pgtype.Float8      `json:"volatility_after"`
 }
 
 type Market struct {
@@ -141,12 +253,15 @@ type Match struct {
 	CalculatorKind          pgtype.Text        `json:"calculator_kind"`
 	CalculatorSchemaVersion pgtype.Int4        `json:"calculator_schema_version"`
 	CalculatorData          json.RawMessage    `json:"calculator_data"`
+	CooperativeResult       pgtype.Text        `json:"cooperative_result"`
+	CooperativeGlobalArena  bool               `json:"cooperative_global_arena"`
 }
 
 type MatchScore struct {
-	MatchID  string  `json:"match_id"`
-	PlayerID string  `json:"player_id"`
-	Score    float64 `json:"score"`
+	MatchID  string      `json:"match_id"`
+	PlayerID string      `json:"player_id"`
+	Score    float64     `json:"score"`
+	Team     pgtype.Text `json:"team"`
 }
 
 type MatchTournament struct {
@@ -159,6 +274,15 @@ type Player struct {
 	Name          string      `json:"name"`
 	GeologistName pgtype.Text `json:"geologist_name"`
 	BetLimit      float64     `json:"bet_limit"`
+	IsGuest       bool        `json:"is_guest"`
+}
+
+type PlayerAchievement struct {
+	PlayerID   string    `json:"player_id"`
+	Kind       string    `json:"kind"`
+	UnlockedAt time.Time `json:"unlocked_at"`
+	MatchID    *string   `json:"match_id"`
+	MarketID   *string   `json:"market_id"`
 }
 
 type PlayerClubMembership struct {
@@ -166,6 +290,32 @@ type PlayerClubMembership struct {
 	PlayerID string `json:"player_id"`
 }
 
+type Season struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	StartsAt    time.Time          `json:"starts_at"`
+	EndsAt      time.Time          `json:"ends_at"`
+	ResetFactor pgtype.Float8      `json:"reset_factor"`
+	ArchivedAt  pgtype.Timestamptz `json:"archived_at"`
+}
+
+type SeasonStanding struct {
+	SeasonID    string        `json:"season_id"`
+	GameID      *string       `json:"game_id"`
+	PlayerID    string        `json:"player_id"`
+	Rank        int32         `json:"rank"`
+	Rating      float64       `json:"rating"`
+	League      string        `json:"league"`
+	StartRank   pgtype.Int4   `json:"start_rank"`
+	StartRating pgtype.Float8 `json:"start_rating"`
+}
+
+type SettlementCheckpoint struct {
+	ID        string    `json:"id"`
+	Date      time.Time `json:"date"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
 type SkullKingTable struct {
 	ID                 string          `json:"id"`
 	HostUserID         string          `json:"host_user_id"`
@@ -194,4 +344,5 @@ type User struct {
 	GoogleOauthUserName string      `json:"google_oauth_user_name"`
 	PlayerID            *string     `json:"player_id"`
 	LegacyIntID         pgtype.Int4 `json:"legacy_int_id"`
+	IsAdmin             bool        `json:"is_admin"`
 }
@@ -17,7 +17,8 @@ SELECT p.id, p.name,
   CASE WHEN latest_elo.elo_after IS NULL THEN NULL ELSE latest_elo.elo_after END AS elo,
   COALESCE(latest_elo.league, 'newbie') AS league,
   COALESCE(cnt_60.cnt, 0) AS cnt_60,
-  COALESCE(cnt_180.cnt, 0) AS cnt_180
+  COALESCE(cnt_180.cnt, 0) AS cnt_180,
+  p.is_guest
 FROM players p
 LEFT JOIN LATERAL (
   SELECT gas.rating_after, gas.elo_after, gas.league
@@ -40,13 +41,14 @@ ORDER BY latest_elo.rating_after DESC NULLS LAST, p.name
 `
 
 type ListPlayersWithStatsRow struct {
-	ID     string      `json:"id"`
-	Name   string      `json:"name"`
-	Rating interface{} `json:"rating"`
-	Elo    interface{} `json:"elo"`
-	League string      `json:"league"`
-	Cnt60  int64       `json:"cnt_60"`
-	Cnt180 int64       `json:"cnt_180"`
+	ID      string      `json:"id"`
+	Name    string      `json:"name"`
+	Rating  interface{} `json:"rating"`
+	Elo     interface{} `json:"elo"`
+	League  string      `json:"league"`
+	Cnt60   int64       `json:"cnt_60"`
+	Cnt180  int64       `json:"cnt_180"`
+	IsGuest bool        `json:"is_guest"`
 }
 
 func (q *Queries) ListPlayersWithStats(ctx context.Context, date pgtype.Timestamptz) ([]ListPlayersWithStatsRow, error) {
@@ -66,6 +68,7 @@ func (q *Queries) ListPlayersWithStats(ctx context.Context, date pgtype.Timestam
 			&i.League,
 			&i.Cnt60,
 			&i.Cnt180,
+			&i.IsGuest,
 		); err != nil {
 			return nil, err
 		}
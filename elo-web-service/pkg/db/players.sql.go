@@ -7,6 +7,7 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 )
@@ -49,26 +50,33 @@ func (q *Queries) AddPlayersIfNotExists(ctx context.Context, arg AddPlayersIfNot
 }
 
 const createPlayer = `-- name: CreatePlayer :one
-INSERT INTO players (id, name, geologist_name)
-VALUES ($1, $2, $3)
+INSERT INTO players (id, name, geologist_name, is_guest)
+VALUES ($1, $2, $3, $4)
 ON CONFLICT (id) DO UPDATE SET id = EXCLUDED.id
-RETURNING id, name, geologist_name, bet_limit
+RETURNING id, name, geologist_name, bet_limit, is_guest
 `
 
 type CreatePlayerParams struct {
 	ID            string      `json:"id"`
 	Name          string      `json:"name"`
 	GeologistName pgtype.Text `json:"geologist_name"`
+	IsGuest       bool        `json:"is_guest"`
 }
 
 func (q *Queries) CreatePlayer(ctx context.Context, arg CreatePlayerParams) (Player, error) {
-	row := q.db.QueryRow(ctx, createPlayer, arg.ID, arg.Name, arg.GeologistName)
+	row := q.db.QueryRow(ctx, createPlayer,
+		arg.ID,
+		arg.Name,
+		arg.GeologistName,
+		arg.IsGuest,
+	)
 	var i Player
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
 		&i.GeologistName,
 		&i.BetLimit,
+		&i.IsGuest,
 	)
 	return i, err
 }
@@ -83,7 +91,7 @@ func (q *Queries) DeletePlayer(ctx context.Context, id string) error {
 }
 
 const getPlayer = `-- name: GetPlayer :one
-SELECT id, name, geologist_name, bet_limit FROM players
+SELECT id, name, geologist_name, bet_limit, is_guest FROM players
 WHERE id = $1
 `
 
@@ -95,12 +103,13 @@ func (q *Queries) GetPlayer(ctx context.Context, id string) (Player, error) {
 		&i.Name,
 		&i.GeologistName,
 		&i.BetLimit,
+		&i.IsGuest,
 	)
 	return i, err
 }
 
 const getPlayerByName = `-- name: GetPlayerByName :one
-SELECT id, name, geologist_name, bet_limit FROM players
+SELECT id, name, geologist_name, bet_limit, is_guest FROM players
 WHERE name = $1
 `
 
@@ -112,10 +121,40 @@ func (q *Queries) GetPlayerByName(ctx context.Context, name string) (Player, err
 		&i.Name,
 		&i.GeologistName,
 		&i.BetLimit,
+		&i.IsGuest,
 	)
 	return i, err
 }
 
+const getPlayerFirstEventDate = `-- name: GetPlayerFirstEventDate :one
+SELECT d::timestamptz AS first_date FROM (
+    SELECT m.date AS d FROM match_scores ms JOIN matches m ON m.id = ms.match_id WHERE ms.player_id = $1
+    UNION ALL
+    SELECT date FROM corrections WHERE player_id = $1
+    UNION ALL
+    SELECT placed_at FROM bets WHERE player_id = $1
+    UNION ALL
+    SELECT date FROM global_arena_settlement WHERE player_id = $1
+    UNION ALL
+    SELECT mk.starts_at FROM markets mk
+    WHERE mk.id IN (SELECT market_id FROM market_guarantors WHERE player_id = $1)
+       OR mk.id IN (SELECT market_id FROM market_outcomes WHERE player_id = $1)
+       OR mk.id IN (SELECT market_id FROM market_win_streak_params WHERE target_player_id = $1)
+) events
+ORDER BY d
+LIMIT 1
+`
+
+// The earliest date the player affects settlements: a match, correction, bet
+// or settlement of theirs, or the start of a market on them. No row when
+// there is none.
+func (q *Queries) GetPlayerFirstEventDate(ctx context.Context, playerID string) (time.Time, error) {
+	row := q.db.QueryRow(ctx, getPlayerFirstEventDate, playerID)
+	var first_date time.Time
+	err := row.Scan(&first_date)
+	return first_date, err
+}
+
 const getPlayerGameEloStats = `-- name: GetPlayerGameEloStats :many
 SELECT
   g.id::text AS game_id,
@@ -243,6 +282,206 @@ func (q *Queries) GetPlayerGameStats(ctx context.Context, playerID string) ([]Ge
 	return items, nil
 }
 
+const listGuestPlayerIDs = `-- name: ListGuestPlayerIDs :many
+SELECT id FROM players
+WHERE is_guest
+ORDER BY id
+`
+
+func (q *Queries) ListGuestPlayerIDs(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, listGuestPlayerIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGuestsAmong = `-- name: ListGuestsAmong :many
+SELECT id FROM players
+WHERE is_guest AND id = ANY($1::uuid[])
+ORDER BY id
+`
+
+func (q *Queries) ListGuestsAmong(ctx context.Context, playerIds []string) ([]string, error) {
+	rows, err := q.db.Query(ctx, listGuestsAmong, playerIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listHeadToHeadMatches = `-- name: ListHeadToHeadMatches :many
+SELECT
+  m.id AS match_id,
+  m.date,
+  g.id AS game_id,
+  g.name AS game_name,
+  (CASE WHEN g.result_type = 'placement' OR g.scoring_direction = 'lower_wins'
+        THEN a.score < b.score ELSE a.score > b.score END)::bool AS player_above,
+  (CASE WHEN g.result_type = 'placement' OR g.scoring_direction = 'lower_wins'
+        THEN b.score < a.score ELSE b.score > a.score END)::bool AS other_above,
+  (pg.id IS NOT NULL AND og.id IS NOT NULL)::bool AS global_settled,
+  COALESCE(pg.rating_earned + pg.rating_staked, 0)::float8 AS player_global_change,
+  COALESCE(og.rating_earned + og.rating_staked, 0)::float8 AS other_global_change,
+  (pa.id IS NOT NULL AND oa.id IS NOT NULL)::bool AS game_settled,
+  COALESCE(pa.rating_earned + pa.rating_staked, 0)::float8 AS player_game_change,
+  COALESCE(oa.rating_earned + oa.rating_staked, 0)::float8 AS other_game_change
+FROM match_scores a
+JOIN match_scores b ON b.match_id = a.match_id AND b.player_id = $1
+JOIN matches m ON m.id = a.match_id
+JOIN games g ON g.id = m.game_id
+LEFT JOIN global_arena_settlement pg ON pg.match_id = m.id AND pg.player_id = a.player_id AND pg.discriminator = 'match'
+LEFT JOIN global_arena_settlement og ON og.match_id = m.id AND og.player_id = b.player_id AND og.discriminator = 'match'
+LEFT JOIN game_arena_settlement pa ON pa.match_id = m.id AND pa.player_id = a.player_id
+LEFT JOIN game_arena_settlement oa ON oa.match_id = m.id AND oa.player_id = b.player_id
+WHERE a.player_id = $2
+  AND m.cooperative_result IS NULL
+  AND (a.team IS NULL OR a.team <> b.team)
+ORDER BY m.date, m.id
+`
+
+type ListHeadToHeadMatchesParams struct {
+	OtherID  string `json:"other_id"`
+	PlayerID string `json:"player_id"`
+}
+
+type ListHeadToHeadMatchesRow struct {
+	MatchID            string             `json:"match_id"`
+	Date               pgtype.Timestamptz `json:"date"`
+	GameID             string             `json:"game_id"`
+	GameName           string             `json:"game_name"`
+	PlayerAbove        bool               `json:"player_above"`
+	OtherAbove         bool               `json:"other_above"`
+	GlobalSettled      bool               `json:"global_settled"`
+	PlayerGlobalChange float64            `json:"player_global_change"`
+	OtherGlobalChange  float64            `json:"other_global_change"`
+	GameSettled        bool               `json:"game_settled"`
+	PlayerGameChange   float64            `json:"player_game_change"`
+	OtherGameChange    float64            `json:"other_game_change"`
+}
+
+// Matches both players took part in, oldest first, with who finished above
+// whom under the game's scoring rules (a lower place, a lower score in a
+// lower_wins game, otherwise a higher score) and each player's rating change
+// in both arenas. Cooperative matches and matches where the two were
+// teammates are left out: neither player finished above the other there. A
+// *_settled column is false when either player has no settlement of the match
+// in that arena (guests).
+func (q *Queries) ListHeadToHeadMatches(ctx context.Context, arg ListHeadToHeadMatchesParams) ([]ListHeadToHeadMatchesRow, error) {
+	rows, err := q.db.Query(ctx, listHeadToHeadMatches, arg.OtherID, arg.PlayerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListHeadToHeadMatchesRow{}
+	for rows.Next() {
+		var i ListHeadToHeadMatchesRow
+		if err := rows.Scan(
+			&i.MatchID,
+			&i.Date,
+			&i.GameID,
+			&i.GameName,
+			&i.PlayerAbove,
+			&i.OtherAbove,
+			&i.GlobalSettled,
+			&i.PlayerGlobalChange,
+			&i.OtherGlobalChange,
+			&i.GameSettled,
+			&i.PlayerGameChange,
+			&i.OtherGameChange,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPlayerOpponents = `-- name: ListPlayerOpponents :many
+SELECT
+  b.player_id AS opponent_id,
+  p.name,
+  COUNT(*)::int AS matches,
+  COUNT(*) FILTER (WHERE CASE WHEN g.result_type = 'placement' OR g.scoring_direction = 'lower_wins'
+                              THEN a.score < b.score ELSE a.score > b.score END)::int AS player_above,
+  COUNT(*) FILTER (WHERE CASE WHEN g.result_type = 'placement' OR g.scoring_direction = 'lower_wins'
+                              THEN b.score < a.score ELSE b.score > a.score END)::int AS opponent_above
+FROM match_scores a
+JOIN match_scores b ON b.match_id = a.match_id AND b.player_id <> a.player_id
+JOIN matches m ON m.id = a.match_id
+JOIN games g ON g.id = m.game_id
+JOIN players p ON p.id = b.player_id
+WHERE a.player_id = $1
+  AND m.cooperative_result IS NULL
+  AND (a.team IS NULL OR a.team <> b.team)
+GROUP BY b.player_id, p.name
+ORDER BY matches DESC, p.name
+`
+
+type ListPlayerOpponentsRow struct {
+	OpponentID    string `json:"opponent_id"`
+	Name          string `json:"name"`
+	Matches       int32  `json:"matches"`
+	PlayerAbove   int32  `json:"player_above"`
+	OpponentAbove int32  `json:"opponent_above"`
+}
+
+// Everyone the player has played against, most shared matches first, with
+// who finished above whom; cooperative matches and teammates are left out as
+// in ListHeadToHeadMatches.
+func (q *Queries) ListPlayerOpponents(ctx context.Context, playerID string) ([]ListPlayerOpponentsRow, error) {
+	rows, err := q.db.Query(ctx, listPlayerOpponents, playerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPlayerOpponentsRow{}
+	for rows.Next() {
+		var i ListPlayerOpponentsRow
+		if err := rows.Scan(
+			&i.OpponentID,
+			&i.Name,
+			&i.Matches,
+			&i.PlayerAbove,
+			&i.OpponentAbove,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listPlayerUserLinks = `-- name: ListPlayerUserLinks :many
 SELECT player_id, id AS user_id FROM users WHERE player_id IS NOT NULL
 `
@@ -273,7 +512,7 @@ func (q *Queries) ListPlayerUserLinks(ctx context.Context) ([]ListPlayerUserLink
 }
 
 const listPlayers = `-- name: ListPlayers :many
-SELECT id, name, geologist_name, bet_limit FROM players
+SELECT id, name, geologist_name, bet_limit, is_guest FROM players
 ORDER BY name
 `
 
@@ -291,6 +530,7 @@ func (q *Queries) ListPlayers(ctx context.Context) ([]Player, error) {
 			&i.Name,
 			&i.GeologistName,
 			&i.BetLimit,
+			&i.IsGuest,
 		); err != nil {
 			return nil, err
 		}
@@ -302,6 +542,84 @@ func (q *Queries) ListPlayers(ctx context.Context) ([]Player, error) {
 	return items, nil
 }
 
+const listSharedMarkets = `-- name: ListSharedMarkets :many
+SELECT a.market_id FROM market_guarantors a
+JOIN market_guarantors b ON b.market_id = a.market_id
+WHERE a.player_id = $1::uuid AND b.player_id = $2::uuid
+UNION
+SELECT a.market_id FROM market_outcomes a
+JOIN market_outcomes b ON b.market_id = a.market_id
+WHERE a.player_id = $1::uuid AND b.player_id = $2::uuid
+UNION
+SELECT market_id FROM market_match_winner_params
+WHERE $1::uuid = ANY(target_player_ids)
+  AND $2::uuid = ANY(target_player_ids)
+ORDER BY market_id
+`
+
+type ListSharedMarketsParams struct {
+	TargetID    string `json:"target_id"`
+	DuplicateID string `json:"duplicate_id"`
+}
+
+// Markets where both players are guarantors, both have an outcome or both are
+// targets; merged, they would split one residual share, price two outcomes for
+// one person or list one target twice.
+func (q *Queries) ListSharedMarkets(ctx context.Context, arg ListSharedMarketsParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, listSharedMarkets, arg.TargetID, arg.DuplicateID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var market_id string
+		if err := rows.Scan(&market_id); err != nil {
+			return nil, err
+		}
+		items = append(items, market_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSharedMatches = `-- name: ListSharedMatches :many
+
+SELECT a.match_id FROM match_scores a
+JOIN match_scores b ON b.match_id = a.match_id
+WHERE a.player_id = $1 AND b.player_id = $2
+ORDER BY a.match_id
+`
+
+type ListSharedMatchesParams struct {
+	TargetID    string `json:"target_id"`
+	DuplicateID string `json:"duplicate_id"`
+}
+
+// Merging a duplicate player into a kept one (MergePlayers).
+// Matches both players took part in; a merge would give one player two scores.
+func (q *Queries) ListSharedMatches(ctx context.Context, arg ListSharedMatchesParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, listSharedMatches, arg.TargetID, arg.DuplicateID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var match_id string
+		if err := rows.Scan(&match_id); err != nil {
+			return nil, err
+		}
+		items = append(items, match_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const lockPlayerForEloCalculation = `-- name: LockPlayerForEloCalculation :one
 SELECT id FROM players WHERE id = $1 FOR UPDATE
 `
@@ -313,11 +631,273 @@ func (q *Queries) LockPlayerForEloCalculation(ctx context.Context, id string) (s
 	return id_2, err
 }
 
+const lockPlayersForEloCalculation = `-- name: LockPlayersForEloCalculation :many
+SELECT id FROM players WHERE id = ANY($1::uuid[]) ORDER BY id FOR UPDATE
+`
+
+func (q *Queries) LockPlayersForEloCalculation(ctx context.Context, ids []string) ([]string, error) {
+	rows, err := q.db.Query(ctx, lockPlayersForEloCalculation, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const playerHasRatingStakes = `-- name: PlayerHasRatingStakes :one
+SELECT EXISTS (
+    SELECT 1 FROM bets WHERE bets.player_id = $1
+    UNION ALL
+    SELECT 1 FROM market_guarantors WHERE market_guarantors.player_id = $1
+    UNION ALL
+    SELECT 1 FROM corrections WHERE corrections.player_id = $1
+) AS has_stakes
+`
+
+// Whether the player has a bet, guarantees a market or has a rating
+// correction: each of them writes global settlements for the player.
+func (q *Queries) PlayerHasRatingStakes(ctx context.Context, playerID string) (bool, error) {
+	row := q.db.QueryRow(ctx, playerHasRatingStakes, playerID)
+	var has_stakes bool
+	err := row.Scan(&has_stakes)
+	return has_stakes, err
+}
+
+const reassignArenaPlayers = `-- name: ReassignArenaPlayers :many
+UPDATE arenas
+SET player_ids = ARRAY(
+    SELECT DISTINCT x FROM unnest(array_replace(player_ids, $1::uuid, $2::uuid)) AS x
+    ORDER BY x)
+WHERE $1::uuid = ANY(player_ids)
+RETURNING id, name, game_ids, player_ids, club_id, tournament_id, starts_at, ends_at, league_ladder
+`
+
+type ReassignArenaPlayersParams struct {
+	DuplicateID string `json:"duplicate_id"`
+	TargetID    string `json:"target_id"`
+}
+
+// Returns the arenas whose filter changed; they are rebuilt from scratch.
+func (q *Queries) ReassignArenaPlayers(ctx context.Context, arg ReassignArenaPlayersParams) ([]Arena, error) {
+	rows, err := q.db.Query(ctx, reassignArenaPlayers, arg.DuplicateID, arg.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Arena{}
+	for rows.Next() {
+		var i Arena
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.GameIds,
+			&i.PlayerIds,
+			&i.ClubID,
+			&i.TournamentID,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.LeagueLadder,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reassignBets = `-- name: ReassignBets :exec
+UPDATE bets SET player_id = $1 WHERE player_id = $2
+`
+
+type ReassignBetsParams struct {
+	TargetID    string `json:"target_id"`
+	DuplicateID string `json:"duplicate_id"`
+}
+
+func (q *Queries) ReassignBets(ctx context.Context, arg ReassignBetsParams) error {
+	_, err := q.db.Exec(ctx, reassignBets, arg.TargetID, arg.DuplicateID)
+	return err
+}
+
+const reassignCorrections = `-- name: ReassignCorrections :exec
+UPDATE corrections SET player_id = $1 WHERE player_id = $2
+`
+
+type ReassignCorrectionsParams struct {
+	TargetID    string `json:"target_id"`
+	DuplicateID string `json:"duplicate_id"`
+}
+
+func (q *Queries) ReassignCorrections(ctx context.Context, arg ReassignCorrectionsParams) error {
+	_, err := q.db.Exec(ctx, reassignCorrections, arg.TargetID, arg.DuplicateID)
+	return err
+}
+
+const reassignLinkedUser = `-- name: ReassignLinkedUser :exec
+UPDATE users SET player_id = $1::uuid WHERE player_id = $2::uuid
+`
+
+type ReassignLinkedUserParams struct {
+	TargetID    string `json:"target_id"`
+	DuplicateID string `json:"duplicate_id"`
+}
+
+func (q *Queries) ReassignLinkedUser(ctx context.Context, arg ReassignLinkedUserParams) error {
+	_, err := q.db.Exec(ctx, reassignLinkedUser, arg.TargetID, arg.DuplicateID)
+	return err
+}
+
+const reassignMarketGuarantors = `-- name: ReassignMarketGuarantors :exec
+UPDATE market_guarantors SET player_id = $1 WHERE player_id = $2
+`
+
+type ReassignMarketGuarantorsParams struct {
+	TargetID    string `json:"target_id"`
+	DuplicateID string `json:"duplicate_id"`
+}
+
+func (q *Queries) ReassignMarketGuarantors(ctx context.Context, arg ReassignMarketGuarantorsParams) error {
+	_, err := q.db.Exec(ctx, reassignMarketGuarantors, arg.TargetID, arg.DuplicateID)
+	return err
+}
+
+const reassignMarketOutcomes = `-- name: ReassignMarketOutcomes :exec
+UPDATE market_outcomes SET player_id = $1::uuid WHERE player_id = $2::uuid
+`
+
+type ReassignMarketOutcomesParams struct {
+	TargetID    string `json:"target_id"`
+	DuplicateID string `json:"duplicate_id"`
+}
+
+func (q *Queries) ReassignMarketOutcomes(ctx context.Context, arg ReassignMarketOutcomesParams) error {
+	_, err := q.db.Exec(ctx, reassignMarketOutcomes, arg.TargetID, arg.DuplicateID)
+	return err
+}
+
+const reassignMarketTargets = `-- name: ReassignMarketTargets :exec
+WITH winner AS (
+    UPDATE market_match_winner_params
+    SET target_player_ids = array_replace(target_player_ids, $2::uuid, $1::uuid)
+    WHERE $2::uuid = ANY(target_player_ids)
+)
+UPDATE market_win_streak_params SET target_player_id = $1::uuid
+WHERE target_player_id = $2::uuid
+`
+
+type ReassignMarketTargetsParams struct {
+	TargetID    string `json:"target_id"`
+	DuplicateID string `json:"duplicate_id"`
+}
+
+func (q *Queries) ReassignMarketTargets(ctx context.Context, arg ReassignMarketTargetsParams) error {
+	_, err := q.db.Exec(ctx, reassignMarketTargets, arg.TargetID, arg.DuplicateID)
+	return err
+}
+
+const reassignMatchScores = `-- name: ReassignMatchScores :exec
+UPDATE match_scores SET player_id = $1 WHERE player_id = $2
+`
+
+type ReassignMatchScoresParams struct {
+	TargetID    string `json:"target_id"`
+	DuplicateID string `json:"duplicate_id"`
+}
+
+func (q *Queries) ReassignMatchScores(ctx context.Context, arg ReassignMatchScoresParams) error {
+	_, err := q.db.Exec(ctx, reassignMatchScores, arg.TargetID, arg.DuplicateID)
+	return err
+}
+
+const reassignMemberships = `-- name: ReassignMemberships :exec
+WITH clubs AS (
+    INSERT INTO player_club_membership (club_id, player_id)
+    SELECT club_id, $1::uuid FROM player_club_membership WHERE player_id = $2::uuid
+    ON CONFLICT DO NOTHING
+)
+INSERT INTO tournament_player_membership (tournament_id, player_id)
+SELECT tournament_id, $1::uuid FROM tournament_player_membership WHERE player_id = $2::uuid
+ON CONFLICT DO NOTHING
+`
+
+type ReassignMembershipsParams struct {
+	TargetID    string `json:"target_id"`
+	DuplicateID string `json:"duplicate_id"`
+}
+
+// The duplicate's own rows go with it (ON DELETE CASCADE).
+func (q *Queries) ReassignMemberships(ctx context.Context, arg ReassignMembershipsParams) error {
+	_, err := q.db.Exec(ctx, reassignMemberships, arg.TargetID, arg.DuplicateID)
+	return err
+}
+
+const reassignSeasonStandings = `-- name: ReassignSeasonStandings :exec
+UPDATE season_standings ss SET player_id = $1::uuid
+WHERE ss.player_id = $2::uuid
+  AND NOT EXISTS (
+      SELECT 1 FROM season_standings t
+      WHERE t.season_id = ss.season_id
+        AND t.game_id IS NOT DISTINCT FROM ss.game_id
+        AND t.player_id = $1::uuid
+  )
+`
+
+type ReassignSeasonStandingsParams struct {
+	TargetID    string `json:"target_id"`
+	DuplicateID string `json:"duplicate_id"`
+}
+
+// A standing in a table the kept player is already on stays with the
+// duplicate and goes with it (ON DELETE CASCADE).
+func (q *Queries) ReassignSeasonStandings(ctx context.Context, arg ReassignSeasonStandingsParams) error {
+	_, err := q.db.Exec(ctx, reassignSeasonStandings, arg.TargetID, arg.DuplicateID)
+	return err
+}
+
+const setPlayerGuest = `-- name: SetPlayerGuest :one
+UPDATE players
+SET is_guest = $2
+WHERE id = $1
+RETURNING id, name, geologist_name, bet_limit, is_guest
+`
+
+type SetPlayerGuestParams struct {
+	ID      string `json:"id"`
+	IsGuest bool   `json:"is_guest"`
+}
+
+func (q *Queries) SetPlayerGuest(ctx context.Context, arg SetPlayerGuestParams) (Player, error) {
+	row := q.db.QueryRow(ctx, setPlayerGuest, arg.ID, arg.IsGuest)
+	var i Player
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.GeologistName,
+		&i.BetLimit,
+		&i.IsGuest,
+	)
+	return i, err
+}
+
 const updatePlayer = `-- name: UpdatePlayer :one
 UPDATE players
 SET name = $2
 WHERE id = $1
-RETURNING id, name, geologist_name, bet_limit
+RETURNING id, name, geologist_name, bet_limit, is_guest
 `
 
 type UpdatePlayerParams struct {
@@ -333,6 +913,7 @@ func (q *Queries) UpdatePlayer(ctx context.Context, arg UpdatePlayerParams) (Pla
 		&i.Name,
 		&i.GeologistName,
 		&i.BetLimit,
+		&i.IsGuest,
 	)
 	return i, err
 }
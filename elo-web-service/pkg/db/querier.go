@@ -14,12 +14,26 @@ import (
 type Querier interface {
 	AddClubMember(ctx context.Context, arg AddClubMemberParams) error
 	AddGame(ctx context.Context, arg AddGameParams) (Game, error)
-	AddGamesIfNotExists(ctx context.Context, arg AddGamesIfNotExistsParams) ([]Game, error)
+	AddGamesIfNotExists(ctx context.Context, arg AddGamesIfNotExistsParams) ([]AddGamesIfNotExistsRow, error)
 	AddMatchTournament(ctx context.Context, arg AddMatchTournamentParams) error
 	AddPlayersIfNotExists(ctx context.Context, arg AddPlayersIfNotExistsParams) ([]AddPlayersIfNotExistsRow, error)
 	AddSkullKingTablePlayer(ctx context.Context, arg AddSkullKingTablePlayerParams) (SkullKingTable, error)
 	AddTournamentMember(ctx context.Context, arg AddTournamentMemberParams) error
+	ArchiveSeason(ctx context.Context, arg ArchiveSeasonParams) (Season, error)
+	CopyCheckpointGameStates(ctx context.Context, arg []CopyCheckpointGameStatesParams) (int64, error)
+	CopyCheckpointGlobalStates(ctx context.Context, arg []CopyCheckpointGlobalStatesParams) (int64, error)
+	CopyCheckpointMarkets(ctx context.Context, arg []CopyCheckpointMarketsParams) (int64, error)
+	CopyCheckpointVirtualOpponentStates(ctx context.Context, arg []CopyCheckpointVirtualOpponentStatesParams) (int64, error)
+	CopyGameArenaSettlements(ctx context.Context, arg []CopyGameArenaSettlementsParams) (int64, error)
+	CopyGameVirtualOpponentSettlements(ctx context.Context, arg []CopyGameVirtualOpponentSettlementsParams) (int64, error)
+	CopyGlobalArenaMatchSettlements(ctx context.Context, arg []CopyGlobalArenaMatchSettlementsParams) (int64, error)
+	CountAuditEntriesForEntity(ctx context.Context, arg CountAuditEntriesForEntityParams) (int64, error)
+	// Arena matches of the player since @since and before the match.
+	CountPlayerArenaMatchesBeforeMatch(ctx context.Context, arg CountPlayerArenaMatchesBeforeMatchParams) (int32, error)
+	CountPlayerArenaMatchesSince(ctx context.Context, arg CountPlayerArenaMatchesSinceParams) (int32, error)
 	CountTournamentMembers(ctx context.Context, tournamentID string) (int32, error)
+	CreateArena(ctx context.Context, arg CreateArenaParams) (Arena, error)
+	CreateAuditEntry(ctx context.Context, arg CreateAuditEntryParams) error
 	CreateClub(ctx context.Context, arg CreateClubParams) (Club, error)
 	CreateCorrection(ctx context.Context, arg CreateCorrectionParams) (Correction, error)
 	CreateEloSettings(ctx context.Context, arg CreateEloSettingsParams) error
@@ -34,34 +48,65 @@ type Querier interface {
 	CreatePlayer(ctx context.Context, arg CreatePlayerParams) (Player, error)
 	// Bulk-inserts the per-target "player wins" outcomes of a match_winner market.
 	CreatePlayerOutcomes(ctx context.Context, arg CreatePlayerOutcomesParams) error
+	CreateSeason(ctx context.Context, arg CreateSeasonParams) (Season, error)
+	CreateSettlementCheckpoint(ctx context.Context, arg CreateSettlementCheckpointParams) error
 	CreateSkullKingTable(ctx context.Context, arg CreateSkullKingTableParams) (SkullKingTable, error)
 	CreateTournament(ctx context.Context, arg CreateTournamentParams) (Tournament, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (string, error)
 	CreateWinStreakParams(ctx context.Context, arg CreateWinStreakParamsParams) error
 	// The two fixed Да/Нет outcomes of a win_streak market.
 	CreateYesNoOutcomes(ctx context.Context, marketID string) error
+	DeleteAllEloSettings(ctx context.Context) error
 	DeleteAllMatchScores(ctx context.Context) error
 	DeleteAllMatches(ctx context.Context) error
 	// Single delete covering match, market, AND correction settlements.
 	// Called at the start of RecalculateFrom so per-market deletes in
 	// UnsettleMarketsFromDate become harmless no-ops.
 	DeleteAllSettlementsFromDate(ctx context.Context, date pgtype.Timestamptz) error
+	DeleteArena(ctx context.Context, id string) (Arena, error)
+	DeleteArenaSettlementsByArena(ctx context.Context, arenaID string) error
+	DeleteArenaSettlementsByMatch(ctx context.Context, matchID string) error
+	// Called next to DeleteAllSettlementsFromDate at the start of RecalculateFrom,
+	// so rows of matches moved later in time do not linger in the replayed range.
+	DeleteArenaSettlementsFromDate(ctx context.Context, date pgtype.Timestamptz) error
 	DeleteClub(ctx context.Context, id string) (Club, error)
 	DeleteEloSettings(ctx context.Context, effectiveDate pgtype.Timestamptz) error
 	DeleteExpiredSkullKingTables(ctx context.Context) error
 	DeleteGame(ctx context.Context, id string) (Game, error)
 	DeleteGameArenaSettlementByMatch(ctx context.Context, matchID *string) error
+	// Game arena and virtual opponent rows are upserted per match on the per-event
+	// path; the in-memory replay clears the window and copies them back instead.
+	DeleteGameArenaSettlementsFromDate(ctx context.Context, date pgtype.Timestamptz) error
+	DeleteGameVirtualOpponentSettlementByMatch(ctx context.Context, matchID string) error
+	DeleteGameVirtualOpponentSettlementsFromDate(ctx context.Context, date pgtype.Timestamptz) error
+	DeleteGlobalArenaDecayReversalsByMatch(ctx context.Context, matchID *string) error
+	DeleteGlobalArenaDecaySettlementByMatch(ctx context.Context, arg DeleteGlobalArenaDecaySettlementByMatchParams) error
 	// Removes both buyer ('market') and guarantor ('market_guarantor') settlement
 	// rows for a market (used by unsettle/recalculation).
 	DeleteGlobalArenaSettlementByMarket(ctx context.Context, marketID *string) error
 	DeleteGlobalArenaSettlementByMatch(ctx context.Context, matchID *string) error
 	DeleteMarket(ctx context.Context, id string) error
+	// Settlements and scores must be deleted first; tournament links cascade and
+	// markets resolved by the match lose their resolution_match_id.
+	DeleteMatch(ctx context.Context, id string) error
 	DeleteMatchScores(ctx context.Context, matchID string) error
 	DeleteMatchTournamentsByMatch(ctx context.Context, matchID string) error
 	DeletePlayer(ctx context.Context, id string) error
+	DeletePlayerAchievement(ctx context.Context, arg DeletePlayerAchievementParams) error
+	DeleteSeason(ctx context.Context, id string) error
+	// A checkpoint dated after a recalculation start includes settlements the
+	// recalculation rewrites.
+	DeleteSettlementCheckpointsAfter(ctx context.Context, date time.Time) error
 	DeleteSkullKingTable(ctx context.Context, id string) error
 	DeleteTournament(ctx context.Context, id string) (Tournament, error)
 	DeleteUser(ctx context.Context, id string) error
+	GetArena(ctx context.Context, id string) (Arena, error)
+	// Every player's latest arena state with the number of arena matches played.
+	GetArenaLeaderboard(ctx context.Context, arenaID string) ([]GetArenaLeaderboardRow, error)
+	// Meeples each opponent has given the player in the arena before the match,
+	// uncapped: 1/(n−1) per shared match, n being the rated players of that match.
+	GetArenaOpponentMeeplesBeforeMatch(ctx context.Context, arg GetArenaOpponentMeeplesBeforeMatchParams) ([]GetArenaOpponentMeeplesBeforeMatchRow, error)
+	GetArenaPlayerHistory(ctx context.Context, arg GetArenaPlayerHistoryParams) ([]GetArenaPlayerHistoryRow, error)
 	GetBetsAggregatedByOutcome(ctx context.Context, marketID string) ([]GetBetsAggregatedByOutcomeRow, error)
 	// Per-buy rows (each carries the shares bought) used by share settlement.
 	GetBetsForSettlement(ctx context.Context, marketID string) ([]GetBetsForSettlementRow, error)
@@ -69,10 +114,27 @@ type Querier interface {
 	GetClub(ctx context.Context, id string) ([]GetClubRow, error)
 	GetCorrectionsFromDate(ctx context.Context, date pgtype.Timestamptz) ([]Correction, error)
 	GetCountMatchesByGame(ctx context.Context, gameID string) (int64, error)
+	// The entry effective exactly at the date (audit snapshots).
+	GetEloSettingsAt(ctx context.Context, effectiveDate pgtype.Timestamptz) (EloSetting, error)
 	GetEloSettingsForDate(ctx context.Context, effectiveDate pgtype.Timestamptz) (GetEloSettingsForDateRow, error)
+	GetFirstMatchDateByGame(ctx context.Context, gameID string) (pgtype.Timestamptz, error)
 	GetGameByID(ctx context.Context, id string) (Game, error)
 	GetGameByName(ctx context.Context, name string) (Game, error)
+	// Merging a duplicate game into a kept one (MergeGames).
+	// The earliest date the game affects settlements: its first match or the
+	// start of a market filtered by it. No row when there is none.
+	GetGameFirstEventDate(ctx context.Context, gameID string) (time.Time, error)
+	GetGameRatingParamsForDate(ctx context.Context, arg GetGameRatingParamsForDateParams) (GameRatingParam, error)
+	// Current virtual opponent Elo of a game (latest cooperative match).
+	GetGameVirtualOpponentElo(ctx context.Context, gameID string) (float64, error)
+	// Virtual opponent Elo (and rating-algorithm uncertainty) of a game before the
+	// given match (same ordering as the per-player "latest before match" queries).
+	GetGameVirtualOpponentEloBeforeMatch(ctx context.Context, arg GetGameVirtualOpponentEloBeforeMatchParams) (GetGameVirtualOpponentEloBeforeMatchRow, error)
 	GetLatestEloSettings(ctx context.Context) (GetLatestEloSettingsRow, error)
+	GetLatestSettlementCheckpoint(ctx context.Context) (SettlementCheckpoint, error)
+	// The checkpoint RecalculateFrom starts from: the latest one that covers
+	// nothing at or after the window start.
+	GetLatestSettlementCheckpointAtOrBefore(ctx context.Context, date time.Time) (SettlementCheckpoint, error)
 	GetMarket(ctx context.Context, id string) (GetMarketRow, error)
 	// Ordered bet stream used to reconstruct the market's price history by
 	// replaying the LMSR from its creation state q=0.
@@ -95,11 +157,20 @@ type Querier interface {
 	GetNearestMarketExpiry(ctx context.Context) (pgtype.Timestamptz, error)
 	GetNearestSkullKingTableExpiry(ctx context.Context) (time.Time, error)
 	GetPlayer(ctx context.Context, id string) (Player, error)
+	// The player's latest state in every custom arena they have played in.
+	GetPlayerArenaStandings(ctx context.Context, playerID string) ([]GetPlayerArenaStandingsRow, error)
+	// The player's arena state from the latest arena settlement before the match;
+	// same-date matches are ordered by id, as in the built-in arenas.
+	GetPlayerArenaStateBeforeMatch(ctx context.Context, arg GetPlayerArenaStateBeforeMatchParams) (GetPlayerArenaStateBeforeMatchRow, error)
 	GetPlayerBetLimit(ctx context.Context, id string) (float64, error)
 	GetPlayerBetsAggregatedForMarket(ctx context.Context, arg GetPlayerBetsAggregatedForMarketParams) ([]GetPlayerBetsAggregatedForMarketRow, error)
 	// Per-buy rows for one player, used to show shares held / elo spent on the detail page.
 	GetPlayerBetsForMarket(ctx context.Context, arg GetPlayerBetsForMarketParams) ([]GetPlayerBetsForMarketRow, error)
 	GetPlayerByName(ctx context.Context, name string) (Player, error)
+	// The earliest date the player affects settlements: a match, correction, bet
+	// or settlement of theirs, or the start of a market on them. No row when
+	// there is none.
+	GetPlayerFirstEventDate(ctx context.Context, playerID string) (time.Time, error)
 	GetPlayerGameEloStats(ctx context.Context, playerID string) ([]GetPlayerGameEloStatsRow, error)
 	// Counts game-specific matches a player participated in within [from_date, to_date].
 	GetPlayerGameMatchCountInPeriod(ctx context.Context, arg GetPlayerGameMatchCountInPeriodParams) (int32, error)
@@ -112,8 +183,14 @@ type Querier interface {
 	//   every player in each of the target player's matches and the outer query then
 	//   filters down to the target player's own rows.
 	GetPlayerGameStats(ctx context.Context, playerID string) ([]GetPlayerGameStatsRow, error)
+	// Game arena counterpart of GetPlayerGlobalUncertaintyBeforeMatch.
+	GetPlayerGameUncertaintyBeforeMatch(ctx context.Context, arg GetPlayerGameUncertaintyBeforeMatchParams) (GetPlayerGameUncertaintyBeforeMatchRow, error)
 	// Counts matches a player participated in within [from_date, to_date].
 	GetPlayerGlobalMatchCountInPeriod(ctx context.Context, arg GetPlayerGlobalMatchCountInPeriodParams) (int32, error)
+	// Rating-algorithm uncertainty (Glicko-2 / TrueSkill) from the latest global
+	// settlement before the match that tracked it; Elo, correction and market rows
+	// carry none and are skipped.
+	GetPlayerGlobalUncertaintyBeforeMatch(ctx context.Context, arg GetPlayerGlobalUncertaintyBeforeMatchParams) (GetPlayerGlobalUncertaintyBeforeMatchRow, error)
 	GetPlayerLatestGameElo(ctx context.Context, arg GetPlayerLatestGameEloParams) (float64, error)
 	GetPlayerLatestGameEloBeforeMatch(ctx context.Context, arg GetPlayerLatestGameEloBeforeMatchParams) (float64, error)
 	// Returns the display game rating and current game league.
@@ -122,17 +199,30 @@ type Querier interface {
 	// Returns the true Elo value (elo_after) for Elo calculations.
 	GetPlayerLatestGlobalElo(ctx context.Context, playerID string) (float64, error)
 	GetPlayerLatestGlobalEloAtDate(ctx context.Context, arg GetPlayerLatestGlobalEloAtDateParams) (float64, error)
+	// The match's own decay reversal (decay.go) precedes its settlement.
 	GetPlayerLatestGlobalEloBeforeMatch(ctx context.Context, arg GetPlayerLatestGlobalEloBeforeMatchParams) (float64, error)
 	// Returns the display rating (rating_after) and current league for rating-track calculations.
 	GetPlayerLatestGlobalRating(ctx context.Context, playerID string) (GetPlayerLatestGlobalRatingRow, error)
 	GetPlayerLatestGlobalRatingAtDate(ctx context.Context, arg GetPlayerLatestGlobalRatingAtDateParams) (GetPlayerLatestGlobalRatingAtDateRow, error)
+	// Includes the match's own decay reversal, as GetPlayerLatestGlobalEloBeforeMatch.
 	GetPlayerLatestGlobalRatingBeforeMatch(ctx context.Context, arg GetPlayerLatestGlobalRatingBeforeMatchParams) (GetPlayerLatestGlobalRatingBeforeMatchRow, error)
 	// Picks the latest settlement before correction $3 for player $1 at date $2.
 	// Same-date matches/markets (discriminator != 'correction') come before corrections.
 	// Earlier same-date corrections (correction_id < $3) are also included.
 	GetPlayerLatestGlobalStateBeforeCorrection(ctx context.Context, arg GetPlayerLatestGlobalStateBeforeCorrectionParams) (GetPlayerLatestGlobalStateBeforeCorrectionRow, error)
+	// Rating, Elo and league in one row, with GetPlayerLatestGlobalRatingBeforeMatch ordering.
+	GetPlayerLatestGlobalStateBeforeMatch(ctx context.Context, arg GetPlayerLatestGlobalStateBeforeMatchParams) (GetPlayerLatestGlobalStateBeforeMatchRow, error)
+	// The decay drop of the player's current inactivity spell: the latest drop
+	// before the match with no match of the player in between. rating_staked
+	// holds the (negative) amount to give back.
+	GetPlayerPendingDecayBeforeMatch(ctx context.Context, arg GetPlayerPendingDecayBeforeMatchParams) (GetPlayerPendingDecayBeforeMatchRow, error)
 	GetPlayerReservedAmount(ctx context.Context, playerID string) (float64, error)
+	// A cooperative match is won or lost by the whole group (cooperative_result),
+	// regardless of the recorded points. Otherwise the best score wins: the
+	// highest one, or the lowest one for lower_wins and placement games.
 	GetPlayerStreakStats(ctx context.Context, arg GetPlayerStreakStatsParams) (GetPlayerStreakStatsRow, error)
+	GetSeason(ctx context.Context, id string) (Season, error)
+	GetSeasonForUpdate(ctx context.Context, id string) (Season, error)
 	GetSettlementDetails(ctx context.Context, marketID *string) ([]GetSettlementDetailsRow, error)
 	GetSkullKingTable(ctx context.Context, id string) (SkullKingTable, error)
 	GetSkullKingTableForUpdate(ctx context.Context, id string) (SkullKingTable, error)
@@ -147,18 +237,98 @@ type Querier interface {
 	// JWT "sub" claim is a bare int (pre-migration token) that isn't a valid UUID.
 	GetUserByLegacyIntID(ctx context.Context, legacyIntID pgtype.Int4) (User, error)
 	GetWinStreakParams(ctx context.Context, marketID string) (MarketWinStreakParam, error)
+	InsertArenaSettlement(ctx context.Context, arg InsertArenaSettlementParams) error
 	InsertBet(ctx context.Context, arg InsertBetParams) (InsertBetRow, error)
+	// A decay drop (match_id NULL) or its reversal (match_id of the match that
+	// ended the inactivity). Elo is carried over unchanged. A drop another
+	// transaction has already written is skipped.
+	InsertGlobalArenaDecaySettlement(ctx context.Context, arg InsertGlobalArenaDecaySettlementParams) error
+	// A reset another transaction has already written is skipped.
+	InsertGlobalArenaSeasonResetSettlement(ctx context.Context, arg InsertGlobalArenaSeasonResetSettlementParams) error
+	InsertSeasonStanding(ctx context.Context, arg InsertSeasonStandingParams) error
+	// The games played before @from_date by every player who plays again from
+	// @from_date on.
+	ListAchievementGamesBefore(ctx context.Context, fromDate pgtype.Timestamptz) ([]ListAchievementGamesBeforeRow, error)
+	// Global arena settlements from @from_date on in event order, for the
+	// standing before every evaluated match. A market settlement carries the
+	// match that resolved the market, if any.
+	ListAchievementGlobalSettlements(ctx context.Context, fromDate pgtype.Timestamptz) ([]ListAchievementGlobalSettlementsRow, error)
+	// Every score of the matches from @from_date on in event order, with the
+	// game's scoring rules, the teams and the cooperative result. Earlier
+	// competitive matches come along when they are among the last @streak_window
+	// of a player who plays again from @from_date on: they carry the win streaks
+	// into the evaluated matches. When @game_id is set only that game's
+	// matches are listed.
+	ListAchievementMatchScores(ctx context.Context, arg ListAchievementMatchScoresParams) ([]ListAchievementMatchScoresRow, error)
+	// The latest global rating of every player before @from_date, the standing
+	// the evaluated matches start from.
+	ListAchievementRatingsBefore(ctx context.Context, fromDate pgtype.Timestamptz) ([]ListAchievementRatingsBeforeRow, error)
 	// Tournament IDs active at @at whose membership includes EVERY player in @player_ids.
 	ListActiveTournamentsForPlayers(ctx context.Context, arg ListActiveTournamentsForPlayersParams) ([]string, error)
 	// Same shape as ListMarketOutcomesWithPools for every market at once (used by
 	// the markets list endpoints), grouped client-side by market_id.
 	ListAllMarketOutcomesWithPools(ctx context.Context) ([]ListAllMarketOutcomesWithPoolsRow, error)
+	ListAllPlayerAchievements(ctx context.Context) ([]PlayerAchievement, error)
+	ListArenas(ctx context.Context) ([]Arena, error)
+	// Newest first; cursor_id is the id of the last entry of the previous page.
+	ListAuditEntriesPaginated(ctx context.Context, arg ListAuditEntriesPaginatedParams) ([]ListAuditEntriesPaginatedRow, error)
+	// The largest rating paid out to a player by a resolved market.
+	ListBiggestMarketPayout(ctx context.Context) ([]ListBiggestMarketPayoutRow, error)
+	ListCheckpointGameStates(ctx context.Context, checkpointID string) ([]ListCheckpointGameStatesRow, error)
+	ListCheckpointGlobalStates(ctx context.Context, checkpointID string) ([]ListCheckpointGlobalStatesRow, error)
+	ListCheckpointMarkets(ctx context.Context, checkpointID string) ([]ListCheckpointMarketsRow, error)
+	ListCheckpointVirtualOpponentStates(ctx context.Context, checkpointID string) ([]ListCheckpointVirtualOpponentStatesRow, error)
+	// Arenas filtered by the club. They read its current members, so a change of
+	// members rebuilds them.
+	ListClubArenas(ctx context.Context, clubID *string) ([]Arena, error)
+	ListClubMemberIDs(ctx context.Context, clubID string) ([]string, error)
 	ListClubs(ctx context.Context) ([]ListClubsRow, error)
 	ListCorrectionsPaginated(ctx context.Context, arg ListCorrectionsPaginatedParams) ([]ListCorrectionsPaginatedRow, error)
 	ListEloSettings(ctx context.Context) ([]ListEloSettingsRow, error)
+	ListGameArenaSettlementsByMatch(ctx context.Context, matchID *string) ([]GameArenaSettlement, error)
+	// Hall of fame records, read straight from match scores and settlements so
+	// they follow every history edit. Ties go to the earliest holder.
+	// The highest score of every score-based game, or of one game.
+	ListGameHighScores(ctx context.Context, gameID *string) ([]ListGameHighScoresRow, error)
+	// The lowest score of every score-based game, or of one game.
+	ListGameLowScores(ctx context.Context, gameID *string) ([]ListGameLowScoresRow, error)
+	// All versions of all games, for the in-memory replay.
+	ListGameRatingParams(ctx context.Context) ([]GameRatingParam, error)
+	ListGameRatingParamsByGame(ctx context.Context, gameID string) ([]GameRatingParam, error)
+	ListGameRatingsAsOf(ctx context.Context, arg ListGameRatingsAsOfParams) ([]ListGameRatingsAsOfRow, error)
+	ListGames(ctx context.Context) ([]Game, error)
 	ListGamesOrderedByLastPlayed(ctx context.Context) ([]ListGamesOrderedByLastPlayedRow, error)
+	ListGlobalArenaSettlementsByMatch(ctx context.Context, matchID *string) ([]GlobalArenaSettlement, error)
+	ListGuestPlayerIDs(ctx context.Context) ([]string, error)
+	ListGuestsAmong(ctx context.Context, playerIds []string) ([]string, error)
+	// Matches both players took part in, oldest first, with who finished above
+	// whom under the game's scoring rules (a lower place, a lower score in a
+	// lower_wins game, otherwise a higher score) and each player's rating change
+	// in both arenas. Cooperative matches and matches where the two were
+	// teammates are left out: neither player finished above the other there. A
+	// *_settled column is false when either player has no settlement of the match
+	// in that arena (guests).
+	ListHeadToHeadMatches(ctx context.Context, arg ListHeadToHeadMatchesParams) ([]ListHeadToHeadMatchesRow, error)
+	// Players whose last global match before @until has not been followed by a
+	// decay drop yet, with that match's date.
+	ListInactivityDecayCandidates(ctx context.Context, until pgtype.Timestamptz) ([]ListInactivityDecayCandidatesRow, error)
+	// Every player with a global match: the date of their last one and whether a
+	// decay drop has followed it (the drop a next match reverses).
+	ListInactivityDecayStates(ctx context.Context) ([]ListInactivityDecayStatesRow, error)
+	// The largest rating change from a single match in the game's arena.
+	ListLargestGameMatchGain(ctx context.Context, gameID string) ([]ListLargestGameMatchGainRow, error)
+	// The largest global rating change from a single match.
+	ListLargestGlobalMatchGain(ctx context.Context) ([]ListLargestGlobalMatchGainRow, error)
+	ListLatestGameArenaStatesBetween(ctx context.Context, arg ListLatestGameArenaStatesBetweenParams) ([]ListLatestGameArenaStatesBetweenRow, error)
 	ListLatestGameEloPerPlayer(ctx context.Context, gameID string) ([]ListLatestGameEloPerPlayerRow, error)
 	ListLatestGameRatingPerPlayer(ctx context.Context, gameID string) ([]ListLatestGameRatingPerPlayerRow, error)
+	ListLatestGameUncertaintiesBetween(ctx context.Context, arg ListLatestGameUncertaintiesBetweenParams) ([]ListLatestGameUncertaintiesBetweenRow, error)
+	// Each player's latest global settlement in [from_date, to_date): the state
+	// before the replay window on top of a checkpoint taken at from_date
+	// (checkpoints.go). from_date is -infinity when there is no checkpoint.
+	ListLatestGlobalArenaStatesBetween(ctx context.Context, arg ListLatestGlobalArenaStatesBetweenParams) ([]ListLatestGlobalArenaStatesBetweenRow, error)
+	ListLatestGlobalUncertaintiesBetween(ctx context.Context, arg ListLatestGlobalUncertaintiesBetweenParams) ([]ListLatestGlobalUncertaintiesBetweenRow, error)
+	ListLatestVirtualOpponentStatesBetween(ctx context.Context, arg ListLatestVirtualOpponentStatesBetweenParams) ([]ListLatestVirtualOpponentStatesBetweenRow, error)
 	ListMarketGuarantors(ctx context.Context, marketID string) ([]ListMarketGuarantorsRow, error)
 	// Outcome rows in the canonical order: yes/no first (win_streak), then player
 	// outcomes, 'other' last. This order fixes the AMM q-vector layout.
@@ -168,44 +338,127 @@ type Querier interface {
 	ListMarketOutcomesWithPools(ctx context.Context, marketID string) ([]ListMarketOutcomesWithPoolsRow, error)
 	ListMarkets(ctx context.Context) ([]ListMarketsRow, error)
 	ListMarketsByResolutionMatch(ctx context.Context, resolutionMatchID *string) ([]ListMarketsByResolutionMatchRow, error)
+	// Markets whose window had begun at @at and that were not resolved or
+	// cancelled before it.
+	ListMarketsOpenAt(ctx context.Context, at pgtype.Timestamptz) ([]ListMarketsOpenAtRow, error)
 	ListMatchResults(ctx context.Context, id string) ([]ListMatchResultsRow, error)
+	// Scores and teams of every competitive match of a game; used to check that
+	// existing results fit a new result type before it is applied.
+	ListMatchScoresByGame(ctx context.Context, gameID string) ([]MatchScore, error)
+	// Scores of every match from a date in replay order (replay.go loads the
+	// window plus the league count look-back in one go).
+	ListMatchScoresFromDate(ctx context.Context, date pgtype.Timestamptz) ([]ListMatchScoresFromDateRow, error)
 	ListMatchesWithPlayers(ctx context.Context) ([]ListMatchesWithPlayersRow, error)
 	ListMatchesWithPlayersByGame(ctx context.Context, id string) ([]ListMatchesWithPlayersByGameRow, error)
 	ListMatchesWithPlayersByGameFromDB(ctx context.Context, gameID string) ([]ListMatchesWithPlayersByGameFromDBRow, error)
 	ListMatchesWithPlayersPaginated(ctx context.Context, arg ListMatchesWithPlayersPaginatedParams) ([]ListMatchesWithPlayersPaginatedRow, error)
+	// The most matches one player played in a UTC calendar day, in any game or
+	// in one game.
+	ListMostMatchesInDay(ctx context.Context, gameID *string) ([]ListMostMatchesInDayRow, error)
 	ListOpenMatchWinnerMarkets(ctx context.Context) ([]ListOpenMatchWinnerMarketsRow, error)
 	ListOpenWinStreakMarkets(ctx context.Context) ([]ListOpenWinStreakMarketsRow, error)
 	ListOverdueMatchWinnerMarkets(ctx context.Context) ([]ListOverdueMatchWinnerMarketsRow, error)
 	ListOverdueMatchWinnerMarketsAtDate(ctx context.Context, closesAt pgtype.Timestamptz) ([]ListOverdueMatchWinnerMarketsAtDateRow, error)
 	ListOverdueWinStreakMarkets(ctx context.Context) ([]ListOverdueWinStreakMarketsRow, error)
 	ListOverdueWinStreakMarketsAtDate(ctx context.Context, closesAt pgtype.Timestamptz) ([]ListOverdueWinStreakMarketsAtDateRow, error)
+	// How many matches every pair of the given players has played together, with
+	// player_id < opponent_id; only the tournament's matches when one is given.
+	ListPairingCounts(ctx context.Context, arg ListPairingCountsParams) ([]ListPairingCountsRow, error)
+	// Seasons whose reset is due strictly before @until and not written yet, with
+	// someone settled to reset.
+	ListPendingSeasonResets(ctx context.Context, until time.Time) ([]ListPendingSeasonResetsRow, error)
+	ListPlayerAchievements(ctx context.Context, playerID string) ([]PlayerAchievement, error)
+	// Arenas filtered by a club the player is a member of.
+	ListPlayerClubArenas(ctx context.Context, playerID string) ([]Arena, error)
+	// Everyone the player has played against, most shared matches first, with
+	// who finished above whom; cooperative matches and teammates are left out as
+	// in ListHeadToHeadMatches.
+	ListPlayerOpponents(ctx context.Context, playerID string) ([]ListPlayerOpponentsRow, error)
 	ListPlayerUserLinks(ctx context.Context) ([]ListPlayerUserLinksRow, error)
 	ListPlayers(ctx context.Context) ([]Player, error)
 	ListPlayersWithStats(ctx context.Context, date pgtype.Timestamptz) ([]ListPlayersWithStatsRow, error)
+	// Rank 1 of every archived table of every season.
+	ListSeasonChampions(ctx context.Context) ([]ListSeasonChampionsRow, error)
+	// Players with a settlement in the game's arena in [from_date, to_date).
+	ListSeasonGameParticipants(ctx context.Context, arg ListSeasonGameParticipantsParams) ([]string, error)
+	// Games with a game arena settlement in [from_date, to_date).
+	ListSeasonGames(ctx context.Context, arg ListSeasonGamesParams) ([]string, error)
+	// Players with a global match settlement in [from_date, to_date).
+	ListSeasonGlobalParticipants(ctx context.Context, arg ListSeasonGlobalParticipantsParams) ([]string, error)
+	// The archived table of the global arena (game_id NULL) or of a game.
+	ListSeasonStandings(ctx context.Context, arg ListSeasonStandingsParams) ([]ListSeasonStandingsRow, error)
+	ListSeasons(ctx context.Context) ([]Season, error)
+	// The first settlement of every player that reached the elite league and
+	// the first market settlement with a net rating gain. A market can expire by
+	// time between two matches, so these are read over the whole history.
+	ListSettlementAchievements(ctx context.Context) ([]ListSettlementAchievementsRow, error)
+	ListSettlementCheckpoints(ctx context.Context) ([]SettlementCheckpoint, error)
+	// Markets where both players are guarantors, both have an outcome or both are
+	// targets; merged, they would split one residual share, price two outcomes for
+	// one person or list one target twice.
+	ListSharedMarkets(ctx context.Context, arg ListSharedMarketsParams) ([]string, error)
+	// Merging a duplicate player into a kept one (MergePlayers).
+	// Matches both players took part in; a merge would give one player two scores.
+	ListSharedMatches(ctx context.Context, arg ListSharedMatchesParams) ([]string, error)
 	ListSkullKingTables(ctx context.Context) ([]SkullKingTable, error)
 	ListTournaments(ctx context.Context) ([]ListTournamentsRow, error)
 	ListTournamentsByMatchIDs(ctx context.Context, matchIds []string) ([]ListTournamentsByMatchIDsRow, error)
+	// Start dates of the seasons with a reset that has no rows yet.
+	ListUnwrittenSeasonResetDates(ctx context.Context) ([]time.Time, error)
 	ListUsers(ctx context.Context) ([]User, error)
 	// Sets status = 'betting_closed' and records the betting_closed_at timestamp (user event).
 	// Only succeeds if current status = 'open'; the caller must check affected rows or
 	// fetch the market first to return a proper domain error.
 	LockMarketBetting(ctx context.Context, id string) error
 	LockPlayerForEloCalculation(ctx context.Context, id string) (string, error)
+	LockPlayersForEloCalculation(ctx context.Context, ids []string) ([]string, error)
 	PlayerHasMatchInTournament(ctx context.Context, arg PlayerHasMatchInTournamentParams) (bool, error)
+	// Whether the player has a bet, guarantees a market or has a rating
+	// correction: each of them writes global settlements for the player.
+	PlayerHasRatingStakes(ctx context.Context, playerID string) (bool, error)
 	// Returns rating_after and elo_after ordered by date for the player graph.
 	RatingHistory(ctx context.Context, playerID string) ([]RatingHistoryRow, error)
+	// Returns the arenas whose filter changed; they are rebuilt from scratch.
+	ReassignArenaGames(ctx context.Context, arg ReassignArenaGamesParams) ([]Arena, error)
+	// Returns the arenas whose filter changed; they are rebuilt from scratch.
+	ReassignArenaPlayers(ctx context.Context, arg ReassignArenaPlayersParams) ([]Arena, error)
+	ReassignBets(ctx context.Context, arg ReassignBetsParams) error
+	ReassignCorrections(ctx context.Context, arg ReassignCorrectionsParams) error
+	// Matches and the fallback game their calculator documents keep.
+	ReassignGameMatches(ctx context.Context, arg ReassignGameMatchesParams) error
+	// Archived tables move only to seasons the kept game has no table in; two
+	// frozen tables cannot be merged, so the duplicate's goes with it.
+	ReassignGameSeasonStandings(ctx context.Context, arg ReassignGameSeasonStandingsParams) error
+	ReassignLinkedUser(ctx context.Context, arg ReassignLinkedUserParams) error
+	ReassignMarketGameFilters(ctx context.Context, arg ReassignMarketGameFiltersParams) error
+	ReassignMarketGuarantors(ctx context.Context, arg ReassignMarketGuarantorsParams) error
+	ReassignMarketOutcomes(ctx context.Context, arg ReassignMarketOutcomesParams) error
+	ReassignMarketTargets(ctx context.Context, arg ReassignMarketTargetsParams) error
+	ReassignMatchScores(ctx context.Context, arg ReassignMatchScoresParams) error
+	// The duplicate's own rows go with it (ON DELETE CASCADE).
+	ReassignMemberships(ctx context.Context, arg ReassignMembershipsParams) error
+	// A standing in a table the kept player is already on stays with the
+	// duplicate and goes with it (ON DELETE CASCADE).
+	ReassignSeasonStandings(ctx context.Context, arg ReassignSeasonStandingsParams) error
+	ReassignSkullKingFallbackGame(ctx context.Context, arg ReassignSkullKingFallbackGameParams) error
 	RemoveClubMember(ctx context.Context, arg RemoveClubMemberParams) error
 	RemoveTournamentMember(ctx context.Context, arg RemoveTournamentMemberParams) error
 	// resolution_outcome is the winning outcome id; NULL for cancelled markets
 	// (cancellation is carried by the status column).
 	ResolveMarket(ctx context.Context, arg ResolveMarketParams) error
+	// Whether [starts_at, ends_at) overlaps an existing season.
+	SeasonOverlaps(ctx context.Context, arg SeasonOverlapsParams) (bool, error)
+	SetPlayerGuest(ctx context.Context, arg SetPlayerGuestParams) (Player, error)
+	TournamentHasArenas(ctx context.Context, tournamentID *string) (bool, error)
 	// Restores the pre-settlement status: betting_closed if the betting lock user event
 	// was set, otherwise open. betting_closed_at is intentionally left untouched — it is
 	// a user event and must never be cleared by recalculation.
 	UnsettleMarket(ctx context.Context, id string) error
+	UpdateArena(ctx context.Context, arg UpdateArenaParams) (Arena, error)
 	UpdateClubIcon(ctx context.Context, arg UpdateClubIconParams) (Club, error)
 	UpdateClubName(ctx context.Context, arg UpdateClubNameParams) (Club, error)
 	UpdateGameName(ctx context.Context, arg UpdateGameNameParams) (Game, error)
+	UpdateGameScoring(ctx context.Context, arg UpdateGameScoringParams) (Game, error)
 	// Persists one component of the LMSR state vector after a bet shifts the
 	// outstanding shares of an outcome.
 	UpdateMarketOutcomeQ(ctx context.Context, arg UpdateMarketOutcomeQParams) error
@@ -218,6 +471,8 @@ type Querier interface {
 	UpdateUserName(ctx context.Context, arg UpdateUserNameParams) error
 	UpdateUserPlayerID(ctx context.Context, arg UpdateUserPlayerIDParams) error
 	UpsertGameArenaSettlementByMatch(ctx context.Context, arg UpsertGameArenaSettlementByMatchParams) error
+	UpsertGameRatingParams(ctx context.Context, arg UpsertGameRatingParamsParams) (GameRatingParam, error)
+	UpsertGameVirtualOpponentSettlement(ctx context.Context, arg UpsertGameVirtualOpponentSettlementParams) error
 	UpsertGlobalArenaSettlementByCorrection(ctx context.Context, arg UpsertGlobalArenaSettlementByCorrectionParams) error
 	// One row per role per player (buyer 'market' / guarantor 'market_guarantor'):
 	// a player who is both gets two rows, hence the discriminator in the conflict
@@ -225,6 +480,7 @@ type Querier interface {
 	UpsertGlobalArenaSettlementByMarket(ctx context.Context, arg UpsertGlobalArenaSettlementByMarketParams) error
 	UpsertGlobalArenaSettlementByMatch(ctx context.Context, arg UpsertGlobalArenaSettlementByMatchParams) error
 	UpsertMatchScore(ctx context.Context, arg UpsertMatchScoreParams) error
+	UpsertPlayerAchievement(ctx context.Context, arg UpsertPlayerAchievementParams) error
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,103 @@
+-- name: ListAchievementMatchScores :many
+-- Every score of the matches from @from_date on in event order, with the
+-- game's scoring rules, the teams and the cooperative result. Earlier
+-- competitive matches come along when they are among the last @streak_window
+-- of a player who plays again from @from_date on: they carry the win streaks
+-- into the evaluated matches. When @game_id is set only that game's
+-- matches are listed.
+SELECT ms.match_id, m.date, m.game_id, g.scoring_direction, g.result_type,
+       m.cooperative_result, ms.player_id, ms.score, ms.team, p.is_guest
+FROM match_scores ms
+JOIN matches m ON m.id = ms.match_id
+JOIN games g ON g.id = m.game_id
+JOIN players p ON p.id = ms.player_id
+WHERE (sqlc.narg('game_id')::uuid IS NULL OR m.game_id = sqlc.narg('game_id')::uuid)
+  AND (m.date >= @from_date
+       OR ms.match_id IN (
+       SELECT recent.match_id
+       FROM (
+           SELECT rs.match_id,
+                  ROW_NUMBER() OVER (PARTITION BY rs.player_id ORDER BY rm.date DESC, rm.id DESC) AS n
+           FROM match_scores rs
+           JOIN matches rm ON rm.id = rs.match_id
+           WHERE rm.date < @from_date
+             AND rm.cooperative_result IS NULL
+             AND rs.player_id IN (
+                 SELECT ls.player_id
+                 FROM match_scores ls
+                 JOIN matches lm ON lm.id = ls.match_id
+                 WHERE lm.date >= @from_date)
+       ) recent
+       WHERE recent.n <= @streak_window::int))
+ORDER BY m.date, m.id, ms.player_id;
+
+-- name: ListAchievementGlobalSettlements :many
+-- Global arena settlements from @from_date on in event order, for the
+-- standing before every evaluated match. A market settlement carries the
+-- match that resolved the market, if any.
+SELECT gas.player_id, gas.date, gas.rating_after,
+       COALESCE(gas.match_id, mk.resolution_match_id) AS match_id
+FROM global_arena_settlement gas
+LEFT JOIN markets mk ON mk.id = gas.market_id
+WHERE gas.date >= @from_date
+ORDER BY gas.date, gas.id;
+
+-- name: ListAchievementRatingsBefore :many
+-- The latest global rating of every player before @from_date, the standing
+-- the evaluated matches start from.
+SELECT DISTINCT ON (player_id) player_id, rating_after
+FROM global_arena_settlement
+WHERE date < @from_date
+ORDER BY player_id, date DESC, id DESC;
+
+-- name: ListAchievementGamesBefore :many
+-- The games played before @from_date by every player who plays again from
+-- @from_date on.
+SELECT DISTINCT ms.player_id, m.game_id
+FROM match_scores ms
+JOIN matches m ON m.id = ms.match_id
+WHERE m.date < @from_date
+  AND ms.player_id IN (
+      SELECT ls.player_id
+      FROM match_scores ls
+      JOIN matches lm ON lm.id = ls.match_id
+      WHERE lm.date >= @from_date);
+
+-- name: ListSettlementAchievements :many
+-- The first settlement of every player that reached the elite league and
+-- the first market settlement with a net rating gain. A market can expire by
+-- time between two matches, so these are read over the whole history.
+SELECT elite.player_id, 'elite_league'::text AS kind, elite.date, elite.match_id, elite.market_id
+FROM (
+    SELECT DISTINCT ON (gas.player_id) gas.player_id, gas.date,
+           COALESCE(gas.match_id, mk.resolution_match_id) AS match_id, gas.market_id
+    FROM global_arena_settlement gas
+    LEFT JOIN markets mk ON mk.id = gas.market_id
+    WHERE gas.league = 'elite'
+    ORDER BY gas.player_id, gas.date, gas.id
+) elite
+UNION ALL
+SELECT won.player_id, 'market_win'::text AS kind, won.date, won.match_id, won.market_id
+FROM (
+    SELECT DISTINCT ON (gas.player_id) gas.player_id, gas.date,
+           COALESCE(gas.match_id, mk.resolution_match_id) AS match_id, gas.market_id
+    FROM global_arena_settlement gas
+    LEFT JOIN markets mk ON mk.id = gas.market_id
+    WHERE gas.discriminator = 'market' AND gas.rating_earned + gas.rating_staked > 0
+    ORDER BY gas.player_id, gas.date, gas.id
+) won;
+
+-- name: ListAllPlayerAchievements :many
+SELECT * FROM player_achievements ORDER BY player_id, kind;
+
+-- name: ListPlayerAchievements :many
+SELECT * FROM player_achievements WHERE player_id = $1 ORDER BY unlocked_at, kind;
+
+-- name: UpsertPlayerAchievement :exec
+INSERT INTO player_achievements (player_id, kind, unlocked_at, match_id, market_id)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (player_id, kind) DO UPDATE
+SET unlocked_at = EXCLUDED.unlocked_at, match_id = EXCLUDED.match_id, market_id = EXCLUDED.market_id;
+
+-- name: DeletePlayerAchievement :exec
+DELETE FROM player_achievements WHERE player_id = $1 AND kind = $2;
@@ -0,0 +1,186 @@
+-- name: ListArenas :many
+SELECT id, name, game_ids, player_ids, club_id, tournament_id, starts_at, ends_at, league_ladder
+FROM arenas
+ORDER BY name;
+
+-- name: GetArena :one
+SELECT id, name, game_ids, player_ids, club_id, tournament_id, starts_at, ends_at, league_ladder
+FROM arenas
+WHERE id = $1;
+
+-- name: CreateArena :one
+INSERT INTO arenas (id, name, game_ids, player_ids, club_id, tournament_id, starts_at, ends_at, league_ladder)
+VALUES (
+    sqlc.arg('id'),
+    sqlc.arg('name'),
+    sqlc.arg('game_ids')::uuid[],
+    sqlc.arg('player_ids')::uuid[],
+    sqlc.narg('club_id'),
+    sqlc.narg('tournament_id'),
+    sqlc.narg('starts_at'),
+    sqlc.narg('ends_at'),
+    sqlc.narg('league_ladder')
+)
+RETURNING id, name, game_ids, player_ids, club_id, tournament_id, starts_at, ends_at, league_ladder;
+
+-- name: UpdateArena :one
+UPDATE arenas
+SET name          = sqlc.arg('name'),
+    game_ids      = sqlc.arg('game_ids')::uuid[],
+    player_ids    = sqlc.arg('player_ids')::uuid[],
+    club_id       = sqlc.narg('club_id'),
+    tournament_id = sqlc.narg('tournament_id'),
+    starts_at     = sqlc.narg('starts_at'),
+    ends_at       = sqlc.narg('ends_at'),
+    league_ladder = sqlc.narg('league_ladder')
+WHERE id = sqlc.arg('id')
+RETURNING id, name, game_ids, player_ids, club_id, tournament_id, starts_at, ends_at, league_ladder;
+
+-- name: DeleteArena :one
+DELETE FROM arenas
+WHERE id = $1
+RETURNING id, name, game_ids, player_ids, club_id, tournament_id, starts_at, ends_at, league_ladder;
+
+-- name: ListClubMemberIDs :many
+SELECT player_id
+FROM player_club_membership
+WHERE club_id = $1;
+
+-- name: ListClubArenas :many
+-- Arenas filtered by the club. They read its current members, so a change of
+-- members rebuilds them.
+SELECT * FROM arenas WHERE club_id = $1 ORDER BY name;
+
+-- name: ListPlayerClubArenas :many
+-- Arenas filtered by a club the player is a member of.
+SELECT a.*
+FROM arenas a
+JOIN player_club_membership m ON m.club_id = a.club_id
+WHERE m.player_id = $1
+ORDER BY a.name;
+
+-- name: TournamentHasArenas :one
+SELECT EXISTS (SELECT 1 FROM arenas WHERE tournament_id = $1);
+
+-- name: DeleteArenaSettlementsByMatch :exec
+DELETE FROM arena_settlement
+WHERE match_id = $1;
+
+-- name: DeleteArenaSettlementsFromDate :exec
+-- Called next to DeleteAllSettlementsFromDate at the start of RecalculateFrom,
+-- so rows of matches moved later in time do not linger in the replayed range.
+DELETE FROM arena_settlement
+WHERE date >= $1;
+
+-- name: DeleteArenaSettlementsByArena :exec
+DELETE FROM arena_settlement
+WHERE arena_id = $1;
+
+-- name: InsertArenaSettlement :exec
+INSERT INTO arena_settlement (
+    id, arena_id, player_id, match_id, date,
+    elo_after, elo_staked, elo_earned, deviation_after, volatility_after,
+    league, cubes, meeples
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13);
+
+-- name: GetPlayerArenaStateBeforeMatch :one
+-- The player's arena state from the latest arena settlement before the match;
+-- same-date matches are ordered by id, as in the built-in arenas.
+SELECT s.date, s.elo_after, s.deviation_after, s.volatility_after, s.league, s.cubes
+FROM arena_settlement s
+WHERE s.arena_id = $1
+  AND s.player_id = $2
+  AND (s.date < $3 OR (s.date = $3 AND s.match_id < $4))
+ORDER BY s.date DESC, s.match_id DESC
+LIMIT 1;
+
+-- name: GetArenaOpponentMeeplesBeforeMatch :many
+-- Meeples each opponent has given the player in the arena before the match,
+-- uncapped: 1/(n−1) per shared match, n being the rated players of that match.
+SELECT o.player_id AS opponent_id, SUM(1.0 / (n.players - 1))::float8 AS meeples
+FROM arena_settlement s
+JOIN arena_settlement o
+  ON o.arena_id = s.arena_id AND o.match_id = s.match_id AND o.player_id <> s.player_id
+JOIN LATERAL (
+    SELECT COUNT(*) AS players
+    FROM arena_settlement c
+    WHERE c.arena_id = s.arena_id AND c.match_id = s.match_id
+) n ON TRUE
+WHERE s.arena_id = sqlc.arg('arena_id')
+  AND s.player_id = sqlc.arg('player_id')
+  AND (s.date < sqlc.arg('date') OR (s.date = sqlc.arg('date') AND s.match_id < sqlc.arg('match_id')))
+GROUP BY o.player_id;
+
+-- name: CountPlayerArenaMatchesBeforeMatch :one
+-- Arena matches of the player since @since and before the match.
+SELECT COUNT(*)::int
+FROM arena_settlement s
+WHERE s.arena_id = sqlc.arg('arena_id')
+  AND s.player_id = sqlc.arg('player_id')
+  AND s.date >= sqlc.arg('since')
+  AND (s.date < sqlc.arg('date') OR (s.date = sqlc.arg('date') AND s.match_id < sqlc.arg('match_id')));
+
+-- name: CountPlayerArenaMatchesSince :one
+SELECT COUNT(*)::int
+FROM arena_settlement s
+WHERE s.arena_id = sqlc.arg('arena_id') AND s.player_id = sqlc.arg('player_id') AND s.date >= sqlc.arg('since');
+
+-- name: GetArenaLeaderboard :many
+-- Every player's latest arena state with the number of arena matches played.
+SELECT
+    latest.player_id,
+    p.name AS player_name,
+    latest.elo_after,
+    latest.deviation_after,
+    latest.league,
+    latest.cubes,
+    latest.meeples,
+    latest.date AS last_match_date,
+    counts.matches_played
+FROM (
+    SELECT DISTINCT ON (s.player_id)
+        s.player_id, s.elo_after, s.deviation_after, s.league, s.cubes, s.meeples, s.date
+    FROM arena_settlement s
+    WHERE s.arena_id = $1
+    ORDER BY s.player_id, s.date DESC, s.match_id DESC
+) latest
+JOIN (
+    SELECT s.player_id, COUNT(*)::int AS matches_played
+    FROM arena_settlement s
+    WHERE s.arena_id = $1
+    GROUP BY s.player_id
+) counts ON counts.player_id = latest.player_id
+JOIN players p ON p.id = latest.player_id
+ORDER BY latest.elo_after DESC, latest.player_id;
+
+-- name: GetArenaPlayerHistory :many
+SELECT s.match_id, s.date, m.game_id, s.elo_after, s.elo_staked, s.elo_earned, s.deviation_after,
+       s.league, s.cubes, s.meeples
+FROM arena_settlement s
+JOIN matches m ON m.id = s.match_id
+WHERE s.arena_id = $1 AND s.player_id = $2
+ORDER BY s.date DESC, s.match_id DESC;
+
+-- name: GetPlayerArenaStandings :many
+-- The player's latest state in every custom arena they have played in.
+SELECT
+    a.id AS arena_id,
+    a.name AS arena_name,
+    a.league_ladder,
+    latest.elo_after,
+    latest.league,
+    latest.cubes,
+    latest.meeples,
+    latest.date AS last_match_date,
+    (SELECT COUNT(*) FROM arena_settlement c
+     WHERE c.arena_id = a.id AND c.player_id = sqlc.arg('player_id'))::int AS matches_played
+FROM arenas a
+JOIN LATERAL (
+    SELECT s.elo_after, s.league, s.cubes, s.meeples, s.date
+    FROM arena_settlement s
+    WHERE s.arena_id = a.id AND s.player_id = sqlc.arg('player_id')
+    ORDER BY s.date DESC, s.match_id DESC
+    LIMIT 1
+) latest ON TRUE
+ORDER BY a.name;
@@ -0,0 +1,20 @@
+-- name: CreateAuditEntry :exec
+INSERT INTO audit_log (id, user_id, entity_type, entity_id, action, before, after, reason)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: ListAuditEntriesPaginated :many
+-- Newest first; cursor_id is the id of the last entry of the previous page.
+SELECT a.id, a.created_at, a.user_id, u.google_oauth_user_name AS user_name,
+       a.entity_type, a.entity_id, a.action, a.before, a.after, a.reason
+FROM audit_log a
+LEFT JOIN users u ON u.id = a.user_id
+WHERE
+  (sqlc.narg('entity_type')::text IS NULL OR a.entity_type = sqlc.narg('entity_type')::text)
+  AND (sqlc.narg('entity_id')::text IS NULL OR a.entity_id = sqlc.narg('entity_id')::text)
+  AND (sqlc.narg('user_id')::uuid IS NULL OR a.user_id = sqlc.narg('user_id')::uuid)
+  AND (sqlc.narg('cursor_id')::uuid IS NULL OR a.id < sqlc.narg('cursor_id')::uuid)
+ORDER BY a.id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: CountAuditEntriesForEntity :one
+SELECT COUNT(*) FROM audit_log WHERE entity_type = $1 AND entity_id = $2;
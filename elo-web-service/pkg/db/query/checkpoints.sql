@@ -0,0 +1,80 @@
+-- name: GetLatestSettlementCheckpointAtOrBefore :one
+-- The checkpoint RecalculateFrom starts from: the latest one that covers
+-- nothing at or after the window start.
+SELECT id, date, created_at
+FROM settlement_checkpoints
+WHERE date <= $1
+ORDER BY date DESC
+LIMIT 1;
+
+-- name: GetLatestSettlementCheckpoint :one
+SELECT id, date, created_at
+FROM settlement_checkpoints
+ORDER BY date DESC
+LIMIT 1;
+
+-- name: ListSettlementCheckpoints :many
+SELECT id, date, created_at
+FROM settlement_checkpoints
+ORDER BY date;
+
+-- name: CreateSettlementCheckpoint :exec
+INSERT INTO settlement_checkpoints (id, date) VALUES ($1, $2);
+
+-- name: DeleteSettlementCheckpointsAfter :exec
+-- A checkpoint dated after a recalculation start includes settlements the
+-- recalculation rewrites.
+DELETE FROM settlement_checkpoints WHERE date > $1;
+
+-- name: ListCheckpointGlobalStates :many
+SELECT player_id, elo_after, rating_after, league, deviation_after, volatility_after
+FROM checkpoint_global_state
+WHERE checkpoint_id = $1
+ORDER BY player_id;
+
+-- name: ListCheckpointGameStates :many
+SELECT player_id, game_id, elo_after, rating_after, league, deviation_after, volatility_after
+FROM checkpoint_game_state
+WHERE checkpoint_id = $1
+ORDER BY player_id, game_id;
+
+-- name: ListCheckpointVirtualOpponentStates :many
+SELECT game_id, elo_after, deviation_after, volatility_after
+FROM checkpoint_virtual_opponent_state
+WHERE checkpoint_id = $1
+ORDER BY game_id;
+
+-- name: ListCheckpointMarkets :many
+SELECT market_id, betting_closed
+FROM checkpoint_markets
+WHERE checkpoint_id = $1
+ORDER BY market_id;
+
+-- name: ListMarketsOpenAt :many
+-- Markets whose window had begun at @at and that were not resolved or
+-- cancelled before it.
+SELECT m.id AS market_id,
+       (m.betting_closed_at IS NOT NULL AND m.betting_closed_at < sqlc.arg('at'))::bool AS betting_closed
+FROM markets m
+WHERE m.starts_at < sqlc.arg('at')
+  AND (m.resolved_at IS NULL OR m.resolved_at >= sqlc.arg('at'))
+ORDER BY m.id;
+
+-- name: CopyCheckpointGlobalStates :copyfrom
+INSERT INTO checkpoint_global_state
+    (checkpoint_id, player_id, elo_after, rating_after, league, deviation_after, volatility_after)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: CopyCheckpointGameStates :copyfrom
+INSERT INTO checkpoint_game_state
+    (checkpoint_id, player_id, game_id, elo_after, rating_after, league, deviation_after, volatility_after)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: CopyCheckpointVirtualOpponentStates :copyfrom
+INSERT INTO checkpoint_virtual_opponent_state
+    (checkpoint_id, game_id, elo_after, deviation_after, volatility_after)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: CopyCheckpointMarkets :copyfrom
+INSERT INTO checkpoint_markets (checkpoint_id, market_id, betting_closed)
+VALUES ($1, $2, $3);
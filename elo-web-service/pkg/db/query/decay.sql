@@ -0,0 +1,75 @@
+-- name: ListInactivityDecayCandidates :many
+-- Players whose last global match before @until has not been followed by a
+-- decay drop yet, with that match's date.
+SELECT last.player_id, last.date::timestamptz AS last_match_date
+FROM (
+    SELECT gas.player_id, MAX(gas.date) AS date
+    FROM global_arena_settlement gas
+    WHERE gas.discriminator = 'match' AND gas.date < sqlc.arg('until')
+    GROUP BY gas.player_id
+) last
+WHERE NOT EXISTS (
+    SELECT 1 FROM global_arena_settlement d
+    WHERE d.player_id = last.player_id
+      AND d.discriminator = 'decay'
+      AND d.match_id IS NULL
+      AND d.date > last.date
+)
+ORDER BY last.player_id;
+
+-- name: GetPlayerPendingDecayBeforeMatch :one
+-- The decay drop of the player's current inactivity spell: the latest drop
+-- before the match with no match of the player in between. rating_staked
+-- holds the (negative) amount to give back.
+SELECT d.id, d.rating_staked
+FROM global_arena_settlement d
+WHERE d.player_id = sqlc.arg('player_id')
+  AND d.discriminator = 'decay'
+  AND d.match_id IS NULL
+  AND d.date < sqlc.arg('date')
+  AND NOT EXISTS (
+      SELECT 1 FROM global_arena_settlement m
+      WHERE m.player_id = d.player_id
+        AND m.discriminator = 'match'
+        AND m.date >= d.date
+        AND (m.date < sqlc.arg('date') OR (m.date = sqlc.arg('date') AND m.match_id < sqlc.arg('match_id')))
+  )
+ORDER BY d.date DESC
+LIMIT 1;
+
+-- name: InsertGlobalArenaDecaySettlement :exec
+-- A decay drop (match_id NULL) or its reversal (match_id of the match that
+-- ended the inactivity). Elo is carried over unchanged. A drop another
+-- transaction has already written is skipped.
+INSERT INTO global_arena_settlement
+    (id, player_id, date, rating_after, elo_after, discriminator, match_id,
+     elo_staked, elo_earned, rating_staked, rating_earned, league)
+VALUES ($1, $2, $3, $4, $5, 'decay', sqlc.narg('match_id'), 0, 0, $6, $7, $8)
+ON CONFLICT (player_id, date) WHERE discriminator = 'decay' AND match_id IS NULL DO NOTHING;
+
+-- name: DeleteGlobalArenaDecaySettlementByMatch :exec
+DELETE FROM global_arena_settlement
+WHERE match_id = sqlc.arg('match_id') AND player_id = sqlc.arg('player_id') AND discriminator = 'decay';
+
+-- name: DeleteGlobalArenaDecayReversalsByMatch :exec
+DELETE FROM global_arena_settlement
+WHERE match_id = $1 AND discriminator = 'decay';
+
+-- name: ListInactivityDecayStates :many
+-- Every player with a global match: the date of their last one and whether a
+-- decay drop has followed it (the drop a next match reverses).
+SELECT last.player_id, last.date::timestamptz AS last_match_date,
+    EXISTS (
+        SELECT 1 FROM global_arena_settlement d
+        WHERE d.player_id = last.player_id
+          AND d.discriminator = 'decay'
+          AND d.match_id IS NULL
+          AND d.date > last.date
+    )::bool AS decayed
+FROM (
+    SELECT gas.player_id, MAX(gas.date) AS date
+    FROM global_arena_settlement gas
+    WHERE gas.discriminator = 'match'
+    GROUP BY gas.player_id
+) last
+ORDER BY last.player_id;
@@ -4,7 +4,11 @@ SELECT elo_const_k, elo_const_d, starting_elo, win_reward,
        newbie_league_goal_gap,
        starting_rating_global_arena, starting_rating_game_arena,
        elite_league_matches_6months, elite_league_matches_2months,
-       market_default_liquidity_b
+       market_default_liquidity_b,
+       global_arena_algorithm, game_arena_algorithm,
+       glicko2_starting_deviation, glicko2_starting_volatility, glicko2_tau,
+       trueskill_starting_sigma, trueskill_beta, trueskill_tau, trueskill_draw_probability,
+       inactivity_decay_days, inactivity_decay_rate
 FROM elo_settings
 WHERE effective_date <= $1
 ORDER BY effective_date DESC
@@ -15,7 +19,11 @@ SELECT elo_const_k, elo_const_d, starting_elo, win_reward, effective_date,
        newbie_league_earned_min, newbie_league_earned_max, newbie_league_earned_tau,
        newbie_league_goal_gap,
        starting_rating_global_arena, starting_rating_game_arena,
-       elite_league_matches_6months, elite_league_matches_2months
+       elite_league_matches_6months, elite_league_matches_2months,
+       global_arena_algorithm, game_arena_algorithm,
+       glicko2_starting_deviation, glicko2_starting_volatility, glicko2_tau,
+       trueskill_starting_sigma, trueskill_beta, trueskill_tau, trueskill_draw_probability,
+       inactivity_decay_days, inactivity_decay_rate
 FROM elo_settings
 ORDER BY effective_date DESC
 LIMIT 1;
@@ -25,17 +33,32 @@ INSERT INTO elo_settings (effective_date, elo_const_k, elo_const_d, starting_elo
     newbie_league_earned_min, newbie_league_earned_max, newbie_league_earned_tau,
     newbie_league_goal_gap,
     starting_rating_global_arena, starting_rating_game_arena,
-    elite_league_matches_6months, elite_league_matches_2months)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13);
+    elite_league_matches_6months, elite_league_matches_2months,
+    global_arena_algorithm, game_arena_algorithm,
+    glicko2_starting_deviation, glicko2_starting_volatility, glicko2_tau,
+    trueskill_starting_sigma, trueskill_beta, trueskill_tau, trueskill_draw_probability,
+    inactivity_decay_days, inactivity_decay_rate)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24);
 
 -- name: ListEloSettings :many
 SELECT effective_date, elo_const_k, elo_const_d, starting_elo, win_reward,
        newbie_league_earned_min, newbie_league_earned_max, newbie_league_earned_tau,
        newbie_league_goal_gap,
        starting_rating_global_arena, starting_rating_game_arena,
-       elite_league_matches_6months, elite_league_matches_2months
+       elite_league_matches_6months, elite_league_matches_2months,
+       global_arena_algorithm, game_arena_algorithm,
+       glicko2_starting_deviation, glicko2_starting_volatility, glicko2_tau,
+       trueskill_starting_sigma, trueskill_beta, trueskill_tau, trueskill_draw_probability,
+       inactivity_decay_days, inactivity_decay_rate
 FROM elo_settings
 ORDER BY effective_date DESC;
 
 -- name: DeleteEloSettings :exec
 DELETE FROM elo_settings WHERE effective_date = $1;
+
+-- name: DeleteAllEloSettings :exec
+DELETE FROM elo_settings;
+
+-- name: GetEloSettingsAt :one
+-- The entry effective exactly at the date (audit snapshots).
+SELECT * FROM elo_settings WHERE effective_date = $1;
@@ -0,0 +1,24 @@
+-- name: GetGameRatingParamsForDate :one
+SELECT * FROM game_rating_params
+WHERE game_id = $1 AND effective_date <= $2
+ORDER BY effective_date DESC
+LIMIT 1;
+
+-- name: ListGameRatingParams :many
+-- All versions of all games, for the in-memory replay.
+SELECT * FROM game_rating_params
+ORDER BY game_id, effective_date DESC;
+
+-- name: ListGameRatingParamsByGame :many
+SELECT * FROM game_rating_params
+WHERE game_id = $1
+ORDER BY effective_date DESC;
+
+-- name: UpsertGameRatingParams :one
+INSERT INTO game_rating_params (game_id, effective_date, elo_const_k, elo_const_d, win_reward)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (game_id, effective_date) DO UPDATE
+    SET elo_const_k = EXCLUDED.elo_const_k,
+        elo_const_d = EXCLUDED.elo_const_d,
+        win_reward  = EXCLUDED.win_reward
+RETURNING *;
@@ -37,4 +37,95 @@ WHERE name = $1;
 
 -- name: GetGameByID :one
 SELECT * FROM games
-WHERE id = $1;
\ No newline at end of file
+WHERE id = $1;
+-- name: UpdateGameScoring :one
+UPDATE games
+SET scoring_direction = $2,
+    result_type = $3
+WHERE id = $1
+RETURNING *;
+
+-- name: GetFirstMatchDateByGame :one
+SELECT m.date
+FROM matches m
+WHERE m.game_id = $1
+ORDER BY m.date ASC
+LIMIT 1;
+
+-- name: ListMatchScoresByGame :many
+-- Scores and teams of every competitive match of a game; used to check that
+-- existing results fit a new result type before it is applied.
+SELECT ms.match_id, ms.player_id, ms.score, ms.team
+FROM match_scores ms
+JOIN matches m ON m.id = ms.match_id
+WHERE m.game_id = $1 AND m.cooperative_result IS NULL
+ORDER BY ms.match_id;
+
+-- name: ListGames :many
+SELECT * FROM games
+ORDER BY id;
+
+-- Merging a duplicate game into a kept one (MergeGames).
+
+-- name: GetGameFirstEventDate :one
+-- The earliest date the game affects settlements: its first match or the
+-- start of a market filtered by it. No row when there is none.
+SELECT d::timestamptz AS first_date FROM (
+    SELECT date AS d FROM matches WHERE game_id = $1
+    UNION ALL
+    SELECT mk.starts_at FROM markets mk
+    WHERE mk.id IN (SELECT market_id FROM market_match_winner_params WHERE $1 = ANY(game_ids))
+       OR mk.id IN (SELECT market_id FROM market_win_streak_params WHERE $1 = ANY(game_ids))
+) events
+ORDER BY d
+LIMIT 1;
+
+-- name: ReassignGameMatches :exec
+-- Matches and the fallback game their calculator documents keep.
+UPDATE matches
+SET game_id = CASE WHEN game_id = sqlc.arg('duplicate_id')::uuid THEN sqlc.arg('target_id')::uuid ELSE game_id END,
+    calculator_data = CASE
+        WHEN calculator_data->>'fallback_game_id' = sqlc.arg('duplicate_id')::uuid::text
+            THEN jsonb_set(calculator_data, '{fallback_game_id}', to_jsonb(sqlc.arg('target_id')::uuid::text))
+        ELSE calculator_data
+    END
+WHERE game_id = sqlc.arg('duplicate_id')::uuid
+   OR calculator_data->>'fallback_game_id' = sqlc.arg('duplicate_id')::uuid::text;
+
+-- name: ReassignSkullKingFallbackGame :exec
+UPDATE skull_king_tables
+SET game_state = jsonb_set(game_state, '{fallbackGameId}', to_jsonb(sqlc.arg('target_id')::text))
+WHERE game_state->>'fallbackGameId' = sqlc.arg('duplicate_id')::text;
+
+-- name: ReassignMarketGameFilters :exec
+WITH winner AS (
+    UPDATE market_match_winner_params
+    SET game_ids = ARRAY(
+        SELECT DISTINCT x FROM unnest(array_replace(game_ids, sqlc.arg('duplicate_id')::uuid, sqlc.arg('target_id')::uuid)) AS x
+        ORDER BY x)
+    WHERE sqlc.arg('duplicate_id')::uuid = ANY(game_ids)
+)
+UPDATE market_win_streak_params
+SET game_ids = ARRAY(
+    SELECT DISTINCT x FROM unnest(array_replace(game_ids, sqlc.arg('duplicate_id')::uuid, sqlc.arg('target_id')::uuid)) AS x
+    ORDER BY x)
+WHERE sqlc.arg('duplicate_id')::uuid = ANY(game_ids);
+
+-- name: ReassignArenaGames :many
+-- Returns the arenas whose filter changed; they are rebuilt from scratch.
+UPDATE arenas
+SET game_ids = ARRAY(
+    SELECT DISTINCT x FROM unnest(array_replace(game_ids, sqlc.arg('duplicate_id')::uuid, sqlc.arg('target_id')::uuid)) AS x
+    ORDER BY x)
+WHERE sqlc.arg('duplicate_id')::uuid = ANY(game_ids)
+RETURNING *;
+
+-- name: ReassignGameSeasonStandings :exec
+-- Archived tables move only to seasons the kept game has no table in; two
+-- frozen tables cannot be merged, so the duplicate's goes with it.
+UPDATE season_standings ss SET game_id = sqlc.arg('target_id')::uuid
+WHERE ss.game_id = sqlc.arg('duplicate_id')::uuid
+  AND NOT EXISTS (
+      SELECT 1 FROM season_standings t
+      WHERE t.season_id = ss.season_id AND t.game_id = sqlc.arg('target_id')::uuid
+  );
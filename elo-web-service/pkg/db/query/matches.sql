@@ -1,14 +1,15 @@
 -- name: CreateMatch :one
-INSERT INTO matches (id, date, game_id, calculator_kind, calculator_schema_version, calculator_data)
-VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO matches (id, date, game_id, calculator_kind, calculator_schema_version, calculator_data,
+                     cooperative_result, cooperative_global_arena)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 ON CONFLICT (id) DO UPDATE SET id = EXCLUDED.id
 RETURNING *;
 
 -- name: UpsertMatchScore :exec
-INSERT INTO match_scores (match_id, player_id, score)
-VALUES ($1, $2, $3)
+INSERT INTO match_scores (match_id, player_id, score, team)
+VALUES ($1, $2, $3, $4)
 ON CONFLICT (match_id, player_id)
-DO UPDATE SET score = EXCLUDED.score;
+DO UPDATE SET score = EXCLUDED.score, team = EXCLUDED.team;
 
 -- name: ListMatchResults :many
 SELECT
@@ -109,7 +110,7 @@ ORDER BY m.date ASC, m.id ASC, s.score DESC;
 
 -- name: ListMatchesWithPlayersPaginated :many
 WITH paginated_matches AS (
-    SELECT DISTINCT m.id, m.date, m.game_id, m.calculator_kind
+    SELECT DISTINCT m.id, m.date, m.game_id, m.calculator_kind, m.cooperative_result, m.cooperative_global_arena
     FROM matches m
     JOIN match_scores ms ON ms.match_id = m.id
     WHERE
@@ -143,9 +144,12 @@ SELECT
     g.id AS game_id,
     g.name AS game_name,
     pm.calculator_kind AS calculator_kind,
+    pm.cooperative_result AS cooperative_result,
+    pm.cooperative_global_arena AS cooperative_global_arena,
     p.id AS player_id,
     p.name AS player_name,
     s.score,
+    s.team,
     gas.rating_staked,
     gas.rating_earned,
     -- CASE forces sqlc to infer a nullable type (interface{}) so pgx can scan NULL
@@ -175,9 +179,12 @@ SELECT
     g.name AS game_name,
     m.calculator_kind AS calculator_kind,
     m.calculator_data AS calculator_data,
+    m.cooperative_result AS cooperative_result,
+    m.cooperative_global_arena AS cooperative_global_arena,
     p.id AS player_id,
     p.name AS player_name,
     s.score,
+    s.team,
     gas.rating_staked,
     gas.rating_earned,
     -- CASE forces sqlc to infer a nullable type (interface{}) so pgx can scan NULL
@@ -210,9 +217,16 @@ SET date = $2,
     game_id = $3,
     calculator_kind = $4,
     calculator_schema_version = $5,
-    calculator_data = $6
+    calculator_data = $6,
+    cooperative_result = $7,
+    cooperative_global_arena = $8
 WHERE id = $1;
 
+-- name: DeleteMatch :exec
+-- Settlements and scores must be deleted first; tournament links cascade and
+-- markets resolved by the match lose their resolution_match_id.
+DELETE FROM matches WHERE id = $1;
+
 -- name: GetMatchesFromDate :many
 SELECT m.*
 FROM matches m
@@ -220,7 +234,7 @@ WHERE m.date >= $1
 ORDER BY m.date ASC, m.id ASC;
 
 -- name: GetMatchScoresForMatch :many
-SELECT player_id, score
+SELECT player_id, score, team
 FROM match_scores
 WHERE match_id = $1;
 
@@ -232,3 +246,26 @@ WHERE match_id = $1;
 SELECT COUNT(DISTINCT m.id) AS total_matches
 FROM matches m
 WHERE m.game_id = $1;
+
+-- name: ListMatchScoresFromDate :many
+-- Scores of every match from a date in replay order (replay.go loads the
+-- window plus the league count look-back in one go).
+SELECT ms.match_id, m.date, ms.player_id, ms.score, ms.team
+FROM match_scores ms
+JOIN matches m ON m.id = ms.match_id
+WHERE m.date >= $1
+ORDER BY m.date ASC, m.id ASC, ms.player_id ASC;
+
+-- name: ListPairingCounts :many
+-- How many matches every pair of the given players has played together, with
+-- player_id < opponent_id; only the tournament's matches when one is given.
+SELECT a.player_id, b.player_id AS opponent_id, COUNT(*)::int AS matches
+FROM match_scores a
+JOIN match_scores b ON b.match_id = a.match_id AND a.player_id < b.player_id
+WHERE a.player_id = ANY(sqlc.arg('player_ids')::uuid[])
+  AND b.player_id = ANY(sqlc.arg('player_ids')::uuid[])
+  AND (sqlc.narg('tournament_id')::uuid IS NULL OR EXISTS (
+      SELECT 1 FROM match_tournament mt
+      WHERE mt.match_id = a.match_id AND mt.tournament_id = sqlc.narg('tournament_id')::uuid
+  ))
+GROUP BY a.player_id, b.player_id;
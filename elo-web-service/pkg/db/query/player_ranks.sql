@@ -4,7 +4,8 @@ SELECT p.id, p.name,
   CASE WHEN latest_elo.elo_after IS NULL THEN NULL ELSE latest_elo.elo_after END AS elo,
   COALESCE(latest_elo.league, 'newbie') AS league,
   COALESCE(cnt_60.cnt, 0) AS cnt_60,
-  COALESCE(cnt_180.cnt, 0) AS cnt_180
+  COALESCE(cnt_180.cnt, 0) AS cnt_180,
+  p.is_guest
 FROM players p
 LEFT JOIN LATERAL (
   SELECT gas.rating_after, gas.elo_after, gas.league
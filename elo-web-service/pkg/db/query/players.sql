@@ -1,6 +1,6 @@
 -- name: CreatePlayer :one
-INSERT INTO players (id, name, geologist_name)
-VALUES ($1, $2, $3)
+INSERT INTO players (id, name, geologist_name, is_guest)
+VALUES ($1, $2, $3, $4)
 ON CONFLICT (id) DO UPDATE SET id = EXCLUDED.id
 RETURNING *;
 
@@ -34,6 +34,33 @@ SET name = $2
 WHERE id = $1
 RETURNING *;
 
+-- name: SetPlayerGuest :one
+UPDATE players
+SET is_guest = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: ListGuestPlayerIDs :many
+SELECT id FROM players
+WHERE is_guest
+ORDER BY id;
+
+-- name: PlayerHasRatingStakes :one
+-- Whether the player has a bet, guarantees a market or has a rating
+-- correction: each of them writes global settlements for the player.
+SELECT EXISTS (
+    SELECT 1 FROM bets WHERE bets.player_id = $1
+    UNION ALL
+    SELECT 1 FROM market_guarantors WHERE market_guarantors.player_id = $1
+    UNION ALL
+    SELECT 1 FROM corrections WHERE corrections.player_id = $1
+) AS has_stakes;
+
+-- name: ListGuestsAmong :many
+SELECT id FROM players
+WHERE is_guest AND id = ANY(sqlc.arg('player_ids')::uuid[])
+ORDER BY id;
+
 -- name: GetPlayerGameStats :many
 -- Per-game stats for the player profile "Частые игры" table:
 --   normalized_score = Σ (gas.elo_earned / K effective at the settlement's date)
@@ -96,3 +123,171 @@ JOIN global_arena_settlement gas ON gas.match_id = ms.match_id AND gas.player_id
 WHERE ms.player_id = $1
 GROUP BY g.id, g.name
 ORDER BY elo_earned DESC;
+
+-- name: LockPlayersForEloCalculation :many
+SELECT id FROM players WHERE id = ANY(sqlc.arg('ids')::uuid[]) ORDER BY id FOR UPDATE;
+
+-- Merging a duplicate player into a kept one (MergePlayers).
+
+-- name: ListSharedMatches :many
+-- Matches both players took part in; a merge would give one player two scores.
+SELECT a.match_id FROM match_scores a
+JOIN match_scores b ON b.match_id = a.match_id
+WHERE a.player_id = sqlc.arg('target_id') AND b.player_id = sqlc.arg('duplicate_id')
+ORDER BY a.match_id;
+
+-- name: ListSharedMarkets :many
+-- Markets where both players are guarantors, both have an outcome or both are
+-- targets; merged, they would split one residual share, price two outcomes for
+-- one person or list one target twice.
+SELECT a.market_id FROM market_guarantors a
+JOIN market_guarantors b ON b.market_id = a.market_id
+WHERE a.player_id = sqlc.arg('target_id')::uuid AND b.player_id = sqlc.arg('duplicate_id')::uuid
+UNION
+SELECT a.market_id FROM market_outcomes a
+JOIN market_outcomes b ON b.market_id = a.market_id
+WHERE a.player_id = sqlc.arg('target_id')::uuid AND b.player_id = sqlc.arg('duplicate_id')::uuid
+UNION
+SELECT market_id FROM market_match_winner_params
+WHERE sqlc.arg('target_id')::uuid = ANY(target_player_ids)
+  AND sqlc.arg('duplicate_id')::uuid = ANY(target_player_ids)
+ORDER BY market_id;
+
+-- name: GetPlayerFirstEventDate :one
+-- The earliest date the player affects settlements: a match, correction, bet
+-- or settlement of theirs, or the start of a market on them. No row when
+-- there is none.
+SELECT d::timestamptz AS first_date FROM (
+    SELECT m.date AS d FROM match_scores ms JOIN matches m ON m.id = ms.match_id WHERE ms.player_id = $1
+    UNION ALL
+    SELECT date FROM corrections WHERE player_id = $1
+    UNION ALL
+    SELECT placed_at FROM bets WHERE player_id = $1
+    UNION ALL
+    SELECT date FROM global_arena_settlement WHERE player_id = $1
+    UNION ALL
+    SELECT mk.starts_at FROM markets mk
+    WHERE mk.id IN (SELECT market_id FROM market_guarantors WHERE player_id = $1)
+       OR mk.id IN (SELECT market_id FROM market_outcomes WHERE player_id = $1)
+       OR mk.id IN (SELECT market_id FROM market_win_streak_params WHERE target_player_id = $1)
+) events
+ORDER BY d
+LIMIT 1;
+
+-- name: ReassignMatchScores :exec
+UPDATE match_scores SET player_id = sqlc.arg('target_id') WHERE player_id = sqlc.arg('duplicate_id');
+
+-- name: ReassignBets :exec
+UPDATE bets SET player_id = sqlc.arg('target_id') WHERE player_id = sqlc.arg('duplicate_id');
+
+-- name: ReassignMarketGuarantors :exec
+UPDATE market_guarantors SET player_id = sqlc.arg('target_id') WHERE player_id = sqlc.arg('duplicate_id');
+
+-- name: ReassignMarketOutcomes :exec
+UPDATE market_outcomes SET player_id = sqlc.arg('target_id')::uuid WHERE player_id = sqlc.arg('duplicate_id')::uuid;
+
+-- name: ReassignMarketTargets :exec
+WITH winner AS (
+    UPDATE market_match_winner_params
+    SET target_player_ids = array_replace(target_player_ids, sqlc.arg('duplicate_id')::uuid, sqlc.arg('target_id')::uuid)
+    WHERE sqlc.arg('duplicate_id')::uuid = ANY(target_player_ids)
+)
+UPDATE market_win_streak_params SET target_player_id = sqlc.arg('target_id')::uuid
+WHERE target_player_id = sqlc.arg('duplicate_id')::uuid;
+
+-- name: ReassignCorrections :exec
+UPDATE corrections SET player_id = sqlc.arg('target_id') WHERE player_id = sqlc.arg('duplicate_id');
+
+-- name: ReassignMemberships :exec
+-- The duplicate's own rows go with it (ON DELETE CASCADE).
+WITH clubs AS (
+    INSERT INTO player_club_membership (club_id, player_id)
+    SELECT club_id, sqlc.arg('target_id')::uuid FROM player_club_membership WHERE player_id = sqlc.arg('duplicate_id')::uuid
+    ON CONFLICT DO NOTHING
+)
+INSERT INTO tournament_player_membership (tournament_id, player_id)
+SELECT tournament_id, sqlc.arg('target_id')::uuid FROM tournament_player_membership WHERE player_id = sqlc.arg('duplicate_id')::uuid
+ON CONFLICT DO NOTHING;
+
+-- name: ReassignArenaPlayers :many
+-- Returns the arenas whose filter changed; they are rebuilt from scratch.
+UPDATE arenas
+SET player_ids = ARRAY(
+    SELECT DISTINCT x FROM unnest(array_replace(player_ids, sqlc.arg('duplicate_id')::uuid, sqlc.arg('target_id')::uuid)) AS x
+    ORDER BY x)
+WHERE sqlc.arg('duplicate_id')::uuid = ANY(player_ids)
+RETURNING *;
+
+-- name: ReassignLinkedUser :exec
+UPDATE users SET player_id = sqlc.arg('target_id')::uuid WHERE player_id = sqlc.arg('duplicate_id')::uuid;
+
+-- name: ReassignSeasonStandings :exec
+-- A standing in a table the kept player is already on stays with the
+-- duplicate and goes with it (ON DELETE CASCADE).
+UPDATE season_standings ss SET player_id = sqlc.arg('target_id')::uuid
+WHERE ss.player_id = sqlc.arg('duplicate_id')::uuid
+  AND NOT EXISTS (
+      SELECT 1 FROM season_standings t
+      WHERE t.season_id = ss.season_id
+        AND t.game_id IS NOT DISTINCT FROM ss.game_id
+        AND t.player_id = sqlc.arg('target_id')::uuid
+  );
+
+-- name: ListHeadToHeadMatches :many
+-- Matches both players took part in, oldest first, with who finished above
+-- whom under the game's scoring rules (a lower place, a lower score in a
+-- lower_wins game, otherwise a higher score) and each player's rating change
+-- in both arenas. Cooperative matches and matches where the two were
+-- teammates are left out: neither player finished above the other there. A
+-- *_settled column is false when either player has no settlement of the match
+-- in that arena (guests).
+SELECT
+  m.id AS match_id,
+  m.date,
+  g.id AS game_id,
+  g.name AS game_name,
+  (CASE WHEN g.result_type = 'placement' OR g.scoring_direction = 'lower_wins'
+        THEN a.score < b.score ELSE a.score > b.score END)::bool AS player_above,
+  (CASE WHEN g.result_type = 'placement' OR g.scoring_direction = 'lower_wins'
+        THEN b.score < a.score ELSE b.score > a.score END)::bool AS other_above,
+  (pg.id IS NOT NULL AND og.id IS NOT NULL)::bool AS global_settled,
+  COALESCE(pg.rating_earned + pg.rating_staked, 0)::float8 AS player_global_change,
+  COALESCE(og.rating_earned + og.rating_staked, 0)::float8 AS other_global_change,
+  (pa.id IS NOT NULL AND oa.id IS NOT NULL)::bool AS game_settled,
+  COALESCE(pa.rating_earned + pa.rating_staked, 0)::float8 AS player_game_change,
+  COALESCE(oa.rating_earned + oa.rating_staked, 0)::float8 AS other_game_change
+FROM match_scores a
+JOIN match_scores b ON b.match_id = a.match_id AND b.player_id = sqlc.arg('other_id')
+JOIN matches m ON m.id = a.match_id
+JOIN games g ON g.id = m.game_id
+LEFT JOIN global_arena_settlement pg ON pg.match_id = m.id AND pg.player_id = a.player_id AND pg.discriminator = 'match'
+LEFT JOIN global_arena_settlement og ON og.match_id = m.id AND og.player_id = b.player_id AND og.discriminator = 'match'
+LEFT JOIN game_arena_settlement pa ON pa.match_id = m.id AND pa.player_id = a.player_id
+LEFT JOIN game_arena_settlement oa ON oa.match_id = m.id AND oa.player_id = b.player_id
+WHERE a.player_id = sqlc.arg('player_id')
+  AND m.cooperative_result IS NULL
+  AND (a.team IS NULL OR a.team <> b.team)
+ORDER BY m.date, m.id;
+
+-- name: ListPlayerOpponents :many
+-- Everyone the player has played against, most shared matches first, with
+-- who finished above whom; cooperative matches and teammates are left out as
+-- in ListHeadToHeadMatches.
+SELECT
+  b.player_id AS opponent_id,
+  p.name,
+  COUNT(*)::int AS matches,
+  COUNT(*) FILTER (WHERE CASE WHEN g.result_type = 'placement' OR g.scoring_direction = 'lower_wins'
+                              THEN a.score < b.score ELSE a.score > b.score END)::int AS player_above,
+  COUNT(*) FILTER (WHERE CASE WHEN g.result_type = 'placement' OR g.scoring_direction = 'lower_wins'
+                              THEN b.score < a.score ELSE b.score > a.score END)::int AS opponent_above
+FROM match_scores a
+JOIN match_scores b ON b.match_id = a.match_id AND b.player_id <> a.player_id
+JOIN matches m ON m.id = a.match_id
+JOIN games g ON g.id = m.game_id
+JOIN players p ON p.id = b.player_id
+WHERE a.player_id = $1
+  AND m.cooperative_result IS NULL
+  AND (a.team IS NULL OR a.team <> b.team)
+GROUP BY b.player_id, p.name
+ORDER BY matches DESC, p.name;
@@ -8,34 +8,40 @@ ORDER BY gas.date;
 -- name: UpsertGlobalArenaSettlementByMatch :exec
 INSERT INTO global_arena_settlement
     (id, player_id, date, rating_after, elo_after, discriminator, match_id,
-     elo_staked, elo_earned, rating_staked, rating_earned, league)
-SELECT $2, $3, m.date, $4, $5, 'match', $1, $6, $7, $8, $9, $10
+     elo_staked, elo_earned, rating_staked, rating_earned, league,
+     deviation_after, volatility_after)
+SELECT $2, $3, m.date, $4, $5, 'match', $1, $6, $7, $8, $9, $10, $11, $12
 FROM matches m WHERE m.id = $1
-ON CONFLICT (match_id, player_id) WHERE match_id IS NOT NULL
-DO UPDATE SET rating_after  = EXCLUDED.rating_after,
-              elo_after     = EXCLUDED.elo_after,
-              date          = EXCLUDED.date,
-              elo_staked    = EXCLUDED.elo_staked,
-              elo_earned    = EXCLUDED.elo_earned,
-              rating_staked = EXCLUDED.rating_staked,
-              rating_earned = EXCLUDED.rating_earned,
-              league        = EXCLUDED.league;
+ON CONFLICT (match_id, player_id, discriminator) WHERE match_id IS NOT NULL
+DO UPDATE SET rating_after     = EXCLUDED.rating_after,
+              elo_after        = EXCLUDED.elo_after,
+              date             = EXCLUDED.date,
+              elo_staked       = EXCLUDED.elo_staked,
+              elo_earned       = EXCLUDED.elo_earned,
+              rating_staked    = EXCLUDED.rating_staked,
+              rating_earned    = EXCLUDED.rating_earned,
+              league           = EXCLUDED.league,
+              deviation_after  = EXCLUDED.deviation_after,
+              volatility_after = EXCLUDED.volatility_after;
 
 -- name: UpsertGameArenaSettlementByMatch :exec
 INSERT INTO game_arena_settlement
     (id, game_id, player_id, date, rating_after, elo_after, discriminator, match_id,
-     elo_staked, elo_earned, rating_staked, rating_earned, league)
-SELECT $2, $3, $4, m.date, $5, $6, 'match', $1, $7, $8, $9, $10, $11
+     elo_staked, elo_earned, rating_staked, rating_earned, league,
+     deviation_after, volatility_after)
+SELECT $2, $3, $4, m.date, $5, $6, 'match', $1, $7, $8, $9, $10, $11, $12, $13
 FROM matches m WHERE m.id = $1
 ON CONFLICT (match_id, player_id) WHERE match_id IS NOT NULL
-DO UPDATE SET rating_after  = EXCLUDED.rating_after,
-              elo_after     = EXCLUDED.elo_after,
-              date          = EXCLUDED.date,
-              elo_staked    = EXCLUDED.elo_staked,
-              elo_earned    = EXCLUDED.elo_earned,
-              rating_staked = EXCLUDED.rating_staked,
-              rating_earned = EXCLUDED.rating_earned,
-              league        = EXCLUDED.league;
+DO UPDATE SET rating_after     = EXCLUDED.rating_after,
+              elo_after        = EXCLUDED.elo_after,
+              date             = EXCLUDED.date,
+              elo_staked       = EXCLUDED.elo_staked,
+              elo_earned       = EXCLUDED.elo_earned,
+              rating_staked    = EXCLUDED.rating_staked,
+              rating_earned    = EXCLUDED.rating_earned,
+              league           = EXCLUDED.league,
+              deviation_after  = EXCLUDED.deviation_after,
+              volatility_after = EXCLUDED.volatility_after;
 
 -- name: DeleteGlobalArenaSettlementByMatch :exec
 DELETE FROM global_arena_settlement WHERE match_id = $1 AND discriminator = 'match';
@@ -43,6 +49,48 @@ DELETE FROM global_arena_settlement WHERE match_id = $1 AND discriminator = 'mat
 -- name: DeleteGameArenaSettlementByMatch :exec
 DELETE FROM game_arena_settlement WHERE match_id = $1;
 
+-- name: ListGlobalArenaSettlementsByMatch :many
+SELECT * FROM global_arena_settlement WHERE match_id = $1 AND discriminator = 'match';
+
+-- name: ListGameArenaSettlementsByMatch :many
+SELECT * FROM game_arena_settlement WHERE match_id = $1;
+
+-- name: UpsertGameVirtualOpponentSettlement :exec
+INSERT INTO game_virtual_opponent_settlement
+    (id, game_id, match_id, date, elo_after, elo_staked, elo_earned,
+     deviation_after, volatility_after)
+SELECT $2, $3, $1, m.date, $4, $5, $6, $7, $8
+FROM matches m WHERE m.id = $1
+ON CONFLICT (match_id)
+DO UPDATE SET game_id          = EXCLUDED.game_id,
+              date             = EXCLUDED.date,
+              elo_after        = EXCLUDED.elo_after,
+              elo_staked       = EXCLUDED.elo_staked,
+              elo_earned       = EXCLUDED.elo_earned,
+              deviation_after  = EXCLUDED.deviation_after,
+              volatility_after = EXCLUDED.volatility_after;
+
+-- name: DeleteGameVirtualOpponentSettlementByMatch :exec
+DELETE FROM game_virtual_opponent_settlement WHERE match_id = $1;
+
+-- name: GetGameVirtualOpponentEloBeforeMatch :one
+-- Virtual opponent Elo (and rating-algorithm uncertainty) of a game before the
+-- given match (same ordering as the per-player "latest before match" queries).
+SELECT vos.elo_after, vos.deviation_after, vos.volatility_after
+FROM game_virtual_opponent_settlement vos
+WHERE vos.game_id = $1
+  AND (vos.date < $2 OR (vos.date = $2 AND vos.match_id < $3))
+ORDER BY vos.date DESC, vos.match_id DESC
+LIMIT 1;
+
+-- name: GetGameVirtualOpponentElo :one
+-- Current virtual opponent Elo of a game (latest cooperative match).
+SELECT vos.elo_after
+FROM game_virtual_opponent_settlement vos
+WHERE vos.game_id = $1
+ORDER BY vos.date DESC, vos.match_id DESC
+LIMIT 1;
+
 -- name: GetPlayerLatestGlobalElo :one
 -- Returns the true Elo value (elo_after) for Elo calculations.
 SELECT gas.elo_after AS rating
@@ -60,9 +108,23 @@ ORDER BY gas.date DESC, gas.id DESC
 LIMIT 1;
 
 -- name: GetPlayerLatestGlobalEloBeforeMatch :one
+-- The match's own decay reversal (decay.go) precedes its settlement.
 SELECT gas.elo_after AS rating
 FROM global_arena_settlement gas
 WHERE gas.player_id = $1
+  AND (gas.date < $2 OR (gas.date = $2 AND gas.match_id IS NOT NULL
+       AND (gas.match_id < $3 OR (gas.match_id = $3 AND gas.discriminator = 'decay'))))
+ORDER BY gas.date DESC, gas.id DESC
+LIMIT 1;
+
+-- name: GetPlayerGlobalUncertaintyBeforeMatch :one
+-- Rating-algorithm uncertainty (Glicko-2 / TrueSkill) from the latest global
+-- settlement before the match that tracked it; Elo, correction and market rows
+-- carry none and are skipped.
+SELECT gas.deviation_after::float8 AS deviation, gas.volatility_after AS volatility
+FROM global_arena_settlement gas
+WHERE gas.player_id = $1
+  AND gas.deviation_after IS NOT NULL
   AND (gas.date < $2 OR (gas.date = $2 AND gas.match_id IS NOT NULL AND gas.match_id < $3))
 ORDER BY gas.date DESC, gas.id DESC
 LIMIT 1;
@@ -84,10 +146,12 @@ ORDER BY gas.date DESC, gas.id DESC
 LIMIT 1;
 
 -- name: GetPlayerLatestGlobalRatingBeforeMatch :one
+-- Includes the match's own decay reversal, as GetPlayerLatestGlobalEloBeforeMatch.
 SELECT gas.rating_after AS rating, gas.league
 FROM global_arena_settlement gas
 WHERE gas.player_id = $1
-  AND (gas.date < $2 OR (gas.date = $2 AND gas.match_id IS NOT NULL AND gas.match_id < $3))
+  AND (gas.date < $2 OR (gas.date = $2 AND gas.match_id IS NOT NULL
+       AND (gas.match_id < $3 OR (gas.match_id = $3 AND gas.discriminator = 'decay'))))
 ORDER BY gas.date DESC, gas.id DESC
 LIMIT 1;
 
@@ -108,6 +172,17 @@ WHERE gas.player_id = $1
 ORDER BY gas.date DESC, gas.match_id DESC
 LIMIT 1;
 
+-- name: GetPlayerGameUncertaintyBeforeMatch :one
+-- Game arena counterpart of GetPlayerGlobalUncertaintyBeforeMatch.
+SELECT gas.deviation_after::float8 AS deviation, gas.volatility_after AS volatility
+FROM game_arena_settlement gas
+WHERE gas.player_id = $1
+  AND gas.game_id = $2
+  AND gas.deviation_after IS NOT NULL
+  AND (gas.date < $3 OR (gas.date = $3 AND gas.match_id < $4))
+ORDER BY gas.date DESC, gas.match_id DESC
+LIMIT 1;
+
 -- name: GetPlayerLatestGameRating :one
 -- Returns the display game rating and current game league.
 SELECT gas.rating_after AS game_rating_after, gas.league
@@ -172,3 +247,92 @@ SELECT COUNT(*)::int AS count
 FROM matches m
 JOIN match_scores ms ON ms.match_id = m.id
 WHERE ms.player_id = $1 AND m.game_id = $2 AND m.date >= $3 AND m.date <= $4;
+
+-- name: GetPlayerLatestGlobalStateBeforeMatch :one
+-- Rating, Elo and league in one row, with GetPlayerLatestGlobalRatingBeforeMatch ordering.
+SELECT gas.rating_after AS rating, gas.elo_after AS elo, gas.league
+FROM global_arena_settlement gas
+WHERE gas.player_id = $1
+  AND (gas.date < $2 OR (gas.date = $2 AND gas.match_id IS NOT NULL
+       AND (gas.match_id < $3 OR (gas.match_id = $3 AND gas.discriminator = 'decay'))))
+ORDER BY gas.date DESC, gas.id DESC
+LIMIT 1;
+
+-- name: ListLatestGlobalArenaStatesBetween :many
+-- Each player's latest global settlement in [from_date, to_date): the state
+-- before the replay window on top of a checkpoint taken at from_date
+-- (checkpoints.go). from_date is -infinity when there is no checkpoint.
+SELECT DISTINCT ON (gas.player_id)
+    gas.player_id, gas.rating_after AS rating, gas.elo_after AS elo, gas.league
+FROM global_arena_settlement gas
+WHERE gas.date >= sqlc.arg('from_date') AND gas.date < sqlc.arg('to_date')
+ORDER BY gas.player_id, gas.date DESC, gas.id DESC;
+
+-- name: ListLatestGlobalUncertaintiesBetween :many
+SELECT DISTINCT ON (gas.player_id)
+    gas.player_id, gas.deviation_after::float8 AS deviation, gas.volatility_after AS volatility
+FROM global_arena_settlement gas
+WHERE gas.date >= sqlc.arg('from_date') AND gas.date < sqlc.arg('to_date')
+  AND gas.deviation_after IS NOT NULL
+ORDER BY gas.player_id, gas.date DESC, gas.id DESC;
+
+-- name: ListLatestGameArenaStatesBetween :many
+SELECT DISTINCT ON (gas.player_id, gas.game_id)
+    gas.player_id, gas.game_id, gas.rating_after AS rating, gas.elo_after AS elo, gas.league
+FROM game_arena_settlement gas
+WHERE gas.date >= sqlc.arg('from_date') AND gas.date < sqlc.arg('to_date')
+ORDER BY gas.player_id, gas.game_id, gas.date DESC, gas.match_id DESC;
+
+-- name: ListLatestGameUncertaintiesBetween :many
+SELECT DISTINCT ON (gas.player_id, gas.game_id)
+    gas.player_id, gas.game_id, gas.deviation_after::float8 AS deviation, gas.volatility_after AS volatility
+FROM game_arena_settlement gas
+WHERE gas.date >= sqlc.arg('from_date') AND gas.date < sqlc.arg('to_date')
+  AND gas.deviation_after IS NOT NULL
+ORDER BY gas.player_id, gas.game_id, gas.date DESC, gas.match_id DESC;
+
+-- name: ListLatestVirtualOpponentStatesBetween :many
+SELECT DISTINCT ON (vos.game_id)
+    vos.game_id, vos.elo_after, vos.deviation_after, vos.volatility_after
+FROM game_virtual_opponent_settlement vos
+WHERE vos.date >= sqlc.arg('from_date') AND vos.date < sqlc.arg('to_date')
+ORDER BY vos.game_id, vos.date DESC, vos.match_id DESC;
+
+-- name: DeleteGameArenaSettlementsFromDate :exec
+-- Game arena and virtual opponent rows are upserted per match on the per-event
+-- path; the in-memory replay clears the window and copies them back instead.
+DELETE FROM game_arena_settlement WHERE date >= $1;
+
+-- name: DeleteGameVirtualOpponentSettlementsFromDate :exec
+DELETE FROM game_virtual_opponent_settlement WHERE date >= $1;
+
+-- name: CopyGlobalArenaMatchSettlements :copyfrom
+INSERT INTO global_arena_settlement
+    (id, player_id, date, rating_after, elo_after, discriminator, match_id,
+     elo_staked, elo_earned, rating_staked, rating_earned, league,
+     deviation_after, volatility_after)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14);
+
+-- name: CopyGameArenaSettlements :copyfrom
+INSERT INTO game_arena_settlement
+    (id, game_id, player_id, date, rating_after, elo_after, discriminator, match_id,
+     elo_staked, elo_earned, rating_staked, rating_earned, league,
+     deviation_after, volatility_after)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15);
+
+-- name: CopyGameVirtualOpponentSettlements :copyfrom
+INSERT INTO game_virtual_opponent_settlement
+    (id, game_id, match_id, date, elo_after, elo_staked, elo_earned,
+     deviation_after, volatility_after)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: ListGameRatingsAsOf :many
+SELECT DISTINCT ON (gas.player_id)
+  gas.player_id,
+  p.name,
+  gas.rating_after AS game_rating_after,
+  gas.league
+FROM game_arena_settlement gas
+JOIN players p ON p.id = gas.player_id
+WHERE gas.game_id = $1 AND gas.date <= $2
+ORDER BY gas.player_id, gas.date DESC, gas.id DESC;
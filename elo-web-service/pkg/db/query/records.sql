@@ -0,0 +1,69 @@
+-- Hall of fame records, read straight from match scores and settlements so
+-- they follow every history edit. Ties go to the earliest holder.
+
+-- name: ListGameHighScores :many
+-- The highest score of every score-based game, or of one game.
+SELECT DISTINCT ON (m.game_id) m.game_id, g.name AS game_name, ms.player_id, p.name AS player_name,
+       ms.score, m.id AS match_id, m.date
+FROM match_scores ms
+JOIN matches m ON m.id = ms.match_id
+JOIN games g ON g.id = m.game_id
+JOIN players p ON p.id = ms.player_id
+WHERE g.result_type = 'score'
+  AND (sqlc.narg('game_id')::uuid IS NULL OR m.game_id = sqlc.narg('game_id')::uuid)
+ORDER BY m.game_id, ms.score DESC, m.date, m.id, ms.player_id;
+
+-- name: ListGameLowScores :many
+-- The lowest score of every score-based game, or of one game.
+SELECT DISTINCT ON (m.game_id) m.game_id, g.name AS game_name, ms.player_id, p.name AS player_name,
+       ms.score, m.id AS match_id, m.date
+FROM match_scores ms
+JOIN matches m ON m.id = ms.match_id
+JOIN games g ON g.id = m.game_id
+JOIN players p ON p.id = ms.player_id
+WHERE g.result_type = 'score'
+  AND (sqlc.narg('game_id')::uuid IS NULL OR m.game_id = sqlc.narg('game_id')::uuid)
+ORDER BY m.game_id, ms.score ASC, m.date, m.id, ms.player_id;
+
+-- name: ListLargestGlobalMatchGain :many
+-- The largest global rating change from a single match.
+SELECT gas.player_id, p.name AS player_name,
+       (gas.rating_earned + gas.rating_staked)::float8 AS gain, gas.match_id, gas.date
+FROM global_arena_settlement gas
+JOIN players p ON p.id = gas.player_id
+WHERE gas.discriminator = 'match'
+ORDER BY gain DESC, gas.date, gas.id
+LIMIT 1;
+
+-- name: ListLargestGameMatchGain :many
+-- The largest rating change from a single match in the game's arena.
+SELECT gas.player_id, p.name AS player_name,
+       (gas.rating_earned + gas.rating_staked)::float8 AS gain, gas.match_id, gas.date
+FROM game_arena_settlement gas
+JOIN players p ON p.id = gas.player_id
+WHERE gas.game_id = $1
+ORDER BY gain DESC, gas.date, gas.id
+LIMIT 1;
+
+-- name: ListMostMatchesInDay :many
+-- The most matches one player played in a UTC calendar day, in any game or
+-- in one game.
+SELECT ms.player_id, p.name AS player_name,
+       (date_trunc('day', m.date AT TIME ZONE 'UTC') AT TIME ZONE 'UTC')::timestamptz AS day,
+       COUNT(*)::int AS matches
+FROM match_scores ms
+JOIN matches m ON m.id = ms.match_id
+JOIN players p ON p.id = ms.player_id
+WHERE sqlc.narg('game_id')::uuid IS NULL OR m.game_id = sqlc.narg('game_id')::uuid
+GROUP BY ms.player_id, p.name, day
+ORDER BY matches DESC, day, p.name
+LIMIT 1;
+
+-- name: ListBiggestMarketPayout :many
+-- The largest rating paid out to a player by a resolved market.
+SELECT gas.player_id, p.name AS player_name, gas.rating_earned AS payout, gas.market_id, gas.date
+FROM global_arena_settlement gas
+JOIN players p ON p.id = gas.player_id
+WHERE gas.discriminator = 'market'
+ORDER BY gas.rating_earned DESC, gas.date, gas.id
+LIMIT 1;
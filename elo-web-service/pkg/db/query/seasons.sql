@@ -0,0 +1,108 @@
+-- name: ListSeasons :many
+SELECT * FROM seasons ORDER BY starts_at DESC;
+
+-- name: GetSeason :one
+SELECT * FROM seasons WHERE id = $1;
+
+-- name: GetSeasonForUpdate :one
+SELECT * FROM seasons WHERE id = $1 FOR UPDATE;
+
+-- name: CreateSeason :one
+INSERT INTO seasons (id, name, starts_at, ends_at, reset_factor)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: DeleteSeason :exec
+DELETE FROM seasons WHERE id = $1;
+
+-- name: SeasonOverlaps :one
+-- Whether [starts_at, ends_at) overlaps an existing season.
+SELECT EXISTS (
+    SELECT 1 FROM seasons
+    WHERE tstzrange(starts_at, ends_at) && tstzrange(sqlc.arg('starts_at')::timestamptz, sqlc.arg('ends_at')::timestamptz)
+)::bool;
+
+-- name: ArchiveSeason :one
+UPDATE seasons SET archived_at = $2 WHERE id = $1
+RETURNING *;
+
+-- name: ListPendingSeasonResets :many
+-- Seasons whose reset is due strictly before @until and not written yet, with
+-- someone settled to reset.
+SELECT s.id, s.starts_at, s.reset_factor::float8 AS reset_factor
+FROM seasons s
+WHERE s.reset_factor IS NOT NULL
+  AND s.starts_at - interval '1 microsecond' < sqlc.arg('until')
+  AND NOT EXISTS (
+      SELECT 1 FROM global_arena_settlement r
+      WHERE r.discriminator = 'season_reset' AND r.date = s.starts_at - interval '1 microsecond'
+  )
+  AND EXISTS (
+      SELECT 1 FROM global_arena_settlement g
+      WHERE g.date < s.starts_at
+  )
+ORDER BY s.starts_at;
+
+-- name: ListUnwrittenSeasonResetDates :many
+-- Start dates of the seasons with a reset that has no rows yet.
+SELECT s.starts_at
+FROM seasons s
+WHERE s.reset_factor IS NOT NULL
+  AND NOT EXISTS (
+      SELECT 1 FROM global_arena_settlement r
+      WHERE r.discriminator = 'season_reset' AND r.date = s.starts_at - interval '1 microsecond'
+  )
+ORDER BY s.starts_at;
+
+-- name: InsertGlobalArenaSeasonResetSettlement :exec
+-- A reset another transaction has already written is skipped.
+INSERT INTO global_arena_settlement
+    (id, player_id, date, rating_after, elo_after, discriminator,
+     elo_staked, elo_earned, rating_staked, rating_earned, league)
+VALUES ($1, $2, $3, $4, $5, 'season_reset', 0, $6, 0, $7, $8)
+ON CONFLICT (player_id, date) WHERE discriminator = 'season_reset' DO NOTHING;
+
+-- name: ListSeasonGlobalParticipants :many
+-- Players with a global match settlement in [from_date, to_date).
+SELECT DISTINCT gas.player_id
+FROM global_arena_settlement gas
+WHERE gas.discriminator = 'match'
+  AND gas.date >= sqlc.arg('from_date') AND gas.date < sqlc.arg('to_date')
+ORDER BY gas.player_id;
+
+-- name: ListSeasonGameParticipants :many
+-- Players with a settlement in the game's arena in [from_date, to_date).
+SELECT DISTINCT gas.player_id
+FROM game_arena_settlement gas
+WHERE gas.game_id = sqlc.arg('game_id')
+  AND gas.date >= sqlc.arg('from_date') AND gas.date < sqlc.arg('to_date')
+ORDER BY gas.player_id;
+
+-- name: ListSeasonGames :many
+-- Games with a game arena settlement in [from_date, to_date).
+SELECT DISTINCT gas.game_id
+FROM game_arena_settlement gas
+WHERE gas.date >= sqlc.arg('from_date') AND gas.date < sqlc.arg('to_date')
+ORDER BY gas.game_id;
+
+-- name: InsertSeasonStanding :exec
+INSERT INTO season_standings (season_id, game_id, player_id, rank, rating, league, start_rank, start_rating)
+VALUES ($1, sqlc.narg('game_id'), $2, $3, $4, $5, sqlc.narg('start_rank'), sqlc.narg('start_rating'));
+
+-- name: ListSeasonStandings :many
+-- The archived table of the global arena (game_id NULL) or of a game.
+SELECT ss.player_id, p.name, ss.rank, ss.rating, ss.league, ss.start_rank, ss.start_rating
+FROM season_standings ss
+JOIN players p ON p.id = ss.player_id
+WHERE ss.season_id = sqlc.arg('season_id')
+  AND ss.game_id IS NOT DISTINCT FROM sqlc.narg('game_id')::uuid
+ORDER BY ss.rank, p.name;
+
+-- name: ListSeasonChampions :many
+-- Rank 1 of every archived table of every season.
+SELECT ss.season_id, ss.game_id, g.name AS game_name, ss.player_id, p.name AS player_name, ss.rating
+FROM season_standings ss
+JOIN players p ON p.id = ss.player_id
+LEFT JOIN games g ON g.id = ss.game_id
+WHERE ss.rank = 1
+ORDER BY ss.season_id, ss.game_id NULLS FIRST, p.name;
@@ -5,7 +5,8 @@ SELECT
     google_oauth_user_id,
     google_oauth_user_name,
     player_id,
-    legacy_int_id
+    legacy_int_id,
+    is_admin
 FROM users;
 
 -- name: GetUserByGoogleOAuthUserID :one
@@ -15,7 +16,8 @@ SELECT
     google_oauth_user_id,
     google_oauth_user_name,
     player_id,
-    legacy_int_id
+    legacy_int_id,
+    is_admin
 FROM users
 WHERE google_oauth_user_id = $1;
 
@@ -26,7 +28,8 @@ SELECT
     google_oauth_user_id,
     google_oauth_user_name,
     player_id,
-    legacy_int_id
+    legacy_int_id,
+    is_admin
 FROM users
 WHERE id = $1;
 
@@ -39,7 +42,8 @@ SELECT
     google_oauth_user_id,
     google_oauth_user_name,
     player_id,
-    legacy_int_id
+    legacy_int_id,
+    is_admin
 FROM users
 WHERE legacy_int_id = $1;
 
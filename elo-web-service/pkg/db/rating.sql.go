@@ -11,6 +11,53 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type CopyGameArenaSettlementsParams struct {
+	ID              string             `json:"id"`
+	GameID          string             `json:"game_id"`
+	PlayerID        string             `json:"player_id"`
+	Date            pgtype.Timestamptz `json:"date"`
+	RatingAfter     float64            `json:"rating_after"`
+	EloAfter        float64            `json:"elo_after"`
+	Discriminator   string             `json:"discriminator"`
+	MatchID         *string            `json:"match_id"`
+	EloStaked       float64            `json:"elo_staked"`
+	EloEarned       float64            `json:"elo_earned"`
+	RatingStaked    float64            `json:"rating_staked"`
+	RatingEarned    float64            `json:"rating_earned"`
+	League          string             `json:"league"`
+	DeviationAfter  pgtype.Float8      `json:"deviation_after"`
+	VolatilityAfter pgtype.Float8      `json:"volatility_after"`
+}
+
+type CopyGameVirtualOpponentSettlementsParams struct {
+	ID              string             `json:"id"`
+	GameID          string             `json:"game_id"`
+	MatchID         string             `json:"match_id"`
+	Date            pgtype.Timestamptz `json:"date"`
+	EloAfter        float64            `json:"elo_after"`
+	EloStaked       float64            `json:"elo_staked"`
+	EloEarned       float64            `json:"elo_earned"`
+	DeviationAfter  pgtype.Float8      `json:"deviation_after"`
+	VolatilityAfter pgtype.Float8      `json:"volatility_after"`
+}
+
+type CopyGlobalArenaMatchSettlementsParams struct {
+	ID              string             `json:"id"`
+	PlayerID        string             `json:"player_id"`
+	Date            pgtype.Timestamptz `json:"date"`
+	RatingAfter     float64            `json:"rating_after"`
+	EloAfter        float64            `json:"elo_after"`
+	Discriminator   string             `json:"discriminator"`
+	MatchID         *string            `json:"match_id"`
+	EloStaked       float64            `json:"elo_staked"`
+	EloEarned       float64            `json:"elo_earned"`
+	RatingStaked    float64            `json:"rating_staked"`
+	RatingEarned    float64            `json:"rating_earned"`
+	League          string             `json:"league"`
+	DeviationAfter  pgtype.Float8      `json:"deviation_after"`
+	VolatilityAfter pgtype.Float8      `json:"volatility_after"`
+}
+
 const deleteGameArenaSettlementByMatch = `-- name: DeleteGameArenaSettlementByMatch :exec
 DELETE FROM game_arena_settlement WHERE match_id = $1
 `
@@ -20,6 +67,35 @@ func (q *Queries) DeleteGameArenaSettlementByMatch(ctx context.Context, matchID
 	return err
 }
 
+const deleteGameArenaSettlementsFromDate = `-- name: DeleteGameArenaSettlementsFromDate :exec
+DELETE FROM game_arena_settlement WHERE date >= $1
+`
+
+// Game arena and virtual opponent rows are upserted per match on the per-event
+// path; the in-memory replay clears the window and copies them back instead.
+func (q *Queries) DeleteGameArenaSettlementsFromDate(ctx context.Context, date pgtype.Timestamptz) error {
+	_, err := q.db.Exec(ctx, deleteGameArenaSettlementsFromDate, date)
+	return err
+}
+
+const deleteGameVirtualOpponentSettlementByMatch = `-- name: DeleteGameVirtualOpponentSettlementByMatch :exec
+DELETE FROM game_virtual_opponent_settlement WHERE match_id = $1
+`
+
+func (q *Queries) DeleteGameVirtualOpponentSettlementByMatch(ctx context.Context, matchID string) error {
+	_, err := q.db.Exec(ctx, deleteGameVirtualOpponentSettlementByMatch, matchID)
+	return err
+}
+
+const deleteGameVirtualOpponentSettlementsFromDate = `-- name: DeleteGameVirtualOpponentSettlementsFromDate :exec
+DELETE FROM game_virtual_opponent_settlement WHERE date >= $1
+`
+
+func (q *Queries) DeleteGameVirtualOpponentSettlementsFromDate(ctx context.Context, date pgtype.Timestamptz) error {
+	_, err := q.db.Exec(ctx, deleteGameVirtualOpponentSettlementsFromDate, date)
+	return err
+}
+
 const deleteGlobalArenaSettlementByMatch = `-- name: DeleteGlobalArenaSettlementByMatch :exec
 DELETE FROM global_arena_settlement WHERE match_id = $1 AND discriminator = 'match'
 `
@@ -29,6 +105,52 @@ func (q *Queries) DeleteGlobalArenaSettlementByMatch(ctx context.Context, matchI
 	return err
 }
 
+const getGameVirtualOpponentElo = `-- name: GetGameVirtualOpponentElo :one
+SELECT vos.elo_after
+FROM game_virtual_opponent_settlement vos
+WHERE vos.game_id = $1
+ORDER BY vos.date DESC, vos.match_id DESC
+LIMIT 1
+`
+
+// Current virtual opponent Elo of a game (latest cooperative match).
+func (q *Queries) GetGameVirtualOpponentElo(ctx context.Context, gameID string) (float64, error) {
+	row := q.db.QueryRow(ctx, getGameVirtualOpponentElo, gameID)
+	var elo_after float64
+	err := row.Scan(&elo_after)
+	return elo_after, err
+}
+
+const getGameVirtualOpponentEloBeforeMatch = `-- name: GetGameVirtualOpponentEloBeforeMatch :one
+SELECT vos.elo_after, vos.deviation_after, vos.volatility_after
+FROM game_virtual_opponent_settlement vos
+WHERE vos.game_id = $1
+  AND (vos.date < $2 OR (vos.date = $2 AND vos.match_id < $3))
+ORDER BY vos.date DESC, vos.match_id DESC
+LIMIT 1
+`
+
+type GetGameVirtualOpponentEloBeforeMatchParams struct {
+	GameID  string             `json:"game_id"`
+	Date    pgtype.Timestamptz `json:"date"`
+	MatchID string             `json:"match_id"`
+}
+
+type GetGameVirtualOpponentEloBeforeMatchRow struct {
+	EloAfter        float64       `json:"elo_after"`
+	DeviationAfter  pgtype.Float8 `json:"deviation_after"`
+	VolatilityAfter pgtype.Float8 `json:"volatility_after"`
+}
+
+// Virtual opponent Elo (and rating-algorithm uncertainty) of a game before the
+// given match (same ordering as the per-player "latest before match" queries).
+func (q *Queries) GetGameVirtualOpponentEloBeforeMatch(ctx context.Context, arg GetGameVirtualOpponentEloBeforeMatchParams) (GetGameVirtualOpponentEloBeforeMatchRow, error) {
+	row := q.db.QueryRow(ctx, getGameVirtualOpponentEloBeforeMatch, arg.GameID, arg.Date, arg.MatchID)
+	var i GetGameVirtualOpponentEloBeforeMatchRow
+	err := row.Scan(&i.EloAfter, &i.DeviationAfter, &i.VolatilityAfter)
+	return i, err
+}
+
 const getPlayerGameMatchCountInPeriod = `-- name: GetPlayerGameMatchCountInPeriod :one
 SELECT COUNT(*)::int AS count
 FROM matches m
@@ -56,6 +178,42 @@ func (q *Queries) GetPlayerGameMatchCountInPeriod(ctx context.Context, arg GetPl
 	return count, err
 }
 
+const getPlayerGameUncertaintyBeforeMatch = `-- name: GetPlayerGameUncertaintyBeforeMatch :one
+SELECT gas.deviation_after::float8 AS deviation, gas.volatility_after AS volatility
+FROM game_arena_settlement gas
+WHERE gas.player_id = $1
+  AND gas.game_id = $2
+  AND gas.deviation_after IS NOT NULL
+  AND (gas.date < $3 OR (gas.date = $3 AND gas.match_id < $4))
+ORDER BY gas.date DESC, gas.match_id DESC
+LIMIT 1
+`
+
+type GetPlayerGameUncertaintyBeforeMatchParams struct {
+	PlayerID string             `json:"player_id"`
+	GameID   string             `json:"game_id"`
+	Date     pgtype.Timestamptz `json:"date"`
+	MatchID  *string            `json:"match_id"`
+}
+
+type GetPlayerGameUncertaintyBeforeMatchRow struct {
+	Deviation  float64       `json:"deviation"`
+	Volatility pgtype.Float8 `json:"volatility"`
+}
+
+// Game arena counterpart of GetPlayerGlobalUncertaintyBeforeMatch.
+func (q *Queries) GetPlayerGameUncertaintyBeforeMatch(ctx context.Context, arg GetPlayerGameUncertaintyBeforeMatchParams) (GetPlayerGameUncertaintyBeforeMatchRow, error) {
+	row := q.db.QueryRow(ctx, getPlayerGameUncertaintyBeforeMatch,
+		arg.PlayerID,
+		arg.GameID,
+		arg.Date,
+		arg.MatchID,
+	)
+	var i GetPlayerGameUncertaintyBeforeMatchRow
+	err := row.Scan(&i.Deviation, &i.Volatility)
+	return i, err
+}
+
 const getPlayerGlobalMatchCountInPeriod = `-- name: GetPlayerGlobalMatchCountInPeriod :one
 SELECT COUNT(*)::int AS count
 FROM matches m
@@ -77,6 +235,37 @@ func (q *Queries) GetPlayerGlobalMatchCountInPeriod(ctx context.Context, arg Get
 	return count, err
 }
 
+const getPlayerGlobalUncertaintyBeforeMatch = `-- name: GetPlayerGlobalUncertaintyBeforeMatch :one
+SELECT gas.deviation_after::float8 AS deviation, gas.volatility_after AS volatility
+FROM global_arena_settlement gas
+WHERE gas.player_id = $1
+  AND gas.deviation_after IS NOT NULL
+  AND (gas.date < $2 OR (gas.date = $2 AND gas.match_id IS NOT NULL AND gas.match_id < $3))
+ORDER BY gas.date DESC, gas.id DESC
+LIMIT 1
+`
+
+type GetPlayerGlobalUncertaintyBeforeMatchParams struct {
+	PlayerID string             `json:"player_id"`
+	Date     pgtype.Timestamptz `json:"date"`
+	MatchID  *string            `json:"match_id"`
+}
+
+type GetPlayerGlobalUncertaintyBeforeMatchRow struct {
+	Deviation  float64       `json:"deviation"`
+	Volatility pgtype.Float8 `json:"volatility"`
+}
+
+// Rating-algorithm uncertainty (Glicko-2 / TrueSkill) from the latest global
+// settlement before the match that tracked it; Elo, correction and market rows
+// carry none and are skipped.
+func (q *Queries) GetPlayerGlobalUncertaintyBeforeMatch(ctx context.Context, arg GetPlayerGlobalUncertaintyBeforeMatchParams) (GetPlayerGlobalUncertaintyBeforeMatchRow, error) {
+	row := q.db.QueryRow(ctx, getPlayerGlobalUncertaintyBeforeMatch, arg.PlayerID, arg.Date, arg.MatchID)
+	var i GetPlayerGlobalUncertaintyBeforeMatchRow
+	err := row.Scan(&i.Deviation, &i.Volatility)
+	return i, err
+}
+
 const getPlayerLatestGameElo = `-- name: GetPlayerLatestGameElo :one
 SELECT gas.elo_after AS game_elo_after
 FROM game_arena_settlement gas
@@ -229,7 +418,8 @@ const getPlayerLatestGlobalEloBeforeMatch = `-- name: GetPlayerLatestGlobalEloBe
 SELECT gas.elo_after AS rating
 FROM global_arena_settlement gas
 WHERE gas.player_id = $1
-  AND (gas.date < $2 OR (gas.date = $2 AND gas.match_id IS NOT NULL AND gas.match_id < $3))
+  AND (gas.date < $2 OR (gas.date = $2 AND gas.match_id IS NOT NULL
+       AND (gas.match_id < $3 OR (gas.match_id = $3 AND gas.discriminator = 'decay'))))
 ORDER BY gas.date DESC, gas.id DESC
 LIMIT 1
 `
@@ -240,6 +430,7 @@ type GetPlayerLatestGlobalEloBeforeMatchParams struct {
 	MatchID  *string            `json:"match_id"`
 }
 
+// The match's own decay reversal (decay.go) precedes its settlement.
 func (q *Queries) GetPlayerLatestGlobalEloBeforeMatch(ctx context.Context, arg GetPlayerLatestGlobalEloBeforeMatchParams) (float64, error) {
 	row := q.db.QueryRow(ctx, getPlayerLatestGlobalEloBeforeMatch, arg.PlayerID, arg.Date, arg.MatchID)
 	var rating float64
@@ -298,7 +489,8 @@ const getPlayerLatestGlobalRatingBeforeMatch = `-- name: GetPlayerLatestGlobalRa
 SELECT gas.rating_after AS rating, gas.league
 FROM global_arena_settlement gas
 WHERE gas.player_id = $1
-  AND (gas.date < $2 OR (gas.date = $2 AND gas.match_id IS NOT NULL AND gas.match_id < $3))
+  AND (gas.date < $2 OR (gas.date = $2 AND gas.match_id IS NOT NULL
+       AND (gas.match_id < $3 OR (gas.match_id = $3 AND gas.discriminator = 'decay'))))
 ORDER BY gas.date DESC, gas.id DESC
 LIMIT 1
 `
@@ -314,6 +506,7 @@ type GetPlayerLatestGlobalRatingBeforeMatchRow struct {
 	League string  `json:"league"`
 }
 
+// Includes the match's own decay reversal, as GetPlayerLatestGlobalEloBeforeMatch.
 func (q *Queries) GetPlayerLatestGlobalRatingBeforeMatch(ctx context.Context, arg GetPlayerLatestGlobalRatingBeforeMatchParams) (GetPlayerLatestGlobalRatingBeforeMatchRow, error) {
 	row := q.db.QueryRow(ctx, getPlayerLatestGlobalRatingBeforeMatch, arg.PlayerID, arg.Date, arg.MatchID)
 	var i GetPlayerLatestGlobalRatingBeforeMatchRow
@@ -321,6 +514,213 @@ func (q *Queries) GetPlayerLatestGlobalRatingBeforeMatch(ctx context.Context, ar
 	return i, err
 }
 
+const getPlayerLatestGlobalStateBeforeMatch = `-- name: GetPlayerLatestGlobalStateBeforeMatch :one
+SELECT gas.rating_after AS rating, gas.elo_after AS elo, gas.league
+FROM global_arena_settlement gas
+WHERE gas.player_id = $1
+  AND (gas.date < $2 OR (gas.date = $2 AND gas.match_id IS NOT NULL
+       AND (gas.match_id < $3 OR (gas.match_id = $3 AND gas.discriminator = 'decay'))))
+ORDER BY gas.date DESC, gas.id DESC
+LIMIT 1
+`
+
+type GetPlayerLatestGlobalStateBeforeMatchParams struct {
+	PlayerID string             `json:"player_id"`
+	Date     pgtype.Timestamptz `json:"date"`
+	MatchID  *string            `json:"match_id"`
+}
+
+type GetPlayerLatestGlobalStateBeforeMatchRow struct {
+	Rating float64 `json:"rating"`
+	Elo    float64 `json:"elo"`
+	League string  `json:"league"`
+}
+
+// Rating, Elo and league in one row, with GetPlayerLatestGlobalRatingBeforeMatch ordering.
+func (q *Queries) GetPlayerLatestGlobalStateBeforeMatch(ctx context.Context, arg GetPlayerLatestGlobalStateBeforeMatchParams) (GetPlayerLatestGlobalStateBeforeMatchRow, error) {
+	row := q.db.QueryRow(ctx, getPlayerLatestGlobalStateBeforeMatch, arg.PlayerID, arg.Date, arg.MatchID)
+	var i GetPlayerLatestGlobalStateBeforeMatchRow
+	err := row.Scan(&i.Rating, &i.Elo, &i.League)
+	return i, err
+}
+
+const listGameArenaSettlementsByMatch = `-- name: ListGameArenaSettlementsByMatch :many
+SELECT id, game_id, player_id, date, rating_after, elo_after, discriminator, match_id, elo_staked, elo_earned, rating_staked, rating_earned, league, deviation_after, volatility_after FROM game_arena_settlement WHERE match_id = $1
+`
+
+func (q *Queries) ListGameArenaSettlementsByMatch(ctx context.Context, matchID *string) ([]GameArenaSettlement, error) {
+	rows, err := q.db.Query(ctx, listGameArenaSettlementsByMatch, matchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GameArenaSettlement{}
+	for rows.Next() {
+		var i GameArenaSettlement
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameID,
+			&i.PlayerID,
+			&i.Date,
+			&i.RatingAfter,
+			&i.EloAfter,
+			&i.Discriminator,
+			&i.MatchID,
+			&i.EloStaked,
+			&i.EloEarned,
+			&i.RatingStaked,
+			&i.RatingEarned,
+			&i.League,
+			&i.DeviationAfter,
+			&i.VolatilityAfter,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGameRatingsAsOf = `-- name: ListGameRatingsAsOf :many
+SELECT DISTINCT ON (gas.player_id)
+  gas.player_id,
+  p.name,
+  gas.rating_after AS game_rating_after,
+  gas.league
+FROM game_arena_settlement gas
+JOIN players p ON p.id = gas.player_id
+WHERE gas.game_id = $1 AND gas.date <= $2
+ORDER BY gas.player_id, gas.date DESC, gas.id DESC
+`
+
+type ListGameRatingsAsOfParams struct {
+	GameID string             `json:"game_id"`
+	Date   pgtype.Timestamptz `json:"date"`
+}
+
+type ListGameRatingsAsOfRow struct {
+	PlayerID        string  `json:"player_id"`
+	Name            string  `json:"name"`
+	GameRatingAfter float64 `json:"game_rating_after"`
+	League          string  `json:"league"`
+}
+
+func (q *Queries) ListGameRatingsAsOf(ctx context.Context, arg ListGameRatingsAsOfParams) ([]ListGameRatingsAsOfRow, error) {
+	rows, err := q.db.Query(ctx, listGameRatingsAsOf, arg.GameID, arg.Date)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListGameRatingsAsOfRow{}
+	for rows.Next() {
+		var i ListGameRatingsAsOfRow
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.Name,
+			&i.GameRatingAfter,
+			&i.League,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGlobalArenaSettlementsByMatch = `-- name: ListGlobalArenaSettlementsByMatch :many
+SELECT id, player_id, date, rating_after, elo_after, discriminator, match_id, market_id, correction_id, elo_staked, elo_earned, rating_staked, rating_earned, league, deviation_after, volatility_after FROM global_arena_settlement WHERE match_id = $1 AND discriminator = 'match'
+`
+
+func (q *Queries) ListGlobalArenaSettlementsByMatch(ctx context.Context, matchID *string) ([]GlobalArenaSettlement, error) {
+	rows, err := q.db.Query(ctx, listGlobalArenaSettlementsByMatch, matchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GlobalArenaSettlement{}
+	for rows.Next() {
+		var i GlobalArenaSettlement
+		if err := rows.Scan(
+			&i.ID,
+			&i.PlayerID,
+			&i.Date,
+			&i.RatingAfter,
+			&i.EloAfter,
+			&i.Discriminator,
+			&i.MatchID,
+			&i.MarketID,
+			&i.CorrectionID,
+			&i.EloStaked,
+			&i.EloEarned,
+			&i.RatingStaked,
+			&i.RatingEarned,
+			&i.League,
+			&i.DeviationAfter,
+			&i.VolatilityAfter,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLatestGameArenaStatesBetween = `-- name: ListLatestGameArenaStatesBetween :many
+SELECT DISTINCT ON (gas.player_id, gas.game_id)
+    gas.player_id, gas.game_id, gas.rating_after AS rating, gas.elo_after AS elo, gas.league
+FROM game_arena_settlement gas
+WHERE gas.date >= $1 AND gas.date < $2
+ORDER BY gas.player_id, gas.game_id, gas.date DESC, gas.match_id DESC
+`
+
+type ListLatestGameArenaStatesBetweenParams struct {
+	FromDate pgtype.Timestamptz `json:"from_date"`
+	ToDate   pgtype.Timestamptz `json:"to_date"`
+}
+
+type ListLatestGameArenaStatesBetweenRow struct {
+	PlayerID string  `json:"player_id"`
+	GameID   string  `json:"game_id"`
+	Rating   float64 `json:"rating"`
+	Elo      float64 `json:"elo"`
+	League   string  `json:"league"`
+}
+
+func (q *Queries) ListLatestGameArenaStatesBetween(ctx context.Context, arg ListLatestGameArenaStatesBetweenParams) ([]ListLatestGameArenaStatesBetweenRow, error) {
+	rows, err := q.db.Query(ctx, listLatestGameArenaStatesBetween, arg.FromDate, arg.ToDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListLatestGameArenaStatesBetweenRow{}
+	for rows.Next() {
+		var i ListLatestGameArenaStatesBetweenRow
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.GameID,
+			&i.Rating,
+			&i.Elo,
+			&i.League,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listLatestGameEloPerPlayer = `-- name: ListLatestGameEloPerPlayer :many
 SELECT DISTINCT ON (gas.player_id) gas.player_id, gas.elo_after AS game_elo_after
 FROM game_arena_settlement gas
@@ -396,6 +796,185 @@ func (q *Queries) ListLatestGameRatingPerPlayer(ctx context.Context, gameID stri
 	return items, nil
 }
 
+const listLatestGameUncertaintiesBetween = `-- name: ListLatestGameUncertaintiesBetween :many
+SELECT DISTINCT ON (gas.player_id, gas.game_id)
+    gas.player_id, gas.game_id, gas.deviation_after::float8 AS deviation, gas.volatility_after AS volatility
+FROM game_arena_settlement gas
+WHERE gas.date >= $1 AND gas.date < $2
+  AND gas.deviation_after IS NOT NULL
+ORDER BY gas.player_id, gas.game_id, gas.date DESC, gas.match_id DESC
+`
+
+type ListLatestGameUncertaintiesBetweenParams struct {
+	FromDate pgtype.Timestamptz `json:"from_date"`
+	ToDate   pgtype.Timestamptz `json:"to_date"`
+}
+
+type ListLatestGameUncertaintiesBetweenRow struct {
+	PlayerID   string        `json:"player_id"`
+	GameID     string        `json:"game_id"`
+	Deviation  float64       `json:"deviation"`
+	Volatility pgtype.Float8 `json:"volatility"`
+}
+
+func (q *Queries) ListLatestGameUncertaintiesBetween(ctx context.Context, arg ListLatestGameUncertaintiesBetweenParams) ([]ListLatestGameUncertaintiesBetweenRow, error) {
+	rows, err := q.db.Query(ctx, listLatestGameUncertaintiesBetween, arg.FromDate, arg.ToDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListLatestGameUncertaintiesBetweenRow{}
+	for rows.Next() {
+		var i ListLatestGameUncertaintiesBetweenRow
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.GameID,
+			&i.Deviation,
+			&i.Volatility,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLatestGlobalArenaStatesBetween = `-- name: ListLatestGlobalArenaStatesBetween :many
+SELECT DISTINCT ON (gas.player_id)
+    gas.player_id, gas.rating_after AS rating, gas.elo_after AS elo, gas.league
+FROM global_arena_settlement gas
+WHERE gas.date >= $1 AND gas.date < $2
+ORDER BY gas.player_id, gas.date DESC, gas.id DESC
+`
+
+type ListLatestGlobalArenaStatesBetweenParams struct {
+	FromDate pgtype.Timestamptz `json:"from_date"`
+	ToDate   pgtype.Timestamptz `json:"to_date"`
+}
+
+type ListLatestGlobalArenaStatesBetweenRow struct {
+	PlayerID string  `json:"player_id"`
+	Rating   float64 `json:"rating"`
+	Elo      float64 `json:"elo"`
+	League   string  `json:"league"`
+}
+
+// Each player's latest global settlement in [from_date, to_date): the state
+// before the replay window on top of a checkpoint taken at from_date
+// (checkpoints.go). from_date is -infinity when there is no checkpoint.
+func (q *Queries) ListLatestGlobalArenaStatesBetween(ctx context.Context, arg ListLatestGlobalArenaStatesBetweenParams) ([]ListLatestGlobalArenaStatesBetweenRow, error) {
+	rows, err := q.db.Query(ctx, listLatestGlobalArenaStatesBetween, arg.FromDate, arg.ToDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListLatestGlobalArenaStatesBetweenRow{}
+	for rows.Next() {
+		var i ListLatestGlobalArenaStatesBetweenRow
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.Rating,
+			&i.Elo,
+			&i.League,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLatestGlobalUncertaintiesBetween = `-- name: ListLatestGlobalUncertaintiesBetween :many
+SELECT DISTINCT ON (gas.player_id)
+    gas.player_id, gas.deviation_after::float8 AS deviation, gas.volatility_after AS volatility
+FROM global_arena_settlement gas
+WHERE gas.date >= $1 AND gas.date < $2
+  AND gas.deviation_after IS NOT NULL
+ORDER BY gas.player_id, gas.date DESC, gas.id DESC
+`
+
+type ListLatestGlobalUncertaintiesBetweenParams struct {
+	FromDate pgtype.Timestamptz `json:"from_date"`
+	ToDate   pgtype.Timestamptz `json:"to_date"`
+}
+
+type ListLatestGlobalUncertaintiesBetweenRow struct {
+	PlayerID   string        `json:"player_id"`
+	Deviation  float64       `json:"deviation"`
+	Volatility pgtype.Float8 `json:"volatility"`
+}
+
+func (q *Queries) ListLatestGlobalUncertaintiesBetween(ctx context.Context, arg ListLatestGlobalUncertaintiesBetweenParams) ([]ListLatestGlobalUncertaintiesBetweenRow, error) {
+	rows, err := q.db.Query(ctx, listLatestGlobalUncertaintiesBetween, arg.FromDate, arg.ToDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListLatestGlobalUncertaintiesBetweenRow{}
+	for rows.Next() {
+		var i ListLatestGlobalUncertaintiesBetweenRow
+		if err := rows.Scan(&i.PlayerID, &i.Deviation, &i.Volatility); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLatestVirtualOpponentStatesBetween = `-- name: ListLatestVirtualOpponentStatesBetween :many
+SELECT DISTINCT ON (vos.game_id)
+    vos.game_id, vos.elo_after, vos.deviation_after, vos.volatility_after
+FROM game_virtual_opponent_settlement vos
+WHERE vos.date >= $1 AND vos.date < $2
+ORDER BY vos.game_id, vos.date DESC, vos.match_id DESC
+`
+
+type ListLatestVirtualOpponentStatesBetweenParams struct {
+	FromDate pgtype.Timestamptz `json:"from_date"`
+	ToDate   pgtype.Timestamptz `json:"to_date"`
+}
+
+type ListLatestVirtualOpponentStatesBetweenRow struct {
+	GameID          string        `json:"game_id"`
+	EloAfter        float64       `json:"elo_after"`
+	DeviationAfter  pgtype.Float8 `json:"deviation_after"`
+	VolatilityAfter pgtype.Float8 `json:"volatility_after"`
+}
+
+func (q *Queries) ListLatestVirtualOpponentStatesBetween(ctx context.Context, arg ListLatestVirtualOpponentStatesBetweenParams) ([]ListLatestVirtualOpponentStatesBetweenRow, error) {
+	rows, err := q.db.Query(ctx, listLatestVirtualOpponentStatesBetween, arg.FromDate, arg.ToDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListLatestVirtualOpponentStatesBetweenRow{}
+	for rows.Next() {
+		var i ListLatestVirtualOpponentStatesBetweenRow
+		if err := rows.Scan(
+			&i.GameID,
+			&i.EloAfter,
+			&i.DeviationAfter,
+			&i.VolatilityAfter,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listMatchesWithPlayersByGameFromDB = `-- name: ListMatchesWithPlayersByGameFromDB :many
 SELECT
     m.id AS match_id,
@@ -491,32 +1070,37 @@ func (q *Queries) RatingHistory(ctx context.Context, playerID string) ([]RatingH
 const upsertGameArenaSettlementByMatch = `-- name: UpsertGameArenaSettlementByMatch :exec
 INSERT INTO game_arena_settlement
     (id, game_id, player_id, date, rating_after, elo_after, discriminator, match_id,
-     elo_staked, elo_earned, rating_staked, rating_earned, league)
-SELECT $2, $3, $4, m.date, $5, $6, 'match', $1, $7, $8, $9, $10, $11
+     elo_staked, elo_earned, rating_staked, rating_earned, league,
+     deviation_after, volatility_after)
+SELECT $2, $3, $4, m.date, $5, $6, 'match', $1, $7, $8, $9, $10, $11, $12, $13
 FROM matches m WHERE m.id = $1
 ON CONFLICT (match_id, player_id) WHERE match_id IS NOT NULL
-DO UPDATE SET rating_after  = EXCLUDED.rating_after,
-              elo_after     = EXCLUDED.elo_after,
-              date          = EXCLUDED.date,
-              elo_staked    = EXCLUDED.elo_staked,
-              elo_earned    = EXCLUDED.elo_earned,
-              rating_staked = EXCLUDED.rating_staked,
-              rating_earned = EXCLUDED.rating_earned,
-              league        = EXCLUDED.league
+DO UPDATE SET rating_after     = EXCLUDED.rating_after,
+              elo_after        = EXCLUDED.elo_after,
+              date             = EXCLUDED.date,
+              elo_staked       = EXCLUDED.elo_staked,
+              elo_earned       = EXCLUDED.elo_earned,
+              rating_staked    = EXCLUDED.rating_staked,
+              rating_earned    = EXCLUDED.rating_earned,
+              league           = EXCLUDED.league,
+              deviation_after  = EXCLUDED.deviation_after,
+              volatility_after = EXCLUDED.volatility_after
 `
 
 type UpsertGameArenaSettlementByMatchParams struct {
-	MatchID      *string `json:"match_id"`
-	ID           string  `json:"id"`
-	GameID       string  `json:"game_id"`
-	PlayerID     string  `json:"player_id"`
-	RatingAfter  float64 `json:"rating_after"`
-	EloAfter     float64 `json:"elo_after"`
-	EloStaked    float64 `json:"elo_staked"`
-	EloEarned    float64 `json:"elo_earned"`
-	RatingStaked float64 `json:"rating_staked"`
-	RatingEarned float64 `json:"rating_earned"`
-	League       string  `json:"league"`
+	MatchID         *string       `json:"match_id"`
+	ID              string        `json:"id"`
+	GameID          string        `json:"game_id"`
+	PlayerID        string        `json:"player_id"`
+	RatingAfter     float64       `json:"rating_after"`
+	EloAfter        float64       `json:"elo_after"`
+	EloStaked       float64       `json:"elo_staked"`
+	EloEarned       float64       `json:"elo_earned"`
+	RatingStaked    float64       `json:"rating_staked"`
+	RatingEarned    float64       `json:"rating_earned"`
+	League          string        `json:"league"`
+	DeviationAfter  pgtype.Float8 `json:"deviation_after"`
+	VolatilityAfter pgtype.Float8 `json:"volatility_after"`
 }
 
 func (q *Queries) UpsertGameArenaSettlementByMatch(ctx context.Context, arg UpsertGameArenaSettlementByMatchParams) error {
@@ -532,6 +1116,49 @@ func (q *Queries) UpsertGameArenaSettlementByMatch(ctx context.Context, arg Upse
 		arg.RatingStaked,
 		arg.RatingEarned,
 		arg.League,
+		arg.DeviationAfter,
+		arg.VolatilityAfter,
+	)
+	return err
+}
+
+const upsertGameVirtualOpponentSettlement = `-- name: UpsertGameVirtualOpponentSettlement :exec
+INSERT INTO game_virtual_opponent_settlement
+    (id, game_id, match_id, date, elo_after, elo_staked, elo_earned,
+     deviation_after, volatility_after)
+SELECT $2, $3, $1, m.date, $4, $5, $6, $7, $8
+FROM matches m WHERE m.id = $1
+ON CONFLICT (match_id)
+DO UPDATE SET game_id          = EXCLUDED.game_id,
+              date             = EXCLUDED.date,
+              elo_after        = EXCLUDED.elo_after,
+              elo_staked       = EXCLUDED.elo_staked,
+              elo_earned       = EXCLUDED.elo_earned,
+              deviation_after  = EXCLUDED.deviation_after,
+              volatility_after = EXCLUDED.volatility_after
+`
+
+type UpsertGameVirtualOpponentSettlementParams struct {
+	MatchID         string        `json:"match_id"`
+	ID              string        `json:"id"`
+	GameID          string        `json:"game_id"`
+	EloAfter        float64       `json:"elo_after"`
+	EloStaked       float64       `json:"elo_staked"`
+	EloEarned       float64       `json:"elo_earned"`
+	DeviationAfter  pgtype.Float8 `json:"deviation_after"`
+	VolatilityAfter pgtype.Float8 `json:"volatility_after"`
+}
+
+func (q *Queries) UpsertGameVirtualOpponentSettlement(ctx context.Context, arg UpsertGameVirtualOpponentSettlementParams) error {
+	_, err := q.db.Exec(ctx, upsertGameVirtualOpponentSettlement,
+		arg.MatchID,
+		arg.ID,
+		arg.GameID,
+		arg.EloAfter,
+		arg.EloStaked,
+		arg.EloEarned,
+		arg.DeviationAfter,
+		arg.VolatilityAfter,
 	)
 	return err
 }
@@ -539,31 +1166,36 @@ func (q *Queries) UpsertGameArenaSettlementByMatch(ctx context.Context, arg Upse
 const upsertGlobalArenaSettlementByMatch = `-- name: UpsertGlobalArenaSettlementByMatch :exec
 INSERT INTO global_arena_settlement
     (id, player_id, date, rating_after, elo_after, discriminator, match_id,
-     elo_staked, elo_earned, rating_staked, rating_earned, league)
-SELECT $2, $3, m.date, $4, $5, 'match', $1, $6, $7, $8, $9, $10
+     elo_staked, elo_earned, rating_staked, rating_earned, league,
+     deviation_after, volatility_after)
+SELECT $2, $3, m.date, $4, $5, 'match', $1, $6, $7, $8, $9, $10, $11, $12
 FROM matches m WHERE m.id = $1
-ON CONFLICT (match_id, player_id) WHERE match_id IS NOT NULL
-DO UPDATE SET rating_after  = EXCLUDED.rating_after,
-              elo_after     = EXCLUDED.elo_after,
-              date          = EXCLUDED.date,
-              elo_staked    = EXCLUDED.elo_staked,
-              elo_earned    = EXCLUDED.elo_earned,
-              rating_staked = EXCLUDED.rating_staked,
-              rating_earned = EXCLUDED.rating_earned,
-              league        = EXCLUDED.league
+ON CONFLICT (match_id, player_id, discriminator) WHERE match_id IS NOT NULL
+DO UPDATE SET rating_after     = EXCLUDED.rating_after,
+              elo_after        = EXCLUDED.elo_after,
+              date             = EXCLUDED.date,
+              elo_staked       = EXCLUDED.elo_staked,
+              elo_earned       = EXCLUDED.elo_earned,
+              rating_staked    = EXCLUDED.rating_staked,
+              rating_earned    = EXCLUDED.rating_earned,
+              league           = EXCLUDED.league,
+              deviation_after  = EXCLUDED.deviation_after,
+              volatility_after = EXCLUDED.volatility_after
 `
 
 type UpsertGlobalArenaSettlementByMatchParams struct {
-	MatchID      *string `json:"match_id"`
-	ID           string  `json:"id"`
-	PlayerID     string  `json:"player_id"`
-	RatingAfter  float64 `json:"rating_after"`
-	EloAfter     float64 `json:"elo_after"`
-	EloStaked    float64 `json:"elo_staked"`
-	EloEarned    float64 `json:"elo_earned"`
-	RatingStaked float64 `json:"rating_staked"`
-	RatingEarned float64 `json:"rating_earned"`
-	League       string  `json:"league"`
+	MatchID         *string       `json:"match_id"`
+	ID              string        `json:"id"`
+	PlayerID        string        `json:"player_id"`
+	RatingAfter     float64       `json:"rating_after"`
+	EloAfter        float64       `json:"elo_after"`
+	EloStaked       float64       `json:"elo_staked"`
+	EloEarned       float64       `json:"elo_earned"`
+	RatingStaked    float64       `json:"rating_staked"`
+	RatingEarned    float64       `json:"rating_earned"`
+	League          string        `json:"league"`
+	DeviationAfter  pgtype.Float8 `json:"deviation_after"`
+	VolatilityAfter pgtype.Float8 `json:"volatility_after"`
 }
 
 func (q *Queries) UpsertGlobalArenaSettlementByMatch(ctx context.Context, arg UpsertGlobalArenaSettlementByMatchParams) error {
@@ -578,6 +1210,8 @@ func (q *Queries) UpsertGlobalArenaSettlementByMatch(ctx context.Context, arg Up
 		arg.RatingStaked,
 		arg.RatingEarned,
 		arg.League,
+		arg.DeviationAfter,
+		arg.VolatilityAfter,
 	)
 	return err
 }
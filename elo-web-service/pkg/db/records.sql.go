@@ -0,0 +1,299 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: records.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const listBiggestMarketPayout = `-- name: ListBiggestMarketPayout :many
+SELECT gas.player_id, p.name AS player_name, gas.rating_earned AS payout, gas.market_id, gas.date
+FROM global_arena_settlement gas
+JOIN players p ON p.id = gas.player_id
+WHERE gas.discriminator = 'market'
+ORDER BY gas.rating_earned DESC, gas.date, gas.id
+LIMIT 1
+`
+
+type ListBiggestMarketPayoutRow struct {
+	PlayerID   string             `json:"player_id"`
+	PlayerName string             `json:"player_name"`
+	Payout     float64            `json:"payout"`
+	MarketID   *string            `json:"market_id"`
+	Date       pgtype.Timestamptz `json:"date"`
+}
+
+// The largest rating paid out to a player by a resolved market.
+func (q *Queries) ListBiggestMarketPayout(ctx context.Context) ([]ListBiggestMarketPayoutRow, error) {
+	rows, err := q.db.Query(ctx, listBiggestMarketPayout)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListBiggestMarketPayoutRow{}
+	for rows.Next() {
+		var i ListBiggestMarketPayoutRow
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.PlayerName,
+			&i.Payout,
+			&i.MarketID,
+			&i.Date,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGameHighScores = `-- name: ListGameHighScores :many
+
+SELECT DISTINCT ON (m.game_id) m.game_id, g.name AS game_name, ms.player_id, p.name AS player_name,
+       ms.score, m.id AS match_id, m.date
+FROM match_scores ms
+JOIN matches m ON m.id = ms.match_id
+JOIN games g ON g.id = m.game_id
+JOIN players p ON p.id = ms.player_id
+WHERE g.result_type = 'score'
+  AND ($1::uuid IS NULL OR m.game_id = $1::uuid)
+ORDER BY m.game_id, ms.score DESC, m.date, m.id, ms.player_id
+`
+
+type ListGameHighScoresRow struct {
+	GameID     string             `json:"game_id"`
+	GameName   string             `json:"game_name"`
+	PlayerID   string             `json:"player_id"`
+	PlayerName string             `json:"player_name"`
+	Score      float64            `json:"score"`
+	MatchID    string             `json:"match_id"`
+	Date       pgtype.Timestamptz `json:"date"`
+}
+
+// Hall of fame records, read straight from match scores and settlements so
+// they follow every history edit. Ties go to the earliest holder.
+// The highest score of every score-based game, or of one game.
+func (q *Queries) ListGameHighScores(ctx context.Context, gameID *string) ([]ListGameHighScoresRow, error) {
+	rows, err := q.db.Query(ctx, listGameHighScores, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListGameHighScoresRow{}
+	for rows.Next() {
+		var i ListGameHighScoresRow
+		if err := rows.Scan(
+			&i.GameID,
+			&i.GameName,
+			&i.PlayerID,
+			&i.PlayerName,
+			&i.Score,
+			&i.MatchID,
+			&i.Date,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGameLowScores = `-- name: ListGameLowScores :many
+SELECT DISTINCT ON (m.game_id) m.game_id, g.name AS game_name, ms.player_id, p.name AS player_name,
+       ms.score, m.id AS match_id, m.date
+FROM match_scores ms
+JOIN matches m ON m.id = ms.match_id
+JOIN games g ON g.id = m.game_id
+JOIN players p ON p.id = ms.player_id
+WHERE g.result_type = 'score'
+  AND ($1::uuid IS NULL OR m.game_id = $1::uuid)
+ORDER BY m.game_id, ms.score ASC, m.date, m.id, ms.player_id
+`
+
+type ListGameLowScoresRow struct {
+	GameID     string             `json:"game_id"`
+	GameName   string             `json:"game_name"`
+	PlayerID   string             `json:"player_id"`
+	PlayerName string             `json:"player_name"`
+	Score      float64            `json:"score"`
+	MatchID    string             `json:"match_id"`
+	Date       pgtype.Timestamptz `json:"date"`
+}
+
+// The lowest score of every score-based game, or of one game.
+func (q *Queries) ListGameLowScores(ctx context.Context, gameID *string) ([]ListGameLowScoresRow, error) {
+	rows, err := q.db.Query(ctx, listGameLowScores, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListGameLowScoresRow{}
+	for rows.Next() {
+		var i ListGameLowScoresRow
+		if err := rows.Scan(
+			&i.GameID,
+			&i.GameName,
+			&i.PlayerID,
+			&i.PlayerName,
+			&i.Score,
+			&i.MatchID,
+			&i.Date,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLargestGameMatchGain = `-- name: ListLargestGameMatchGain :many
+SELECT gas.player_id, p.name AS player_name,
+       (gas.rating_earned + gas.rating_staked)::float8 AS gain, gas.match_id, gas.date
+FROM game_arena_settlement gas
+JOIN players p ON p.id = gas.player_id
+WHERE gas.game_id = $1
+ORDER BY gain DESC, gas.date, gas.id
+LIMIT 1
+`
+
+type ListLargestGameMatchGainRow struct {
+	PlayerID   string             `json:"player_id"`
+	PlayerName string             `json:"player_name"`
+	Gain       float64            `json:"gain"`
+	MatchID    *string            `json:"match_id"`
+	Date       pgtype.Timestamptz `json:"date"`
+}
+
+// The largest rating change from a single match in the game's arena.
+func (q *Queries) ListLargestGameMatchGain(ctx context.Context, gameID string) ([]ListLargestGameMatchGainRow, error) {
+	rows, err := q.db.Query(ctx, listLargestGameMatchGain, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListLargestGameMatchGainRow{}
+	for rows.Next() {
+		var i ListLargestGameMatchGainRow
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.PlayerName,
+			&i.Gain,
+			&i.MatchID,
+			&i.Date,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLargestGlobalMatchGain = `-- name: ListLargestGlobalMatchGain :many
+SELECT gas.player_id, p.name AS player_name,
+       (gas.rating_earned + gas.rating_staked)::float8 AS gain, gas.match_id, gas.date
+FROM global_arena_settlement gas
+JOIN players p ON p.id = gas.player_id
+WHERE gas.discriminator = 'match'
+ORDER BY gain DESC, gas.date, gas.id
+LIMIT 1
+`
+
+type ListLargestGlobalMatchGainRow struct {
+	PlayerID   string             `json:"player_id"`
+	PlayerName string             `json:"player_name"`
+	Gain       float64            `json:"gain"`
+	MatchID    *string            `json:"match_id"`
+	Date       pgtype.Timestamptz `json:"date"`
+}
+
+// The largest global rating change from a single match.
+func (q *Queries) ListLargestGlobalMatchGain(ctx context.Context) ([]ListLargestGlobalMatchGainRow, error) {
+	rows, err := q.db.Query(ctx, listLargestGlobalMatchGain)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListLargestGlobalMatchGainRow{}
+	for rows.Next() {
+		var i ListLargestGlobalMatchGainRow
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.PlayerName,
+			&i.Gain,
+			&i.MatchID,
+			&i.Date,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMostMatchesInDay = `-- name: ListMostMatchesInDay :many
+SELECT ms.player_id, p.name AS player_name,
+       (date_trunc('day', m.date AT TIME ZONE 'UTC') AT TIME ZONE 'UTC')::timestamptz AS day,
+       COUNT(*)::int AS matches
+FROM match_scores ms
+JOIN matches m ON m.id = ms.match_id
+JOIN players p ON p.id = ms.player_id
+WHERE $1::uuid IS NULL OR m.game_id = $1::uuid
+GROUP BY ms.player_id, p.name, day
+ORDER BY matches DESC, day, p.name
+LIMIT 1
+`
+
+type ListMostMatchesInDayRow struct {
+	PlayerID   string    `json:"player_id"`
+	PlayerName string    `json:"player_name"`
+	Day        time.Time `json:"day"`
+	Matches    int32     `json:"matches"`
+}
+
+// The most matches one player played in a UTC calendar day, in any game or
+// in one game.
+func (q *Queries) ListMostMatchesInDay(ctx context.Context, gameID *string) ([]ListMostMatchesInDayRow, error) {
+	rows, err := q.db.Query(ctx, listMostMatchesInDay, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListMostMatchesInDayRow{}
+	for rows.Next() {
+		var i ListMostMatchesInDayRow
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.PlayerName,
+			&i.Day,
+			&i.Matches,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,508 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: seasons.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const archiveSeason = `-- name: ArchiveSeason :one
+UPDATE seasons SET archived_at = $2 WHERE id = $1
+RETURNING id, name, starts_at, ends_at, reset_factor, archived_at
+`
+
+type ArchiveSeasonParams struct {
+	ID         string             `json:"id"`
+	ArchivedAt pgtype.Timestamptz `json:"archived_at"`
+}
+
+func (q *Queries) ArchiveSeason(ctx context.Context, arg ArchiveSeasonParams) (Season, error) {
+	row := q.db.QueryRow(ctx, archiveSeason, arg.ID, arg.ArchivedAt)
+	var i Season
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.ResetFactor,
+		&i.ArchivedAt,
+	)
+	return i, err
+}
+
+const createSeason = `-- name: CreateSeason :one
+INSERT INTO seasons (id, name, starts_at, ends_at, reset_factor)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, name, starts_at, ends_at, reset_factor, archived_at
+`
+
+type CreateSeasonParams struct {
+	ID          string        `json:"id"`
+	Name        string        `json:"name"`
+	StartsAt    time.Time     `json:"starts_at"`
+	EndsAt      time.Time     `json:"ends_at"`
+	ResetFactor pgtype.Float8 `json:"reset_factor"`
+}
+
+func (q *Queries) CreateSeason(ctx context.Context, arg CreateSeasonParams) (Season, error) {
+	row := q.db.QueryRow(ctx, createSeason,
+		arg.ID,
+		arg.Name,
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.ResetFactor,
+	)
+	var i Season
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.ResetFactor,
+		&i.ArchivedAt,
+	)
+	return i, err
+}
+
+const deleteSeason = `-- name: DeleteSeason :exec
+DELETE FROM seasons WHERE id = $1
+`
+
+func (q *Queries) DeleteSeason(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, deleteSeason, id)
+	return err
+}
+
+const getSeason = `-- name: GetSeason :one
+SELECT id, name, starts_at, ends_at, reset_factor, archived_at FROM seasons WHERE id = $1
+`
+
+func (q *Queries) GetSeason(ctx context.Context, id string) (Season, error) {
+	row := q.db.QueryRow(ctx, getSeason, id)
+	var i Season
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.ResetFactor,
+		&i.ArchivedAt,
+	)
+	return i, err
+}
+
+const getSeasonForUpdate = `-- name: GetSeasonForUpdate :one
+SELECT id, name, starts_at, ends_at, reset_factor, archived_at FROM seasons WHERE id = $1 FOR UPDATE
+`
+
+func (q *Queries) GetSeasonForUpdate(ctx context.Context, id string) (Season, error) {
+	row := q.db.QueryRow(ctx, getSeasonForUpdate, id)
+	var i Season
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.ResetFactor,
+		&i.ArchivedAt,
+	)
+	return i, err
+}
+
+const insertGlobalArenaSeasonResetSettlement = `-- name: InsertGlobalArenaSeasonResetSettlement :exec
+INSERT INTO global_arena_settlement
+    (id, player_id, date, rating_after, elo_after, discriminator,
+     elo_staked, elo_earned, rating_staked, rating_earned, league)
+VALUES ($1, $2, $3, $4, $5, 'season_reset', 0, $6, 0, $7, $8)
+ON CONFLICT (player_id, date) WHERE discriminator = 'season_reset' DO NOTHING
+`
+
+type InsertGlobalArenaSeasonResetSettlementParams struct {
+	ID           string             `json:"id"`
+	PlayerID     string             `json:"player_id"`
+	Date         pgtype.Timestamptz `json:"date"`
+	RatingAfter  float64            `json:"rating_after"`
+	EloAfter     float64            `json:"elo_after"`
+	EloEarned    float64            `json:"elo_earned"`
+	RatingEarned float64            `json:"rating_earned"`
+	League       string             `json:"league"`
+}
+
+// A reset another transaction has already written is skipped.
+func (q *Queries) InsertGlobalArenaSeasonResetSettlement(ctx context.Context, arg InsertGlobalArenaSeasonResetSettlementParams) error {
+	_, err := q.db.Exec(ctx, insertGlobalArenaSeasonResetSettlement,
+		arg.ID,
+		arg.PlayerID,
+		arg.Date,
+		arg.RatingAfter,
+		arg.EloAfter,
+		arg.EloEarned,
+		arg.RatingEarned,
+		arg.League,
+	)
+	return err
+}
+
+const insertSeasonStanding = `-- name: InsertSeasonStanding :exec
+INSERT INTO season_standings (season_id, game_id, player_id, rank, rating, league, start_rank, start_rating)
+VALUES ($1, $6, $2, $3, $4, $5, $7, $8)
+`
+
+type InsertSeasonStandingParams struct {
+	SeasonID    string        `json:"season_id"`
+	PlayerID    string        `json:"player_id"`
+	Rank        int32         `json:"rank"`
+	Rating      float64       `json:"rating"`
+	League      string        `json:"league"`
+	GameID      *string       `json:"game_id"`
+	StartRank   pgtype.Int4   `json:"start_rank"`
+	StartRating pgtype.Float8 `json:"start_rating"`
+}
+
+func (q *Queries) InsertSeasonStanding(ctx context.Context, arg InsertSeasonStandingParams) error {
+	_, err := q.db.Exec(ctx, insertSeasonStanding,
+		arg.SeasonID,
+		arg.PlayerID,
+		arg.Rank,
+		arg.Rating,
+		arg.League,
+		arg.GameID,
+		arg.StartRank,
+		arg.StartRating,
+	)
+	return err
+}
+
+const listPendingSeasonResets = `-- name: ListPendingSeasonResets :many
+SELECT s.id, s.starts_at, s.reset_factor::float8 AS reset_factor
+FROM seasons s
+WHERE s.reset_factor IS NOT NULL
+  AND s.starts_at - interval '1 microsecond' < $1
+  AND NOT EXISTS (
+      SELECT 1 FROM global_arena_settlement r
+      WHERE r.discriminator = 'season_reset' AND r.date = s.starts_at - interval '1 microsecond'
+  )
+  AND EXISTS (
+      SELECT 1 FROM global_arena_settlement g
+      WHERE g.date < s.starts_at
+  )
+ORDER BY s.starts_at
+`
+
+type ListPendingSeasonResetsRow struct {
+	ID          string    `json:"id"`
+	StartsAt    time.Time `json:"starts_at"`
+	ResetFactor float64   `json:"reset_factor"`
+}
+
+// Seasons whose reset is due strictly before @until and not written yet, with
+// someone settled to reset.
+func (q *Queries) ListPendingSeasonResets(ctx context.Context, until time.Time) ([]ListPendingSeasonResetsRow, error) {
+	rows, err := q.db.Query(ctx, listPendingSeasonResets, until)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPendingSeasonResetsRow{}
+	for rows.Next() {
+		var i ListPendingSeasonResetsRow
+		if err := rows.Scan(&i.ID, &i.StartsAt, &i.ResetFactor); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSeasonChampions = `-- name: ListSeasonChampions :many
+SELECT ss.season_id, ss.game_id, g.name AS game_name, ss.player_id, p.name AS player_name, ss.rating
+FROM season_standings ss
+JOIN players p ON p.id = ss.player_id
+LEFT JOIN games g ON g.id = ss.game_id
+WHERE ss.rank = 1
+ORDER BY ss.season_id, ss.game_id NULLS FIRST, p.name
+`
+
+type ListSeasonChampionsRow struct {
+	SeasonID   string      `json:"season_id"`
+	GameID     *string     `json:"game_id"`
+	GameName   pgtype.Text `json:"game_name"`
+	PlayerID   string      `json:"player_id"`
+	PlayerName string      `json:"player_name"`
+	Rating     float64     `json:"rating"`
+}
+
+// Rank 1 of every archived table of every season.
+func (q *Queries) ListSeasonChampions(ctx context.Context) ([]ListSeasonChampionsRow, error) {
+	rows, err := q.db.Query(ctx, listSeasonChampions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListSeasonChampionsRow{}
+	for rows.Next() {
+		var i ListSeasonChampionsRow
+		if err := rows.Scan(
+			&i.SeasonID,
+			&i.GameID,
+			&i.GameName,
+			&i.PlayerID,
+			&i.PlayerName,
+			&i.Rating,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSeasonGameParticipants = `-- name: ListSeasonGameParticipants :many
+SELECT DISTINCT gas.player_id
+FROM game_arena_settlement gas
+WHERE gas.game_id = $1
+  AND gas.date >= $2 AND gas.date < $3
+ORDER BY gas.player_id
+`
+
+type ListSeasonGameParticipantsParams struct {
+	GameID   string             `json:"game_id"`
+	FromDate pgtype.Timestamptz `json:"from_date"`
+	ToDate   pgtype.Timestamptz `json:"to_date"`
+}
+
+// Players with a settlement in the game's arena in [from_date, to_date).
+func (q *Queries) ListSeasonGameParticipants(ctx context.Context, arg ListSeasonGameParticipantsParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, listSeasonGameParticipants, arg.GameID, arg.FromDate, arg.ToDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var player_id string
+		if err := rows.Scan(&player_id); err != nil {
+			return nil, err
+		}
+		items = append(items, player_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSeasonGames = `-- name: ListSeasonGames :many
+SELECT DISTINCT gas.game_id
+FROM game_arena_settlement gas
+WHERE gas.date >= $1 AND gas.date < $2
+ORDER BY gas.game_id
+`
+
+type ListSeasonGamesParams struct {
+	FromDate pgtype.Timestamptz `json:"from_date"`
+	ToDate   pgtype.Timestamptz `json:"to_date"`
+}
+
+// Games with a game arena settlement in [from_date, to_date).
+func (q *Queries) ListSeasonGames(ctx context.Context, arg ListSeasonGamesParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, listSeasonGames, arg.FromDate, arg.ToDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var game_id string
+		if err := rows.Scan(&game_id); err != nil {
+			return nil, err
+		}
+		items = append(items, game_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSeasonGlobalParticipants = `-- name: ListSeasonGlobalParticipants :many
+SELECT DISTINCT gas.player_id
+FROM global_arena_settlement gas
+WHERE gas.discriminator = 'match'
+  AND gas.date >= $1 AND gas.date < $2
+ORDER BY gas.player_id
+`
+
+type ListSeasonGlobalParticipantsParams struct {
+	FromDate pgtype.Timestamptz `json:"from_date"`
+	ToDate   pgtype.Timestamptz `json:"to_date"`
+}
+
+// Players with a global match settlement in [from_date, to_date).
+func (q *Queries) ListSeasonGlobalParticipants(ctx context.Context, arg ListSeasonGlobalParticipantsParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, listSeasonGlobalParticipants, arg.FromDate, arg.ToDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var player_id string
+		if err := rows.Scan(&player_id); err != nil {
+			return nil, err
+		}
+		items = append(items, player_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSeasonStandings = `-- name: ListSeasonStandings :many
+SELECT ss.player_id, p.name, ss.rank, ss.rating, ss.league, ss.start_rank, ss.start_rating
+FROM season_standings ss
+JOIN players p ON p.id = ss.player_id
+WHERE ss.season_id = $1
+  AND ss.game_id IS NOT DISTINCT FROM $2::uuid
+ORDER BY ss.rank, p.name
+`
+
+type ListSeasonStandingsParams struct {
+	SeasonID string  `json:"season_id"`
+	GameID   *string `json:"game_id"`
+}
+
+type ListSeasonStandingsRow struct {
+	PlayerID    string        `json:"player_id"`
+	Name        string        `json:"name"`
+	Rank        int32         `json:"rank"`
+	Rating      float64       `json:"rating"`
+	League      string        `json:"league"`
+	StartRank   pgtype.Int4   `json:"start_rank"`
+	StartRating pgtype.Float8 `json:"start_rating"`
+}
+
+// The archived table of the global arena (game_id NULL) or of a game.
+func (q *Queries) ListSeasonStandings(ctx context.Context, arg ListSeasonStandingsParams) ([]ListSeasonStandingsRow, error) {
+	rows, err := q.db.Query(ctx, listSeasonStandings, arg.SeasonID, arg.GameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListSeasonStandingsRow{}
+	for rows.Next() {
+		var i ListSeasonStandingsRow
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.Name,
+			&i.Rank,
+			&i.Rating,
+			&i.League,
+			&i.StartRank,
+			&i.StartRating,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSeasons = `-- name: ListSeasons :many
+SELECT id, name, starts_at, ends_at, reset_factor, archived_at FROM seasons ORDER BY starts_at DESC
+`
+
+func (q *Queries) ListSeasons(ctx context.Context) ([]Season, error) {
+	rows, err := q.db.Query(ctx, listSeasons)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Season{}
+	for rows.Next() {
+		var i Season
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.ResetFactor,
+			&i.ArchivedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnwrittenSeasonResetDates = `-- name: ListUnwrittenSeasonResetDates :many
+SELECT s.starts_at
+FROM seasons s
+WHERE s.reset_factor IS NOT NULL
+  AND NOT EXISTS (
+      SELECT 1 FROM global_arena_settlement r
+      WHERE r.discriminator = 'season_reset' AND r.date = s.starts_at - interval '1 microsecond'
+  )
+ORDER BY s.starts_at
+`
+
+// Start dates of the seasons with a reset that has no rows yet.
+func (q *Queries) ListUnwrittenSeasonResetDates(ctx context.Context) ([]time.Time, error) {
+	rows, err := q.db.Query(ctx, listUnwrittenSeasonResetDates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []time.Time{}
+	for rows.Next() {
+		var starts_at time.Time
+		if err := rows.Scan(&starts_at); err != nil {
+			return nil, err
+		}
+		items = append(items, starts_at)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const seasonOverlaps = `-- name: SeasonOverlaps :one
+SELECT EXISTS (
+    SELECT 1 FROM seasons
+    WHERE tstzrange(starts_at, ends_at) && tstzrange($1::timestamptz, $2::timestamptz)
+)::bool
+`
+
+type SeasonOverlapsParams struct {
+	StartsAt time.Time `json:"starts_at"`
+	EndsAt   time.Time `json:"ends_at"`
+}
+
+// Whether [starts_at, ends_at) overlaps an existing season.
+func (q *Queries) SeasonOverlaps(ctx context.Context, arg SeasonOverlapsParams) (bool, error) {
+	row := q.db.QueryRow(ctx, seasonOverlaps, arg.StartsAt, arg.EndsAt)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
@@ -53,7 +53,8 @@ SELECT
     google_oauth_user_id,
     google_oauth_user_name,
     player_id,
-    legacy_int_id
+    legacy_int_id,
+    is_admin
 FROM users
 WHERE id = $1
 `
@@ -68,6 +69,7 @@ func (q *Queries) GetUser(ctx context.Context, id string) (User, error) {
 		&i.GoogleOauthUserName,
 		&i.PlayerID,
 		&i.LegacyIntID,
+		&i.IsAdmin,
 	)
 	return i, err
 }
@@ -79,7 +81,8 @@ SELECT
     google_oauth_user_id,
     google_oauth_user_name,
     player_id,
-    legacy_int_id
+    legacy_int_id,
+    is_admin
 FROM users
 WHERE google_oauth_user_id = $1
 `
@@ -94,6 +97,7 @@ func (q *Queries) GetUserByGoogleOAuthUserID(ctx context.Context, googleOauthUse
 		&i.GoogleOauthUserName,
 		&i.PlayerID,
 		&i.LegacyIntID,
+		&i.IsAdmin,
 	)
 	return i, err
 }
@@ -105,7 +109,8 @@ SELECT
     google_oauth_user_id,
     google_oauth_user_name,
     player_id,
-    legacy_int_id
+    legacy_int_id,
+    is_admin
 FROM users
 WHERE legacy_int_id = $1
 `
@@ -122,6 +127,7 @@ func (q *Queries) GetUserByLegacyIntID(ctx context.Context, legacyIntID pgtype.I
 		&i.GoogleOauthUserName,
 		&i.PlayerID,
 		&i.LegacyIntID,
+		&i.IsAdmin,
 	)
 	return i, err
 }
@@ -133,7 +139,8 @@ SELECT
     google_oauth_user_id,
     google_oauth_user_name,
     player_id,
-    legacy_int_id
+    legacy_int_id,
+    is_admin
 FROM users
 `
 
@@ -153,6 +160,7 @@ func (q *Queries) ListUsers(ctx context.Context) ([]User, error) {
 			&i.GoogleOauthUserName,
 			&i.PlayerID,
 			&i.LegacyIntID,
+			&i.IsAdmin,
 		); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,379 @@
+package elo
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+// Achievement kinds, as stored in player_achievements.kind.
+const (
+	AchievementFirstWin        = "first_win"         // first match finished first alone
+	AchievementWinStreak10     = "win_streak_10"     // ten such wins in a row
+	AchievementBeatLeader      = "beat_leader"       // finished above the global #1
+	AchievementDistinctGames20 = "distinct_games_20" // played twenty distinct games
+	AchievementMarketWin       = "market_win"        // net rating gain from a resolved market
+	AchievementEliteLeague     = "elite_league"      // reached the elite league
+)
+
+const (
+	achievementWinStreak     = 10
+	achievementDistinctGames = 20
+)
+
+// Achievement is an unlocked achievement. MatchID is the unlocking match; for
+// a market win it is the match that resolved the market, nil when the market
+// expired.
+type Achievement struct {
+	PlayerID   string
+	Kind       string
+	UnlockedAt time.Time
+	MatchID    *string
+	MarketID   *string
+}
+
+// ListAchievements returns the player's achievements, oldest first.
+func (s *PlayerService) ListAchievements(ctx context.Context, playerID string) ([]db.PlayerAchievement, error) {
+	return s.Queries.ListPlayerAchievements(ctx, playerID)
+}
+
+// BackfillAchievements evaluates the whole history when player_achievements
+// is empty, which is the case right after migration 057 on an existing
+// history. Afterwards every match and replay keeps the table in line.
+func (s *MatchService) BackfillAchievements(ctx context.Context) error {
+	return runInTx(ctx, s.Pool, func(q *db.Queries) error {
+		stored, err := q.ListAllPlayerAchievements(ctx)
+		if err != nil {
+			return fmt.Errorf("list achievements: %w", err)
+		}
+		if len(stored) > 0 {
+			return nil
+		}
+		return syncAchievements(ctx, q, time.Time{})
+	})
+}
+
+// syncAchievements brings player_achievements in line with the history from
+// from on: rows no rule unlocks any more are deleted, moved ones are updated
+// and new ones inserted. Match achievements unlocked before from stand, since
+// no edit at or after from can change them; the replay passes its start date
+// and a new match its own date, and the zero time evaluates the whole history.
+// Elite league and market wins are the first qualifying settlement, read over
+// the whole history because markets also expire by time between matches.
+func syncAchievements(ctx context.Context, q *db.Queries, from time.Time) error {
+	fromDate := pgtype.Timestamptz{Time: from, Valid: true}
+	stored, err := q.ListAllPlayerAchievements(ctx)
+	if err != nil {
+		return fmt.Errorf("list achievements: %w", err)
+	}
+	base, err := loadAchievementBaseline(ctx, q, from, stored)
+	if err != nil {
+		return err
+	}
+	scores, err := q.ListAchievementMatchScores(ctx, db.ListAchievementMatchScoresParams{FromDate: fromDate, StreakWindow: achievementWinStreak})
+	if err != nil {
+		return fmt.Errorf("list match scores: %w", err)
+	}
+	settlements, err := q.ListAchievementGlobalSettlements(ctx, fromDate)
+	if err != nil {
+		return fmt.Errorf("list global settlements: %w", err)
+	}
+	firsts, err := q.ListSettlementAchievements(ctx)
+	if err != nil {
+		return fmt.Errorf("list settlement achievements: %w", err)
+	}
+
+	type key struct{ playerID, kind string }
+	want := make(map[key]Achievement)
+	for _, a := range evaluateAchievements(base, scores, settlements) {
+		want[key{a.PlayerID, a.Kind}] = a
+	}
+	for _, r := range firsts {
+		want[key{r.PlayerID, r.Kind}] = Achievement{PlayerID: r.PlayerID, Kind: r.Kind, UnlockedAt: r.Date.Time, MatchID: r.MatchID, MarketID: r.MarketID}
+	}
+	for _, row := range stored {
+		if !settlementAchievement(row.Kind) && row.UnlockedAt.Before(from) {
+			continue
+		}
+		k := key{row.PlayerID, row.Kind}
+		a, ok := want[k]
+		if !ok {
+			if err := q.DeletePlayerAchievement(ctx, db.DeletePlayerAchievementParams{PlayerID: row.PlayerID, Kind: row.Kind}); err != nil {
+				return fmt.Errorf("delete achievement %s of player %s: %w", row.Kind, row.PlayerID, err)
+			}
+			continue
+		}
+		if a.UnlockedAt.Equal(row.UnlockedAt) && equalIDs(a.MatchID, row.MatchID) && equalIDs(a.MarketID, row.MarketID) {
+			delete(want, k)
+		}
+	}
+	for _, a := range want {
+		if err := q.UpsertPlayerAchievement(ctx, db.UpsertPlayerAchievementParams{
+			PlayerID:   a.PlayerID,
+			Kind:       a.Kind,
+			UnlockedAt: a.UnlockedAt,
+			MatchID:    a.MatchID,
+			MarketID:   a.MarketID,
+		}); err != nil {
+			return fmt.Errorf("upsert achievement %s of player %s: %w", a.Kind, a.PlayerID, err)
+		}
+	}
+	return nil
+}
+
+// settlementAchievement reports whether kind is unlocked by a settlement
+// rather than by a match.
+func settlementAchievement(kind string) bool {
+	return kind == AchievementEliteLeague || kind == AchievementMarketWin
+}
+
+// achievementBaseline is what the match rules need of the history before
+// from: the match achievements already unlocked, the global ratings and the
+// games every player has played.
+type achievementBaseline struct {
+	from     time.Time
+	unlocked map[string]map[string]bool
+	rating   map[string]float64
+	games    map[string]map[string]bool
+}
+
+func loadAchievementBaseline(ctx context.Context, q *db.Queries, from time.Time, stored []db.PlayerAchievement) (achievementBaseline, error) {
+	base := achievementBaseline{
+		from:     from,
+		unlocked: make(map[string]map[string]bool),
+		rating:   make(map[string]float64),
+		games:    make(map[string]map[string]bool),
+	}
+	for _, row := range stored {
+		if settlementAchievement(row.Kind) || !row.UnlockedAt.Before(from) {
+			continue
+		}
+		if base.unlocked[row.PlayerID] == nil {
+			base.unlocked[row.PlayerID] = make(map[string]bool)
+		}
+		base.unlocked[row.PlayerID][row.Kind] = true
+	}
+
+	fromDate := pgtype.Timestamptz{Time: from, Valid: true}
+	ratings, err := q.ListAchievementRatingsBefore(ctx, fromDate)
+	if err != nil {
+		return achievementBaseline{}, fmt.Errorf("list ratings before %v: %w", from, err)
+	}
+	for _, r := range ratings {
+		base.rating[r.PlayerID] = r.RatingAfter
+	}
+	games, err := q.ListAchievementGamesBefore(ctx, fromDate)
+	if err != nil {
+		return achievementBaseline{}, fmt.Errorf("list games before %v: %w", from, err)
+	}
+	for _, r := range games {
+		if base.games[r.PlayerID] == nil {
+			base.games[r.PlayerID] = make(map[string]bool)
+		}
+		base.games[r.PlayerID][r.GameID] = true
+	}
+	return base, nil
+}
+
+// achievementMatch is one match of the history with its scores.
+type achievementMatch struct {
+	id          string
+	date        time.Time
+	gameID      string
+	scores      map[string]float64 // ranking scores, higher is better
+	teams       map[string]string
+	cooperative bool
+	guests      map[string]bool
+}
+
+// winners returns the players who finished first alone, grouped into sides
+// the way the rating engine groups them: every member of a winning team wins.
+// A shared first place and a cooperative match have no winners.
+func (m achievementMatch) winners() map[string]bool {
+	if m.cooperative {
+		return nil
+	}
+	members, ok := newMatchSides(m.scores, m.teams).winners()
+	if !ok {
+		return nil
+	}
+	set := make(map[string]bool, len(members))
+	for _, playerID := range members {
+		set[playerID] = true
+	}
+	return set
+}
+
+// evaluateAchievements walks the matches and global settlements in event
+// order from the baseline on and returns the first unlock of every match
+// achievement of every player. Both lists come ordered by date; a settlement
+// is seen before a match when it is dated earlier or belongs to a match
+// already walked. Matches before base.from only carry win streaks forward.
+func evaluateAchievements(base achievementBaseline, scores []db.ListAchievementMatchScoresRow, settlements []db.ListAchievementGlobalSettlementsRow) []Achievement {
+	e := achievementEvaluator{
+		from:      base.from,
+		unlocked:  base.unlocked,
+		rating:    base.rating,
+		games:     base.games,
+		streak:    make(map[string]int),
+		processed: make(map[string]bool),
+	}
+	if e.unlocked == nil {
+		e.unlocked = make(map[string]map[string]bool)
+	}
+	if e.rating == nil {
+		e.rating = make(map[string]float64)
+	}
+	if e.games == nil {
+		e.games = make(map[string]map[string]bool)
+	}
+	si := 0
+	for _, m := range groupAchievementMatches(scores) {
+		for si < len(settlements) && settledBefore(settlements[si], m, e.processed) {
+			e.settle(settlements[si])
+			si++
+		}
+		e.match(m)
+	}
+	return e.result
+}
+
+func settledBefore(s db.ListAchievementGlobalSettlementsRow, m achievementMatch, processed map[string]bool) bool {
+	if s.Date.Time.Before(m.date) {
+		return true
+	}
+	return s.Date.Time.Equal(m.date) && s.MatchID != nil && processed[*s.MatchID]
+}
+
+func groupAchievementMatches(rows []db.ListAchievementMatchScoresRow) []achievementMatch {
+	var matches []achievementMatch
+	raw := make(map[string]float64)
+	flush := func(r db.ListAchievementMatchScoresRow) {
+		scoring := GameScoring{Direction: r.ScoringDirection, ResultType: r.ResultType}
+		matches[len(matches)-1].scores = scoring.RankingScores(raw)
+		raw = make(map[string]float64)
+	}
+	for i, r := range rows {
+		if i == 0 || r.MatchID != rows[i-1].MatchID {
+			if i > 0 {
+				flush(rows[i-1])
+			}
+			matches = append(matches, achievementMatch{
+				id:          r.MatchID,
+				date:        r.Date.Time,
+				gameID:      r.GameID,
+				teams:       make(map[string]string),
+				cooperative: r.CooperativeResult.Valid,
+				guests:      make(map[string]bool),
+			})
+		}
+		m := &matches[len(matches)-1]
+		raw[r.PlayerID] = r.Score
+		if r.Team.Valid {
+			m.teams[r.PlayerID] = r.Team.String
+		}
+		if r.IsGuest {
+			m.guests[r.PlayerID] = true
+		}
+	}
+	if len(rows) > 0 {
+		flush(rows[len(rows)-1])
+	}
+	return matches
+}
+
+type achievementEvaluator struct {
+	from      time.Time
+	result    []Achievement
+	unlocked  map[string]map[string]bool
+	rating    map[string]float64         // latest global rating of every settled player
+	games     map[string]map[string]bool // distinct games of every player
+	streak    map[string]int             // current run of sole wins
+	processed map[string]bool            // matches walked so far
+}
+
+func (e *achievementEvaluator) unlock(playerID, kind string, date time.Time, matchID *string) {
+	if e.unlocked[playerID] == nil {
+		e.unlocked[playerID] = make(map[string]bool)
+	}
+	if e.unlocked[playerID][kind] {
+		return
+	}
+	e.unlocked[playerID][kind] = true
+	e.result = append(e.result, Achievement{PlayerID: playerID, Kind: kind, UnlockedAt: date, MatchID: matchID})
+}
+
+func (e *achievementEvaluator) settle(s db.ListAchievementGlobalSettlementsRow) {
+	e.rating[s.PlayerID] = s.RatingAfter
+}
+
+// match applies one match. A cooperative match counts towards the games
+// played but neither wins nor breaks a streak, and no one in it finishes
+// above anyone else.
+func (e *achievementEvaluator) match(m achievementMatch) {
+	e.processed[m.id] = true
+	matchID := &m.id
+	warmUp := m.date.Before(e.from)
+	winners := m.winners()
+
+	// The global #1 before this match: the highest rating, ties shared.
+	top := math.Inf(-1)
+	for _, r := range e.rating {
+		top = math.Max(top, r)
+	}
+	leaders := make(map[string]bool)
+	for playerID, r := range e.rating {
+		if r == top {
+			leaders[playerID] = true
+		}
+	}
+
+	for playerID, score := range m.scores {
+		if m.guests[playerID] {
+			continue
+		}
+		if !m.cooperative {
+			if winners[playerID] {
+				e.streak[playerID]++
+				if !warmUp {
+					e.unlock(playerID, AchievementFirstWin, m.date, matchID)
+					if e.streak[playerID] >= achievementWinStreak {
+						e.unlock(playerID, AchievementWinStreak10, m.date, matchID)
+					}
+				}
+			} else {
+				e.streak[playerID] = 0
+			}
+		}
+		if warmUp {
+			continue
+		}
+
+		if !m.cooperative && !leaders[playerID] {
+			for leaderID := range leaders {
+				if other, ok := m.scores[leaderID]; ok && score > other {
+					e.unlock(playerID, AchievementBeatLeader, m.date, matchID)
+				}
+			}
+		}
+
+		if e.games[playerID] == nil {
+			e.games[playerID] = make(map[string]bool)
+		}
+		e.games[playerID][m.gameID] = true
+		if len(e.games[playerID]) >= achievementDistinctGames {
+			e.unlock(playerID, AchievementDistinctGames20, m.date, matchID)
+		}
+	}
+}
+
+func equalIDs(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,184 @@
+package elo
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+var achievementsT0 = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func achievementScores(matchID string, hour int, gameID string, scores map[string]float64) []db.ListAchievementMatchScoresRow {
+	var rows []db.ListAchievementMatchScoresRow
+	for playerID, score := range scores {
+		rows = append(rows, db.ListAchievementMatchScoresRow{
+			MatchID:          matchID,
+			Date:             pgtype.Timestamptz{Time: achievementsT0.Add(time.Duration(hour) * time.Hour), Valid: true},
+			GameID:           gameID,
+			ScoringDirection: ScoringHigherWins,
+			ResultType:       ResultTypeScore,
+			PlayerID:         playerID,
+			Score:            score,
+			IsGuest:          playerID == "guest",
+		})
+	}
+	return rows
+}
+
+func achievementsByKey(list []Achievement) map[string]Achievement {
+	m := make(map[string]Achievement, len(list))
+	for _, a := range list {
+		m[a.PlayerID+"/"+a.Kind] = a
+	}
+	return m
+}
+
+func TestAchievementsWinsAndStreak(t *testing.T) {
+	var scores []db.ListAchievementMatchScoresRow
+	// A tie for first is no win and ends a run.
+	scores = append(scores, achievementScores("m00", 0, "g", map[string]float64{"a": 5, "b": 5})...)
+	for i := 1; i <= 12; i++ {
+		s := map[string]float64{"a": 10, "b": 1}
+		if i == 5 {
+			s = map[string]float64{"a": 1, "b": 10}
+		}
+		scores = append(scores, achievementScores(fmt.Sprintf("m%02d", i), i, "g", s)...)
+	}
+	got := achievementsByKey(evaluateAchievements(achievementBaseline{}, scores, nil))
+
+	if a, ok := got["a/"+AchievementFirstWin]; !ok || *a.MatchID != "m01" {
+		t.Errorf("a first win = %+v, want m01", a)
+	}
+	if a, ok := got["b/"+AchievementFirstWin]; !ok || *a.MatchID != "m05" {
+		t.Errorf("b first win = %+v, want m05", a)
+	}
+	// a's run restarts after m05 and reaches 7 by m12.
+	if a, ok := got["a/"+AchievementWinStreak10]; ok {
+		t.Errorf("a unlocked a streak without ten wins in a row: %+v", a)
+	}
+
+	for i := 13; i <= 15; i++ {
+		scores = append(scores, achievementScores(fmt.Sprintf("m%02d", i), i, "g", map[string]float64{"a": 10, "b": 1})...)
+	}
+	got = achievementsByKey(evaluateAchievements(achievementBaseline{}, scores, nil))
+	if a, ok := got["a/"+AchievementWinStreak10]; !ok || *a.MatchID != "m15" {
+		t.Errorf("a streak = %+v, want m15", a)
+	}
+}
+
+func TestAchievementsBeatLeaderUsesStandingBeforeMatch(t *testing.T) {
+	at := func(hour int) pgtype.Timestamptz {
+		return pgtype.Timestamptz{Time: achievementsT0.Add(time.Duration(hour) * time.Hour), Valid: true}
+	}
+	m1, m2 := "m1", "m2"
+	settlements := []db.ListAchievementGlobalSettlementsRow{
+		{PlayerID: "leader", Date: at(0), RatingAfter: 1500},
+		{PlayerID: "p", Date: at(0), RatingAfter: 1000},
+		{PlayerID: "p", Date: at(1), RatingAfter: 1600, MatchID: &m1},
+		{PlayerID: "leader", Date: at(1), RatingAfter: 1400, MatchID: &m1},
+	}
+	var scores []db.ListAchievementMatchScoresRow
+	scores = append(scores, achievementScores(m1, 1, "g", map[string]float64{"p": 10, "leader": 1, "guest": 20})...)
+	// p is #1 by now: finishing above the former leader is no longer a feat.
+	scores = append(scores, achievementScores(m2, 2, "g", map[string]float64{"leader": 10, "p": 1})...)
+	got := achievementsByKey(evaluateAchievements(achievementBaseline{}, scores, settlements))
+
+	if a, ok := got["p/"+AchievementBeatLeader]; !ok || *a.MatchID != m1 {
+		t.Errorf("p beat leader = %+v, want m1", a)
+	}
+	if a, ok := got["leader/"+AchievementBeatLeader]; !ok || *a.MatchID != m2 {
+		t.Errorf("leader beat leader = %+v, want m2 once p leads", a)
+	}
+	if _, ok := got["p/"+AchievementFirstWin]; ok {
+		t.Error("p won m1, where a guest finished first")
+	}
+	for k := range got {
+		if k[:len("guest/")] == "guest/" {
+			t.Errorf("guest unlocked %s", k)
+		}
+	}
+}
+
+func TestAchievementsDistinctGames(t *testing.T) {
+	var scores []db.ListAchievementMatchScoresRow
+	for i := 0; i < 20; i++ {
+		scores = append(scores, achievementScores(fmt.Sprintf("m%02d", i), i, fmt.Sprintf("g%02d", i), map[string]float64{"a": 1, "b": 2})...)
+	}
+	got := achievementsByKey(evaluateAchievements(achievementBaseline{}, scores, nil))
+
+	if a, ok := got["a/"+AchievementDistinctGames20]; !ok || *a.MatchID != "m19" {
+		t.Errorf("a distinct games = %+v, want m19", a)
+	}
+}
+
+func TestAchievementsTeamsAndCooperative(t *testing.T) {
+	var scores []db.ListAchievementMatchScoresRow
+	add := func(i int, s map[string]float64, teams map[string]string, coop bool) {
+		rows := achievementScores(fmt.Sprintf("m%02d", i), i, "g", s)
+		for j := range rows {
+			if team, ok := teams[rows[j].PlayerID]; ok {
+				rows[j].Team = pgtype.Text{String: team, Valid: true}
+			}
+			rows[j].CooperativeResult = pgtype.Text{String: "won", Valid: coop}
+		}
+		scores = append(scores, rows...)
+	}
+	// a and b win as a team: both win, c and d do not.
+	add(0, map[string]float64{"a": 7, "b": 7, "c": 3, "d": 3}, map[string]string{"a": "x", "b": "x", "c": "y", "d": "y"}, false)
+	// Two teams sharing the top score: nobody wins, a's run ends.
+	add(1, map[string]float64{"a": 5, "b": 5, "c": 5, "d": 5}, map[string]string{"a": "x", "b": "x", "c": "y", "d": "y"}, false)
+	// c finishes the cooperative match with the best score: no win.
+	add(2, map[string]float64{"c": 9, "d": 1}, nil, true)
+	got := achievementsByKey(evaluateAchievements(achievementBaseline{}, scores, nil))
+
+	for _, p := range []string{"a", "b"} {
+		if a, ok := got[p+"/"+AchievementFirstWin]; !ok || *a.MatchID != "m00" {
+			t.Errorf("%s first win = %+v, want m00", p, a)
+		}
+	}
+	for _, p := range []string{"c", "d"} {
+		if a, ok := got[p+"/"+AchievementFirstWin]; ok {
+			t.Errorf("%s unlocked a first win: %+v", p, a)
+		}
+	}
+
+	// Nine wins, a cooperative match, then the tenth: the cooperative match
+	// does not break the run.
+	scores = nil
+	for i := 0; i < 9; i++ {
+		add(i, map[string]float64{"a": 10, "b": 1}, nil, false)
+	}
+	add(9, map[string]float64{"a": 1, "b": 10}, nil, true)
+	add(10, map[string]float64{"a": 10, "b": 1}, nil, false)
+	got = achievementsByKey(evaluateAchievements(achievementBaseline{}, scores, nil))
+	if a, ok := got["a/"+AchievementWinStreak10]; !ok || *a.MatchID != "m10" {
+		t.Errorf("a streak = %+v, want m10", a)
+	}
+	if _, ok := got["b/"+AchievementFirstWin]; ok {
+		t.Error("b won a cooperative match")
+	}
+}
+
+func TestAchievementsFromBaseline(t *testing.T) {
+	var scores []db.ListAchievementMatchScoresRow
+	for i := 0; i < 12; i++ {
+		scores = append(scores, achievementScores(fmt.Sprintf("m%02d", i), i, "g", map[string]float64{"a": 10, "b": 1})...)
+	}
+	// The window starts at m09: the first nine matches only carry a's run,
+	// and a's first win and the game b played before stand as they are.
+	base := achievementBaseline{
+		from:     achievementsT0.Add(9 * time.Hour),
+		unlocked: map[string]map[string]bool{"a": {AchievementFirstWin: true}},
+		games:    map[string]map[string]bool{"b": {"g": true, "h": true}},
+	}
+	got := evaluateAchievements(base, scores, nil)
+	if len(got) != 1 || got[0].PlayerID != "a" || got[0].Kind != AchievementWinStreak10 || *got[0].MatchID != "m09" {
+		t.Fatalf("unlocks = %+v, want only a's streak at m09", got)
+	}
+	if n := len(base.games["b"]); n != 2 {
+		t.Errorf("b played %d games, want the 2 of the baseline", n)
+	}
+}
@@ -0,0 +1,717 @@
+package elo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+// Custom arenas (ADR-02) are filtered competitive scopes with a rating of
+// their own. Every match that passes an arena's filters is rated again as a
+// sub-match among the arena's eligible players, with the global arena's rating
+// algorithm; the result is stored in arena_settlement.
+
+// ArenaFilters are the match filters of a custom arena. Empty / nil filters
+// match everything.
+type ArenaFilters struct {
+	GameIDs      []string
+	PlayerIDs    []string
+	ClubID       *string
+	TournamentID *string
+	StartsAt     *time.Time
+	EndsAt       *time.Time
+}
+
+type IArenaService interface {
+	ListArenas(ctx context.Context) ([]db.Arena, error)
+	GetArena(ctx context.Context, id string) (db.Arena, error)
+	// CreateArena stores a new arena and settles every existing match that
+	// passes its filters. ladder is optional (nil: no leagues).
+	CreateArena(ctx context.Context, id, name string, filters ArenaFilters, ladder *LeagueLadderConfig) (db.Arena, error)
+	// UpdateArena replaces the arena's name, filters and ladder and rebuilds
+	// its settlements from scratch.
+	UpdateArena(ctx context.Context, id, name string, filters ArenaFilters, ladder *LeagueLadderConfig) (db.Arena, error)
+	// DeleteArena removes the arena together with its settlements.
+	DeleteArena(ctx context.Context, id string) (db.Arena, error)
+	GetLeaderboard(ctx context.Context, id string) ([]db.GetArenaLeaderboardRow, error)
+	GetPlayerHistory(ctx context.Context, arenaID, playerID string) ([]db.GetArenaPlayerHistoryRow, error)
+	// GetPlayerStandings returns the player's progress in every custom arena
+	// they have played in, with leagues as of now.
+	GetPlayerStandings(ctx context.Context, playerID string, now time.Time) ([]PlayerArenaStanding, error)
+}
+
+type ArenaService struct {
+	Queries *db.Queries
+	Pool    *pgxpool.Pool
+}
+
+func NewArenaService(pool *pgxpool.Pool) IArenaService {
+	return &ArenaService{Queries: db.New(pool), Pool: pool}
+}
+
+func (s *ArenaService) ListArenas(ctx context.Context) ([]db.Arena, error) {
+	return s.Queries.ListArenas(ctx)
+}
+
+func (s *ArenaService) GetArena(ctx context.Context, id string) (db.Arena, error) {
+	return s.Queries.GetArena(ctx, id)
+}
+
+func (s *ArenaService) CreateArena(ctx context.Context, id, name string, filters ArenaFilters, ladder *LeagueLadderConfig) (db.Arena, error) {
+	ladderDoc, err := leagueLadderDocument(ladder)
+	if err != nil {
+		return db.Arena{}, err
+	}
+
+	var created db.Arena
+	err = runInTx(ctx, s.Pool, func(q *db.Queries) error {
+		var err error
+		created, err = q.CreateArena(ctx, db.CreateArenaParams{
+			ID:           id,
+			Name:         name,
+			GameIds:      nonNilIDs(filters.GameIDs),
+			PlayerIds:    nonNilIDs(filters.PlayerIDs),
+			ClubID:       filters.ClubID,
+			TournamentID: filters.TournamentID,
+			StartsAt:     optionalTimestamptz(filters.StartsAt),
+			EndsAt:       optionalTimestamptz(filters.EndsAt),
+			LeagueLadder: ladderDoc,
+		})
+		if err != nil {
+			return err
+		}
+		return rebuildArena(ctx, q, created)
+	})
+	return created, err
+}
+
+func (s *ArenaService) UpdateArena(ctx context.Context, id, name string, filters ArenaFilters, ladder *LeagueLadderConfig) (db.Arena, error) {
+	ladderDoc, err := leagueLadderDocument(ladder)
+	if err != nil {
+		return db.Arena{}, err
+	}
+
+	var updated db.Arena
+	err = runInTx(ctx, s.Pool, func(q *db.Queries) error {
+		var err error
+		updated, err = q.UpdateArena(ctx, db.UpdateArenaParams{
+			ID:           id,
+			Name:         name,
+			GameIds:      nonNilIDs(filters.GameIDs),
+			PlayerIds:    nonNilIDs(filters.PlayerIDs),
+			ClubID:       filters.ClubID,
+			TournamentID: filters.TournamentID,
+			StartsAt:     optionalTimestamptz(filters.StartsAt),
+			EndsAt:       optionalTimestamptz(filters.EndsAt),
+			LeagueLadder: ladderDoc,
+		})
+		if err != nil {
+			// ErrNoRows and unique violations are returned raw for the handler.
+			return err
+		}
+		return rebuildArena(ctx, q, updated)
+	})
+	return updated, err
+}
+
+func (s *ArenaService) DeleteArena(ctx context.Context, id string) (db.Arena, error) {
+	return s.Queries.DeleteArena(ctx, id)
+}
+
+// GetLeaderboard returns db.IsNoRows when the arena does not exist, so an
+// unknown arena is told apart from an empty one. League is the league held
+// now rather than the one stored with the last settlement.
+func (s *ArenaService) GetLeaderboard(ctx context.Context, id string) ([]db.GetArenaLeaderboardRow, error) {
+	arena, err := s.Queries.GetArena(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.Queries.GetArenaLeaderboard(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	ladder, err := ParseLeagueLadder(arena.LeagueLadder)
+	if err != nil || ladder == nil {
+		return rows, err
+	}
+
+	now := time.Now()
+	for i, r := range rows {
+		p, err := s.currentProgress(ctx, ladder, id, r.PlayerID, r.EloAfter, r.Cubes, r.Meeples, now)
+		if err != nil {
+			return nil, err
+		}
+		rows[i].League = pgtype.Text{String: ladder.Effective(r.League.String, p, now.Sub(r.LastMatchDate.Time)), Valid: true}
+	}
+	return rows, nil
+}
+
+func (s *ArenaService) GetPlayerHistory(ctx context.Context, arenaID, playerID string) ([]db.GetArenaPlayerHistoryRow, error) {
+	if _, err := s.Queries.GetArena(ctx, arenaID); err != nil {
+		return nil, err
+	}
+	return s.Queries.GetArenaPlayerHistory(ctx, db.GetArenaPlayerHistoryParams{ArenaID: arenaID, PlayerID: playerID})
+}
+
+// arenaMatch is the part of a match the arena filters look at.
+type arenaMatch struct {
+	gameID      string
+	date        time.Time
+	scores      map[string]float64 // ranking scores (GameScoring.RankingScores)
+	teams       map[string]string
+	cooperative bool
+	tournaments map[string]bool
+	guests      map[string]bool // rated against, but not settled
+}
+
+// arenaScope is an arena with its club membership and ladder resolved.
+type arenaScope struct {
+	db.Arena
+	clubMembers map[string]bool
+	ladder      *LeagueLadder
+}
+
+// subMatch returns the ranking scores of the players the arena rates in m.
+// ok is false when the match does not pass the arena's filters or fewer than
+// two sides are left among the eligible players.
+func (a arenaScope) subMatch(m arenaMatch) (map[string]float64, bool) {
+	if m.cooperative {
+		return nil, false
+	}
+	if len(a.GameIds) > 0 && !slices.Contains(a.GameIds, m.gameID) {
+		return nil, false
+	}
+	if a.StartsAt.Valid && m.date.Before(a.StartsAt.Time) {
+		return nil, false
+	}
+	if a.EndsAt.Valid && !m.date.Before(a.EndsAt.Time) {
+		return nil, false
+	}
+	if a.TournamentID != nil && !m.tournaments[*a.TournamentID] {
+		return nil, false
+	}
+
+	scores := make(map[string]float64, len(m.scores))
+	for playerID, score := range m.scores {
+		if len(a.PlayerIds) > 0 && !slices.Contains(a.PlayerIds, playerID) {
+			continue
+		}
+		if a.ClubID != nil && !a.clubMembers[playerID] {
+			continue
+		}
+		scores[playerID] = score
+	}
+	if len(newMatchSides(scores, m.teams).members) < 2 {
+		return nil, false
+	}
+	return scores, true
+}
+
+// arenaResult is one player's settlement in a custom arena.
+type arenaResult struct {
+	after  Skill
+	staked float64
+	earned float64
+}
+
+// rateArenaMatch rates a sub-match the way buildEloResults rates the global
+// arena's true-skill track. Players missing from ratings start from the
+// algorithm's starting skill. Pure calculation — no DB writes.
+func rateArenaMatch(algo RatingAlgorithm, ratings, deviations, volatilities map[string]float64, scores map[string]float64, teams map[string]string) map[string]arenaResult {
+	sides := newMatchSides(scores, teams)
+	start := algo.Starting()
+	sideSkill := sides.skills(ratings, deviations, volatilities, start)
+	changes := algo.Rate(sideSkill, sides.scores)
+
+	results := make(map[string]arenaResult, len(scores))
+	for id := range scores {
+		side := sides.sideOf[id]
+		prev := playerSkill(id, ratings, deviations, volatilities, start)
+		results[id] = arenaResult{
+			after:  sides.memberSkill(id, prev, sideSkill[side], changes[side].After),
+			staked: changes[side].Staked,
+			earned: changes[side].Earned,
+		}
+	}
+	return results
+}
+
+// settleCustomArenas rewrites the custom arena settlements of one match. It is
+// a step of EventProcessor.processMatchSettlements, so it runs for new matches
+// and during replay alike.
+func settleCustomArenas(ctx context.Context, q *db.Queries, matchID, gameID string, playerScores map[string]float64, state MatchPrevState, matchDate time.Time) error {
+	if err := q.DeleteArenaSettlementsByMatch(ctx, matchID); err != nil {
+		return fmt.Errorf("delete arena settlements for match %s: %w", matchID, err)
+	}
+
+	arenas, err := q.ListArenas(ctx)
+	if err != nil {
+		return fmt.Errorf("list arenas: %w", err)
+	}
+	if len(arenas) == 0 {
+		return nil
+	}
+	scopes, err := loadArenaScopes(ctx, q, arenas)
+	if err != nil {
+		return err
+	}
+
+	m := arenaMatch{
+		gameID:      gameID,
+		date:        matchDate,
+		scores:      state.Scoring.RankingScores(playerScores),
+		teams:       state.Teams,
+		cooperative: state.Coop != nil,
+		tournaments: make(map[string]bool),
+		guests:      state.Guests,
+	}
+	if slices.ContainsFunc(arenas, func(a db.Arena) bool { return a.TournamentID != nil }) {
+		rows, err := q.ListTournamentsByMatchIDs(ctx, []string{matchID})
+		if err != nil {
+			return fmt.Errorf("list tournaments of match %s: %w", matchID, err)
+		}
+		for _, r := range rows {
+			m.tournaments[r.TournamentID] = true
+		}
+	}
+
+	return settleArenaMatch(ctx, q, scopes, matchID, m, state.Settings)
+}
+
+// rebuildArena drops an arena's settlements and settles every match again in
+// chronological order, with the settings in force on each match date.
+func rebuildArena(ctx context.Context, q *db.Queries, arena db.Arena) error {
+	if err := q.DeleteArenaSettlementsByArena(ctx, arena.ID); err != nil {
+		return fmt.Errorf("delete settlements of arena %s: %w", arena.ID, err)
+	}
+	scopes, err := loadArenaScopes(ctx, q, []db.Arena{arena})
+	if err != nil {
+		return err
+	}
+
+	from := pgtype.Timestamptz{Valid: true}
+	if arena.StartsAt.Valid {
+		from = arena.StartsAt
+	}
+	matches, err := q.GetMatchesFromDate(ctx, from)
+	if err != nil {
+		return fmt.Errorf("get matches from date %v: %w", from.Time, err)
+	}
+
+	tournaments := make(map[string]map[string]bool)
+	if arena.TournamentID != nil && len(matches) > 0 {
+		matchIDs := make([]string, len(matches))
+		for i, match := range matches {
+			matchIDs[i] = match.ID
+		}
+		rows, err := q.ListTournamentsByMatchIDs(ctx, matchIDs)
+		if err != nil {
+			return fmt.Errorf("list match tournaments: %w", err)
+		}
+		for _, r := range rows {
+			if tournaments[r.MatchID] == nil {
+				tournaments[r.MatchID] = make(map[string]bool)
+			}
+			tournaments[r.MatchID][r.TournamentID] = true
+		}
+	}
+
+	guestIDs, err := q.ListGuestPlayerIDs(ctx)
+	if err != nil {
+		return fmt.Errorf("list guests: %w", err)
+	}
+	guests := guestSet(guestIDs)
+
+	scorings := make(map[string]GameScoring)
+	for _, match := range matches {
+		if arena.EndsAt.Valid && !match.Date.Time.Before(arena.EndsAt.Time) {
+			break
+		}
+		if len(arena.GameIds) > 0 && !slices.Contains(arena.GameIds, match.GameID) {
+			continue
+		}
+
+		scoring, ok := scorings[match.GameID]
+		if !ok {
+			game, err := q.GetGameByID(ctx, match.GameID)
+			if err != nil {
+				return fmt.Errorf("get game %s: %w", match.GameID, err)
+			}
+			scoring = GameScoringOf(game)
+			scorings[match.GameID] = scoring
+		}
+		matchScores, err := q.GetMatchScoresForMatch(ctx, match.ID)
+		if err != nil {
+			return fmt.Errorf("get scores for match %s: %w", match.ID, err)
+		}
+		playerScores := make(map[string]float64, len(matchScores))
+		for _, ms := range matchScores {
+			playerScores[ms.PlayerID] = ms.Score
+		}
+		settingsRow, err := q.GetEloSettingsForDate(ctx, match.Date)
+		if err != nil {
+			return fmt.Errorf("get elo settings: %w", err)
+		}
+
+		m := arenaMatch{
+			gameID:      match.GameID,
+			date:        match.Date.Time,
+			scores:      scoring.RankingScores(playerScores),
+			teams:       teamsOf(matchScores),
+			cooperative: match.CooperativeResult.Valid,
+			tournaments: tournaments[match.ID],
+			guests:      guests,
+		}
+		if err := settleArenaMatch(ctx, q, scopes, match.ID, m, EloSettingsFromDB(settingsRow)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rebuildClubArenas rebuilds the arenas filtered by the club. They read its
+// current members, so a change of members has to reach every settlement of
+// the arena, not only those after the next replay point.
+func rebuildClubArenas(ctx context.Context, q *db.Queries, clubID string) error {
+	arenas, err := q.ListClubArenas(ctx, &clubID)
+	if err != nil {
+		return fmt.Errorf("list arenas of club %s: %w", clubID, err)
+	}
+	for _, arena := range arenas {
+		if err := rebuildArena(ctx, q, arena); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadArenaScopes parses the arenas' ladders and resolves the club membership
+// of every arena with a club filter.
+func loadArenaScopes(ctx context.Context, q *db.Queries, arenas []db.Arena) ([]arenaScope, error) {
+	scopes := make([]arenaScope, len(arenas))
+	for i, a := range arenas {
+		scopes[i].Arena = a
+		ladder, err := ParseLeagueLadder(a.LeagueLadder)
+		if err != nil {
+			return nil, fmt.Errorf("arena %s: %w", a.ID, err)
+		}
+		scopes[i].ladder = ladder
+		if a.ClubID == nil {
+			continue
+		}
+		members, err := q.ListClubMemberIDs(ctx, *a.ClubID)
+		if err != nil {
+			return nil, fmt.Errorf("list members of club %s: %w", *a.ClubID, err)
+		}
+		scopes[i].clubMembers = make(map[string]bool, len(members))
+		for _, pid := range members {
+			scopes[i].clubMembers[pid] = true
+		}
+	}
+	return scopes, nil
+}
+
+// settleArenaMatch inserts the settlements of one match into every arena it
+// qualifies for, together with the players' ladder progress.
+func settleArenaMatch(ctx context.Context, q *db.Queries, scopes []arenaScope, matchID string, m arenaMatch, settings EloSettings) error {
+	algo := newRatingAlgorithm(settings.GlobalAlgorithm, settings)
+	date := pgtype.Timestamptz{Time: m.date, Valid: true}
+
+	for _, a := range scopes {
+		scores, ok := a.subMatch(m)
+		if !ok {
+			continue
+		}
+
+		ratings := make(map[string]float64, len(scores))
+		deviations := make(map[string]float64, len(scores))
+		volatilities := make(map[string]float64, len(scores))
+		prev := make(map[string]arenaPrevState, len(scores))
+		for playerID := range scores {
+			st, err := loadArenaPrevState(ctx, q, a, playerID, matchID, m.date)
+			if err != nil {
+				return err
+			}
+			prev[playerID] = st
+			if !st.played {
+				continue
+			}
+			ratings[playerID] = st.elo
+			deviations[playerID] = st.deviation
+			volatilities[playerID] = st.volatility
+		}
+
+		for playerID, r := range rateArenaMatch(algo, ratings, deviations, volatilities, scores, m.teams) {
+			if m.guests[playerID] {
+				continue
+			}
+			st := prev[playerID]
+			opponents := make([]string, 0, len(scores)-1)
+			for id := range scores {
+				if id != playerID {
+					opponents = append(opponents, id)
+				}
+			}
+			after := st.progressAfter(r.after.Rating, opponents)
+
+			var league pgtype.Text
+			if a.ladder != nil {
+				league = pgtype.Text{String: st.leagueAfter(a.ladder, algo.Starting().Rating, after, m.date), Valid: true}
+			}
+			if err := q.InsertArenaSettlement(ctx, db.InsertArenaSettlementParams{
+				ID:              newSettlementID(),
+				ArenaID:         a.ID,
+				PlayerID:        playerID,
+				MatchID:         matchID,
+				Date:            date,
+				EloAfter:        r.after.Rating,
+				EloStaked:       r.staked,
+				EloEarned:       r.earned,
+				DeviationAfter:  uncertaintyColumn(r.after.Deviation),
+				VolatilityAfter: uncertaintyColumn(r.after.Volatility),
+				League:          league,
+				Cubes:           after.Cubes,
+				Meeples:         after.Meeples,
+			}); err != nil {
+				return fmt.Errorf("insert arena %s settlement for player %s: %w", a.ID, playerID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// arenaPrevState is a player's arena state before a match.
+type arenaPrevState struct {
+	played     bool // false: first arena match
+	lastMatch  time.Time
+	elo        float64
+	deviation  float64
+	volatility float64
+	league     string
+	cubes      float64
+	// opponentMeeples: uncapped meeples given by each opponent so far.
+	opponentMeeples map[string]float64
+	// recent: arena matches within each ladder window before the match.
+	recent map[int]int
+}
+
+func loadArenaPrevState(ctx context.Context, q *db.Queries, a arenaScope, playerID, matchID string, matchDate time.Time) (arenaPrevState, error) {
+	date := pgtype.Timestamptz{Time: matchDate, Valid: true}
+	st := arenaPrevState{recent: make(map[int]int)}
+	if a.ladder != nil {
+		for _, days := range a.ladder.Windows() {
+			st.recent[days] = 0
+		}
+	}
+
+	row, err := q.GetPlayerArenaStateBeforeMatch(ctx, db.GetPlayerArenaStateBeforeMatchParams{
+		ArenaID:  a.ID,
+		PlayerID: playerID,
+		Date:     date,
+		MatchID:  matchID,
+	})
+	if db.IsNoRows(err) {
+		return st, nil
+	}
+	if err != nil {
+		return st, fmt.Errorf("get arena %s state for player %s: %w", a.ID, playerID, err)
+	}
+	st.played = true
+	st.lastMatch = row.Date.Time
+	st.elo = row.EloAfter
+	st.deviation = row.DeviationAfter.Float64
+	st.volatility = row.VolatilityAfter.Float64
+	st.league = row.League.String
+	st.cubes = row.Cubes
+
+	meeples, err := q.GetArenaOpponentMeeplesBeforeMatch(ctx, db.GetArenaOpponentMeeplesBeforeMatchParams{
+		ArenaID:  a.ID,
+		PlayerID: playerID,
+		Date:     date,
+		MatchID:  matchID,
+	})
+	if err != nil {
+		return st, fmt.Errorf("get arena %s meeples for player %s: %w", a.ID, playerID, err)
+	}
+	st.opponentMeeples = make(map[string]float64, len(meeples))
+	for _, r := range meeples {
+		st.opponentMeeples[r.OpponentID] = r.Meeples
+	}
+
+	if a.ladder != nil {
+		for _, days := range a.ladder.Windows() {
+			count, err := q.CountPlayerArenaMatchesBeforeMatch(ctx, db.CountPlayerArenaMatchesBeforeMatchParams{
+				ArenaID:  a.ID,
+				PlayerID: playerID,
+				Since:    pgtype.Timestamptz{Time: matchDate.AddDate(0, 0, -days), Valid: true},
+				Date:     date,
+				MatchID:  matchID,
+			})
+			if err != nil {
+				return st, fmt.Errorf("count arena %s matches for player %s: %w", a.ID, playerID, err)
+			}
+			st.recent[days] = int(count)
+		}
+	}
+	return st, nil
+}
+
+// progressBefore is the ladder progress the player brings into the match;
+// starting is the arena's starting rating for a first match.
+func (st arenaPrevState) progressBefore(starting float64) LeagueProgress {
+	elo := starting
+	if st.played {
+		elo = st.elo
+	}
+	return LeagueProgress{
+		Elo:           elo,
+		Rating:        elo,
+		Cubes:         st.cubes,
+		Meeples:       addMeeples(st.opponentMeeples, nil),
+		RecentMatches: st.recent,
+	}
+}
+
+// progressAfter is the ladder progress once the match is settled.
+func (st arenaPrevState) progressAfter(elo float64, opponents []string) LeagueProgress {
+	recent := make(map[int]int, len(st.recent))
+	for days, n := range st.recent {
+		recent[days] = n + 1
+	}
+	return LeagueProgress{
+		Elo:           elo,
+		Rating:        elo,
+		Cubes:         st.cubes + 1,
+		Meeples:       addMeeples(st.opponentMeeples, opponents),
+		RecentMatches: recent,
+	}
+}
+
+// leagueAfter is the player's league once the match is settled: the league
+// held before it (inactivity and "keep" applied) climbs with the new progress.
+func (st arenaPrevState) leagueAfter(ladder *LeagueLadder, starting float64, after LeagueProgress, matchDate time.Time) string {
+	before := st.progressBefore(starting)
+	var league string
+	if st.played {
+		league = ladder.Effective(st.league, before, matchDate.Sub(st.lastMatch))
+	} else {
+		league = ladder.Initial(before)
+	}
+	return ladder.After(league, after)
+}
+
+// LeagueRequirement is one rule of the next league with the player's value.
+type LeagueRequirement struct {
+	LeagueRuleConfig
+	Current float64
+	Met     bool
+}
+
+// PlayerArenaStanding is a player's progress in one custom arena.
+type PlayerArenaStanding struct {
+	ArenaID       string
+	ArenaName     string
+	Elo           float64
+	Cubes         float64
+	Meeples       float64
+	MatchesPlayed int
+	LastMatchDate time.Time
+	// League is held now, with inactivity and "keep" applied; empty when the
+	// arena has no ladder.
+	League string
+	// NextLeague is empty at the top of the ladder.
+	NextLeague   string
+	Requirements []LeagueRequirement
+}
+
+func (s *ArenaService) GetPlayerStandings(ctx context.Context, playerID string, now time.Time) ([]PlayerArenaStanding, error) {
+	rows, err := s.Queries.GetPlayerArenaStandings(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	standings := make([]PlayerArenaStanding, 0, len(rows))
+	for _, r := range rows {
+		st := PlayerArenaStanding{
+			ArenaID:       r.ArenaID,
+			ArenaName:     r.ArenaName,
+			Elo:           r.EloAfter,
+			Cubes:         r.Cubes,
+			Meeples:       r.Meeples,
+			MatchesPlayed: int(r.MatchesPlayed),
+			LastMatchDate: r.LastMatchDate.Time,
+		}
+		ladder, err := ParseLeagueLadder(r.LeagueLadder)
+		if err != nil {
+			return nil, fmt.Errorf("arena %s: %w", r.ArenaID, err)
+		}
+		if ladder != nil {
+			p, err := s.currentProgress(ctx, ladder, r.ArenaID, playerID, r.EloAfter, r.Cubes, r.Meeples, now)
+			if err != nil {
+				return nil, err
+			}
+			st.League = ladder.Effective(r.League.String, p, now.Sub(st.LastMatchDate))
+			if next, ok := ladder.Next(st.League); ok {
+				st.NextLeague = next.Name
+				for _, rule := range next.Requires {
+					st.Requirements = append(st.Requirements, LeagueRequirement{
+						LeagueRuleConfig: rule,
+						Current:          rule.Current(p),
+						Met:              rule.Met(p),
+					})
+				}
+			}
+		}
+		standings = append(standings, st)
+	}
+	return standings, nil
+}
+
+// currentProgress is a player's ladder progress as of now.
+func (s *ArenaService) currentProgress(ctx context.Context, ladder *LeagueLadder, arenaID, playerID string, elo, cubes, meeples float64, now time.Time) (LeagueProgress, error) {
+	p := LeagueProgress{Elo: elo, Rating: elo, Cubes: cubes, Meeples: meeples, RecentMatches: make(map[int]int)}
+	for _, days := range ladder.Windows() {
+		count, err := s.Queries.CountPlayerArenaMatchesSince(ctx, db.CountPlayerArenaMatchesSinceParams{
+			ArenaID:  arenaID,
+			PlayerID: playerID,
+			Since:    pgtype.Timestamptz{Time: now.AddDate(0, 0, -days), Valid: true},
+		})
+		if err != nil {
+			return p, fmt.Errorf("count arena %s matches for player %s: %w", arenaID, playerID, err)
+		}
+		p.RecentMatches[days] = int(count)
+	}
+	return p, nil
+}
+
+// leagueLadderDocument validates a ladder for storage; nil stores NULL.
+func leagueLadderDocument(cfg *LeagueLadderConfig) (json.RawMessage, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	if _, err := NewLeagueLadder(*cfg); err != nil {
+		return nil, err
+	}
+	return json.Marshal(cfg)
+}
+
+// nonNilIDs keeps an omitted id list from being stored as NULL.
+func nonNilIDs(ids []string) []string {
+	if ids == nil {
+		return []string{}
+	}
+	return ids
+}
+
+func optionalTimestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
@@ -0,0 +1,107 @@
+package elo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+func TestArenaSubMatch(t *testing.T) {
+	day := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	club, tournament := "club", "cup"
+	match := arenaMatch{
+		gameID:      "chess",
+		date:        day,
+		scores:      map[string]float64{"a": 3, "b": 2, "c": 1},
+		tournaments: map[string]bool{"cup": true},
+	}
+
+	cases := []struct {
+		name  string
+		arena arenaScope
+		match func(m arenaMatch) arenaMatch
+		want  []string // rated players; nil: the match is not part of the arena
+	}{
+		{"no filters", arenaScope{}, nil, []string{"a", "b", "c"}},
+		{"game in set", arenaScope{Arena: db.Arena{GameIds: []string{"go", "chess"}}}, nil, []string{"a", "b", "c"}},
+		{"game not in set", arenaScope{Arena: db.Arena{GameIds: []string{"go"}}}, nil, nil},
+		{"starts at match date", arenaScope{Arena: db.Arena{StartsAt: pgtype.Timestamptz{Time: day, Valid: true}}}, nil, []string{"a", "b", "c"}},
+		{"ends at match date", arenaScope{Arena: db.Arena{EndsAt: pgtype.Timestamptz{Time: day, Valid: true}}}, nil, nil},
+		{"tournament linked", arenaScope{Arena: db.Arena{TournamentID: &tournament}}, nil, []string{"a", "b", "c"}},
+		{"tournament not linked", arenaScope{Arena: db.Arena{TournamentID: &tournament}},
+			func(m arenaMatch) arenaMatch { m.tournaments = nil; return m }, nil},
+		{"player set", arenaScope{Arena: db.Arena{PlayerIds: []string{"a", "c", "z"}}}, nil, []string{"a", "c"}},
+		{"club members", arenaScope{Arena: db.Arena{ClubID: &club}, clubMembers: map[string]bool{"b": true, "c": true}},
+			nil, []string{"b", "c"}},
+		{"player set and club intersect", arenaScope{
+			Arena:       db.Arena{PlayerIds: []string{"a", "b"}, ClubID: &club},
+			clubMembers: map[string]bool{"b": true, "c": true},
+		}, nil, nil},
+		{"one team left", arenaScope{Arena: db.Arena{PlayerIds: []string{"a", "b"}}},
+			func(m arenaMatch) arenaMatch {
+				m.scores = map[string]float64{"a": 1, "b": 1, "c": 0}
+				m.teams = map[string]string{"a": "A", "b": "A", "c": "B"}
+				return m
+			}, nil},
+		{"cooperative", arenaScope{}, func(m arenaMatch) arenaMatch { m.cooperative = true; return m }, nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := match
+			if tc.match != nil {
+				m = tc.match(m)
+			}
+			got, ok := tc.arena.subMatch(m)
+			if tc.want == nil {
+				if ok {
+					t.Fatalf("match should not be rated, got %v", got)
+				}
+				return
+			}
+			if !ok || len(got) != len(tc.want) {
+				t.Fatalf("got %v (ok=%v), want players %v", got, ok, tc.want)
+			}
+			for _, id := range tc.want {
+				if got[id] != m.scores[id] {
+					t.Errorf("%s: score %v, want %v", id, got[id], m.scores[id])
+				}
+			}
+		})
+	}
+}
+
+// A sub-match is rated like an independent match among the remaining players.
+func TestRateArenaMatchEqualsIndependentMatch(t *testing.T) {
+	algo := newRatingAlgorithm(AlgorithmElo, testSettings)
+	ratings := map[string]float64{"a": 1100}
+	scores := map[string]float64{"a": 1, "b": 0}
+
+	got := rateArenaMatch(algo, ratings, nil, nil, scores, nil)
+	want := CalculateNewElo(map[string]float64{"a": 1100, "b": testStartingElo}, testStartingElo,
+		scores, testSettings.K, testSettings.D, testSettings.WinReward)
+	for id, r := range got {
+		if !floatsEqual(r.after.Rating, want[id]) {
+			t.Errorf("%s: got %v, want %v", id, r.after.Rating, want[id])
+		}
+	}
+	if !floatsEqual(got["b"].staked+got["b"].earned, want["b"]-testStartingElo) {
+		t.Errorf("new player: staked %v + earned %v != delta %v", got["b"].staked, got["b"].earned, want["b"]-testStartingElo)
+	}
+}
+
+func TestRateArenaMatchTeams(t *testing.T) {
+	algo := newRatingAlgorithm(AlgorithmElo, testSettings)
+	ratings := map[string]float64{"a": 1100, "b": 900, "c": 1000}
+	scores := map[string]float64{"a": 1, "b": 1, "c": 0}
+	teams := map[string]string{"a": "A", "b": "A", "c": "B"}
+
+	got := rateArenaMatch(algo, ratings, nil, nil, scores, teams)
+	if !floatsEqual(got["a"].after.Rating-1100, got["b"].after.Rating-900) {
+		t.Errorf("teammates should move equally: %v vs %v", got["a"].after.Rating-1100, got["b"].after.Rating-900)
+	}
+	if got["a"].after.Rating <= 1100 || got["c"].after.Rating >= 1000 {
+		t.Errorf("winners should gain and the loser lose: %+v", got)
+	}
+}
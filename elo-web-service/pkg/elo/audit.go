@@ -0,0 +1,282 @@
+package elo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+// The audit log records who changed a user event or a piece of configuration
+// and what the entity looked like before and after. Services write entries in
+// the transaction of the change; the acting user travels in the context (see
+// WithActor), so service signatures stay as they are.
+
+// Audited entity types.
+const (
+	AuditEntityMatch      = "match"
+	AuditEntityCorrection = "correction"
+	AuditEntityMarket     = "market"
+	AuditEntitySettings   = "settings"
+	AuditEntityClub       = "club"
+	AuditEntityTournament = "tournament"
+	AuditEntityPlayer     = "player"
+	AuditEntityGame       = "game"
+	AuditEntitySeason     = "season"
+)
+
+// Audited actions.
+const (
+	AuditActionCreate       = "create"
+	AuditActionUpdate       = "update"
+	AuditActionDelete       = "delete"
+	AuditActionLock         = "lock"
+	AuditActionAddMember    = "add_member"
+	AuditActionRemoveMember = "remove_member"
+	AuditActionMerge        = "merge"
+	AuditActionArchive      = "archive"
+	AuditActionRatingParams = "rating_params"
+)
+
+type actorKey struct{}
+
+// WithActor returns a context whose changes are attributed to userID in the
+// audit log.
+func WithActor(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, actorKey{}, userID)
+}
+
+// actorFromContext returns the user set by WithActor, nil when there is none.
+func actorFromContext(ctx context.Context) *string {
+	if id, ok := ctx.Value(actorKey{}).(string); ok && id != "" {
+		return &id
+	}
+	return nil
+}
+
+type auditReasonKey struct{}
+
+// withAuditReason returns a context whose audit entries record why the change
+// was made, e.g. the reason an admin gave for overriding a match date.
+func withAuditReason(ctx context.Context, reason string) context.Context {
+	return context.WithValue(ctx, auditReasonKey{}, reason)
+}
+
+func auditReasonFromContext(ctx context.Context) pgtype.Text {
+	reason, ok := ctx.Value(auditReasonKey{}).(string)
+	return pgtype.Text{String: reason, Valid: ok && reason != ""}
+}
+
+// recordAudit appends an audit entry; before and after are marshalled to JSON
+// and a nil one is stored as NULL.
+func recordAudit(ctx context.Context, q *db.Queries, entityType, entityID, action string, before, after any) error {
+	beforeJSON, err := auditPayload(before)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := auditPayload(after)
+	if err != nil {
+		return err
+	}
+	if err := q.CreateAuditEntry(ctx, db.CreateAuditEntryParams{
+		ID:         newSettlementID(),
+		UserID:     actorFromContext(ctx),
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		Before:     beforeJSON,
+		After:      afterJSON,
+		Reason:     auditReasonFromContext(ctx),
+	}); err != nil {
+		return fmt.Errorf("record %s %s audit entry: %w", entityType, action, err)
+	}
+	return nil
+}
+
+func auditPayload(v any) (json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("marshal audit payload: %w", err)
+	}
+	return b, nil
+}
+
+// auditValue turns a nil snapshot pointer into an untyped nil, which
+// recordAudit stores as NULL rather than JSON null.
+func auditValue[T any](v *T) any {
+	if v == nil {
+		return nil
+	}
+	return v
+}
+
+// settingsAuditID keys a settings entry in the audit log by its effective date.
+func settingsAuditID(effectiveDate time.Time) string {
+	return effectiveDate.UTC().Format(time.RFC3339Nano)
+}
+
+// matchAuditPlayer is one player line of an audited match.
+type matchAuditPlayer struct {
+	PlayerID string  `json:"player_id"`
+	Score    float64 `json:"score"`
+	Team     *string `json:"team,omitempty"`
+}
+
+// matchAuditState is a match as the audit log records it: the match row, its
+// scores and its tournaments.
+type matchAuditState struct {
+	db.Match
+	Players       []matchAuditPlayer `json:"players"`
+	TournamentIDs []string           `json:"tournament_ids"`
+}
+
+// matchAuditSnapshot reads a match for the audit log.
+func matchAuditSnapshot(ctx context.Context, q *db.Queries, matchID string) (*matchAuditState, error) {
+	match, err := q.GetMatch(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("get match %s for audit: %w", matchID, err)
+	}
+	scores, err := q.GetMatchScoresForMatch(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("get scores of match %s for audit: %w", matchID, err)
+	}
+	tournaments, err := q.ListTournamentsByMatchIDs(ctx, []string{matchID})
+	if err != nil {
+		return nil, fmt.Errorf("get tournaments of match %s for audit: %w", matchID, err)
+	}
+
+	state := &matchAuditState{
+		Match:         match,
+		Players:       make([]matchAuditPlayer, 0, len(scores)),
+		TournamentIDs: make([]string, 0, len(tournaments)),
+	}
+	for _, s := range scores {
+		p := matchAuditPlayer{PlayerID: s.PlayerID, Score: s.Score}
+		if s.Team.Valid {
+			p.Team = &s.Team.String
+		}
+		state.Players = append(state.Players, p)
+	}
+	sort.Slice(state.Players, func(i, j int) bool { return state.Players[i].PlayerID < state.Players[j].PlayerID })
+	for _, t := range tournaments {
+		state.TournamentIDs = append(state.TournamentIDs, t.TournamentID)
+	}
+	sort.Strings(state.TournamentIDs)
+	return state, nil
+}
+
+// clubAuditState is a club with its members.
+type clubAuditState struct {
+	db.Club
+	PlayerIDs []string `json:"player_ids"`
+}
+
+func clubAuditSnapshot(ctx context.Context, q *db.Queries, clubID string) (*clubAuditState, error) {
+	rows, err := q.GetClub(ctx, clubID)
+	if err != nil {
+		return nil, fmt.Errorf("get club %s for audit: %w", clubID, err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	state := &clubAuditState{
+		Club: db.Club{
+			ID:            rows[0].ClubID,
+			Name:          rows[0].ClubName,
+			GeologistName: rows[0].ClubGeologistName,
+			Icon:          rows[0].ClubIcon,
+		},
+		PlayerIDs: []string{},
+	}
+	for _, r := range rows {
+		if r.PlayerID != nil {
+			state.PlayerIDs = append(state.PlayerIDs, *r.PlayerID)
+		}
+	}
+	sort.Strings(state.PlayerIDs)
+	return state, nil
+}
+
+// tournamentAuditState is a tournament with its members.
+type tournamentAuditState struct {
+	db.Tournament
+	PlayerIDs []string `json:"player_ids"`
+}
+
+func tournamentAuditSnapshot(ctx context.Context, q *db.Queries, tournamentID string) (*tournamentAuditState, error) {
+	rows, err := q.GetTournament(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("get tournament %s for audit: %w", tournamentID, err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	state := &tournamentAuditState{
+		Tournament: db.Tournament{
+			ID:        rows[0].TournamentID,
+			Name:      rows[0].TournamentName,
+			StartDate: rows[0].StartDate,
+			EndDate:   rows[0].EndDate,
+		},
+		PlayerIDs: []string{},
+	}
+	for _, r := range rows {
+		if r.PlayerID != nil {
+			state.PlayerIDs = append(state.PlayerIDs, *r.PlayerID)
+		}
+	}
+	sort.Strings(state.PlayerIDs)
+	return state, nil
+}
+
+// AuditFilter narrows ListAuditEntries; empty fields match everything.
+type AuditFilter struct {
+	EntityType string
+	EntityID   string
+	UserID     string
+	// CursorID continues after the last entry of the previous page.
+	CursorID string
+	Limit    int32
+}
+
+type IAuditService interface {
+	// ListAuditEntries returns audit entries newest first.
+	ListAuditEntries(ctx context.Context, filter AuditFilter) ([]db.ListAuditEntriesPaginatedRow, error)
+	// CountEntityEntries counts the audit entries of one entity.
+	CountEntityEntries(ctx context.Context, entityType, entityID string) (int64, error)
+}
+
+type AuditService struct {
+	Queries *db.Queries
+}
+
+func NewAuditService(pool *pgxpool.Pool) IAuditService {
+	return &AuditService{Queries: db.New(pool)}
+}
+
+func (s *AuditService) ListAuditEntries(ctx context.Context, filter AuditFilter) ([]db.ListAuditEntriesPaginatedRow, error) {
+	arg := db.ListAuditEntriesPaginatedParams{
+		EntityType: pgtype.Text{String: filter.EntityType, Valid: filter.EntityType != ""},
+		EntityID:   pgtype.Text{String: filter.EntityID, Valid: filter.EntityID != ""},
+		Limit:      filter.Limit,
+	}
+	if filter.UserID != "" {
+		arg.UserID = &filter.UserID
+	}
+	if filter.CursorID != "" {
+		arg.CursorID = &filter.CursorID
+	}
+	return s.Queries.ListAuditEntriesPaginated(ctx, arg)
+}
+
+func (s *AuditService) CountEntityEntries(ctx context.Context, entityType, entityID string) (int64, error) {
+	return s.Queries.CountAuditEntriesForEntity(ctx, db.CountAuditEntriesForEntityParams{EntityType: entityType, EntityID: entityID})
+}
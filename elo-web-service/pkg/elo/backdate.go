@@ -0,0 +1,175 @@
+package elo
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+// Admins may add or move a match to any past date (AddMatchWithOverride,
+// UpdateMatchWithOverride), bypassing the 30-day window for new matches and
+// the three-day limit on date changes. The full replay runs as for any
+// backdated match; the reason goes into the audit entry and the caller gets
+// back what the replay changed.
+
+// HistoryChange lists the players and markets whose current state differs
+// after a history edit.
+type HistoryChange struct {
+	Players []PlayerStandingChange
+	Markets []MarketOutcomeChange
+}
+
+// PlayerStandingChange is a player's leaderboard standing before and after.
+type PlayerStandingChange struct {
+	PlayerID     string
+	Name         string
+	RatingBefore float64
+	RatingAfter  float64
+	LeagueBefore string
+	LeagueAfter  string
+	RankBefore   *int
+	RankAfter    *int
+}
+
+// MarketOutcomeChange is a market whose status, outcome or resolving match
+// changed.
+type MarketOutcomeChange struct {
+	MarketID string
+	Before   MarketState
+	After    MarketState
+}
+
+// MarketState is how a market stands after a replay.
+type MarketState struct {
+	Status            string
+	ResolutionOutcome *string
+	ResolutionMatchID *string
+}
+
+func marketStateOf(m db.ListMarketsRow) MarketState {
+	return MarketState{Status: m.Status, ResolutionOutcome: m.ResolutionOutcome, ResolutionMatchID: m.ResolutionMatchID}
+}
+
+// historySnapshot is the state a history edit is reported against.
+type historySnapshot struct {
+	players []Player
+	markets []db.ListMarketsRow
+}
+
+func takeHistorySnapshot(ctx context.Context, q *db.Queries, now time.Time) (historySnapshot, error) {
+	players, err := playersWithRank(ctx, q, now)
+	if err != nil {
+		return historySnapshot{}, err
+	}
+	markets, err := q.ListMarkets(ctx)
+	if err != nil {
+		return historySnapshot{}, fmt.Errorf("list markets: %w", err)
+	}
+	return historySnapshot{players: players, markets: markets}, nil
+}
+
+// diffHistory reports the players and markets that differ between two
+// snapshots, players in the order of the later leaderboard and markets by id.
+func diffHistory(before, after historySnapshot) HistoryChange {
+	change := HistoryChange{Players: []PlayerStandingChange{}, Markets: []MarketOutcomeChange{}}
+
+	playersBefore := make(map[string]Player, len(before.players))
+	for _, p := range before.players {
+		playersBefore[p.ID] = p
+	}
+	for _, p := range after.players {
+		b, ok := playersBefore[p.ID]
+		if !ok {
+			b = Player{Elo: p.Elo, League: p.League}
+		}
+		if ok && math.Abs(b.Elo-p.Elo) < 1e-9 && b.League == p.League && equalPtr(b.Rank, p.Rank) {
+			continue
+		}
+		change.Players = append(change.Players, PlayerStandingChange{
+			PlayerID:     p.ID,
+			Name:         p.Name,
+			RatingBefore: b.Elo,
+			RatingAfter:  p.Elo,
+			LeagueBefore: b.League,
+			LeagueAfter:  p.League,
+			RankBefore:   b.Rank,
+			RankAfter:    p.Rank,
+		})
+	}
+
+	marketsBefore := make(map[string]db.ListMarketsRow, len(before.markets))
+	for _, m := range before.markets {
+		marketsBefore[m.ID] = m
+	}
+	for _, m := range after.markets {
+		b, ok := marketsBefore[m.ID]
+		if !ok {
+			continue
+		}
+		stateBefore, stateAfter := marketStateOf(b), marketStateOf(m)
+		if stateBefore.Status == stateAfter.Status &&
+			equalPtr(stateBefore.ResolutionOutcome, stateAfter.ResolutionOutcome) &&
+			equalPtr(stateBefore.ResolutionMatchID, stateAfter.ResolutionMatchID) {
+			continue
+		}
+		change.Markets = append(change.Markets, MarketOutcomeChange{MarketID: m.ID, Before: stateBefore, After: stateAfter})
+	}
+	slices.SortFunc(change.Markets, func(a, b MarketOutcomeChange) int { return strings.Compare(a.MarketID, b.MarketID) })
+	return change
+}
+
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// validateDateOverride checks an admin override: a reason is required and
+// the date may be arbitrarily old but not in the future.
+func validateDateOverride(now, date time.Time, reason string) error {
+	if strings.TrimSpace(reason) == "" {
+		return ErrOverrideReasonRequired
+	}
+	if date.After(now.Add(newMatchClockSkewTolerance)) {
+		return fmt.Errorf("%w: now=%v date=%v", ErrMatchDateInFuture, now, date)
+	}
+	return nil
+}
+
+// AddMatchWithOverride adds a match at any past date on an admin's behalf
+// and replays history from it. The reason is recorded with the audit entry.
+func (s *MatchService) AddMatchWithOverride(ctx context.Context, gameID string, playerScores map[string]float64, date time.Time, reason string, opts AddMatchOpts) (db.Match, HistoryChange, error) {
+	if err := validateDateOverride(time.Now(), date, reason); err != nil {
+		return db.Match{}, HistoryChange{}, err
+	}
+	opts.ClientDate = true
+	if opts.ID == "" {
+		opts.ID = newSettlementID()
+	}
+	change := &HistoryChange{}
+	match, err := s.addMatch(withAuditReason(ctx, strings.TrimSpace(reason)), gameID, playerScores, date, opts, change)
+	if err != nil {
+		return db.Match{}, HistoryChange{}, err
+	}
+	return match, *change, nil
+}
+
+// UpdateMatchWithOverride is UpdateMatch without the limit on how far the
+// date may move, for admins. The reason is recorded with the audit entry.
+func (s *MatchService) UpdateMatchWithOverride(ctx context.Context, matchID string, gameID string, playerScores map[string]float64, date time.Time, reason string, opts UpdateMatchOpts) (db.Match, HistoryChange, error) {
+	if err := validateDateOverride(time.Now(), date, reason); err != nil {
+		return db.Match{}, HistoryChange{}, err
+	}
+	change := &HistoryChange{}
+	match, err := s.updateMatch(withAuditReason(ctx, strings.TrimSpace(reason)), matchID, gameID, playerScores, date, opts, change)
+	if err != nil {
+		return db.Match{}, HistoryChange{}, err
+	}
+	return match, *change, nil
+}
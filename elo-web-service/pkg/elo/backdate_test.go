@@ -0,0 +1,90 @@
+package elo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+func TestValidateDateOverride(t *testing.T) {
+	now := time.Date(2026, 6, 12, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name    string
+		date    time.Time
+		reason  string
+		wantErr error
+	}{
+		{"two months ago", now.Add(-60 * 24 * time.Hour), "camp weekend", nil},
+		{"years ago", now.AddDate(-3, 0, 0), "import", nil},
+		{"slightly future within skew", now.Add(5 * time.Minute), "clock", nil},
+		{"future beyond skew", now.Add(time.Hour), "typo", ErrMatchDateInFuture},
+		{"no reason", now.Add(-60 * 24 * time.Hour), "", ErrOverrideReasonRequired},
+		{"blank reason", now.Add(-60 * 24 * time.Hour), "  ", ErrOverrideReasonRequired},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := validateDateOverride(now, c.date, c.reason)
+			if c.wantErr == nil && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+			if c.wantErr != nil && !errors.Is(err, c.wantErr) {
+				t.Errorf("expected %v, got %v", c.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestDiffHistory(t *testing.T) {
+	rank := func(r int) *int { return &r }
+	str := func(s string) *string { return &s }
+
+	before := historySnapshot{
+		players: []Player{
+			{ID: "a", Name: "A", Elo: 1010, League: "amateur", Rank: rank(1)},
+			{ID: "b", Name: "B", Elo: 990, League: "amateur", Rank: rank(2)},
+			{ID: "c", Name: "C", Elo: 1000, League: "newbie", Rank: rank(3)},
+		},
+		markets: []db.ListMarketsRow{
+			{ID: "m2", Status: "betting"},
+			{ID: "m1", Status: "resolved", ResolutionOutcome: str("o1"), ResolutionMatchID: str("x")},
+			{ID: "m3", Status: "cancelled"},
+		},
+	}
+	after := historySnapshot{
+		players: []Player{
+			{ID: "b", Name: "B", Elo: 1015, League: "amateur", Rank: rank(1)},
+			{ID: "a", Name: "A", Elo: 1005, League: "amateur", Rank: rank(2)},
+			{ID: "c", Name: "C", Elo: 1000, League: "newbie", Rank: rank(3)},
+		},
+		markets: []db.ListMarketsRow{
+			{ID: "m2", Status: "resolved", ResolutionOutcome: str("o2"), ResolutionMatchID: str("y")},
+			{ID: "m1", Status: "resolved", ResolutionOutcome: str("o1"), ResolutionMatchID: str("y")},
+			{ID: "m3", Status: "cancelled"},
+		},
+	}
+
+	got := diffHistory(before, after)
+
+	if len(got.Players) != 2 || got.Players[0].PlayerID != "b" || got.Players[1].PlayerID != "a" {
+		t.Fatalf("players = %+v, want b then a", got.Players)
+	}
+	b := got.Players[0]
+	if b.RatingBefore != 990 || b.RatingAfter != 1015 || *b.RankBefore != 2 || *b.RankAfter != 1 {
+		t.Errorf("player b change = %+v", b)
+	}
+
+	if len(got.Markets) != 2 || got.Markets[0].MarketID != "m1" || got.Markets[1].MarketID != "m2" {
+		t.Fatalf("markets = %+v, want m1 then m2", got.Markets)
+	}
+	if m := got.Markets[1]; m.Before.Status != "betting" || m.After.Status != "resolved" || *m.After.ResolutionOutcome != "o2" {
+		t.Errorf("market m2 change = %+v", m)
+	}
+
+	if same := diffHistory(before, before); len(same.Players) != 0 || len(same.Markets) != 0 {
+		t.Errorf("identical snapshots differ: %+v", same)
+	}
+}
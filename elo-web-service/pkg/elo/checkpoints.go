@@ -0,0 +1,518 @@
+package elo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+// Settlement checkpoints snapshot the latest state of every arena as of a
+// date: global and game arena standings, virtual opponents and the markets
+// still open. The state before a date is the nearest checkpoint at or before
+// it with the settlements between the two laid on top, so the replay window
+// of RecalculateFrom does not depend on how long history is.
+//
+// Checkpoints are derived data. RecalculateFrom drops those dated after its
+// start, and VerifySettlementCheckpoints recomputes history from scratch to
+// check the ones it keeps.
+
+const (
+	// settlementCheckpointInterval is the spacing between periodic checkpoints.
+	settlementCheckpointInterval = 7 * 24 * time.Hour
+	// settlementCheckpointLag keeps periodic checkpoints a day behind the clock,
+	// clear of events still being entered for today.
+	settlementCheckpointLag = 24 * time.Hour
+	// settlementCheckpointCheckInterval is how often the periodic job wakes up.
+	settlementCheckpointCheckInterval = time.Hour
+)
+
+// settlementState is the latest state of every arena before a date.
+type settlementState struct {
+	global  map[string]*arenaStanding
+	game    map[playerGame]*arenaStanding
+	virtual map[string]*arenaStanding
+}
+
+// checkpointSnapshot is what a checkpoint stores: the settlement state and
+// the open markets, market id → whether betting had closed.
+type checkpointSnapshot struct {
+	settlementState
+	markets map[string]bool
+}
+
+// CheckpointMismatch is one difference between a stored checkpoint and the
+// state recomputed from scratch. Scope is "global", "game", "virtual" or
+// "market"; Field "presence" means the entry exists on one side only.
+type CheckpointMismatch struct {
+	CheckpointID string
+	Date         time.Time
+	Scope        string
+	PlayerID     string
+	GameID       string
+	MarketID     string
+	Field        string
+	Stored       string
+	Recomputed   string
+}
+
+// CheckpointVerification is the result of VerifySettlementCheckpoints.
+type CheckpointVerification struct {
+	Checkpoints int
+	Mismatches  []CheckpointMismatch
+}
+
+// checkpointTolerance absorbs float noise between the stored snapshot and the
+// recomputed one.
+const checkpointTolerance = 1e-6
+
+// loadSettlementState reads the state of every arena before date from the
+// nearest checkpoint at or before it and the settlements since.
+func loadSettlementState(ctx context.Context, q *db.Queries, date time.Time) (settlementState, error) {
+	state := settlementState{
+		global:  make(map[string]*arenaStanding),
+		game:    make(map[playerGame]*arenaStanding),
+		virtual: make(map[string]*arenaStanding),
+	}
+	from := pgtype.Timestamptz{InfinityModifier: pgtype.NegativeInfinity, Valid: true}
+
+	cp, err := q.GetLatestSettlementCheckpointAtOrBefore(ctx, date)
+	switch {
+	case err == nil:
+		if err := state.loadCheckpoint(ctx, q, cp.ID); err != nil {
+			return settlementState{}, err
+		}
+		from = pgtype.Timestamptz{Time: cp.Date, Valid: true}
+	case !errors.Is(err, pgx.ErrNoRows):
+		return settlementState{}, fmt.Errorf("get settlement checkpoint: %w", err)
+	}
+
+	if err := state.loadBetween(ctx, q, from, pgtype.Timestamptz{Time: date, Valid: true}); err != nil {
+		return settlementState{}, err
+	}
+	return state, nil
+}
+
+// loadCheckpoint reads the stored standings of a checkpoint.
+func (s settlementState) loadCheckpoint(ctx context.Context, q *db.Queries, checkpointID string) error {
+	globalRows, err := q.ListCheckpointGlobalStates(ctx, checkpointID)
+	if err != nil {
+		return fmt.Errorf("list checkpoint global states: %w", err)
+	}
+	for _, r := range globalRows {
+		s.global[r.PlayerID] = &arenaStanding{
+			elo: r.EloAfter, rating: r.RatingAfter, league: r.League, settled: true,
+			deviation: r.DeviationAfter.Float64, volatility: r.VolatilityAfter.Float64,
+		}
+	}
+
+	gameRows, err := q.ListCheckpointGameStates(ctx, checkpointID)
+	if err != nil {
+		return fmt.Errorf("list checkpoint game states: %w", err)
+	}
+	for _, r := range gameRows {
+		s.game[playerGame{r.PlayerID, r.GameID}] = &arenaStanding{
+			elo: r.EloAfter, rating: r.RatingAfter, league: r.League, settled: true,
+			deviation: r.DeviationAfter.Float64, volatility: r.VolatilityAfter.Float64,
+		}
+	}
+
+	virtualRows, err := q.ListCheckpointVirtualOpponentStates(ctx, checkpointID)
+	if err != nil {
+		return fmt.Errorf("list checkpoint virtual opponent states: %w", err)
+	}
+	for _, r := range virtualRows {
+		s.virtual[r.GameID] = &arenaStanding{
+			elo: r.EloAfter, settled: true,
+			deviation: r.DeviationAfter.Float64, volatility: r.VolatilityAfter.Float64,
+		}
+	}
+	return nil
+}
+
+// loadBetween lays the latest settlements in [from, to) over the state. A
+// later settlement replaces elo, rating and league; uncertainty is replaced
+// only by a settlement that tracked it.
+func (s settlementState) loadBetween(ctx context.Context, q *db.Queries, from, to pgtype.Timestamptz) error {
+	globalRows, err := q.ListLatestGlobalArenaStatesBetween(ctx, db.ListLatestGlobalArenaStatesBetweenParams{FromDate: from, ToDate: to})
+	if err != nil {
+		return fmt.Errorf("list global arena states: %w", err)
+	}
+	for _, r := range globalRows {
+		st := s.global[r.PlayerID]
+		if st == nil {
+			st = &arenaStanding{}
+			s.global[r.PlayerID] = st
+		}
+		st.elo, st.rating, st.league, st.settled = r.Elo, r.Rating, r.League, true
+	}
+	globalUncertainty, err := q.ListLatestGlobalUncertaintiesBetween(ctx, db.ListLatestGlobalUncertaintiesBetweenParams{FromDate: from, ToDate: to})
+	if err != nil {
+		return fmt.Errorf("list global uncertainties: %w", err)
+	}
+	for _, r := range globalUncertainty {
+		if st, ok := s.global[r.PlayerID]; ok {
+			st.deviation, st.volatility = r.Deviation, r.Volatility.Float64
+		}
+	}
+
+	gameRows, err := q.ListLatestGameArenaStatesBetween(ctx, db.ListLatestGameArenaStatesBetweenParams{FromDate: from, ToDate: to})
+	if err != nil {
+		return fmt.Errorf("list game arena states: %w", err)
+	}
+	for _, r := range gameRows {
+		key := playerGame{r.PlayerID, r.GameID}
+		st := s.game[key]
+		if st == nil {
+			st = &arenaStanding{}
+			s.game[key] = st
+		}
+		st.elo, st.rating, st.league, st.settled = r.Elo, r.Rating, r.League, true
+	}
+	gameUncertainty, err := q.ListLatestGameUncertaintiesBetween(ctx, db.ListLatestGameUncertaintiesBetweenParams{FromDate: from, ToDate: to})
+	if err != nil {
+		return fmt.Errorf("list game uncertainties: %w", err)
+	}
+	for _, r := range gameUncertainty {
+		if st, ok := s.game[playerGame{r.PlayerID, r.GameID}]; ok {
+			st.deviation, st.volatility = r.Deviation, r.Volatility.Float64
+		}
+	}
+
+	virtualRows, err := q.ListLatestVirtualOpponentStatesBetween(ctx, db.ListLatestVirtualOpponentStatesBetweenParams{FromDate: from, ToDate: to})
+	if err != nil {
+		return fmt.Errorf("list virtual opponent states: %w", err)
+	}
+	for _, r := range virtualRows {
+		s.virtual[r.GameID] = &arenaStanding{
+			elo:        r.EloAfter,
+			settled:    true,
+			deviation:  r.DeviationAfter.Float64,
+			volatility: r.VolatilityAfter.Float64,
+		}
+	}
+	return nil
+}
+
+// computeCheckpoint builds the snapshot a checkpoint at date stores.
+func computeCheckpoint(ctx context.Context, q *db.Queries, date time.Time) (checkpointSnapshot, error) {
+	state, err := loadSettlementState(ctx, q, date)
+	if err != nil {
+		return checkpointSnapshot{}, err
+	}
+	rows, err := q.ListMarketsOpenAt(ctx, pgtype.Timestamptz{Time: date, Valid: true})
+	if err != nil {
+		return checkpointSnapshot{}, fmt.Errorf("list markets open at %v: %w", date, err)
+	}
+	snap := checkpointSnapshot{settlementState: state, markets: make(map[string]bool, len(rows))}
+	for _, r := range rows {
+		snap.markets[r.MarketID] = r.BettingClosed
+	}
+	return snap, nil
+}
+
+// readCheckpoint reads a stored checkpoint back.
+func readCheckpoint(ctx context.Context, q *db.Queries, checkpointID string) (checkpointSnapshot, error) {
+	snap := checkpointSnapshot{
+		settlementState: settlementState{
+			global:  make(map[string]*arenaStanding),
+			game:    make(map[playerGame]*arenaStanding),
+			virtual: make(map[string]*arenaStanding),
+		},
+		markets: make(map[string]bool),
+	}
+	if err := snap.loadCheckpoint(ctx, q, checkpointID); err != nil {
+		return checkpointSnapshot{}, err
+	}
+	rows, err := q.ListCheckpointMarkets(ctx, checkpointID)
+	if err != nil {
+		return checkpointSnapshot{}, fmt.Errorf("list checkpoint markets: %w", err)
+	}
+	for _, r := range rows {
+		snap.markets[r.MarketID] = r.BettingClosed
+	}
+	return snap, nil
+}
+
+// createSettlementCheckpoint stores a checkpoint of the state before date.
+func createSettlementCheckpoint(ctx context.Context, q *db.Queries, date time.Time) (db.SettlementCheckpoint, error) {
+	snap, err := computeCheckpoint(ctx, q, date)
+	if err != nil {
+		return db.SettlementCheckpoint{}, err
+	}
+
+	id := newSettlementID()
+	if err := q.CreateSettlementCheckpoint(ctx, db.CreateSettlementCheckpointParams{ID: id, Date: date}); err != nil {
+		return db.SettlementCheckpoint{}, fmt.Errorf("create settlement checkpoint: %w", err)
+	}
+
+	globalRows := make([]db.CopyCheckpointGlobalStatesParams, 0, len(snap.global))
+	for playerID, st := range snap.global {
+		deviation, volatility := storedUncertainty(st)
+		globalRows = append(globalRows, db.CopyCheckpointGlobalStatesParams{
+			CheckpointID: id, PlayerID: playerID,
+			EloAfter: st.elo, RatingAfter: st.rating, League: st.league,
+			DeviationAfter: deviation, VolatilityAfter: volatility,
+		})
+	}
+	if _, err := q.CopyCheckpointGlobalStates(ctx, globalRows); err != nil {
+		return db.SettlementCheckpoint{}, fmt.Errorf("copy checkpoint global states: %w", err)
+	}
+
+	gameRows := make([]db.CopyCheckpointGameStatesParams, 0, len(snap.game))
+	for key, st := range snap.game {
+		deviation, volatility := storedUncertainty(st)
+		gameRows = append(gameRows, db.CopyCheckpointGameStatesParams{
+			CheckpointID: id, PlayerID: key.playerID, GameID: key.gameID,
+			EloAfter: st.elo, RatingAfter: st.rating, League: st.league,
+			DeviationAfter: deviation, VolatilityAfter: volatility,
+		})
+	}
+	if _, err := q.CopyCheckpointGameStates(ctx, gameRows); err != nil {
+		return db.SettlementCheckpoint{}, fmt.Errorf("copy checkpoint game states: %w", err)
+	}
+
+	virtualRows := make([]db.CopyCheckpointVirtualOpponentStatesParams, 0, len(snap.virtual))
+	for gameID, st := range snap.virtual {
+		deviation, volatility := storedUncertainty(st)
+		virtualRows = append(virtualRows, db.CopyCheckpointVirtualOpponentStatesParams{
+			CheckpointID: id, GameID: gameID, EloAfter: st.elo,
+			DeviationAfter: deviation, VolatilityAfter: volatility,
+		})
+	}
+	if _, err := q.CopyCheckpointVirtualOpponentStates(ctx, virtualRows); err != nil {
+		return db.SettlementCheckpoint{}, fmt.Errorf("copy checkpoint virtual opponent states: %w", err)
+	}
+
+	marketRows := make([]db.CopyCheckpointMarketsParams, 0, len(snap.markets))
+	for marketID, bettingClosed := range snap.markets {
+		marketRows = append(marketRows, db.CopyCheckpointMarketsParams{
+			CheckpointID: id, MarketID: marketID, BettingClosed: bettingClosed,
+		})
+	}
+	if _, err := q.CopyCheckpointMarkets(ctx, marketRows); err != nil {
+		return db.SettlementCheckpoint{}, fmt.Errorf("copy checkpoint markets: %w", err)
+	}
+
+	return db.SettlementCheckpoint{ID: id, Date: date}, nil
+}
+
+// storedUncertainty maps the in-memory "0 = never tracked" back to NULL.
+func storedUncertainty(st *arenaStanding) (pgtype.Float8, pgtype.Float8) {
+	if st.deviation == 0 {
+		return pgtype.Float8{}, pgtype.Float8{}
+	}
+	return pgtype.Float8{Float64: st.deviation, Valid: true}, pgtype.Float8{Float64: st.volatility, Valid: true}
+}
+
+// createSettlementCheckpointIfDue takes a checkpoint settlementCheckpointLag
+// behind now once the latest one is settlementCheckpointInterval old.
+func createSettlementCheckpointIfDue(ctx context.Context, q *db.Queries, now time.Time) error {
+	date := now.Add(-settlementCheckpointLag)
+	latest, err := q.GetLatestSettlementCheckpoint(ctx)
+	switch {
+	case err == nil:
+		if date.Sub(latest.Date) < settlementCheckpointInterval {
+			return nil
+		}
+	case !errors.Is(err, pgx.ErrNoRows):
+		return fmt.Errorf("get latest settlement checkpoint: %w", err)
+	}
+	_, err = createSettlementCheckpoint(ctx, q, date)
+	return err
+}
+
+// catchUpTimedSettlements writes the decay drops, season resets and market
+// expiries due before now, in the checkpoint's transaction. Their jobs run on
+// timers and after downtime write rows dated well in the past; a checkpoint
+// is only taken behind this watermark, so none of them can land before it
+// afterwards.
+func (s *MatchService) catchUpTimedSettlements(ctx context.Context, q *db.Queries, now time.Time) error {
+	if err := applyTimedSettlements(ctx, q, now); err != nil {
+		return err
+	}
+	return s.MarketService.ExpireMarketsAtDate(ctx, q, now)
+}
+
+// ScheduleSettlementCheckpoints takes a checkpoint when one is due and then
+// reschedules itself.
+func (s *MatchService) ScheduleSettlementCheckpoints(ctx context.Context) {
+	err := runInTx(ctx, s.Pool, func(q *db.Queries) error {
+		now := time.Now()
+		if err := s.catchUpTimedSettlements(ctx, q, now); err != nil {
+			return err
+		}
+		return createSettlementCheckpointIfDue(ctx, q, now)
+	})
+	if err != nil {
+		log.Printf("ScheduleSettlementCheckpoints error: %v", err)
+	}
+	time.AfterFunc(settlementCheckpointCheckInterval, func() { s.ScheduleSettlementCheckpoints(context.Background()) })
+}
+
+// CreateSettlementCheckpoint stores a checkpoint of the state before date,
+// which must not be in the future.
+func (s *MatchService) CreateSettlementCheckpoint(ctx context.Context, date time.Time) (db.SettlementCheckpoint, error) {
+	var cp db.SettlementCheckpoint
+	err := runInTx(ctx, s.Pool, func(q *db.Queries) error {
+		if err := s.catchUpTimedSettlements(ctx, q, time.Now()); err != nil {
+			return err
+		}
+		var err error
+		cp, err = createSettlementCheckpoint(ctx, q, date)
+		return err
+	})
+	return cp, err
+}
+
+// VerifySettlementCheckpoints recalculates all of history in a rolled-back
+// transaction, without any checkpoint to start from, and diffs the state at
+// every stored checkpoint date against the stored snapshot.
+func (s *MatchService) VerifySettlementCheckpoints(ctx context.Context) (CheckpointVerification, error) {
+	tx, err := s.Pool.Begin(ctx)
+	if err != nil {
+		return CheckpointVerification{}, fmt.Errorf("unable to begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+	q := s.Queries.WithTx(tx)
+
+	checkpoints, err := q.ListSettlementCheckpoints(ctx)
+	if err != nil {
+		return CheckpointVerification{}, fmt.Errorf("list settlement checkpoints: %w", err)
+	}
+	stored := make([]checkpointSnapshot, len(checkpoints))
+	for i, cp := range checkpoints {
+		if stored[i], err = readCheckpoint(ctx, q, cp.ID); err != nil {
+			return CheckpointVerification{}, err
+		}
+	}
+
+	// Starting before every checkpoint drops them all, so the replay and the
+	// states below are computed from settlements alone.
+	if err := s.EventProcessor.RecalculateFrom(ctx, q, time.Time{}, nil); err != nil {
+		return CheckpointVerification{}, fmt.Errorf("recalculate from scratch: %w", err)
+	}
+
+	result := CheckpointVerification{Checkpoints: len(checkpoints), Mismatches: []CheckpointMismatch{}}
+	for i, cp := range checkpoints {
+		recomputed, err := computeCheckpoint(ctx, q, cp.Date)
+		if err != nil {
+			return CheckpointVerification{}, err
+		}
+		result.Mismatches = append(result.Mismatches, diffCheckpoint(cp, stored[i], recomputed)...)
+	}
+	return result, nil
+}
+
+// diffCheckpoint lists every difference between a stored and a recomputed
+// snapshot, in a stable order.
+func diffCheckpoint(cp db.SettlementCheckpoint, stored, recomputed checkpointSnapshot) []CheckpointMismatch {
+	var out []CheckpointMismatch
+	add := func(m CheckpointMismatch) {
+		m.CheckpointID, m.Date = cp.ID, cp.Date
+		out = append(out, m)
+	}
+
+	for _, playerID := range unionKeys(stored.global, recomputed.global, lessString) {
+		for _, d := range diffStanding(stored.global[playerID], recomputed.global[playerID], true) {
+			d.Scope, d.PlayerID = "global", playerID
+			add(d)
+		}
+	}
+
+	gameKeys := unionKeys(stored.game, recomputed.game, func(a, b playerGame) bool {
+		if a.playerID != b.playerID {
+			return a.playerID < b.playerID
+		}
+		return a.gameID < b.gameID
+	})
+	for _, key := range gameKeys {
+		for _, d := range diffStanding(stored.game[key], recomputed.game[key], true) {
+			d.Scope, d.PlayerID, d.GameID = "game", key.playerID, key.gameID
+			add(d)
+		}
+	}
+
+	for _, gameID := range unionKeys(stored.virtual, recomputed.virtual, lessString) {
+		for _, d := range diffStanding(stored.virtual[gameID], recomputed.virtual[gameID], false) {
+			d.Scope, d.GameID = "virtual", gameID
+			add(d)
+		}
+	}
+
+	for _, marketID := range unionKeys(stored.markets, recomputed.markets, lessString) {
+		s, inStored := stored.markets[marketID]
+		r, inRecomputed := recomputed.markets[marketID]
+		switch {
+		case inStored != inRecomputed:
+			add(CheckpointMismatch{Scope: "market", MarketID: marketID, Field: "presence",
+				Stored: presence(inStored), Recomputed: presence(inRecomputed)})
+		case s != r:
+			add(CheckpointMismatch{Scope: "market", MarketID: marketID, Field: "betting_closed",
+				Stored: strconv.FormatBool(s), Recomputed: strconv.FormatBool(r)})
+		}
+	}
+	return out
+}
+
+// diffStanding compares two standings of the same entry; either may be nil.
+// Virtual opponents have no rating or league.
+func diffStanding(stored, recomputed *arenaStanding, rated bool) []CheckpointMismatch {
+	if stored == nil || recomputed == nil {
+		return []CheckpointMismatch{{Field: "presence", Stored: presence(stored != nil), Recomputed: presence(recomputed != nil)}}
+	}
+	var out []CheckpointMismatch
+	float := func(field string, s, r float64) {
+		if math.Abs(s-r) > checkpointTolerance {
+			out = append(out, CheckpointMismatch{Field: field, Stored: formatFloat(s), Recomputed: formatFloat(r)})
+		}
+	}
+	float("elo", stored.elo, recomputed.elo)
+	if rated {
+		float("rating", stored.rating, recomputed.rating)
+		if stored.league != recomputed.league {
+			out = append(out, CheckpointMismatch{Field: "league", Stored: stored.league, Recomputed: recomputed.league})
+		}
+	}
+	float("deviation", stored.deviation, recomputed.deviation)
+	float("volatility", stored.volatility, recomputed.volatility)
+	return out
+}
+
+func lessString(a, b string) bool { return a < b }
+
+func presence(ok bool) string {
+	if ok {
+		return "present"
+	}
+	return "missing"
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// unionKeys returns the keys of both maps ordered by less.
+func unionKeys[K comparable, V any](a, b map[K]V, less func(x, y K) bool) []K {
+	keys := make([]K, 0, len(a))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return less(keys[i], keys[j]) })
+	return keys
+}
@@ -0,0 +1,60 @@
+package elo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+func TestDiffCheckpoint(t *testing.T) {
+	cp := db.SettlementCheckpoint{ID: "cp1", Date: time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)}
+	snapshot := func() checkpointSnapshot {
+		return checkpointSnapshot{
+			settlementState: settlementState{
+				global: map[string]*arenaStanding{
+					"a": {elo: 1010, rating: 1005, league: "amateur", settled: true},
+					"b": {elo: 990, rating: 995, league: "amateur", settled: true, deviation: 80, volatility: 0.06},
+				},
+				game:    map[playerGame]*arenaStanding{{"a", "g1"}: {elo: 1020, rating: 1010, league: "amateur", settled: true}},
+				virtual: map[string]*arenaStanding{"g2": {elo: 1000, settled: true}},
+			},
+			markets: map[string]bool{"m1": false},
+		}
+	}
+
+	if got := diffCheckpoint(cp, snapshot(), snapshot()); len(got) != 0 {
+		t.Fatalf("identical snapshots differ: %+v", got)
+	}
+
+	recomputed := snapshot()
+	recomputed.global["a"].rating += checkpointTolerance / 2
+	if got := diffCheckpoint(cp, snapshot(), recomputed); len(got) != 0 {
+		t.Errorf("float noise is not a mismatch: %+v", got)
+	}
+
+	recomputed = snapshot()
+	recomputed.global["b"].league = "elite"
+	recomputed.game[playerGame{"a", "g1"}].elo = 1030
+	delete(recomputed.virtual, "g2")
+	recomputed.markets["m1"] = true
+	recomputed.markets["m2"] = false
+
+	want := []CheckpointMismatch{
+		{Scope: "global", PlayerID: "b", Field: "league", Stored: "amateur", Recomputed: "elite"},
+		{Scope: "game", PlayerID: "a", GameID: "g1", Field: "elo", Stored: "1020", Recomputed: "1030"},
+		{Scope: "virtual", GameID: "g2", Field: "presence", Stored: "present", Recomputed: "missing"},
+		{Scope: "market", MarketID: "m1", Field: "betting_closed", Stored: "false", Recomputed: "true"},
+		{Scope: "market", MarketID: "m2", Field: "presence", Stored: "missing", Recomputed: "present"},
+	}
+	got := diffCheckpoint(cp, snapshot(), recomputed)
+	if len(got) != len(want) {
+		t.Fatalf("got %d mismatches, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		want[i].CheckpointID, want[i].Date = cp.ID, cp.Date
+		if got[i] != want[i] {
+			t.Errorf("mismatch %d:\n got  %+v\n want %+v", i, got[i], want[i])
+		}
+	}
+}
@@ -2,6 +2,7 @@ package elo
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -22,10 +23,11 @@ type IClubService interface {
 
 type ClubService struct {
 	Queries *db.Queries
+	Pool    *pgxpool.Pool
 }
 
 func NewClubService(pool *pgxpool.Pool) IClubService {
-	return &ClubService{Queries: db.New(pool)}
+	return &ClubService{Queries: db.New(pool), Pool: pool}
 }
 
 func (s *ClubService) ListClubs(ctx context.Context) ([]db.ListClubsRow, error) {
@@ -37,11 +39,15 @@ func (s *ClubService) GetClub(ctx context.Context, id string) ([]db.GetClubRow,
 }
 
 func (s *ClubService) CreateClub(ctx context.Context, id, name string) (db.Club, error) {
-	return s.Queries.CreateClub(ctx, db.CreateClubParams{ID: id, Name: name})
+	return s.auditedChange(ctx, id, AuditActionCreate, func(q *db.Queries) (db.Club, error) {
+		return q.CreateClub(ctx, db.CreateClubParams{ID: id, Name: name})
+	})
 }
 
 func (s *ClubService) UpdateClub(ctx context.Context, id string, name string) (db.Club, error) {
-	return s.Queries.UpdateClubName(ctx, db.UpdateClubNameParams{ID: id, Name: name})
+	return s.auditedChange(ctx, id, AuditActionUpdate, func(q *db.Queries) (db.Club, error) {
+		return q.UpdateClubName(ctx, db.UpdateClubNameParams{ID: id, Name: name})
+	})
 }
 
 func (s *ClubService) UpdateClubIcon(ctx context.Context, id string, icon *string) (db.Club, error) {
@@ -49,17 +55,66 @@ func (s *ClubService) UpdateClubIcon(ctx context.Context, id string, icon *strin
 	if icon != nil {
 		iconText = pgtype.Text{String: *icon, Valid: true}
 	}
-	return s.Queries.UpdateClubIcon(ctx, db.UpdateClubIconParams{ID: id, Icon: iconText})
+	return s.auditedChange(ctx, id, AuditActionUpdate, func(q *db.Queries) (db.Club, error) {
+		return q.UpdateClubIcon(ctx, db.UpdateClubIconParams{ID: id, Icon: iconText})
+	})
 }
 
+// DeleteClub removes a club no arena filters by; ErrClubUsedByArena otherwise.
 func (s *ClubService) DeleteClub(ctx context.Context, id string) (db.Club, error) {
-	return s.Queries.DeleteClub(ctx, id)
+	return s.auditedChange(ctx, id, AuditActionDelete, func(q *db.Queries) (db.Club, error) {
+		arenas, err := q.ListClubArenas(ctx, &id)
+		if err != nil {
+			return db.Club{}, fmt.Errorf("list arenas of club %s: %w", id, err)
+		}
+		if len(arenas) > 0 {
+			return db.Club{}, fmt.Errorf("%w: %s", ErrClubUsedByArena, arenas[0].Name)
+		}
+		return q.DeleteClub(ctx, id)
+	})
 }
 
+// AddMember adds the player to the club and rebuilds the arenas filtered by it.
 func (s *ClubService) AddMember(ctx context.Context, clubID, playerID string) error {
-	return s.Queries.AddClubMember(ctx, db.AddClubMemberParams{ClubID: clubID, PlayerID: playerID})
+	_, err := s.auditedChange(ctx, clubID, AuditActionAddMember, func(q *db.Queries) (db.Club, error) {
+		if err := q.AddClubMember(ctx, db.AddClubMemberParams{ClubID: clubID, PlayerID: playerID}); err != nil {
+			return db.Club{}, err
+		}
+		return db.Club{}, rebuildClubArenas(ctx, q, clubID)
+	})
+	return err
 }
 
+// RemoveMember removes the player from the club and rebuilds the arenas
+// filtered by it.
 func (s *ClubService) RemoveMember(ctx context.Context, clubID, playerID string) error {
-	return s.Queries.RemoveClubMember(ctx, db.RemoveClubMemberParams{ClubID: clubID, PlayerID: playerID})
+	_, err := s.auditedChange(ctx, clubID, AuditActionRemoveMember, func(q *db.Queries) (db.Club, error) {
+		if err := q.RemoveClubMember(ctx, db.RemoveClubMemberParams{ClubID: clubID, PlayerID: playerID}); err != nil {
+			return db.Club{}, err
+		}
+		return db.Club{}, rebuildClubArenas(ctx, q, clubID)
+	})
+	return err
+}
+
+// auditedChange runs change in a transaction and records the club with its
+// members before and after it. Errors of change come back unwrapped, so
+// callers still see ErrNoRows and constraint violations.
+func (s *ClubService) auditedChange(ctx context.Context, clubID, action string, change func(q *db.Queries) (db.Club, error)) (db.Club, error) {
+	var club db.Club
+	err := runInTx(ctx, s.Pool, func(q *db.Queries) error {
+		before, err := clubAuditSnapshot(ctx, q, clubID)
+		if err != nil {
+			return err
+		}
+		if club, err = change(q); err != nil {
+			return err
+		}
+		after, err := clubAuditSnapshot(ctx, q, clubID)
+		if err != nil {
+			return err
+		}
+		return recordAudit(ctx, q, AuditEntityClub, clubID, action, auditValue(before), auditValue(after))
+	})
+	return club, err
 }
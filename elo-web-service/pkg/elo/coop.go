@@ -0,0 +1,169 @@
+package elo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+// A cooperative match is settled as one team of all its players against the
+// game's virtual opponent. The group side scores 1 on a win and 0 on a loss,
+// the virtual opponent the opposite; the recorded points play no part.
+
+const (
+	// virtualOpponentSide is the side key of the game's virtual opponent.
+	virtualOpponentSide = "virtual"
+	// coopTeam is the implicit team label of the cooperative group.
+	coopTeam = "coop"
+)
+
+// Cooperative results as stored in matches.cooperative_result.
+const (
+	CoopResultWon  = "won"
+	CoopResultLost = "lost"
+)
+
+// Cooperative describes the outcome of a cooperative match.
+type Cooperative struct {
+	Won bool
+	// GlobalArena: the result also moves the global arena (not only the game arena).
+	GlobalArena bool
+}
+
+// CoopPrevState is the cooperative part of MatchPrevState.
+type CoopPrevState struct {
+	Cooperative
+	// VirtualElo is the game's virtual opponent Elo before this match.
+	VirtualElo float64
+	// Rating-algorithm uncertainty of the virtual opponent (zero: the
+	// algorithm's starting values).
+	VirtualDeviation  float64
+	VirtualVolatility float64
+}
+
+// coopColumns converts an optional Cooperative into the matches columns.
+func coopColumns(c *Cooperative) (pgtype.Text, bool) {
+	if c == nil {
+		return pgtype.Text{}, false
+	}
+	result := CoopResultLost
+	if c.Won {
+		result = CoopResultWon
+	}
+	return pgtype.Text{String: result, Valid: true}, c.GlobalArena
+}
+
+// cooperativeOf reads the cooperative outcome of a stored match; nil for a
+// competitive match.
+func cooperativeOf(m db.Match) *Cooperative {
+	if !m.CooperativeResult.Valid {
+		return nil
+	}
+	return &Cooperative{
+		Won:         m.CooperativeResult.String == CoopResultWon,
+		GlobalArena: m.CooperativeGlobalArena,
+	}
+}
+
+// coopSides builds the two sides of a cooperative match: the whole group as
+// one team and the member-less virtual opponent, whose Elo is fixed by the caller.
+func coopSides(playerScores map[string]float64, coop CoopPrevState) matchSides {
+	teams := make(map[string]string, len(playerScores))
+	for playerID := range playerScores {
+		teams[playerID] = coopTeam
+	}
+	sides := newMatchSides(playerScores, teams)
+
+	group, virtual := 0.0, 1.0
+	if coop.Won {
+		group, virtual = 1.0, 0.0
+	}
+	sides.scores[teamSidePrefix+coopTeam] = group
+	sides.scores[virtualOpponentSide] = virtual
+	sides.fixed = map[string]Skill{virtualOpponentSide: {
+		Rating:     coop.VirtualElo,
+		Deviation:  coop.VirtualDeviation,
+		Volatility: coop.VirtualVolatility,
+	}}
+	return sides
+}
+
+// virtualOpponentResult is the virtual opponent's settlement for one match.
+type virtualOpponentResult struct {
+	eloStaked       float64
+	eloEarned       float64
+	eloAfter        float64
+	deviationAfter  float64
+	volatilityAfter float64
+}
+
+// buildVirtualOpponentResult computes the virtual opponent's new Elo from the
+// game arena view of the match. Pure calculation — no DB writes.
+func buildVirtualOpponentResult(playerScores map[string]float64, state MatchPrevState) virtualOpponentResult {
+	gs := state.GameParams.gameSettings(state.Settings)
+	algo := newRatingAlgorithm(gs.GameAlgorithm, gs)
+	sides := coopSides(playerScores, *state.Coop)
+	sideSkill := sides.skills(state.GameElo, state.GameDeviation, state.GameVolatility, algo.Starting())
+
+	r := algo.Rate(sideSkill, sides.scores)[virtualOpponentSide]
+	return virtualOpponentResult{
+		eloStaked:       r.Staked,
+		eloEarned:       r.Earned,
+		eloAfter:        r.After.Rating,
+		deviationAfter:  r.After.Deviation,
+		volatilityAfter: r.After.Volatility,
+	}
+}
+
+// settlesGlobalArena reports whether the match produces global arena settlements.
+func (st MatchPrevState) settlesGlobalArena() bool {
+	return st.Coop == nil || st.Coop.GlobalArena
+}
+
+// loadCoopPrevState returns the cooperative state of a match, or nil for a
+// competitive match.
+func loadCoopPrevState(ctx context.Context, q *db.Queries, match db.Match, startingElo float64) (*CoopPrevState, error) {
+	coop := cooperativeOf(match)
+	if coop == nil {
+		return nil, nil
+	}
+	state := &CoopPrevState{Cooperative: *coop, VirtualElo: startingElo}
+	prev, err := q.GetGameVirtualOpponentEloBeforeMatch(ctx, db.GetGameVirtualOpponentEloBeforeMatchParams{
+		GameID:  match.GameID,
+		Date:    match.Date,
+		MatchID: match.ID,
+	})
+	if err == nil {
+		state.VirtualElo = prev.EloAfter
+		state.VirtualDeviation = prev.DeviationAfter.Float64
+		state.VirtualVolatility = prev.VolatilityAfter.Float64
+	} else if !db.IsNoRows(err) {
+		return nil, fmt.Errorf("get virtual opponent elo for game %s: %w", match.GameID, err)
+	}
+	return state, nil
+}
+
+// storeVirtualOpponentSettlement upserts the virtual opponent's settlement for a
+// cooperative match; a no-op for a competitive one.
+func storeVirtualOpponentSettlement(ctx context.Context, q *db.Queries, matchID, gameID string, playerScores map[string]float64, state MatchPrevState) error {
+	if state.Coop == nil {
+		return nil
+	}
+	r := buildVirtualOpponentResult(playerScores, state)
+	if err := q.UpsertGameVirtualOpponentSettlement(ctx, db.UpsertGameVirtualOpponentSettlementParams{
+		MatchID:   matchID,
+		ID:        newSettlementID(),
+		GameID:    gameID,
+		EloAfter:  r.eloAfter,
+		EloStaked: r.eloStaked,
+		EloEarned: r.eloEarned,
+
+		DeviationAfter:  uncertaintyColumn(r.deviationAfter),
+		VolatilityAfter: uncertaintyColumn(r.volatilityAfter),
+	}); err != nil {
+		return fmt.Errorf("unable to upsert virtual opponent settlement for match %s: %w", matchID, err)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package elo
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func coopState(elos map[string]float64, won bool, virtualElo float64) MatchPrevState {
+	state := prevStateFor(elos, nil)
+	state.Coop = &CoopPrevState{Cooperative: Cooperative{Won: won}, VirtualElo: virtualElo}
+	return state
+}
+
+func TestBuildEloResultsCoopWinAgainstEvenOpponent(t *testing.T) {
+	elos := map[string]float64{"a": 1100, "b": 900}
+	scores := map[string]float64{"a": 23, "b": 23}
+	state := coopState(elos, true, testStartingElo)
+
+	results := buildEloResults(scores, state)
+	for id, prev := range elos {
+		if got := results[id].newGameElo - prev; !floatsEqual(got, testK*0.5) {
+			t.Errorf("player %s: game delta %v, want %v", id, got, testK*0.5)
+		}
+	}
+
+	virtual := buildVirtualOpponentResult(scores, state)
+	if !floatsEqual(virtual.eloAfter, testStartingElo-testK*0.5) {
+		t.Errorf("virtual opponent elo %v, want %v", virtual.eloAfter, testStartingElo-testK*0.5)
+	}
+	if !floatsEqual(virtual.eloStaked+virtual.eloEarned, -testK*0.5) {
+		t.Errorf("virtual opponent delta %v, want %v", virtual.eloStaked+virtual.eloEarned, -testK*0.5)
+	}
+}
+
+func TestBuildEloResultsCoopIgnoresRecordedPoints(t *testing.T) {
+	elos := map[string]float64{"a": 1000, "b": 1000}
+	lowPoints := buildEloResults(map[string]float64{"a": 0, "b": 0}, coopState(elos, false, 1200))
+	highPoints := buildEloResults(map[string]float64{"a": 25, "b": 25}, coopState(elos, false, 1200))
+	if lowPoints["a"].newGameElo != highPoints["a"].newGameElo {
+		t.Errorf("points changed the result: %v vs %v", lowPoints["a"].newGameElo, highPoints["a"].newGameElo)
+	}
+	// Losing to a stronger game costs less than losing to an even one.
+	even := buildEloResults(map[string]float64{"a": 0, "b": 0}, coopState(elos, false, testStartingElo))
+	if lowPoints["a"].newGameElo <= even["a"].newGameElo {
+		t.Errorf("loss to a harder game %v should cost less than to an even one %v",
+			lowPoints["a"].newGameElo, even["a"].newGameElo)
+	}
+}
+
+func TestBuildEloResultsSoloCoop(t *testing.T) {
+	results := buildEloResults(map[string]float64{"a": 1}, coopState(map[string]float64{"a": 1000}, true, 1000))
+	if !floatsEqual(results["a"].newGameElo, 1000+testK*0.5) {
+		t.Errorf("solo coop win: game elo %v, want %v", results["a"].newGameElo, 1000+testK*0.5)
+	}
+}
+
+func TestValidateMatchPlayers(t *testing.T) {
+	won := &Cooperative{Won: true}
+	cases := []struct {
+		name   string
+		scores map[string]float64
+		teams  map[string]string
+		coop   *Cooperative
+		want   error
+	}{
+		{"competitive needs two players", map[string]float64{"a": 1}, nil, nil, ErrTooFewPlayers},
+		{"solo coop is allowed", map[string]float64{"a": 1}, nil, won, nil},
+		{"empty coop", map[string]float64{}, nil, won, ErrTooFewPlayers},
+		{"coop cannot have teams", map[string]float64{"a": 1, "b": 1}, map[string]string{"a": "A", "b": "B"}, won, ErrInvalidTeams},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateMatchPlayers(tc.scores, tc.teams, tc.coop)
+			if tc.want == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.want != nil && !errors.Is(err, tc.want) {
+				t.Fatalf("got %v, want %v", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestWinnersCooperativeMatch(t *testing.T) {
+	m := makeMatch(time.Now(), "g", map[string]float64{"10": 0, "20": 0})
+	m.Cooperative = &Cooperative{Won: true}
+	winners, ok := m.Winners()
+	if !ok || len(winners) != 2 {
+		t.Fatalf("won coop: got (%v, %v), want both players", winners, ok)
+	}
+
+	m.Cooperative = &Cooperative{Won: false}
+	if _, ok := m.Winners(); ok {
+		t.Fatal("lost coop: expected no winner")
+	}
+}
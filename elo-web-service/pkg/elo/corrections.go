@@ -38,6 +38,9 @@ func (s *CorrectionService) CreateGlobalArenaRatingCorrection(ctx context.Contex
 
 	q := db.New(tx)
 
+	if err := checkNotGuests(ctx, q, []string{playerID}); err != nil {
+		return err
+	}
 	correction, err := q.CreateCorrection(ctx, db.CreateCorrectionParams{
 		ID:            id,
 		PlayerID:      playerID,
@@ -47,6 +50,12 @@ func (s *CorrectionService) CreateGlobalArenaRatingCorrection(ctx context.Contex
 	if err != nil {
 		return fmt.Errorf("create correction: %w", err)
 	}
+	if err := recordAudit(ctx, q, AuditEntityCorrection, correction.ID, AuditActionCreate, nil, correction); err != nil {
+		return err
+	}
+	if err := applyTimedSettlements(ctx, q, correction.Date.Time); err != nil {
+		return err
+	}
 
 	settingsRow, err := q.GetEloSettingsForDate(ctx, correction.Date)
 	if err != nil {
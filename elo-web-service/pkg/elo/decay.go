@@ -0,0 +1,162 @@
+package elo
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+// Inactivity decay lowers the global display rating of a player who has not
+// played for inactivity_decay_days: once per inactivity spell they lose
+// inactivity_decay_rate of their rating above the starting rating. The drop
+// is a global_arena_settlement row with discriminator 'decay'; the player's
+// next match reverses it with a second 'decay' row that references the match.
+// Both are derived rows: the event processor generates them in event order,
+// so RecalculateFrom replays them like every other settlement.
+
+// inactivityDecayAmount is the rating lost by a player inactive long enough;
+// 0 when decay is disabled or the rating is not above the starting rating.
+func inactivityDecayAmount(rating float64, s EloSettings) float64 {
+	if s.InactivityDecayDays <= 0 || rating <= s.StartingRatingGlobal {
+		return 0
+	}
+	return s.InactivityDecayRate * (rating - s.StartingRatingGlobal)
+}
+
+// inactivityDecayDate is when a player whose last match was at lastMatch
+// decays; ok is false when decay is disabled.
+func inactivityDecayDate(lastMatch time.Time, s EloSettings) (time.Time, bool) {
+	if s.InactivityDecayDays <= 0 {
+		return time.Time{}, false
+	}
+	return lastMatch.AddDate(0, 0, s.InactivityDecayDays), true
+}
+
+// applyInactivityDecay inserts every decay drop due strictly before until,
+// using the settings in force on each player's last match date. It runs, as
+// part of applyTimedSettlements, before each event that writes global
+// settlements, so drops land in date order.
+func applyInactivityDecay(ctx context.Context, q *db.Queries, until time.Time) error {
+	candidates, err := q.ListInactivityDecayCandidates(ctx, pgtype.Timestamptz{Time: until, Valid: true})
+	if err != nil {
+		return fmt.Errorf("list inactivity decay candidates: %w", err)
+	}
+
+	for _, c := range candidates {
+		lastMatch := pgtype.Timestamptz{Time: c.LastMatchDate, Valid: true}
+		settingsRow, err := q.GetEloSettingsForDate(ctx, lastMatch)
+		if err != nil {
+			return fmt.Errorf("get elo settings: %w", err)
+		}
+		settings := EloSettingsFromDB(settingsRow)
+
+		due, ok := inactivityDecayDate(c.LastMatchDate, settings)
+		if !ok || !due.Before(until) {
+			continue
+		}
+		dueDate := pgtype.Timestamptz{Time: due, Valid: true}
+
+		prev, err := q.GetPlayerLatestGlobalRatingAtDate(ctx, db.GetPlayerLatestGlobalRatingAtDateParams{
+			PlayerID: c.PlayerID,
+			Date:     dueDate,
+		})
+		if err != nil {
+			return fmt.Errorf("get rating of player %s at %v: %w", c.PlayerID, due, err)
+		}
+		amount := inactivityDecayAmount(prev.Rating, settings)
+		if amount <= 0 {
+			continue
+		}
+		prevElo, err := q.GetPlayerLatestGlobalEloAtDate(ctx, db.GetPlayerLatestGlobalEloAtDateParams{
+			PlayerID: c.PlayerID,
+			Date:     dueDate,
+		})
+		if err != nil {
+			return fmt.Errorf("get elo of player %s at %v: %w", c.PlayerID, due, err)
+		}
+
+		if err := q.InsertGlobalArenaDecaySettlement(ctx, db.InsertGlobalArenaDecaySettlementParams{
+			ID:           newSettlementID(),
+			PlayerID:     c.PlayerID,
+			Date:         dueDate,
+			RatingAfter:  prev.Rating - amount,
+			EloAfter:     prevElo,
+			RatingStaked: -amount,
+			League:       prev.League,
+		}); err != nil {
+			return fmt.Errorf("insert decay settlement for player %s: %w", c.PlayerID, err)
+		}
+	}
+	return nil
+}
+
+// reverseInactivityDecay gives a returning player their decayed rating back
+// ahead of the match's own settlement. Replaying the match rewrites the
+// reversal.
+func reverseInactivityDecay(ctx context.Context, q *db.Queries, match db.Match, playerID string) error {
+	if err := q.DeleteGlobalArenaDecaySettlementByMatch(ctx, db.DeleteGlobalArenaDecaySettlementByMatchParams{
+		MatchID:  &match.ID,
+		PlayerID: playerID,
+	}); err != nil {
+		return fmt.Errorf("delete decay reversal of player %s: %w", playerID, err)
+	}
+
+	pending, err := q.GetPlayerPendingDecayBeforeMatch(ctx, db.GetPlayerPendingDecayBeforeMatchParams{
+		PlayerID: playerID,
+		Date:     match.Date,
+		MatchID:  &match.ID,
+	})
+	if db.IsNoRows(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get pending decay of player %s: %w", playerID, err)
+	}
+
+	prev, err := q.GetPlayerLatestGlobalRatingBeforeMatch(ctx, db.GetPlayerLatestGlobalRatingBeforeMatchParams{
+		PlayerID: playerID,
+		Date:     match.Date,
+		MatchID:  &match.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("get rating of player %s before match %s: %w", playerID, match.ID, err)
+	}
+	prevElo, err := q.GetPlayerLatestGlobalEloBeforeMatch(ctx, db.GetPlayerLatestGlobalEloBeforeMatchParams{
+		PlayerID: playerID,
+		Date:     match.Date,
+		MatchID:  &match.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("get elo of player %s before match %s: %w", playerID, match.ID, err)
+	}
+
+	amount := -pending.RatingStaked
+	return q.InsertGlobalArenaDecaySettlement(ctx, db.InsertGlobalArenaDecaySettlementParams{
+		ID:           newSettlementID(),
+		PlayerID:     playerID,
+		Date:         match.Date,
+		RatingAfter:  prev.Rating + amount,
+		EloAfter:     prevElo,
+		RatingEarned: amount,
+		League:       prev.League,
+		MatchID:      &match.ID,
+	})
+}
+
+// inactivityDecayInterval is how often drops and season resets due without
+// any event are written.
+const inactivityDecayInterval = time.Hour
+
+func (s *MatchService) ScheduleInactivityDecay(ctx context.Context) {
+	err := runInTx(ctx, s.Pool, func(q *db.Queries) error {
+		return applyTimedSettlements(ctx, q, time.Now())
+	})
+	if err != nil {
+		log.Printf("ScheduleInactivityDecay error: %v", err)
+	}
+	time.AfterFunc(inactivityDecayInterval, func() { s.ScheduleInactivityDecay(context.Background()) })
+}
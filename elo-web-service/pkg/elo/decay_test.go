@@ -0,0 +1,46 @@
+package elo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInactivityDecayAmount(t *testing.T) {
+	s := testSettings
+	s.InactivityDecayDays = 90
+	s.InactivityDecayRate = 0.25
+
+	cases := []struct {
+		name   string
+		days   int
+		rating float64
+		want   float64
+	}{
+		{"quarter of the rating above start", 90, testStartingElo + 200, 50},
+		{"at the starting rating", 90, testStartingElo, 0},
+		{"below the starting rating", 90, testStartingElo - 100, 0},
+		{"disabled", 0, testStartingElo + 200, 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s.InactivityDecayDays = tc.days
+			if got := inactivityDecayAmount(tc.rating, s); !floatsEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestInactivityDecayDate(t *testing.T) {
+	s := testSettings
+	last := time.Date(2026, 1, 31, 18, 0, 0, 0, time.UTC)
+
+	if _, ok := inactivityDecayDate(last, s); ok {
+		t.Error("decay is disabled by default")
+	}
+	s.InactivityDecayDays = 30
+	due, ok := inactivityDecayDate(last, s)
+	if want := time.Date(2026, 3, 2, 18, 0, 0, 0, time.UTC); !ok || !due.Equal(want) {
+		t.Errorf("got %v (ok=%v), want %v", due, ok, want)
+	}
+}
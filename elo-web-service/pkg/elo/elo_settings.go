@@ -2,6 +2,7 @@ package elo
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -22,10 +23,11 @@ type IEloSettingsService interface {
 
 type EloSettingsService struct {
 	Queries *db.Queries
+	Pool    *pgxpool.Pool
 }
 
 func NewEloSettingsService(pool *pgxpool.Pool) *EloSettingsService {
-	return &EloSettingsService{Queries: db.New(pool)}
+	return &EloSettingsService{Queries: db.New(pool), Pool: pool}
 }
 
 // GetForDate returns the effective elo settings row for the given timestamp.
@@ -45,10 +47,31 @@ func (s *EloSettingsService) List(ctx context.Context) ([]db.ListEloSettingsRow,
 
 // Create inserts a new elo settings entry effective at the given date.
 func (s *EloSettingsService) Create(ctx context.Context, arg db.CreateEloSettingsParams) error {
-	return s.Queries.CreateEloSettings(ctx, arg)
+	return runInTx(ctx, s.Pool, func(q *db.Queries) error {
+		if err := q.CreateEloSettings(ctx, arg); err != nil {
+			return err
+		}
+		after, err := q.GetEloSettingsAt(ctx, arg.EffectiveDate)
+		if err != nil {
+			return fmt.Errorf("get created elo settings: %w", err)
+		}
+		return recordAudit(ctx, q, AuditEntitySettings, settingsAuditID(arg.EffectiveDate.Time), AuditActionCreate, nil, after)
+	})
 }
 
 // Delete removes the elo settings entry effective at the given (future) date.
 func (s *EloSettingsService) Delete(ctx context.Context, t pgtype.Timestamptz) error {
-	return s.Queries.DeleteEloSettings(ctx, t)
+	return runInTx(ctx, s.Pool, func(q *db.Queries) error {
+		before, err := q.GetEloSettingsAt(ctx, t)
+		if db.IsNoRows(err) {
+			return nil // nothing to delete, nothing to record
+		}
+		if err != nil {
+			return fmt.Errorf("get elo settings: %w", err)
+		}
+		if err := q.DeleteEloSettings(ctx, t); err != nil {
+			return err
+		}
+		return recordAudit(ctx, q, AuditEntitySettings, settingsAuditID(t.Time), AuditActionDelete, before, nil)
+	})
 }
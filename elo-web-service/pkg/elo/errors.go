@@ -6,6 +6,8 @@ var (
 	ErrTooFewPlayers                    = errors.New("партия требует минимум 2 игрока")
 	ErrDateChangeTooLarge               = errors.New("изменение даты партии не может превышать 3 дня")
 	ErrMatchDateOutOfRange              = errors.New("дата партии не может быть в будущем или старше 30 дней")
+	ErrMatchDateInFuture                = errors.New("дата партии не может быть в будущем")
+	ErrOverrideReasonRequired           = errors.New("для изменения даты партии в обход ограничений нужна причина")
 	ErrBetLimitExceeded                 = errors.New("ставка превысит лимит бронирования")
 	ErrMarketNotOpen                    = errors.New("рынок не открыт")
 	ErrMarketOutcomeNotFound            = errors.New("указанный исход не существует на этом рынке")
@@ -16,6 +18,32 @@ var (
 	ErrHistoryChangeConflict            = errors.New("изменение истории невозможно: ставка была сделана до того, как рынок был разрешён в результате новой даты партии")
 	ErrHistoryChangeConflictBettingLock = errors.New("изменение истории невозможно: приём ставок был закрыт до того, как рынок был разрешён в результате новой даты партии")
 	ErrMatchNotFound                    = errors.New("матч не найден")
+	ErrInvalidTeams                     = errors.New("некорректный состав команд")
+	ErrTeamScoreMismatch                = errors.New("у игроков одной команды должен быть одинаковый счёт")
+	ErrInvalidPlacement                 = errors.New("некорректные места игроков")
+	ErrInvalidGameScoring               = errors.New("некорректные правила подсчёта игры")
+	ErrInvalidRatingAlgorithm           = errors.New("неизвестный алгоритм рейтинга")
+	ErrInvalidLeagueLadder              = errors.New("некорректная лестница лиг")
+	ErrInvalidEloSettings               = errors.New("некорректные настройки рейтинга")
+	ErrInvalidGameRatingParams          = errors.New("некорректные параметры рейтинга игры")
+	ErrPlayerMergeSelf                  = errors.New("нельзя объединить игрока с самим собой")
+	ErrPlayerMergeConflict              = errors.New("игроков нельзя объединить")
+	ErrGameMergeSelf                    = errors.New("нельзя объединить игру с самой собой")
+	ErrGameMergeConflict                = errors.New("игры нельзя объединить")
+	ErrGuestCannotBet                   = errors.New("гости не могут делать ставки")
+	ErrGuestNotRated                    = errors.New("гостю нельзя начислить корректировку или назначить его гарантом рынка")
+	ErrGuestHasStakes                   = errors.New("игрока со ставками, гарантиями рынков или корректировками нельзя сделать гостем")
+	ErrClubNotFound                     = errors.New("клуб не найден")
+	ErrClubUsedByArena                  = errors.New("клуб используется фильтром арены")
+	ErrTournamentUsedByArena            = errors.New("турнир используется фильтром арены")
+	ErrInvalidLeaderboardPeriod         = errors.New("начало периода должно быть раньше его конца")
+	ErrInvalidSeason                    = errors.New("некорректные даты или коэффициент сброса сезона")
+	ErrSeasonOverlap                    = errors.New("сезон пересекается с другим сезоном")
+	ErrSeasonNotEnded                   = errors.New("сезон ещё не закончился")
+	ErrSeasonArchived                   = errors.New("сезон уже в архиве")
+	ErrInvalidMatchmaking               = errors.New("столы заданы неверно или не вмещают всех участников")
+	ErrMatchmakingOutsider              = errors.New("участник не входит в клуб или турнир")
+	ErrHeadToHeadSelf                   = errors.New("нельзя сравнить игрока с самим собой")
 
 	ErrTournamentMemberHasMatches    = errors.New("нельзя удалить участника, сыгравшего партии в турнире")
 	ErrTournamentDatesNarrowEloRange = errors.New("даты турнира не охватывают уже сыгранные партии")
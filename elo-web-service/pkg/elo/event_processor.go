@@ -28,8 +28,11 @@ type Settlement interface {
 }
 
 // EventProcessor applies settlements for match events in the order defined by the ADR:
+//    inactivity decay    (drops due before the match and reversal for its players,
+//                         written while the match's prior state is loaded; decay.go)
 // 1. Rating from match   (rating_pay/earn → player_ratings)
 // 2. game_elo            (match_scores game_elo_* fields)
+//    custom arenas       (arena_settlement, see arenas.go)
 // 3. Market resolution   (match-triggered) → SettleMarket (handles step 4: rating update)
 // 5. Time-based expiry   (closes_at <= match.date) → SettleMarket (handles step 6: rating update)
 //
@@ -54,6 +57,11 @@ func (p *EventProcessor) processMatchSettlements(
 		return fmt.Errorf("elo calc for match %s: %w", matchID, err)
 	}
 
+	// Custom arenas (ADR-02): rated independently, nothing else reads them.
+	if err := settleCustomArenas(ctx, q, matchID, gameID, playerScores, state, matchDate); err != nil {
+		return fmt.Errorf("custom arenas for match %s: %w", matchID, err)
+	}
+
 	// Steps 3 & 4: Match-triggered market resolution (SettleMarket applies rating inside)
 	if err := p.MarketService.TriggerResolutionForMatch(ctx, q, matchID); err != nil {
 		return fmt.Errorf("market resolution for match %s: %w", matchID, err)
@@ -67,31 +75,39 @@ func (p *EventProcessor) processMatchSettlements(
 	return nil
 }
 
-// RecalculateFrom unsettle and reapply all settlements from startDate.
+// RecalculateFrom unsettle and reapply all settlements from startDate with the
+// in-memory replay (replay.go); observe, when set, sees every replayed match.
 // Must be called within an active transaction.
 func (p *EventProcessor) RecalculateFrom(
 	ctx context.Context,
 	q *db.Queries,
 	startDate time.Time,
-	calcAndUpdateElo EloCalcFunc,
-	lockAndGetPrevElos func(ctx context.Context, q *db.Queries, match db.Match, playerScores map[string]float64) (MatchPrevState, error),
+	observe MatchObserver,
 ) error {
-	// Snapshot resolved_at for all markets that will be unsettled. Used later to detect
-	// whether recalculation moves any market's resolution to an earlier time.
-	oldResolutions, err := q.GetMarketsForUnsettleWithResolvedAt(ctx, pgtype.Timestamptz{Time: startDate, Valid: true})
+	oldResolutions, err := p.unsettleFrom(ctx, q, startDate)
 	if err != nil {
-		return fmt.Errorf("snapshot market resolutions: %w", err)
+		return err
 	}
-
-	// Delete all settlement rows from startDate in one query (match, market, and correction).
-	// The per-market deletes inside UnsettleMarketsFromDate will become no-ops.
-	if err := q.DeleteAllSettlementsFromDate(ctx, pgtype.Timestamptz{Time: startDate, Valid: true}); err != nil {
-		return fmt.Errorf("delete settlements from date: %w", err)
+	affectedIDs, err := p.replayInMemory(ctx, q, startDate, observe)
+	if err != nil {
+		return err
 	}
+	return p.finishReplay(ctx, q, startDate, affectedIDs, oldResolutions)
+}
 
-	// Reset market state (status, resolved_at) for markets resolved on/after startDate.
-	if err := p.MarketService.UnsettleMarketsFromDate(ctx, q, startDate); err != nil {
-		return fmt.Errorf("unsettle markets: %w", err)
+// RecalculateFromByEvent is RecalculateFrom settling one event at a time
+// through the database, as AddMatch does. It is the reference the in-memory
+// replay is checked against. Must be called within an active transaction.
+func (p *EventProcessor) RecalculateFromByEvent(
+	ctx context.Context,
+	q *db.Queries,
+	startDate time.Time,
+	calcAndUpdateElo EloCalcFunc,
+	lockAndGetPrevElos func(ctx context.Context, q *db.Queries, match db.Match, playerScores map[string]float64) (MatchPrevState, error),
+) error {
+	oldResolutions, err := p.unsettleFrom(ctx, q, startDate)
+	if err != nil {
+		return err
 	}
 
 	matches, err := q.GetMatchesFromDate(ctx, pgtype.Timestamptz{Time: startDate, Valid: true})
@@ -131,6 +147,7 @@ func (p *EventProcessor) RecalculateFrom(
 			if err != nil {
 				return fmt.Errorf("lock/get prev elos for match %s: %w", match.ID, err)
 			}
+			state.Teams = teamsOf(matchScores)
 
 			if err := p.processMatchSettlements(ctx, q, match.ID, match.GameID, playerScores,
 				state, match.Date.Time, calcAndUpdateElo); err != nil {
@@ -140,6 +157,9 @@ func (p *EventProcessor) RecalculateFrom(
 			correction := corrections[ci]
 			ci++
 
+			if err := applyTimedSettlements(ctx, q, correction.Date.Time); err != nil {
+				return err
+			}
 			if err := applyCorrectionWithinTx(ctx, q, correction); err != nil {
 				return fmt.Errorf("apply correction %s: %w", correction.ID, err)
 			}
@@ -147,14 +167,59 @@ func (p *EventProcessor) RecalculateFrom(
 		}
 	}
 
+	// Drops and resets due after the last replayed event.
+	if err := applyTimedSettlements(ctx, q, time.Now()); err != nil {
+		return err
+	}
+
 	affectedIDs := make([]string, 0, len(allAffectedPlayers))
 	for pid := range allAffectedPlayers {
 		affectedIDs = append(affectedIDs, pid)
 	}
+	return p.finishReplay(ctx, q, startDate, affectedIDs, oldResolutions)
+}
+
+// unsettleFrom deletes every settlement from startDate and reopens the markets
+// resolved since then. It returns their previous resolutions for
+// validateUserEventsAgainstNewResolutions.
+func (p *EventProcessor) unsettleFrom(ctx context.Context, q *db.Queries, startDate time.Time) ([]db.GetMarketsForUnsettleWithResolvedAtRow, error) {
+	// Snapshot resolved_at for all markets that will be unsettled. Used later to detect
+	// whether recalculation moves any market's resolution to an earlier time.
+	oldResolutions, err := q.GetMarketsForUnsettleWithResolvedAt(ctx, pgtype.Timestamptz{Time: startDate, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("snapshot market resolutions: %w", err)
+	}
+
+	// Delete all settlement rows from startDate in one query (match, market, and correction).
+	// The per-market deletes inside UnsettleMarketsFromDate will become no-ops.
+	if err := q.DeleteAllSettlementsFromDate(ctx, pgtype.Timestamptz{Time: startDate, Valid: true}); err != nil {
+		return nil, fmt.Errorf("delete settlements from date: %w", err)
+	}
+	if err := q.DeleteArenaSettlementsFromDate(ctx, pgtype.Timestamptz{Time: startDate, Valid: true}); err != nil {
+		return nil, fmt.Errorf("delete arena settlements from date: %w", err)
+	}
+	// Checkpoints after startDate snapshot settlements that are about to change.
+	if err := q.DeleteSettlementCheckpointsAfter(ctx, startDate); err != nil {
+		return nil, fmt.Errorf("delete settlement checkpoints: %w", err)
+	}
+
+	// Reset market state (status, resolved_at) for markets resolved on/after startDate.
+	if err := p.MarketService.UnsettleMarketsFromDate(ctx, q, startDate); err != nil {
+		return nil, fmt.Errorf("unsettle markets: %w", err)
+	}
+	return oldResolutions, nil
+}
+
+// finishReplay recalculates bet limits of the replayed players and the
+// achievements from startDate, and validates user events against the new
+// market resolutions.
+func (p *EventProcessor) finishReplay(ctx context.Context, q *db.Queries, startDate time.Time, affectedIDs []string, oldResolutions []db.GetMarketsForUnsettleWithResolvedAtRow) error {
 	if err := RecalculateBetLimits(ctx, q, affectedIDs); err != nil {
 		return fmt.Errorf("recalculate bet limits: %w", err)
 	}
-
+	if err := syncAchievements(ctx, q, startDate); err != nil {
+		return fmt.Errorf("sync achievements: %w", err)
+	}
 	return validateUserEventsAgainstNewResolutions(ctx, q, oldResolutions)
 }
 
@@ -0,0 +1,115 @@
+package elo
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+// MergeGames folds a duplicate game into the kept one. Its matches, the game
+// filters of markets and arenas and the fallback game of calculators move to
+// targetID and the duplicate is deleted. History is replayed from the
+// duplicate's first match (or the start of a market filtered by it), which
+// rebuilds game_arena_settlement of the kept game with both games' matches
+// under the kept game's scoring and rating parameters; arenas whose filter
+// changed are rebuilt from scratch.
+func (s *MatchService) MergeGames(ctx context.Context, targetID, duplicateID string) (db.Game, error) {
+	if targetID == duplicateID {
+		return db.Game{}, ErrGameMergeSelf
+	}
+	var merged db.Game
+	err := runInTx(ctx, s.Pool, func(q *db.Queries) error {
+		var err error
+		if merged, err = q.GetGameByID(ctx, targetID); err != nil {
+			return fmt.Errorf("get game %s: %w", targetID, err)
+		}
+		duplicate, err := q.GetGameByID(ctx, duplicateID)
+		if err != nil {
+			return fmt.Errorf("get game %s: %w", duplicateID, err)
+		}
+		if err := checkMergedScores(ctx, q, GameScoringOf(merged), duplicate); err != nil {
+			return err
+		}
+
+		from, err := q.GetGameFirstEventDate(ctx, duplicateID)
+		hasEvents := err == nil
+		if err != nil && !db.IsNoRows(err) {
+			return fmt.Errorf("get first event of game %s: %w", duplicateID, err)
+		}
+
+		if err := q.ReassignGameMatches(ctx, db.ReassignGameMatchesParams{TargetID: targetID, DuplicateID: duplicateID}); err != nil {
+			return fmt.Errorf("move matches of game %s: %w", duplicateID, err)
+		}
+		if err := q.ReassignSkullKingFallbackGame(ctx, db.ReassignSkullKingFallbackGameParams{TargetID: targetID, DuplicateID: duplicateID}); err != nil {
+			return fmt.Errorf("move skull king tables of game %s: %w", duplicateID, err)
+		}
+		if err := q.ReassignMarketGameFilters(ctx, db.ReassignMarketGameFiltersParams{TargetID: targetID, DuplicateID: duplicateID}); err != nil {
+			return fmt.Errorf("move market filters of game %s: %w", duplicateID, err)
+		}
+		if err := q.ReassignGameSeasonStandings(ctx, db.ReassignGameSeasonStandingsParams{TargetID: targetID, DuplicateID: duplicateID}); err != nil {
+			return fmt.Errorf("move season standings of game %s: %w", duplicateID, err)
+		}
+		arenas, err := q.ReassignArenaGames(ctx, db.ReassignArenaGamesParams{TargetID: targetID, DuplicateID: duplicateID})
+		if err != nil {
+			return fmt.Errorf("move arena filters of game %s: %w", duplicateID, err)
+		}
+
+		// Settlements of the duplicate's matches are all dated on or after
+		// its first event, so the replay clears them before the game goes.
+		if hasEvents {
+			if err := s.recalculateEloFromDate(ctx, q, from); err != nil {
+				return fmt.Errorf("unable to recalculate Elo: %w", err)
+			}
+		}
+		for _, arena := range arenas {
+			if err := rebuildArena(ctx, q, arena); err != nil {
+				return err
+			}
+		}
+		if _, err := q.DeleteGame(ctx, duplicateID); err != nil {
+			return fmt.Errorf("delete game %s: %w", duplicateID, err)
+		}
+		return recordAudit(ctx, q, AuditEntityGame, targetID, AuditActionMerge, duplicate, merged)
+	})
+	return merged, err
+}
+
+// checkMergedScores makes sure every competitive match of the duplicate means
+// the same under the kept game's scoring: its scores must be a valid result
+// there and rank the players in the same order as under the duplicate's own
+// scoring. Otherwise points would be read as places, or the winner and the
+// loser swapped, when the replay rates them in the kept game.
+func checkMergedScores(ctx context.Context, q *db.Queries, kept GameScoring, duplicate db.Game) error {
+	own := GameScoringOf(duplicate)
+	if own == kept {
+		return nil
+	}
+	byMatch, err := gameMatchScores(ctx, q, duplicate.ID)
+	if err != nil {
+		return err
+	}
+	for matchID, res := range byMatch {
+		if err := kept.ValidateResult(res.scores, res.teams); err != nil {
+			return fmt.Errorf("%w: партия %s: %v", ErrGameMergeConflict, matchID, err)
+		}
+		if !sameRanking(own.RankingScores(res.scores), kept.RankingScores(res.scores)) {
+			return fmt.Errorf("%w: порядок мест в партии %s изменится", ErrGameMergeConflict, matchID)
+		}
+	}
+	return nil
+}
+
+// sameRanking reports whether two higher-is-better score maps over the same
+// players order every pair of players alike, ties included.
+func sameRanking(a, b map[string]float64) bool {
+	for i, ai := range a {
+		for j, aj := range a {
+			if cmp.Compare(ai, aj) != cmp.Compare(b[i], b[j]) {
+				return false
+			}
+		}
+	}
+	return true
+}
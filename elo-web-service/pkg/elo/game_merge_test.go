@@ -0,0 +1,26 @@
+package elo
+
+import "testing"
+
+func TestSameRanking(t *testing.T) {
+	higher := GameScoring{Direction: ScoringHigherWins, ResultType: ResultTypeScore}
+	lower := GameScoring{Direction: ScoringLowerWins, ResultType: ResultTypeScore}
+	placement := GameScoring{Direction: ScoringHigherWins, ResultType: ResultTypePlacement}
+
+	points := map[string]float64{"a": 30, "b": 10, "c": 10}
+	if sameRanking(higher.RankingScores(points), lower.RankingScores(points)) {
+		t.Error("higher_wins and lower_wins rank the same points alike")
+	}
+	if !sameRanking(higher.RankingScores(points), higher.RankingScores(points)) {
+		t.Error("a ranking differs from itself")
+	}
+
+	// Places 1, 2, 2 rank like lower-is-better scores, ties included.
+	places := map[string]float64{"a": 1, "b": 2, "c": 2}
+	if !sameRanking(placement.RankingScores(places), lower.RankingScores(places)) {
+		t.Error("places and lower_wins scores rank differently")
+	}
+	if sameRanking(placement.RankingScores(places), higher.RankingScores(places)) {
+		t.Error("places read as higher_wins points rank alike")
+	}
+}
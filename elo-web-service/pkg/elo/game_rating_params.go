@@ -0,0 +1,160 @@
+package elo
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+// GameRatingParams overrides K, D and win_reward for one game from
+// EffectiveDate on (game_rating_params). A nil field falls back to the
+// elo_settings value effective at the match date.
+type GameRatingParams struct {
+	EffectiveDate time.Time
+	K             *float64
+	D             *float64
+	WinReward     *float64
+}
+
+func gameRatingParamsFromDB(row db.GameRatingParam) GameRatingParams {
+	return GameRatingParams{
+		EffectiveDate: row.EffectiveDate,
+		K:             float8Ptr(row.EloConstK),
+		D:             float8Ptr(row.EloConstD),
+		WinReward:     float8Ptr(row.WinReward),
+	}
+}
+
+func float8Ptr(v pgtype.Float8) *float64 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Float64
+}
+
+func float8Of(v *float64) pgtype.Float8 {
+	if v == nil {
+		return pgtype.Float8{}
+	}
+	return pgtype.Float8{Float64: *v, Valid: true}
+}
+
+// gameSettings is s with the game's overrides, used by the game arena.
+func (p GameRatingParams) gameSettings(s EloSettings) EloSettings {
+	if p.K != nil {
+		s.K = *p.K
+	}
+	if p.D != nil {
+		s.D = *p.D
+	}
+	if p.WinReward != nil {
+		s.WinReward = *p.WinReward
+	}
+	return s
+}
+
+// globalSettings is s for the global arena. The game's K replaces the
+// elo_settings K there too, so a game tuned to move ratings less (or more)
+// does so in the global table as well, not only in its own arena. D and
+// win_reward set the scale every game's matches are compared on, so they stay
+// global.
+func (p GameRatingParams) globalSettings(s EloSettings) EloSettings {
+	if p.K != nil {
+		s.K = *p.K
+	}
+	return s
+}
+
+// validateGameRatingParams applies the bounds validateEloSettings uses for
+// the same fields.
+func validateGameRatingParams(p GameRatingParams) error {
+	switch {
+	case p.K != nil && (*p.K <= 0 || math.IsNaN(*p.K)):
+		return fmt.Errorf("%w: k must be positive", ErrInvalidGameRatingParams)
+	case p.D != nil && (*p.D <= 0 || math.IsNaN(*p.D)):
+		return fmt.Errorf("%w: d must be positive", ErrInvalidGameRatingParams)
+	case p.WinReward != nil && (*p.WinReward < 0.1 || *p.WinReward > 5):
+		return fmt.Errorf("%w: win_reward must be between 0.1 and 5", ErrInvalidGameRatingParams)
+	}
+	return nil
+}
+
+// gameRatingParamsAt reads the version of a game's parameters in effect at
+// date; a game without one gets no overrides.
+func gameRatingParamsAt(ctx context.Context, q *db.Queries, gameID string, date time.Time) (GameRatingParams, error) {
+	row, err := q.GetGameRatingParamsForDate(ctx, db.GetGameRatingParamsForDateParams{GameID: gameID, EffectiveDate: date})
+	if db.IsNoRows(err) {
+		return GameRatingParams{}, nil
+	}
+	if err != nil {
+		return GameRatingParams{}, fmt.Errorf("get rating params of game %s: %w", gameID, err)
+	}
+	return gameRatingParamsFromDB(row), nil
+}
+
+// SetGameRatingParams stores a version of a game's rating parameters and
+// replays history from its effective date, or from the game's first match
+// when that is later. A version with every field nil reverts the game to
+// elo_settings from that date.
+func (s *MatchService) SetGameRatingParams(ctx context.Context, gameID string, params GameRatingParams) error {
+	if err := validateGameRatingParams(params); err != nil {
+		return err
+	}
+	return runInTx(ctx, s.Pool, func(q *db.Queries) error {
+		if _, err := q.GetGameByID(ctx, gameID); err != nil {
+			return fmt.Errorf("get game %s: %w", gameID, err)
+		}
+		var before *db.GameRatingParam
+		row, err := q.GetGameRatingParamsForDate(ctx, db.GetGameRatingParamsForDateParams{GameID: gameID, EffectiveDate: params.EffectiveDate})
+		switch {
+		case err == nil:
+			before = &row
+		case !db.IsNoRows(err):
+			return fmt.Errorf("get rating params of game %s: %w", gameID, err)
+		}
+		after, err := q.UpsertGameRatingParams(ctx, db.UpsertGameRatingParamsParams{
+			GameID:        gameID,
+			EffectiveDate: params.EffectiveDate,
+			EloConstK:     float8Of(params.K),
+			EloConstD:     float8Of(params.D),
+			WinReward:     float8Of(params.WinReward),
+		})
+		if err != nil {
+			return fmt.Errorf("store rating params of game %s: %w", gameID, err)
+		}
+		if err := recordAudit(ctx, q, AuditEntityGame, gameID, AuditActionRatingParams, auditValue(before), after); err != nil {
+			return err
+		}
+
+		firstDate, err := q.GetFirstMatchDateByGame(ctx, gameID)
+		if db.IsNoRows(err) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("get first match date for game %s: %w", gameID, err)
+		}
+		from := params.EffectiveDate
+		if firstDate.Time.After(from) {
+			from = firstDate.Time
+		}
+		return s.recalculateEloFromDate(ctx, q, from)
+	})
+}
+
+// ListGameRatingParams returns the versions of a game's rating parameters,
+// newest first.
+func (s *GameService) ListGameRatingParams(ctx context.Context, gameID string) ([]GameRatingParams, error) {
+	rows, err := s.Queries.ListGameRatingParamsByGame(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("list rating params of game %s: %w", gameID, err)
+	}
+	out := make([]GameRatingParams, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, gameRatingParamsFromDB(r))
+	}
+	return out, nil
+}
@@ -0,0 +1,61 @@
+package elo
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBuildEloResultsGameRatingParams(t *testing.T) {
+	elos := map[string]float64{"a": 1100, "b": 1000, "c": 900}
+	scores := map[string]float64{"a": 3, "b": 10, "c": 7}
+	k, d, winReward := 2*testK, 2*testD, 2.0
+
+	state := prevStateFor(elos, nil)
+	state.GameParams = GameRatingParams{K: &k, D: &d, WinReward: &winReward}
+	results := buildEloResults(scores, state)
+
+	wantGame := CalculateNewElo(elos, testStartingElo, scores, k, d, winReward)
+	// The global arena takes only K from the game.
+	wantGlobal := CalculateNewElo(elos, testStartingElo, scores, k, testD, testWinReward)
+	for id := range scores {
+		if !floatsEqual(results[id].newGameElo, wantGame[id]) {
+			t.Errorf("player %s: game elo %v, want %v", id, results[id].newGameElo, wantGame[id])
+		}
+		if !floatsEqual(results[id].newGlobalElo, wantGlobal[id]) {
+			t.Errorf("player %s: global elo %v, want %v", id, results[id].newGlobalElo, wantGlobal[id])
+		}
+	}
+
+	// A version without overrides rates like elo_settings.
+	state.GameParams = GameRatingParams{}
+	plain := buildEloResults(scores, state)
+	want := CalculateNewElo(elos, testStartingElo, scores, testK, testD, testWinReward)
+	for id := range scores {
+		if plain[id].newGameElo != want[id] || plain[id].newGlobalElo != want[id] {
+			t.Errorf("player %s: elo %v/%v without overrides, want %v", id, plain[id].newGlobalElo, plain[id].newGameElo, want[id])
+		}
+	}
+}
+
+func TestValidateGameRatingParams(t *testing.T) {
+	f := func(v float64) *float64 { return &v }
+	cases := []struct {
+		name    string
+		params  GameRatingParams
+		wantErr bool
+	}{
+		{"no overrides", GameRatingParams{}, false},
+		{"all overrides", GameRatingParams{K: f(64), D: f(400), WinReward: f(1.5)}, false},
+		{"zero k", GameRatingParams{K: f(0)}, true},
+		{"negative d", GameRatingParams{D: f(-1)}, true},
+		{"win reward too high", GameRatingParams{WinReward: f(6)}, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := validateGameRatingParams(c.params)
+			if c.wantErr != errors.Is(err, ErrInvalidGameRatingParams) {
+				t.Errorf("got %v, want error: %v", err, c.wantErr)
+			}
+		})
+	}
+}
@@ -26,6 +26,10 @@ type GameStatistics struct {
 	Name         string
 	TotalMatches int
 	Players      []GamePlayerStat
+	Scoring      GameScoring
+	// VirtualOpponentElo is the game's learned cooperative difficulty; nil
+	// until the game has a cooperative match.
+	VirtualOpponentElo *float64
 }
 
 type GameTitles struct {
@@ -55,7 +59,9 @@ type IGameService interface {
 	GetGameMatches(ctx context.Context, id string) ([]GameMatch, error)
 	DeleteGame(ctx context.Context, id string) (*db.Game, error)
 	UpdateGameName(ctx context.Context, id string, name string) (*db.Game, error)
+	GetGameByID(ctx context.Context, id string) (*db.Game, error)
 	AddGame(ctx context.Context, id, name string) (*db.Game, error)
+	ListGameRatingParams(ctx context.Context, gameID string) ([]GameRatingParams, error)
 }
 
 type GameService struct {
@@ -101,17 +107,14 @@ func (s *GameService) GetGameStatistics(ctx context.Context, id string) (*GameSt
 		return nil, fmt.Errorf("unable to get match count: %w", err)
 	}
 
-	// Get game name from the games list (reuse existing query)
-	gameRows, err := s.Queries.ListGamesOrderedByLastPlayed(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("unable to get game name: %w", err)
-	}
 	gameName := id
-	for _, g := range gameRows {
-		if g.ID == id {
-			gameName = g.Name
-			break
-		}
+	scoring := GameScoring{Direction: ScoringHigherWins, ResultType: ResultTypeScore}
+	game, err := s.Queries.GetGameByID(ctx, id)
+	if err == nil {
+		gameName = game.Name
+		scoring = GameScoringOf(game)
+	} else if !db.IsNoRows(err) {
+		return nil, fmt.Errorf("unable to get game: %w", err)
 	}
 
 	settingsRow, err := s.Queries.GetEloSettingsForDate(ctx, pgtype.Timestamptz{Time: time.Now(), Valid: true})
@@ -170,11 +173,20 @@ func (s *GameService) GetGameStatistics(ctx context.Context, id string) (*GameSt
 		rank++
 	}
 
+	var virtualOpponentElo *float64
+	if v, err := s.Queries.GetGameVirtualOpponentElo(ctx, id); err == nil {
+		virtualOpponentElo = &v
+	} else if !db.IsNoRows(err) {
+		return nil, fmt.Errorf("unable to get virtual opponent elo: %w", err)
+	}
+
 	return &GameStatistics{
-		Id:           id,
-		Name:         gameName,
-		TotalMatches: int(totalMatches),
-		Players:      players,
+		Id:                 id,
+		Name:               gameName,
+		TotalMatches:       int(totalMatches),
+		Players:            players,
+		Scoring:            scoring,
+		VirtualOpponentElo: virtualOpponentElo,
 	}, nil
 }
 
@@ -236,6 +248,14 @@ func (s *GameService) UpdateGameName(ctx context.Context, id string, name string
 	return &g, nil
 }
 
+func (s *GameService) GetGameByID(ctx context.Context, id string) (*db.Game, error) {
+	g, err := s.Queries.GetGameByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &g, nil
+}
+
 func (s *GameService) AddGame(ctx context.Context, id, name string) (*db.Game, error) {
 	g, err := s.Queries.AddGame(ctx, db.AddGameParams{
 		ID:   id,
@@ -0,0 +1,124 @@
+package elo
+
+import "math"
+
+// glicko2Scale converts between the Elo scale and the Glicko-2 scale
+// (400 / ln 10, the same logistic slope as Elo with D = 400).
+const glicko2Scale = 173.7178
+
+// glicko2Epsilon is the convergence tolerance of the volatility iteration.
+const glicko2Epsilon = 1e-6
+
+// glicko2Algorithm is Glicko-2 (Glickman, "Example of the Glicko-2 system").
+// A match is one rating period in which every other side is an opponent:
+// a higher ranking score is a win (1), an equal one a draw (0.5).
+type glicko2Algorithm struct {
+	startingRating     float64
+	startingDeviation  float64
+	startingVolatility float64
+	tau                float64
+}
+
+func (a glicko2Algorithm) Starting() Skill {
+	return Skill{Rating: a.startingRating, Deviation: a.startingDeviation, Volatility: a.startingVolatility}
+}
+
+func (a glicko2Algorithm) Rate(prev map[string]Skill, scores map[string]float64) map[string]SkillChange {
+	start := a.Starting()
+	out := make(map[string]SkillChange, len(scores))
+	for key, score := range scores {
+		self := withStartingUncertainty(prev[key], start)
+		mu := (self.Rating - a.startingRating) / glicko2Scale
+		phi := self.Deviation / glicko2Scale
+
+		// Step 3–4: estimated variance and the expected / actual sums of g(φj)·s.
+		var invV, expected, actual float64
+		for other, otherScore := range scores {
+			if other == key {
+				continue
+			}
+			opp := withStartingUncertainty(prev[other], start)
+			g := glicko2G(opp.Deviation / glicko2Scale)
+			e := 1 / (1 + math.Exp(-g*(mu-(opp.Rating-a.startingRating)/glicko2Scale)))
+			invV += g * g * e * (1 - e)
+			expected += g * e
+			actual += g * glicko2Outcome(score, otherScore)
+		}
+		if invV == 0 {
+			out[key] = SkillChange{After: self}
+			continue
+		}
+		v := 1 / invV
+		delta := v * (actual - expected)
+
+		// Steps 5–7: new volatility, pre-period deviation, new deviation and rating.
+		volatility := a.newVolatility(phi, self.Volatility, v, delta)
+		phiStar := math.Sqrt(phi*phi + volatility*volatility)
+		newPhi := 1 / math.Sqrt(1/(phiStar*phiStar)+1/v)
+
+		staked := -glicko2Scale * newPhi * newPhi * expected
+		earned := glicko2Scale * newPhi * newPhi * actual
+		out[key] = SkillChange{
+			After: Skill{
+				Rating:     self.Rating + staked + earned,
+				Deviation:  math.Min(glicko2Scale*newPhi, a.startingDeviation),
+				Volatility: volatility,
+			},
+			Staked: staked,
+			Earned: earned,
+		}
+	}
+	return out
+}
+
+// newVolatility is step 5 of Glicko-2: the Illinois iteration for σ′.
+func (a glicko2Algorithm) newVolatility(phi, sigma, v, delta float64) float64 {
+	alpha := math.Log(sigma * sigma)
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		d := phi*phi + v + ex
+		return ex*(delta*delta-phi*phi-v-ex)/(2*d*d) - (x-alpha)/(a.tau*a.tau)
+	}
+
+	lo := alpha
+	var hi float64
+	if delta*delta > phi*phi+v {
+		hi = math.Log(delta*delta - phi*phi - v)
+	} else {
+		k := 1.0
+		for f(alpha-k*a.tau) < 0 {
+			k++
+		}
+		hi = alpha - k*a.tau
+	}
+
+	fLo, fHi := f(lo), f(hi)
+	for math.Abs(hi-lo) > glicko2Epsilon {
+		c := lo + (lo-hi)*fLo/(fHi-fLo)
+		fC := f(c)
+		if fC*fHi <= 0 {
+			lo, fLo = hi, fHi
+		} else {
+			fLo /= 2
+		}
+		hi, fHi = c, fC
+	}
+	return math.Exp(lo / 2)
+}
+
+// glicko2G dampens an opponent's impact by their rating deviation.
+func glicko2G(phi float64) float64 {
+	return 1 / math.Sqrt(1+3*phi*phi/(math.Pi*math.Pi))
+}
+
+// glicko2Outcome is a side's result against one opponent.
+func glicko2Outcome(score, opponentScore float64) float64 {
+	switch {
+	case score > opponentScore:
+		return 1
+	case score < opponentScore:
+		return 0
+	default:
+		return 0.5
+	}
+}
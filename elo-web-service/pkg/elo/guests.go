@@ -0,0 +1,132 @@
+package elo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+// Guests take part in matches like anyone else: their scores are recorded and
+// rank them against the other players, so everyone else is settled as if they
+// had met a newcomer. A guest's own rating never moves off the starting
+// values, and no settlement is written for them in any arena.
+
+// SetPlayerGuest turns the guest flag of a player on or off and replays
+// history from the player's first event, so their past matches either stop
+// or start moving ratings, theirs and their opponents'. A player with bets,
+// market guarantees or corrections cannot become a guest
+// (ErrGuestHasStakes): those settle in the global arena whatever the flag.
+func (s *MatchService) SetPlayerGuest(ctx context.Context, playerID string, guest bool) (db.Player, error) {
+	var updated db.Player
+	err := runInTx(ctx, s.Pool, func(q *db.Queries) error {
+		var err error
+		updated, err = setPlayerGuest(ctx, q, s.recalculateEloFromDate, playerID, guest)
+		return err
+	})
+	return updated, err
+}
+
+// UpdatePlayer renames a player and, when guest is set, changes the guest
+// flag as SetPlayerGuest does, in one transaction.
+func (s *MatchService) UpdatePlayer(ctx context.Context, playerID, name string, guest *bool) (db.Player, error) {
+	var updated db.Player
+	err := runInTx(ctx, s.Pool, func(q *db.Queries) error {
+		var err error
+		if updated, err = q.UpdatePlayer(ctx, db.UpdatePlayerParams{ID: playerID, Name: name}); err != nil {
+			return err
+		}
+		if guest != nil {
+			updated, err = setPlayerGuest(ctx, q, s.recalculateEloFromDate, playerID, *guest)
+		}
+		return err
+	})
+	return updated, err
+}
+
+func setPlayerGuest(ctx context.Context, q *db.Queries, recalculate func(context.Context, *db.Queries, time.Time) error, playerID string, guest bool) (db.Player, error) {
+	if _, err := q.LockPlayerForEloCalculation(ctx, playerID); err != nil {
+		return db.Player{}, fmt.Errorf("lock player %s: %w", playerID, err)
+	}
+	before, err := q.GetPlayer(ctx, playerID)
+	if err != nil {
+		return db.Player{}, fmt.Errorf("get player %s: %w", playerID, err)
+	}
+	if before.IsGuest == guest {
+		return before, nil
+	}
+	if guest {
+		hasStakes, err := q.PlayerHasRatingStakes(ctx, playerID)
+		if err != nil {
+			return db.Player{}, fmt.Errorf("check stakes of player %s: %w", playerID, err)
+		}
+		if hasStakes {
+			return db.Player{}, ErrGuestHasStakes
+		}
+	}
+	updated, err := q.SetPlayerGuest(ctx, db.SetPlayerGuestParams{ID: playerID, IsGuest: guest})
+	if err != nil {
+		return db.Player{}, fmt.Errorf("set guest flag of player %s: %w", playerID, err)
+	}
+
+	from, err := q.GetPlayerFirstEventDate(ctx, playerID)
+	switch {
+	case err == nil:
+		if err := recalculate(ctx, q, from); err != nil {
+			return db.Player{}, fmt.Errorf("unable to recalculate Elo: %w", err)
+		}
+	case !db.IsNoRows(err):
+		return db.Player{}, fmt.Errorf("get first event of player %s: %w", playerID, err)
+	}
+	if err := RecalculateBetLimits(ctx, q, []string{playerID}); err != nil {
+		return db.Player{}, fmt.Errorf("recalculate bet limits: %w", err)
+	}
+	if err := recordAudit(ctx, q, AuditEntityPlayer, playerID, AuditActionUpdate, before, updated); err != nil {
+		return db.Player{}, err
+	}
+	return updated, nil
+}
+
+// checkNotGuests returns ErrGuestNotRated when any of the players is a guest.
+func checkNotGuests(ctx context.Context, q *db.Queries, playerIDs []string) error {
+	guests, err := listGuests(ctx, q, playerIDs)
+	if err != nil {
+		return err
+	}
+	if len(guests) > 0 {
+		return ErrGuestNotRated
+	}
+	return nil
+}
+
+// listGuests returns which of the players are guests.
+func listGuests(ctx context.Context, q *db.Queries, playerIDs []string) (map[string]bool, error) {
+	ids, err := q.ListGuestsAmong(ctx, playerIDs)
+	if err != nil {
+		return nil, fmt.Errorf("list guests: %w", err)
+	}
+	return guestSet(ids), nil
+}
+
+func guestSet(ids []string) map[string]bool {
+	guests := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		guests[id] = true
+	}
+	return guests
+}
+
+// setGuestPrevState puts a guest at the starting values of both arenas, as a
+// player who has never been settled.
+func setGuestPrevState(state *MatchPrevState, playerID string) {
+	s := state.Settings
+	state.Elo[playerID] = s.StartingElo
+	state.Rating[playerID] = s.StartingRatingGlobal
+	state.League[playerID] = initialLeagueForStarting(s.StartingRatingGlobal, s.StartingElo, s)
+	state.GameElo[playerID] = s.StartingElo
+	state.GameRating[playerID] = s.StartingRatingGame
+	state.GameLeague[playerID] = initialLeagueForStarting(s.StartingRatingGame, s.StartingElo, s)
+	state.Count6M[playerID] = 0
+	state.Count2M[playerID] = 0
+}
@@ -0,0 +1,28 @@
+package elo
+
+import "testing"
+
+func TestSetGuestPrevStateRatesGuestAsNewcomer(t *testing.T) {
+	// The guest has a high rating from before they became a guest; it must
+	// not make beating them worth more.
+	state := prevStateFor(map[string]float64{"a": 1100, "g": 1400}, nil)
+	state.Count6M["g"], state.Count2M["g"] = 30, 10
+	setGuestPrevState(&state, "g")
+
+	if state.Elo["g"] != testStartingElo || state.GameElo["g"] != testStartingElo {
+		t.Fatalf("guest elo = %v / %v, want %v", state.Elo["g"], state.GameElo["g"], testStartingElo)
+	}
+	if state.Rating["g"] != testSettings.StartingRatingGlobal || state.GameRating["g"] != testSettings.StartingRatingGame {
+		t.Fatalf("guest rating = %v / %v, want the starting ratings", state.Rating["g"], state.GameRating["g"])
+	}
+	if state.Count6M["g"] != 0 || state.Count2M["g"] != 0 {
+		t.Fatalf("guest match counts = %d / %d, want 0", state.Count6M["g"], state.Count2M["g"])
+	}
+
+	scores := map[string]float64{"a": 10, "g": 5}
+	results := buildEloResults(scores, state)
+	want := CalculateNewElo(map[string]float64{"a": 1100, "g": testStartingElo}, testStartingElo, scores, testK, testD, testWinReward)
+	if !floatsEqual(results["a"].newGlobalElo, want["a"]) {
+		t.Errorf("player elo after beating a guest = %v, want %v", results["a"].newGlobalElo, want["a"])
+	}
+}
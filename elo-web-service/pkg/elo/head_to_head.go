@@ -0,0 +1,165 @@
+package elo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+// rivalsLimit is how many most common opponents Rivals lists.
+const rivalsLimit = 10
+
+// HeadToHeadRecord counts the shared matches of two players and who finished
+// above whom. RatingExchanged is the rating the player took from the other:
+// half the difference of their rating changes in every shared match both
+// were settled in, which is exactly the player's change in a two-player
+// match. Streak is the current run of matches one of them finished above the
+// other; a tie ends it.
+type HeadToHeadRecord struct {
+	Matches         int
+	PlayerAbove     int
+	OtherAbove      int
+	Ties            int
+	RatingExchanged float64
+	Streak          HeadToHeadStreak
+}
+
+// HeadToHeadStreak is a run of consecutive shared matches. LeaderID is nil
+// when the last shared match was a tie or there is none.
+type HeadToHeadStreak struct {
+	LeaderID *string
+	Length   int
+}
+
+// HeadToHeadGame is the record in one game, with the rating exchanged in the
+// game's arena.
+type HeadToHeadGame struct {
+	GameID   string
+	GameName string
+	HeadToHeadRecord
+}
+
+// HeadToHead is the record of two players over all their shared matches, with
+// the rating exchanged in the global arena, and per game.
+type HeadToHead struct {
+	PlayerID string
+	OtherID  string
+	HeadToHeadRecord
+	Games []HeadToHeadGame
+}
+
+// Opponent is someone the player has shared matches with.
+type Opponent struct {
+	PlayerID      string
+	Name          string
+	Matches       int
+	PlayerAbove   int
+	OpponentAbove int
+}
+
+// Rivals lists the player's most common opponents and their nemesis: the
+// opponent who finished above them most often among those with a winning
+// record against them, nil when there is none.
+type Rivals struct {
+	Opponents []Opponent
+	Nemesis   *Opponent
+}
+
+// HeadToHead compares two players over the matches they both played.
+func (s *PlayerService) HeadToHead(ctx context.Context, playerID, otherID string) (HeadToHead, error) {
+	if playerID == otherID {
+		return HeadToHead{}, ErrHeadToHeadSelf
+	}
+	for _, id := range []string{playerID, otherID} {
+		if _, err := s.Queries.GetPlayer(ctx, id); err != nil {
+			return HeadToHead{}, fmt.Errorf("get player %s: %w", id, err)
+		}
+	}
+	rows, err := s.Queries.ListHeadToHeadMatches(ctx, db.ListHeadToHeadMatchesParams{PlayerID: playerID, OtherID: otherID})
+	if err != nil {
+		return HeadToHead{}, fmt.Errorf("list head-to-head matches: %w", err)
+	}
+	return headToHead(playerID, otherID, rows), nil
+}
+
+// headToHead aggregates the shared matches, oldest first.
+func headToHead(playerID, otherID string, rows []db.ListHeadToHeadMatchesRow) HeadToHead {
+	result := HeadToHead{PlayerID: playerID, OtherID: otherID, Games: []HeadToHeadGame{}}
+	games := make(map[string]int)
+	for _, r := range rows {
+		result.add(playerID, otherID, r.PlayerAbove, r.OtherAbove, r.GlobalSettled, r.PlayerGlobalChange, r.OtherGlobalChange)
+
+		i, ok := games[r.GameID]
+		if !ok {
+			i = len(result.Games)
+			games[r.GameID] = i
+			result.Games = append(result.Games, HeadToHeadGame{GameID: r.GameID, GameName: r.GameName})
+		}
+		result.Games[i].add(playerID, otherID, r.PlayerAbove, r.OtherAbove, r.GameSettled, r.PlayerGameChange, r.OtherGameChange)
+	}
+	return result
+}
+
+func (h *HeadToHeadRecord) add(playerID, otherID string, playerAbove, otherAbove, settled bool, playerChange, otherChange float64) {
+	h.Matches++
+	var leader *string
+	switch {
+	case playerAbove:
+		h.PlayerAbove++
+		leader = &playerID
+	case otherAbove:
+		h.OtherAbove++
+		leader = &otherID
+	default:
+		h.Ties++
+	}
+	if settled {
+		h.RatingExchanged += (playerChange - otherChange) / 2
+	}
+
+	switch {
+	case leader == nil:
+		h.Streak = HeadToHeadStreak{}
+	case h.Streak.LeaderID != nil && *h.Streak.LeaderID == *leader:
+		h.Streak.Length++
+	default:
+		h.Streak = HeadToHeadStreak{LeaderID: leader, Length: 1}
+	}
+}
+
+// Rivals lists the player's most common opponents and their nemesis.
+func (s *PlayerService) Rivals(ctx context.Context, playerID string) (Rivals, error) {
+	if _, err := s.Queries.GetPlayer(ctx, playerID); err != nil {
+		return Rivals{}, fmt.Errorf("get player %s: %w", playerID, err)
+	}
+	rows, err := s.Queries.ListPlayerOpponents(ctx, playerID)
+	if err != nil {
+		return Rivals{}, fmt.Errorf("list opponents: %w", err)
+	}
+	return rivals(rows), nil
+}
+
+func rivals(rows []db.ListPlayerOpponentsRow) Rivals {
+	result := Rivals{Opponents: []Opponent{}}
+	for _, r := range rows {
+		o := Opponent{
+			PlayerID:      r.OpponentID,
+			Name:          r.Name,
+			Matches:       int(r.Matches),
+			PlayerAbove:   int(r.PlayerAbove),
+			OpponentAbove: int(r.OpponentAbove),
+		}
+		if len(result.Opponents) < rivalsLimit {
+			result.Opponents = append(result.Opponents, o)
+		}
+		if o.OpponentAbove <= o.PlayerAbove {
+			continue
+		}
+		if n := result.Nemesis; n == nil || o.OpponentAbove > n.OpponentAbove ||
+			(o.OpponentAbove == n.OpponentAbove && o.OpponentAbove-o.PlayerAbove > n.OpponentAbove-n.PlayerAbove) {
+			result.Nemesis = &o
+		}
+	}
+	return result
+}
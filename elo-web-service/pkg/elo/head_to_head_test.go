@@ -0,0 +1,58 @@
+package elo
+
+import (
+	"testing"
+
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+func TestHeadToHeadAggregatesMatches(t *testing.T) {
+	rows := []db.ListHeadToHeadMatchesRow{
+		{GameID: "chess", GameName: "Chess", PlayerAbove: true, GlobalSettled: true, PlayerGlobalChange: 10, OtherGlobalChange: -10, GameSettled: true, PlayerGameChange: 12, OtherGameChange: -12},
+		{GameID: "go", GameName: "Go", OtherAbove: true, GlobalSettled: true, PlayerGlobalChange: -6, OtherGlobalChange: 4, GameSettled: true, PlayerGameChange: -8, OtherGameChange: 8},
+		// A guest's match: nobody exchanged rating.
+		{GameID: "chess", GameName: "Chess", PlayerAbove: true, PlayerGlobalChange: 5},
+		{GameID: "chess", GameName: "Chess", PlayerAbove: true, GlobalSettled: true, PlayerGlobalChange: 2, OtherGlobalChange: -2, GameSettled: true, PlayerGameChange: 3, OtherGameChange: -3},
+	}
+	h := headToHead("p", "o", rows)
+
+	if h.Matches != 4 || h.PlayerAbove != 3 || h.OtherAbove != 1 || h.Ties != 0 {
+		t.Errorf("record = %+v, want 4 matches, 3 above, 1 below", h.HeadToHeadRecord)
+	}
+	if !floatsEqual(h.RatingExchanged, 10-5+2) {
+		t.Errorf("rating exchanged = %v, want 7", h.RatingExchanged)
+	}
+	if h.Streak.LeaderID == nil || *h.Streak.LeaderID != "p" || h.Streak.Length != 2 {
+		t.Errorf("streak = %+v, want p for 2 matches", h.Streak)
+	}
+	if len(h.Games) != 2 || h.Games[0].GameID != "chess" || h.Games[0].Matches != 3 || h.Games[0].Streak.Length != 3 {
+		t.Fatalf("games = %+v, want chess with 3 matches won in a row first", h.Games)
+	}
+	if !floatsEqual(h.Games[0].RatingExchanged, 15) || !floatsEqual(h.Games[1].RatingExchanged, -8) {
+		t.Errorf("game exchanges = %v, %v, want 15, -8", h.Games[0].RatingExchanged, h.Games[1].RatingExchanged)
+	}
+}
+
+func TestHeadToHeadTieEndsStreak(t *testing.T) {
+	h := headToHead("p", "o", []db.ListHeadToHeadMatchesRow{{PlayerAbove: true}, {}})
+	if h.Streak.LeaderID != nil || h.Streak.Length != 0 || h.Ties != 1 {
+		t.Errorf("streak after a tie = %+v (ties %d), want none", h.Streak, h.Ties)
+	}
+}
+
+func TestRivalsNemesisHasWinningRecord(t *testing.T) {
+	r := rivals([]db.ListPlayerOpponentsRow{
+		{OpponentID: "a", Matches: 20, PlayerAbove: 12, OpponentAbove: 8},
+		{OpponentID: "b", Matches: 9, PlayerAbove: 2, OpponentAbove: 7},
+		{OpponentID: "c", Matches: 8, PlayerAbove: 0, OpponentAbove: 7},
+	})
+	if len(r.Opponents) != 3 || r.Opponents[0].PlayerID != "a" {
+		t.Errorf("opponents = %+v, want the query order", r.Opponents)
+	}
+	if r.Nemesis == nil || r.Nemesis.PlayerID != "c" {
+		t.Errorf("nemesis = %+v, want c: as often above as b, with a better record", r.Nemesis)
+	}
+	if r := rivals([]db.ListPlayerOpponentsRow{{OpponentID: "a", Matches: 2, PlayerAbove: 1, OpponentAbove: 1}}); r.Nemesis != nil {
+		t.Errorf("nemesis = %+v, want none without a winning record", r.Nemesis)
+	}
+}
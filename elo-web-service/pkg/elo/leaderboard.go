@@ -0,0 +1,150 @@
+package elo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+// LeaderboardQuery selects the table GET /leaderboard returns.
+type LeaderboardQuery struct {
+	GameID string  // the game's arena; "" for the global arena
+	ClubID *string // only the club's current members, ranked among themselves
+}
+
+// LeaderboardEntry is a ranked player. The Previous fields are set only by
+// LeaderboardDiff, and stay nil for a player who was not on the earlier table.
+type LeaderboardEntry struct {
+	PlayerID       string
+	Name           string
+	Rank           int
+	Rating         float64
+	League         string
+	PreviousRank   *int
+	PreviousRating *float64
+}
+
+// Leaderboard lists the players settled in the arena as of at. Guests and
+// players without a settlement by then are left out.
+func (s *PlayerService) Leaderboard(ctx context.Context, at time.Time, query LeaderboardQuery) ([]LeaderboardEntry, error) {
+	members, err := clubMembers(ctx, s.Queries, query.ClubID)
+	if err != nil {
+		return nil, err
+	}
+	return leaderboard(ctx, s.Queries, at, query.GameID, members)
+}
+
+// LeaderboardDiff is the table as of at with every player's rank and rating
+// as of since, for "movers of the month" summaries.
+func (s *PlayerService) LeaderboardDiff(ctx context.Context, since, at time.Time, query LeaderboardQuery) ([]LeaderboardEntry, error) {
+	if !since.Before(at) {
+		return nil, ErrInvalidLeaderboardPeriod
+	}
+	members, err := clubMembers(ctx, s.Queries, query.ClubID)
+	if err != nil {
+		return nil, err
+	}
+	before, err := leaderboard(ctx, s.Queries, since, query.GameID, members)
+	if err != nil {
+		return nil, err
+	}
+	after, err := leaderboard(ctx, s.Queries, at, query.GameID, members)
+	if err != nil {
+		return nil, err
+	}
+	return diffLeaderboards(before, after), nil
+}
+
+// diffLeaderboards fills the Previous fields of the later table from the
+// earlier one.
+func diffLeaderboards(before, after []LeaderboardEntry) []LeaderboardEntry {
+	byID := make(map[string]LeaderboardEntry, len(before))
+	for _, e := range before {
+		byID[e.PlayerID] = e
+	}
+	for i, e := range after {
+		if b, ok := byID[e.PlayerID]; ok {
+			after[i].PreviousRank = &b.Rank
+			after[i].PreviousRating = &b.Rating
+		}
+	}
+	return after
+}
+
+// clubMembers returns the members of the club, or nil when no club is given.
+func clubMembers(ctx context.Context, q *db.Queries, clubID *string) (map[string]bool, error) {
+	if clubID == nil {
+		return nil, nil
+	}
+	rows, err := q.GetClub(ctx, *clubID)
+	if err != nil {
+		return nil, fmt.Errorf("get club %s: %w", *clubID, err)
+	}
+	if len(rows) == 0 {
+		return nil, ErrClubNotFound
+	}
+	members := make(map[string]bool, len(rows))
+	for _, r := range rows {
+		if r.PlayerID != nil {
+			members[*r.PlayerID] = true
+		}
+	}
+	return members, nil
+}
+
+// leaderboard ranks the players of the global arena (gameID "") or of a
+// game's arena as of at, keeping only members when it is not nil.
+func leaderboard(ctx context.Context, q *db.Queries, at time.Time, gameID string, members map[string]bool) ([]LeaderboardEntry, error) {
+	var players []Player
+	if gameID == "" {
+		all, err := playersWithRank(ctx, q, at)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range all {
+			if p.Settled && !p.Guest {
+				players = append(players, Player{ID: p.ID, Name: p.Name, Elo: p.Elo, League: p.League})
+			}
+		}
+	} else {
+		if _, err := q.GetGameByID(ctx, gameID); err != nil {
+			return nil, fmt.Errorf("get game %s: %w", gameID, err)
+		}
+		rows, err := q.ListGameRatingsAsOf(ctx, db.ListGameRatingsAsOfParams{
+			GameID: gameID,
+			Date:   pgtype.Timestamptz{Time: at, Valid: true},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list game ratings: %w", err)
+		}
+		for _, r := range rows {
+			players = append(players, Player{ID: r.PlayerID, Name: r.Name, Elo: r.GameRatingAfter, League: r.League})
+		}
+	}
+
+	if members != nil {
+		kept := players[:0]
+		for _, p := range players {
+			if members[p.ID] {
+				kept = append(kept, p)
+			}
+		}
+		players = kept
+	}
+	rankPlayers(players)
+
+	entries := make([]LeaderboardEntry, 0, len(players))
+	for _, p := range players {
+		entries = append(entries, LeaderboardEntry{
+			PlayerID: p.ID,
+			Name:     p.Name,
+			Rank:     *p.Rank,
+			Rating:   p.Elo,
+			League:   p.League,
+		})
+	}
+	return entries, nil
+}
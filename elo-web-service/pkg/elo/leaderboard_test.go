@@ -0,0 +1,53 @@
+package elo
+
+import "testing"
+
+func TestRankPlayersSharesRankOnlyWithinLeague(t *testing.T) {
+	players := []Player{
+		{ID: "newbie", Elo: 1100.2, League: "newbie"},
+		{ID: "amateur-low", Elo: 1000, League: "amateur"},
+		{ID: "amateur-tie", Elo: 1100.4, League: "amateur"},
+		{ID: "amateur-high", Elo: 1099.8, League: "amateur"},
+		{ID: "elite", Elo: 900, League: "elite"},
+	}
+	rankPlayers(players)
+
+	want := []struct {
+		id   string
+		rank int
+	}{
+		{"elite", 1},
+		{"amateur-tie", 2},
+		{"amateur-high", 2},
+		{"amateur-low", 4},
+		{"newbie", 5},
+	}
+	for i, w := range want {
+		if players[i].ID != w.id || *players[i].Rank != w.rank {
+			t.Errorf("position %d = %s rank %d, want %s rank %d", i, players[i].ID, *players[i].Rank, w.id, w.rank)
+		}
+	}
+}
+
+func TestDiffLeaderboards(t *testing.T) {
+	before := []LeaderboardEntry{
+		{PlayerID: "a", Rank: 1, Rating: 1200},
+		{PlayerID: "b", Rank: 2, Rating: 1100},
+	}
+	after := []LeaderboardEntry{
+		{PlayerID: "b", Rank: 1, Rating: 1250},
+		{PlayerID: "a", Rank: 2, Rating: 1180},
+		{PlayerID: "c", Rank: 3, Rating: 1000},
+	}
+	got := diffLeaderboards(before, after)
+
+	if got[0].PreviousRank == nil || *got[0].PreviousRank != 2 || *got[0].PreviousRating != 1100 {
+		t.Errorf("b previous = %v / %v, want rank 2, rating 1100", got[0].PreviousRank, got[0].PreviousRating)
+	}
+	if got[1].PreviousRank == nil || *got[1].PreviousRank != 1 || *got[1].PreviousRating != 1200 {
+		t.Errorf("a previous = %v / %v, want rank 1, rating 1200", got[1].PreviousRank, got[1].PreviousRating)
+	}
+	if got[2].PreviousRank != nil || got[2].PreviousRating != nil {
+		t.Errorf("c was not on the earlier table, got previous %v / %v", got[2].PreviousRank, got[2].PreviousRating)
+	}
+}
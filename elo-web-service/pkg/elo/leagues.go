@@ -0,0 +1,290 @@
+package elo
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// A league ladder (ADR-02) orders an arena's leagues from the lowest. A player
+// climbs while they meet the entry rules of the next league, falls back from a
+// "keep" league once its rules stop holding, and loses a league for every
+// inactivity period without a match.
+
+// Progression rule kinds available in arena ladder documents.
+const (
+	LeagueRuleCubes         = "cubes"
+	LeagueRuleMeeples       = "meeples"
+	LeagueRuleRating        = "rating"
+	LeagueRuleRecentMatches = "recent_matches"
+
+	// leagueRuleRatingGap is internal to the built-in arenas: the gap between
+	// true Elo and the display rating (ADR-03 newbie league).
+	leagueRuleRatingGap = "rating_gap"
+)
+
+// meeplesPerOpponentCap is the most meeples a single opponent can give.
+const meeplesPerOpponentCap = 2
+
+// LeagueProgress is a player's standing an arena's rules are checked against.
+type LeagueProgress struct {
+	Elo     float64
+	Rating  float64 // display rating; equals Elo in custom arenas
+	Cubes   float64
+	Meeples float64
+	// RecentMatches counts arena matches within the last N days, keyed by N.
+	RecentMatches map[int]int
+}
+
+// LeagueRuleConfig is one progression rule. Rules on a lower bound use Min;
+// rating_gap is an upper bound held in Max. Days is the window of
+// recent_matches.
+type LeagueRuleConfig struct {
+	Kind string  `json:"kind"`
+	Min  float64 `json:"min,omitempty"`
+	Max  float64 `json:"max,omitempty"`
+	Days int     `json:"days,omitempty"`
+}
+
+// LeagueConfig is one league of a ladder document.
+type LeagueConfig struct {
+	Name     string             `json:"name"`
+	Requires []LeagueRuleConfig `json:"requires,omitempty"`
+	// Keep: the player stays only while Requires holds.
+	Keep bool `json:"keep,omitempty"`
+	// InactivityDays: a league is lost per this many days without a match (0: never).
+	InactivityDays int `json:"inactivity_days,omitempty"`
+}
+
+// LeagueLadderConfig is the JSON document stored in arenas.league_ladder.
+type LeagueLadderConfig struct {
+	Leagues []LeagueConfig `json:"leagues"`
+}
+
+// leagueMeasure reads the value a rule kind compares with its bound.
+type leagueMeasure struct {
+	current func(p LeagueProgress, days int) float64
+	// upper: the rule holds while the value stays at or below Max.
+	upper bool
+	// builtin: not accepted in arena ladder documents.
+	builtin bool
+}
+
+var leagueMeasures = map[string]leagueMeasure{
+	LeagueRuleCubes:   {current: func(p LeagueProgress, _ int) float64 { return p.Cubes }},
+	LeagueRuleMeeples: {current: func(p LeagueProgress, _ int) float64 { return p.Meeples }},
+	LeagueRuleRating:  {current: func(p LeagueProgress, _ int) float64 { return p.Rating }},
+	LeagueRuleRecentMatches: {current: func(p LeagueProgress, days int) float64 {
+		return float64(p.RecentMatches[days])
+	}},
+	leagueRuleRatingGap: {current: func(p LeagueProgress, _ int) float64 { return p.Elo - p.Rating }, upper: true, builtin: true},
+}
+
+// Current is the player's value for the rule.
+func (r LeagueRuleConfig) Current(p LeagueProgress) float64 {
+	return leagueMeasures[r.Kind].current(p, r.Days)
+}
+
+// Met reports whether the player satisfies the rule.
+func (r LeagueRuleConfig) Met(p LeagueProgress) bool {
+	if leagueMeasures[r.Kind].upper {
+		return r.Current(p) <= r.Max
+	}
+	return r.Current(p) >= r.Min
+}
+
+// LeagueLadder is a validated ladder.
+type LeagueLadder struct {
+	leagues []LeagueConfig
+}
+
+// ParseLeagueLadder validates a ladder document; a NULL document (no leagues)
+// yields a nil ladder.
+func ParseLeagueLadder(raw json.RawMessage) (*LeagueLadder, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var cfg LeagueLadderConfig
+	if err := json.Unmarshal(raw, &cfg); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidLeagueLadder, err)
+	}
+	return NewLeagueLadder(cfg)
+}
+
+// NewLeagueLadder validates a ladder configuration.
+func NewLeagueLadder(cfg LeagueLadderConfig) (*LeagueLadder, error) {
+	if len(cfg.Leagues) == 0 {
+		return nil, fmt.Errorf("%w: нет ни одной лиги", ErrInvalidLeagueLadder)
+	}
+	seen := make(map[string]bool, len(cfg.Leagues))
+	for i, l := range cfg.Leagues {
+		if l.Name == "" || seen[l.Name] {
+			return nil, fmt.Errorf("%w: имя лиги %q пустое или повторяется", ErrInvalidLeagueLadder, l.Name)
+		}
+		seen[l.Name] = true
+		if i == 0 && (len(l.Requires) > 0 || l.Keep || l.InactivityDays > 0) {
+			return nil, fmt.Errorf("%w: у первой лиги не может быть условий", ErrInvalidLeagueLadder)
+		}
+		if l.InactivityDays < 0 {
+			return nil, fmt.Errorf("%w: отрицательный срок неактивности в лиге %s", ErrInvalidLeagueLadder, l.Name)
+		}
+		for _, r := range l.Requires {
+			m, ok := leagueMeasures[r.Kind]
+			if !ok || m.builtin {
+				return nil, fmt.Errorf("%w: неизвестное условие %q", ErrInvalidLeagueLadder, r.Kind)
+			}
+			if r.Min < 0 || (r.Kind == LeagueRuleRecentMatches) != (r.Days > 0) {
+				return nil, fmt.Errorf("%w: некорректное условие %q в лиге %s", ErrInvalidLeagueLadder, r.Kind, l.Name)
+			}
+		}
+	}
+	return &LeagueLadder{leagues: cfg.Leagues}, nil
+}
+
+// Config returns the ladder document.
+func (l *LeagueLadder) Config() LeagueLadderConfig {
+	return LeagueLadderConfig{Leagues: l.leagues}
+}
+
+// Windows lists the recent_matches windows (in days) the ladder's rules need.
+func (l *LeagueLadder) Windows() []int {
+	var days []int
+	for _, league := range l.leagues {
+		for _, r := range league.Requires {
+			if r.Days > 0 && !slices.Contains(days, r.Days) {
+				days = append(days, r.Days)
+			}
+		}
+	}
+	return days
+}
+
+func (l *LeagueLadder) index(name string) int {
+	for i, league := range l.leagues {
+		if league.Name == name {
+			return i
+		}
+	}
+	return 0 // unknown (renamed) league: start from the bottom
+}
+
+func (l *LeagueLadder) meets(i int, p LeagueProgress) bool {
+	for _, r := range l.leagues[i].Requires {
+		if !r.Met(p) {
+			return false
+		}
+	}
+	return true
+}
+
+// settle climbs from league i while the next league's rules hold, then falls
+// back from "keep" leagues whose rules no longer hold.
+func (l *LeagueLadder) settle(i int, p LeagueProgress) int {
+	for i+1 < len(l.leagues) && l.meets(i+1, p) {
+		i++
+	}
+	for i > 0 && l.leagues[i].Keep && !l.meets(i, p) {
+		i--
+	}
+	return i
+}
+
+// Initial is the league of a player without arena history.
+func (l *LeagueLadder) Initial(p LeagueProgress) string {
+	return l.leagues[l.settle(0, p)].Name
+}
+
+// Effective is the league a player holds at a point in time given their last
+// stored league: a league is lost per full inactivity period since the last
+// match (idle), and "keep" leagues whose rules no longer hold are left.
+// It never promotes — promotion happens only when a match is settled.
+func (l *LeagueLadder) Effective(stored string, p LeagueProgress, idle time.Duration) string {
+	i := l.index(stored)
+	for i > 0 && l.leagues[i].InactivityDays > 0 {
+		period := time.Duration(l.leagues[i].InactivityDays) * 24 * time.Hour
+		if idle < period {
+			break
+		}
+		idle -= period
+		i--
+	}
+	for i > 0 && l.leagues[i].Keep && !l.meets(i, p) {
+		i--
+	}
+	return l.leagues[i].Name
+}
+
+// After is the league after a settlement, from the effective league before it.
+func (l *LeagueLadder) After(prev string, p LeagueProgress) string {
+	return l.leagues[l.settle(l.index(prev), p)].Name
+}
+
+// Next returns the league above the given one; ok is false at the top.
+func (l *LeagueLadder) Next(name string) (LeagueConfig, bool) {
+	i := l.index(name)
+	if i+1 >= len(l.leagues) {
+		return LeagueConfig{}, false
+	}
+	return l.leagues[i+1], true
+}
+
+// addMeeples returns the meeple total after a match: perOpponent holds the
+// meeples each opponent has given so far, opponents are the other rated
+// players of the match (n−1 of them). Every opponent gives at most
+// meeplesPerOpponentCap in total.
+func addMeeples(perOpponent map[string]float64, opponents []string) float64 {
+	given := make(map[string]float64, len(perOpponent)+len(opponents))
+	for id, m := range perOpponent {
+		given[id] = m
+	}
+	for _, id := range opponents {
+		given[id] += 1 / float64(len(opponents))
+	}
+	total := 0.0
+	for _, m := range given {
+		total += min(m, meeplesPerOpponentCap)
+	}
+	return total
+}
+
+// ADR-03 leagues of the built-in arenas, expressed as ladders. They are not
+// configurable: the newbie league drives the display rating.
+const (
+	leagueNewbie  = "newbie"
+	leagueAmateur = "amateur"
+	leagueElite   = "elite"
+)
+
+// Elite windows: GetPlayerGlobalMatchCountInPeriod is asked for 6 and 2 months.
+const (
+	eliteWindow6M = 6 * 30
+	eliteWindow2M = 2 * 30
+)
+
+func globalLeagueLadder(s EloSettings) *LeagueLadder {
+	return &LeagueLadder{leagues: []LeagueConfig{
+		{Name: leagueNewbie},
+		{Name: leagueAmateur, Requires: []LeagueRuleConfig{{Kind: leagueRuleRatingGap, Max: s.NewbieLeagueGoalGap}}},
+		{Name: leagueElite, Keep: true, Requires: []LeagueRuleConfig{
+			{Kind: LeagueRuleRecentMatches, Days: eliteWindow6M, Min: float64(s.EliteMatches6M)},
+			{Kind: LeagueRuleRecentMatches, Days: eliteWindow2M, Min: float64(s.EliteMatches2M)},
+		}},
+	}}
+}
+
+func gameLeagueLadder(s EloSettings) *LeagueLadder {
+	return &LeagueLadder{leagues: []LeagueConfig{
+		{Name: leagueNewbie},
+		{Name: leagueAmateur, Requires: []LeagueRuleConfig{{Kind: leagueRuleRatingGap, Max: s.NewbieLeagueGoalGap}}},
+	}}
+}
+
+// eliteProgress is the part of LeagueProgress the built-in ladders read.
+func eliteProgress(rating, elo float64, count6M, count2M int) LeagueProgress {
+	return LeagueProgress{
+		Elo:           elo,
+		Rating:        rating,
+		RecentMatches: map[int]int{eliteWindow6M: count6M, eliteWindow2M: count2M},
+	}
+}
@@ -0,0 +1,177 @@
+package elo
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+// The built-in arenas keep their ADR-03 league rules on the ladder engine.
+func TestGlobalLadderMatchesADR03(t *testing.T) {
+	s := testSettings
+	s.EliteMatches6M, s.EliteMatches2M = 20, 5
+
+	cases := []struct {
+		name             string
+		prev             string
+		rating, elo      float64
+		count6M, count2M int
+		want             string
+	}{
+		{"newbie below goal", leagueNewbie, 900, 1000, 30, 10, leagueNewbie},
+		{"newbie reaches goal", leagueNewbie, 950, 1000, 0, 0, leagueAmateur},
+		{"newbie straight to elite", leagueNewbie, 960, 1000, 20, 5, leagueElite},
+		{"amateur ignores the gap", leagueAmateur, 500, 1000, 0, 0, leagueAmateur},
+		{"amateur to elite", leagueAmateur, 1000, 1000, 20, 5, leagueElite},
+		{"elite without 2 months", leagueElite, 1000, 1000, 20, 4, leagueAmateur},
+		{"elite stays", leagueElite, 1000, 1000, 25, 6, leagueElite},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := determineGlobalLeague(tc.prev, tc.rating, tc.elo, tc.count6M, tc.count2M, s); got != tc.want {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+
+	if got := effectiveLeague(leagueElite, 4, 20, s); got != leagueAmateur {
+		t.Errorf("elite below 2-month threshold: got %s, want amateur", got)
+	}
+	if got := effectiveLeague(leagueNewbie, 100, 100, s); got != leagueNewbie {
+		t.Errorf("effective league never promotes: got %s", got)
+	}
+	if got := determineGameLeague(leagueAmateur, 0, 1000, s); got != leagueAmateur {
+		t.Errorf("game amateur is never demoted: got %s", got)
+	}
+	if got := initialLeagueForStarting(1000, 1000, s); got != leagueAmateur {
+		t.Errorf("no starting gap: got %s, want amateur", got)
+	}
+	if got := initialLeagueForStarting(0, 1000, s); got != leagueNewbie {
+		t.Errorf("starting gap: got %s, want newbie", got)
+	}
+}
+
+func testLadder(t *testing.T) *LeagueLadder {
+	t.Helper()
+	ladder, err := ParseLeagueLadder(json.RawMessage(`{"leagues": [
+		{"name": "bronze"},
+		{"name": "silver", "requires": [{"kind": "cubes", "min": 3}], "inactivity_days": 30},
+		{"name": "gold", "requires": [{"kind": "meeples", "min": 4}, {"kind": "rating", "min": 1100}], "inactivity_days": 30},
+		{"name": "master", "requires": [{"kind": "recent_matches", "days": 7, "min": 2}], "keep": true}
+	]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ladder
+}
+
+func TestLeagueLadderProgression(t *testing.T) {
+	ladder := testLadder(t)
+	day := 24 * time.Hour
+	active := map[int]int{7: 2}
+
+	cases := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"climbs one league", ladder.After("bronze", LeagueProgress{Cubes: 3}), "silver"},
+		{"climbs several leagues", ladder.After("bronze", LeagueProgress{Cubes: 5, Meeples: 4, Rating: 1100, RecentMatches: active}), "master"},
+		{"needs every rule", ladder.After("silver", LeagueProgress{Cubes: 9, Meeples: 9, Rating: 1099}), "silver"},
+		{"no demotion without keep", ladder.After("gold", LeagueProgress{}), "gold"},
+		{"keep league is left", ladder.After("master", LeagueProgress{RecentMatches: map[int]int{7: 1}}), "gold"},
+		{"unknown league starts at the bottom", ladder.After("platinum", LeagueProgress{}), "bronze"},
+		{"initial league", ladder.Initial(LeagueProgress{Rating: 1000}), "bronze"},
+		{"inactivity below the period", ladder.Effective("gold", LeagueProgress{}, 29*day), "gold"},
+		{"one period costs one league", ladder.Effective("gold", LeagueProgress{}, 30*day), "silver"},
+		{"two periods", ladder.Effective("gold", LeagueProgress{}, 60*day), "bronze"},
+		{"keep league has no inactivity period", ladder.Effective("master", LeagueProgress{RecentMatches: active}, 365*day), "master"},
+		{"effective never promotes", ladder.Effective("bronze", LeagueProgress{Cubes: 10}, 0), "bronze"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.got != tc.want {
+				t.Errorf("got %s, want %s", tc.got, tc.want)
+			}
+		})
+	}
+
+	if next, ok := ladder.Next("silver"); !ok || next.Name != "gold" {
+		t.Errorf("next after silver: got %v (ok=%v)", next.Name, ok)
+	}
+	if _, ok := ladder.Next("master"); ok {
+		t.Error("master is the top league")
+	}
+	if got := ladder.Windows(); len(got) != 1 || got[0] != 7 {
+		t.Errorf("windows: got %v, want [7]", got)
+	}
+}
+
+func TestParseLeagueLadderValidation(t *testing.T) {
+	if ladder, err := ParseLeagueLadder(json.RawMessage(`null`)); ladder != nil || err != nil {
+		t.Fatalf("null document: got %v, %v", ladder, err)
+	}
+
+	invalid := map[string]string{
+		"no leagues":          `{"leagues": []}`,
+		"duplicate name":      `{"leagues": [{"name": "a"}, {"name": "a"}]}`,
+		"first league rules":  `{"leagues": [{"name": "a", "requires": [{"kind": "cubes", "min": 1}]}]}`,
+		"unknown kind":        `{"leagues": [{"name": "a"}, {"name": "b", "requires": [{"kind": "wins", "min": 1}]}]}`,
+		"built-in kind":       `{"leagues": [{"name": "a"}, {"name": "b", "requires": [{"kind": "rating_gap", "max": 1}]}]}`,
+		"window without days": `{"leagues": [{"name": "a"}, {"name": "b", "requires": [{"kind": "recent_matches", "min": 1}]}]}`,
+		"days on cubes":       `{"leagues": [{"name": "a"}, {"name": "b", "requires": [{"kind": "cubes", "min": 1, "days": 3}]}]}`,
+		"negative min":        `{"leagues": [{"name": "a"}, {"name": "b", "requires": [{"kind": "cubes", "min": -1}]}]}`,
+		"negative inactivity": `{"leagues": [{"name": "a"}, {"name": "b", "inactivity_days": -1}]}`,
+		"malformed document":  `{"leagues": 1}`,
+	}
+	for name, doc := range invalid {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseLeagueLadder(json.RawMessage(doc)); !errors.Is(err, ErrInvalidLeagueLadder) {
+				t.Errorf("got %v, want ErrInvalidLeagueLadder", err)
+			}
+		})
+	}
+}
+
+func TestAddMeeples(t *testing.T) {
+	// A 3-player match gives 1/2 meeple per opponent.
+	if got := addMeeples(nil, []string{"b", "c"}); !floatsEqual(got, 1) {
+		t.Errorf("first match: got %v, want 1", got)
+	}
+	// An opponent stops giving meeples at the cap.
+	if got := addMeeples(map[string]float64{"b": 2, "c": 0.5}, []string{"b"}); !floatsEqual(got, 2+0.5) {
+		t.Errorf("capped opponent: got %v, want 2.5", got)
+	}
+	if got := addMeeples(map[string]float64{"b": 5}, nil); !floatsEqual(got, meeplesPerOpponentCap) {
+		t.Errorf("stored total is capped: got %v", got)
+	}
+}
+
+func TestArenaPrevStateLeagueAfter(t *testing.T) {
+	ladder := testLadder(t)
+	matchDate := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	newPlayer := arenaPrevState{recent: map[int]int{7: 0}}
+	after := newPlayer.progressAfter(1010, []string{"b"})
+	if after.Cubes != 1 || after.RecentMatches[7] != 1 || !floatsEqual(after.Meeples, 1) {
+		t.Fatalf("first match progress: %+v", after)
+	}
+	if got := newPlayer.leagueAfter(ladder, 1000, after, matchDate); got != "bronze" {
+		t.Errorf("first match: got %s, want bronze", got)
+	}
+
+	// Away for two inactivity periods, then back with enough cubes for silver.
+	returning := arenaPrevState{
+		played:    true,
+		lastMatch: matchDate.AddDate(0, 0, -60),
+		elo:       1200,
+		league:    "gold",
+		cubes:     10,
+		recent:    map[int]int{7: 0},
+	}
+	after = returning.progressAfter(1210, []string{"b"})
+	if got := returning.leagueAfter(ladder, 1000, after, matchDate); got != "silver" {
+		t.Errorf("returning player: got %s, want silver", got)
+	}
+}
@@ -11,23 +11,52 @@ import (
 type MatchInfo struct {
 	Match          db.Match
 	ParticipantSet map[string]bool
+	// PlayerScoreMap holds ranking scores (higher is better): the raw scores
+	// converted by the game's scoring rules (GameScoring.RankingScores).
 	PlayerScoreMap map[string]float64
 	MaxScore       float64
+	// Teams maps player → team label for a team match; nil otherwise.
+	Teams map[string]string
+	// Cooperative is set for a cooperative match; nil otherwise.
+	Cooperative *Cooperative
 }
 
-// SoleWinnerID returns the single player holding the strict maximum score of
-// the match. When two or more players share the top score (a tie), there is no
-// sole winner and ok is false.
-func (m MatchInfo) SoleWinnerID() (string, bool) {
+// Winners returns the players of the single side holding the strict maximum
+// score: one player in an individual match, every member of the winning team
+// in a team match, the whole group in a won cooperative match. When two or
+// more sides share the top score (a tie), or the game beat a cooperative
+// group, there is no winner and ok is false.
+func (m MatchInfo) Winners() ([]string, bool) {
+	if m.Cooperative != nil {
+		if !m.Cooperative.Won {
+			return nil, false
+		}
+		return sortedKeys(m.ParticipantSet), true
+	}
+	sides := newMatchSides(m.PlayerScoreMap, m.Teams)
 	count := 0
 	winner := ""
-	for pid, score := range m.PlayerScoreMap {
+	for key, score := range sides.scores {
 		if score >= m.MaxScore {
 			count++
-			winner = pid
+			winner = key
 		}
 	}
-	return winner, count == 1
+	if count != 1 {
+		return nil, false
+	}
+	return sides.members[winner], true
+}
+
+// SoleWinnerID returns the single player who won the match. Team-aware: sides
+// are compared rather than players, so a tie between teams has no winner, and
+// a winning team of two or more players has no sole winner either (ok is false).
+func (m MatchInfo) SoleWinnerID() (string, bool) {
+	winners, ok := m.Winners()
+	if !ok || len(winners) != 1 {
+		return "", false
+	}
+	return winners[0], true
 }
 
 // SettleFunc settles a market with a given outcome within an active transaction.
@@ -83,3 +112,13 @@ type WinStreakCreateParams struct {
 	WinsRequired   int32
 	MaxLosses      *int32
 }
+
+// sortedKeys returns the keys of a set in ascending order.
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sortPlayerIDs(keys)
+	return keys
+}
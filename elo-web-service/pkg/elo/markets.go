@@ -219,6 +219,11 @@ func (s *MarketService) CreateMarket(ctx context.Context, params CreateMarketPar
 
 	q := s.Queries.WithTx(tx)
 
+	// The residual a guarantor absorbs is a global settlement, which guests
+	// never get.
+	if err := checkNotGuests(ctx, q, params.GuarantorPlayerIDs); err != nil {
+		return db.Market{}, err
+	}
 	market, err := q.CreateMarket(ctx, db.CreateMarketParams{
 		ID:         params.ID,
 		MarketType: params.MarketType,
@@ -244,6 +249,14 @@ func (s *MarketService) CreateMarket(ctx context.Context, params CreateMarketPar
 		}
 	}
 
+	after, err := q.GetMarket(ctx, market.ID)
+	if err != nil {
+		return db.Market{}, fmt.Errorf("get market: %w", err)
+	}
+	if err := recordAudit(ctx, q, AuditEntityMarket, market.ID, AuditActionCreate, nil, after); err != nil {
+		return db.Market{}, err
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return db.Market{}, fmt.Errorf("commit tx: %w", err)
 	}
@@ -282,6 +295,14 @@ func (s *MarketService) PlaceBet(ctx context.Context, id string, marketID string
 	if _, err := q.LockPlayerForEloCalculation(ctx, playerID); err != nil {
 		return PlaceBetOutcome{}, fmt.Errorf("lock player: %w", err)
 	}
+	// A payout would be a global settlement, which guests never get.
+	player, err := q.GetPlayer(ctx, playerID)
+	if err != nil {
+		return PlaceBetOutcome{}, fmt.Errorf("get player: %w", err)
+	}
+	if player.IsGuest {
+		return PlaceBetOutcome{}, ErrGuestCannotBet
+	}
 
 	market, err := q.GetMarket(ctx, marketID)
 	if err != nil {
@@ -428,14 +449,24 @@ func (s *MarketService) TriggerResolutionForMatch(ctx context.Context, q *db.Que
 		return fmt.Errorf("get scores for match %s: %w", matchID, err)
 	}
 
+	game, err := q.GetGameByID(ctx, match.GameID)
+	if err != nil {
+		return fmt.Errorf("get game %s: %w", match.GameID, err)
+	}
+
 	participantSet := make(map[string]bool)
-	playerScoreMap := make(map[string]float64)
-	maxScore := -1e18
+	rawScores := make(map[string]float64)
 	for _, s := range scores {
 		participantSet[s.PlayerID] = true
-		playerScoreMap[s.PlayerID] = s.Score
-		if s.Score > maxScore {
-			maxScore = s.Score
+		rawScores[s.PlayerID] = s.Score
+	}
+	// Winner detection works on ranking scores (higher is better), so lower_wins
+	// and placement games resolve markets correctly.
+	playerScoreMap := GameScoringOf(game).RankingScores(rawScores)
+	maxScore := -1e18
+	for _, score := range playerScoreMap {
+		if score > maxScore {
+			maxScore = score
 		}
 	}
 
@@ -444,6 +475,8 @@ func (s *MarketService) TriggerResolutionForMatch(ctx context.Context, q *db.Que
 		ParticipantSet: participantSet,
 		PlayerScoreMap: playerScoreMap,
 		MaxScore:       maxScore,
+		Teams:          teamsOf(scores),
+		Cooperative:    cooperativeOf(match),
 	}
 
 	settle := s.SettleMarket
@@ -753,6 +786,13 @@ func (s *MarketService) LockMarketBetting(ctx context.Context, marketID string)
 	if err := q.LockMarketBetting(ctx, marketID); err != nil {
 		return fmt.Errorf("lock market betting: %w", err)
 	}
+	after, err := q.GetMarket(ctx, marketID)
+	if err != nil {
+		return fmt.Errorf("get market: %w", err)
+	}
+	if err := recordAudit(ctx, q, AuditEntityMarket, marketID, AuditActionLock, market, after); err != nil {
+		return err
+	}
 
 	return tx.Commit(ctx)
 }
@@ -779,6 +819,11 @@ func (s *MarketService) ExpireOverdueMarkets(ctx context.Context) error {
 
 	q := s.Queries.WithTx(tx)
 
+	// Drops and resets due by now precede the settlements written below.
+	if err := applyTimedSettlements(ctx, q, time.Now()); err != nil {
+		return err
+	}
+
 	for marketType, handler := range marketTypeHandlers {
 		if err := handler.ResolutionTrigger().OnOverdue(ctx, q, s.SettleMarket); err != nil {
 			return fmt.Errorf("expire %s markets: %w", marketType, err)
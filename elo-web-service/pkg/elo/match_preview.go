@@ -0,0 +1,245 @@
+package elo
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+// MatchPreview is what AddMatch would settle for a match, including the
+// replay of later events for a backdated match. Nothing is persisted.
+type MatchPreview struct {
+	Players []PlayerMatchPreview
+	Markets []MarketPreview
+}
+
+// PlayerMatchPreview holds a player's arena changes from the previewed match.
+type PlayerMatchPreview struct {
+	PlayerID string
+	// Global is nil for a cooperative match that does not move the global arena.
+	Global *ArenaDelta
+	Game   *ArenaDelta
+}
+
+// ArenaDelta is a player's rating, Elo and league around one match settlement.
+// LeagueBefore is empty for the player's first match in the arena.
+type ArenaDelta struct {
+	RatingBefore float64
+	RatingAfter  float64
+	EloBefore    float64
+	EloAfter     float64
+	LeagueBefore string
+	LeagueAfter  string
+}
+
+// MarketPreview is a market whose resolution the previewed match changes.
+type MarketPreview struct {
+	MarketID          string
+	Status            string
+	ResolutionOutcome *string
+	Payouts           []MarketPayout
+}
+
+// MarketPayout is one settlement row of a resolved market; Role is "buyer" or
+// "guarantor".
+type MarketPayout struct {
+	PlayerID string
+	Role     string
+	Staked   float64
+	Earned   float64
+}
+
+// PreviewMatch runs the whole AddMatch flow in a transaction that is never
+// committed, so the result is exactly what saving the match would settle.
+func (s *MatchService) PreviewMatch(ctx context.Context, gameID string, playerScores map[string]float64, date time.Time, opts AddMatchOpts) (MatchPreview, error) {
+	if err := validateMatchPlayers(playerScores, opts.Teams, opts.Cooperative); err != nil {
+		return MatchPreview{}, err
+	}
+	if opts.ClientDate {
+		if err := validateNewMatchDate(time.Now(), date); err != nil {
+			return MatchPreview{}, err
+		}
+	}
+	if opts.ID == "" {
+		opts.ID = newSettlementID()
+	}
+
+	tx, err := s.Pool.Begin(ctx)
+	if err != nil {
+		return MatchPreview{}, fmt.Errorf("unable to begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	q := s.Queries.WithTx(tx)
+
+	marketsBefore, err := q.ListMarkets(ctx)
+	if err != nil {
+		return MatchPreview{}, fmt.Errorf("list markets: %w", err)
+	}
+
+	match, err := s.addMatchWithinTx(ctx, q, gameID, playerScores, date, opts)
+	if err != nil {
+		return MatchPreview{}, err
+	}
+
+	players, err := previewPlayers(ctx, q, match)
+	if err != nil {
+		return MatchPreview{}, err
+	}
+	markets, err := previewMarkets(ctx, q, marketsBefore)
+	if err != nil {
+		return MatchPreview{}, err
+	}
+	return MatchPreview{Players: players, Markets: markets}, nil
+}
+
+// previewPlayers reads the match's own global and game arena settlements.
+// Before-values are derived from the settlement deltas, so a decay reversal
+// written for the match is already included in them.
+func previewPlayers(ctx context.Context, q *db.Queries, match db.Match) ([]PlayerMatchPreview, error) {
+	byPlayer := make(map[string]*PlayerMatchPreview)
+	get := func(playerID string) *PlayerMatchPreview {
+		p, ok := byPlayer[playerID]
+		if !ok {
+			p = &PlayerMatchPreview{PlayerID: playerID}
+			byPlayer[playerID] = p
+		}
+		return p
+	}
+
+	global, err := q.ListGlobalArenaSettlementsByMatch(ctx, &match.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list global settlements of match %s: %w", match.ID, err)
+	}
+	for _, gs := range global {
+		delta := &ArenaDelta{
+			RatingBefore: gs.RatingAfter - gs.RatingStaked - gs.RatingEarned,
+			RatingAfter:  gs.RatingAfter,
+			EloBefore:    gs.EloAfter - gs.EloStaked - gs.EloEarned,
+			EloAfter:     gs.EloAfter,
+			LeagueAfter:  gs.League,
+		}
+		prev, err := q.GetPlayerLatestGlobalRatingBeforeMatch(ctx, db.GetPlayerLatestGlobalRatingBeforeMatchParams{
+			PlayerID: gs.PlayerID,
+			Date:     match.Date,
+			MatchID:  &match.ID,
+		})
+		if err != nil && !db.IsNoRows(err) {
+			return nil, fmt.Errorf("get league of player %s before match: %w", gs.PlayerID, err)
+		}
+		delta.LeagueBefore = prev.League
+		get(gs.PlayerID).Global = delta
+	}
+
+	game, err := q.ListGameArenaSettlementsByMatch(ctx, &match.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list game settlements of match %s: %w", match.ID, err)
+	}
+	for _, gs := range game {
+		delta := &ArenaDelta{
+			RatingBefore: gs.RatingAfter - gs.RatingStaked - gs.RatingEarned,
+			RatingAfter:  gs.RatingAfter,
+			EloBefore:    gs.EloAfter - gs.EloStaked - gs.EloEarned,
+			EloAfter:     gs.EloAfter,
+			LeagueAfter:  gs.League,
+		}
+		prev, err := q.GetPlayerLatestGameRatingBeforeMatch(ctx, db.GetPlayerLatestGameRatingBeforeMatchParams{
+			PlayerID: gs.PlayerID,
+			GameID:   gs.GameID,
+			Date:     match.Date,
+			MatchID:  &match.ID,
+		})
+		if err != nil && !db.IsNoRows(err) {
+			return nil, fmt.Errorf("get game league of player %s before match: %w", gs.PlayerID, err)
+		}
+		delta.LeagueBefore = prev.League
+		get(gs.PlayerID).Game = delta
+	}
+
+	ids := make([]string, 0, len(byPlayer))
+	for id := range byPlayer {
+		ids = append(ids, id)
+	}
+	sortPlayerIDs(ids)
+	players := make([]PlayerMatchPreview, 0, len(ids))
+	for _, id := range ids {
+		players = append(players, *byPlayer[id])
+	}
+	return players, nil
+}
+
+// previewMarkets returns the markets whose resolution differs from before the
+// match: newly resolved ones, and for a backdated match also ones the replay
+// resolved differently.
+func previewMarkets(ctx context.Context, q *db.Queries, before []db.ListMarketsRow) ([]MarketPreview, error) {
+	after, err := q.ListMarkets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list markets: %w", err)
+	}
+	old := make(map[string]db.ListMarketsRow, len(before))
+	for _, m := range before {
+		old[m.ID] = m
+	}
+
+	var markets []MarketPreview
+	for _, m := range after {
+		o, ok := old[m.ID]
+		if ok && !marketResolutionChanged(o, m) {
+			continue
+		}
+		if m.Status != "resolved" && m.Status != "cancelled" {
+			// Unsettled by the replay and not settled again: no payouts to show.
+			markets = append(markets, MarketPreview{MarketID: m.ID, Status: m.Status})
+			continue
+		}
+		payouts, err := marketPayouts(ctx, q, m.ID)
+		if err != nil {
+			return nil, err
+		}
+		markets = append(markets, MarketPreview{
+			MarketID:          m.ID,
+			Status:            m.Status,
+			ResolutionOutcome: m.ResolutionOutcome,
+			Payouts:           payouts,
+		})
+	}
+	slices.SortFunc(markets, func(a, b MarketPreview) int { return strings.Compare(a.MarketID, b.MarketID) })
+	return markets, nil
+}
+
+// marketResolutionChanged reports whether a market's status, resolution time
+// or winning outcome differs between two snapshots.
+func marketResolutionChanged(before, after db.ListMarketsRow) bool {
+	if before.Status != after.Status || !before.ResolvedAt.Time.Equal(after.ResolvedAt.Time) {
+		return true
+	}
+	if before.ResolutionOutcome == nil || after.ResolutionOutcome == nil {
+		return before.ResolutionOutcome != after.ResolutionOutcome
+	}
+	return *before.ResolutionOutcome != *after.ResolutionOutcome
+}
+
+func marketPayouts(ctx context.Context, q *db.Queries, marketID string) ([]MarketPayout, error) {
+	buyers, err := q.GetSettlementDetails(ctx, &marketID)
+	if err != nil {
+		return nil, fmt.Errorf("get settlement details of market %s: %w", marketID, err)
+	}
+	guarantors, err := q.GetMarketGuarantorPayouts(ctx, marketID)
+	if err != nil {
+		return nil, fmt.Errorf("get guarantor payouts of market %s: %w", marketID, err)
+	}
+	payouts := make([]MarketPayout, 0, len(buyers)+len(guarantors))
+	for _, b := range buyers {
+		payouts = append(payouts, MarketPayout{PlayerID: b.PlayerID, Role: "buyer", Staked: b.Staked, Earned: b.Earned})
+	}
+	for _, g := range guarantors {
+		payouts = append(payouts, MarketPayout{PlayerID: g.PlayerID, Role: "guarantor", Staked: g.Staked, Earned: g.Earned})
+	}
+	return payouts, nil
+}
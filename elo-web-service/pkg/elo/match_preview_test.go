@@ -0,0 +1,38 @@
+package elo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+func TestMarketResolutionChanged(t *testing.T) {
+	at := func(h int) pgtype.Timestamptz {
+		return pgtype.Timestamptz{Time: time.Date(2026, 5, 1, h, 0, 0, 0, time.UTC), Valid: true}
+	}
+	yes, no := "yes", "no"
+	resolved := db.ListMarketsRow{Status: "resolved", ResolvedAt: at(10), ResolutionOutcome: &yes}
+
+	cases := []struct {
+		name   string
+		before db.ListMarketsRow
+		after  db.ListMarketsRow
+		want   bool
+	}{
+		{"still open", db.ListMarketsRow{Status: "open"}, db.ListMarketsRow{Status: "open"}, false},
+		{"resolved by the match", db.ListMarketsRow{Status: "open"}, resolved, true},
+		{"replayed unchanged", resolved, db.ListMarketsRow{Status: "resolved", ResolvedAt: at(10), ResolutionOutcome: &yes}, false},
+		{"resolved earlier", resolved, db.ListMarketsRow{Status: "resolved", ResolvedAt: at(9), ResolutionOutcome: &yes}, true},
+		{"other winner", resolved, db.ListMarketsRow{Status: "resolved", ResolvedAt: at(10), ResolutionOutcome: &no}, true},
+		{"cancelled instead", resolved, db.ListMarketsRow{Status: "cancelled", ResolvedAt: at(10)}, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := marketResolutionChanged(tc.before, tc.after); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
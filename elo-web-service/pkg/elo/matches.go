@@ -44,6 +44,12 @@ type AddMatchOpts struct {
 	// that produced this match. Already validated by the caller (handler);
 	// stored verbatim alongside the match.
 	Calculator *CalculatorInput
+	// Teams maps player id → team label for a team match; nil for an
+	// individual match. Validated by validateTeams.
+	Teams map[string]string
+	// Cooperative marks a cooperative match (the group against the game);
+	// nil for a competitive match.
+	Cooperative *Cooperative
 }
 
 // CalculatorInput is the validated calculator state attached to a new match.
@@ -63,6 +69,12 @@ type UpdateMatchOpts struct {
 	//   - &CalculatorUpdate{Kind: nil} → clear calculator columns (set to NULL)
 	//   - &CalculatorUpdate{Kind: &k, Data: d} → replace with validated document
 	Calculator *CalculatorUpdate
+	// Teams replaces the match's team assignment: nil keeps the current teams
+	// of the players still in the match, an empty non-nil map makes it an
+	// individual match.
+	Teams map[string]string
+	// Cooperative replaces the match's cooperative outcome (nil → competitive).
+	Cooperative *Cooperative
 }
 
 // CalculatorUpdate describes a change to a match's calculator columns.
@@ -75,14 +87,86 @@ type CalculatorUpdate struct {
 
 type IMatchService interface {
 	AddMatch(ctx context.Context, gameID string, playerScores map[string]float64, date time.Time, opts AddMatchOpts) (db.Match, error)
+	// PreviewMatch runs AddMatch in a transaction that is always rolled back and
+	// reports what the match would settle. opts.ID is generated when empty.
+	PreviewMatch(ctx context.Context, gameID string, playerScores map[string]float64, date time.Time, opts AddMatchOpts) (MatchPreview, error)
+	// PredictMatch reports the expected score and win probability of every
+	// player of a proposed lineup from their current Elo.
+	PredictMatch(ctx context.Context, gameID string, playerIDs []string) ([]PlayerPrediction, error)
 	UpdateMatch(ctx context.Context, matchID string, gameID string, playerScores map[string]float64, date time.Time, opts UpdateMatchOpts) (db.Match, error)
+
+	// AddMatchWithOverride and UpdateMatchWithOverride are the admin variants
+	// of AddMatch and UpdateMatch: the date may be any past date, reason is
+	// required (ErrOverrideReasonRequired) and stored in the audit log, and
+	// the result reports the players and markets the replay changed.
+	AddMatchWithOverride(ctx context.Context, gameID string, playerScores map[string]float64, date time.Time, reason string, opts AddMatchOpts) (db.Match, HistoryChange, error)
+	UpdateMatchWithOverride(ctx context.Context, matchID string, gameID string, playerScores map[string]float64, date time.Time, reason string, opts UpdateMatchOpts) (db.Match, HistoryChange, error)
 	RecalculateAllGameElo(ctx context.Context) error
 
+	// DeleteMatch deletes a match and recalculates history from its date.
+	// Returns ErrMatchNotFound for an unknown match and ErrHistoryChangeConflict
+	// (or its betting-lock variant) when the replay would invalidate a bet.
+	DeleteMatch(ctx context.Context, matchID string) error
+
 	// DeleteMarketAndRecalculate hard-deletes an open market and recalculates
 	// Elo from the market's created_at date. Returns ErrMarketNotOpen if the
 	// market is already resolved or cancelled.
 	DeleteMarketAndRecalculate(ctx context.Context, marketID string) error
 
+	// UpdateGameScoring changes a game's scoring rules and recalculates Elo
+	// from the game's first match. Returns ErrInvalidPlacement when switching
+	// to placement results and an existing match does not hold valid places.
+	UpdateGameScoring(ctx context.Context, gameID string, scoring GameScoring) (db.Game, error)
+
+	// SetGameRatingParams stores a version of a game's K, D and win_reward
+	// overrides and recalculates Elo from its effective date. Returns
+	// ErrInvalidGameRatingParams for out-of-range values.
+	SetGameRatingParams(ctx context.Context, gameID string, params GameRatingParams) error
+
+	// MergePlayers moves everything of duplicateID over to targetID, deletes
+	// the duplicate and recalculates Elo from its first event. Returns
+	// ErrPlayerMergeConflict when both players played the same match or share
+	// a market or a linked user, and ErrPlayerMergeSelf when the ids are equal.
+	MergePlayers(ctx context.Context, targetID, duplicateID string) (db.Player, error)
+
+	// MergeGames moves the matches, market and arena game filters and
+	// calculator fallback game of duplicateID to targetID, deletes the
+	// duplicate and recalculates Elo from its first match. Returns
+	// ErrGameMergeSelf when the ids are equal.
+	MergeGames(ctx context.Context, targetID, duplicateID string) (db.Game, error)
+
+	// SetPlayerGuest marks a player as a guest or a regular player and
+	// recalculates Elo from their first event when the flag changes. Returns
+	// ErrGuestHasStakes for a player with bets, guarantees or corrections.
+	SetPlayerGuest(ctx context.Context, playerID string, guest bool) (db.Player, error)
+	// UpdatePlayer renames a player and, when guest is non-nil, applies
+	// SetPlayerGuest in the same transaction.
+	UpdatePlayer(ctx context.Context, playerID, name string, guest *bool) (db.Player, error)
+
+	// SimulateEloSettings replays the whole history under proposed settings in
+	// a rolled-back transaction and compares the result with today. Returns
+	// ErrInvalidEloSettings for unusable settings.
+	SimulateEloSettings(ctx context.Context, proposed EloSettings) (SettingsSimulation, error)
+
+	// BackfillAchievements evaluates the achievements over the whole history
+	// while none are stored yet.
+	BackfillAchievements(ctx context.Context) error
+
+	// ScheduleInactivityDecay applies due inactivity decay drops now and then
+	// every inactivityDecayInterval, so idle players decay without new events.
+	ScheduleInactivityDecay(ctx context.Context)
+
+	// ScheduleSettlementCheckpoints takes a settlement checkpoint whenever the
+	// latest one is settlementCheckpointInterval old, checking hourly.
+	ScheduleSettlementCheckpoints(ctx context.Context)
+
+	// CreateSettlementCheckpoint stores a checkpoint of the state before date.
+	CreateSettlementCheckpoint(ctx context.Context, date time.Time) (db.SettlementCheckpoint, error)
+
+	// VerifySettlementCheckpoints recomputes history from scratch in a
+	// rolled-back transaction and diffs it against every stored checkpoint.
+	VerifySettlementCheckpoints(ctx context.Context) (CheckpointVerification, error)
+
 	// Read-side queries used by the match list/detail handlers.
 	ListMatchesWithPlayersPaginated(ctx context.Context, arg db.ListMatchesWithPlayersPaginatedParams) ([]db.ListMatchesWithPlayersPaginatedRow, error)
 	GetMatchWithPlayers(ctx context.Context, id string) ([]db.GetMatchWithPlayersRow, error)
@@ -114,12 +198,20 @@ func calculatorColumnsFromUpdate(u *CalculatorUpdate) (pgtype.Text, pgtype.Int4,
 // AddMatch adds a single match with Elo calculations
 // Validates that game_id and all player_ids exist via foreign key constraints
 func (s *MatchService) AddMatch(ctx context.Context, gameID string, playerScores map[string]float64, date time.Time, opts AddMatchOpts) (db.Match, error) {
-	if len(playerScores) < 2 {
-		return db.Match{}, ErrTooFewPlayers
+	return s.addMatch(ctx, gameID, playerScores, date, opts, nil)
+}
+
+// addMatch implements AddMatch and AddMatchWithOverride. A non-nil change
+// marks an admin override: the date window is not checked and change receives
+// what the replay altered.
+func (s *MatchService) addMatch(ctx context.Context, gameID string, playerScores map[string]float64, date time.Time, opts AddMatchOpts, change *HistoryChange) (db.Match, error) {
+	if err := validateMatchPlayers(playerScores, opts.Teams, opts.Cooperative); err != nil {
+		return db.Match{}, err
 	}
 
-	if opts.ClientDate {
-		if err := validateNewMatchDate(time.Now(), date); err != nil {
+	now := time.Now()
+	if opts.ClientDate && change == nil {
+		if err := validateNewMatchDate(now, date); err != nil {
 			return db.Match{}, err
 		}
 	}
@@ -134,9 +226,64 @@ func (s *MatchService) AddMatch(ctx context.Context, gameID string, playerScores
 
 	q := s.Queries.WithTx(tx)
 
+	// A retried request finds its match already there and is not a new change.
+	retry := false
+	if opts.ID != "" {
+		_, err := q.GetMatch(ctx, opts.ID)
+		if err != nil && !db.IsNoRows(err) {
+			return db.Match{}, fmt.Errorf("get match %s: %w", opts.ID, err)
+		}
+		retry = err == nil
+	}
+
+	var before historySnapshot
+	if change != nil {
+		if before, err = takeHistorySnapshot(ctx, q, now); err != nil {
+			return db.Match{}, err
+		}
+	}
+
+	createdMatch, err := s.addMatchWithinTx(ctx, q, gameID, playerScores, date, opts)
+	if err != nil {
+		return db.Match{}, err
+	}
+
+	if change != nil {
+		after, err := takeHistorySnapshot(ctx, q, now)
+		if err != nil {
+			return db.Match{}, err
+		}
+		*change = diffHistory(before, after)
+	}
+
+	if !retry {
+		after, err := matchAuditSnapshot(ctx, q, createdMatch.ID)
+		if err != nil {
+			return db.Match{}, err
+		}
+		if err := recordAudit(ctx, q, AuditEntityMatch, createdMatch.ID, AuditActionCreate, nil, after); err != nil {
+			return db.Match{}, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return db.Match{}, fmt.Errorf("unable to commit tx: %w", err)
+	}
+
+	return createdMatch, nil
+}
+
+// addMatchWithinTx creates the match and settles it (or replays history from a
+// client-supplied date). Shared by AddMatch and PreviewMatch.
+func (s *MatchService) addMatchWithinTx(ctx context.Context, q *db.Queries, gameID string, playerScores map[string]float64, date time.Time, opts AddMatchOpts) (db.Match, error) {
+	if err := validateMatchResult(ctx, q, gameID, playerScores, opts.Teams, opts.Cooperative); err != nil {
+		return db.Match{}, err
+	}
+
 	dt := pgtype.Timestamptz{Time: date, Valid: true}
 
 	calcKind, calcVer, calcData := calculatorColumns(opts.Calculator)
+	coopResult, coopGlobal := coopColumns(opts.Cooperative)
 
 	// create match (foreign key will validate game_id exists)
 	// ON CONFLICT (id) DO UPDATE returns the existing row on retry (idempotency).
@@ -147,11 +294,24 @@ func (s *MatchService) AddMatch(ctx context.Context, gameID string, playerScores
 		CalculatorKind:          calcKind,
 		CalculatorSchemaVersion: calcVer,
 		CalculatorData:          calcData,
+		CooperativeResult:       coopResult,
+		CooperativeGlobalArena:  coopGlobal,
 	})
 	if err != nil {
 		return db.Match{}, fmt.Errorf("unable to create match: %w", err)
 	}
 
+	// Tournament links go first: custom arenas filtered by tournament read
+	// them while the match is settled.
+	playerIDs := playerIDsOf(playerScores)
+	tournamentIDs, err := mergeWithActiveTournaments(ctx, q, date, playerIDs, opts.TournamentIDs)
+	if err != nil {
+		return db.Match{}, err
+	}
+	if err := applyMatchTournaments(ctx, q, createdMatch.ID, tournamentIDs, playerIDs); err != nil {
+		return db.Match{}, err
+	}
+
 	if opts.ClientDate {
 		// Client-supplied (possibly backdated) date: write scores, then replay all
 		// events from that date so this match and every later one settle in order.
@@ -160,6 +320,7 @@ func (s *MatchService) AddMatch(ctx context.Context, gameID string, playerScores
 				MatchID:  createdMatch.ID,
 				PlayerID: playerID,
 				Score:    score,
+				Team:     teamText(opts.Teams, playerID),
 			}); err != nil {
 				return db.Match{}, fmt.Errorf("unable to insert match score for player %s: %w", playerID, err)
 			}
@@ -174,11 +335,7 @@ func (s *MatchService) AddMatch(ctx context.Context, gameID string, playerScores
 		if err != nil {
 			return db.Match{}, err
 		}
-
-		playerIDs := make([]string, 0, len(playerScores))
-		for playerID := range playerScores {
-			playerIDs = append(playerIDs, playerID)
-		}
+		state.Teams = opts.Teams
 
 		if err := s.EventProcessor.processMatchSettlements(
 			ctx, q, createdMatch.ID, gameID, playerScores,
@@ -191,19 +348,9 @@ func (s *MatchService) AddMatch(ctx context.Context, gameID string, playerScores
 		if err := RecalculateBetLimits(ctx, q, playerIDs); err != nil {
 			return db.Match{}, fmt.Errorf("recalculate bet limits: %w", err)
 		}
-	}
-
-	playerIDs := playerIDsOf(playerScores)
-	tournamentIDs, err := mergeWithActiveTournaments(ctx, q, date, playerIDs, opts.TournamentIDs)
-	if err != nil {
-		return db.Match{}, err
-	}
-	if err := applyMatchTournaments(ctx, q, createdMatch.ID, tournamentIDs, playerIDs); err != nil {
-		return db.Match{}, err
-	}
-
-	if err := tx.Commit(ctx); err != nil {
-		return db.Match{}, fmt.Errorf("unable to commit tx: %w", err)
+		if err := syncAchievements(ctx, q, date); err != nil {
+			return db.Match{}, fmt.Errorf("sync achievements: %w", err)
+		}
 	}
 
 	return createdMatch, nil
@@ -216,6 +363,19 @@ func (s *MatchService) AddMatch(ctx context.Context, gameID string, playerScores
 // when it is &CalculatorUpdate{Kind: nil} they are cleared; otherwise they are
 // replaced with the validated document.
 func (s *MatchService) UpdateMatch(ctx context.Context, matchID string, gameID string, playerScores map[string]float64, date time.Time, opts UpdateMatchOpts) (db.Match, error) {
+	return s.updateMatch(ctx, matchID, gameID, playerScores, date, opts, nil)
+}
+
+// updateMatch implements UpdateMatch and UpdateMatchWithOverride; a non-nil
+// change lifts the limit on the date shift and receives what the replay
+// altered.
+func (s *MatchService) updateMatch(ctx context.Context, matchID string, gameID string, playerScores map[string]float64, date time.Time, opts UpdateMatchOpts, change *HistoryChange) (db.Match, error) {
+	if opts.Teams != nil {
+		if err := validateMatchPlayers(playerScores, opts.Teams, opts.Cooperative); err != nil {
+			return db.Match{}, err
+		}
+	}
+
 	tx, err := s.Pool.Begin(ctx)
 	if err != nil {
 		return db.Match{}, fmt.Errorf("unable to begin tx: %w", err)
@@ -230,9 +390,30 @@ func (s *MatchService) UpdateMatch(ctx context.Context, matchID string, gameID s
 	if err != nil {
 		return db.Match{}, fmt.Errorf("%w: %v", ErrMatchNotFound, err)
 	}
+	before, err := matchAuditSnapshot(ctx, q, matchID)
+	if err != nil {
+		return db.Match{}, err
+	}
+	if opts.Teams == nil {
+		if opts.Teams, err = currentTeams(ctx, q, matchID, playerScores, opts.Cooperative); err != nil {
+			return db.Match{}, err
+		}
+		if err := validateMatchPlayers(playerScores, opts.Teams, opts.Cooperative); err != nil {
+			return db.Match{}, err
+		}
+	}
+
+	if err := validateMatchResult(ctx, q, gameID, playerScores, opts.Teams, opts.Cooperative); err != nil {
+		return db.Match{}, err
+	}
 
 	oldDate := existingMatch.Date.Time
-	if err := validateMatchDateChange(oldDate, date); err != nil {
+	var history historySnapshot
+	if change == nil {
+		if err := validateMatchDateChange(oldDate, date); err != nil {
+			return db.Match{}, err
+		}
+	} else if history, err = takeHistorySnapshot(ctx, q, time.Now()); err != nil {
 		return db.Match{}, err
 	}
 
@@ -249,6 +430,7 @@ func (s *MatchService) UpdateMatch(ctx context.Context, matchID string, gameID s
 		CalculatorSchemaVersion: existingMatch.CalculatorSchemaVersion,
 		CalculatorData:          existingMatch.CalculatorData,
 	}
+	updateParams.CooperativeResult, updateParams.CooperativeGlobalArena = coopColumns(opts.Cooperative)
 	if opts.Calculator != nil {
 		k, v, d := calculatorColumnsFromUpdate(opts.Calculator)
 		updateParams.CalculatorKind = k
@@ -268,6 +450,9 @@ func (s *MatchService) UpdateMatch(ctx context.Context, matchID string, gameID s
 	if err = q.DeleteGameArenaSettlementByMatch(ctx, &matchID); err != nil {
 		return db.Match{}, fmt.Errorf("unable to delete game arena settlement for match %s: %w", matchID, err)
 	}
+	if err = q.DeleteGameVirtualOpponentSettlementByMatch(ctx, matchID); err != nil {
+		return db.Match{}, fmt.Errorf("unable to delete virtual opponent settlement for match %s: %w", matchID, err)
+	}
 	err = q.DeleteMatchScores(ctx, matchID)
 	if err != nil {
 		return db.Match{}, fmt.Errorf("unable to delete old match scores: %w", err)
@@ -278,19 +463,17 @@ func (s *MatchService) UpdateMatch(ctx context.Context, matchID string, gameID s
 			MatchID:  matchID,
 			PlayerID: playerID,
 			Score:    score,
+			Team:     teamText(opts.Teams, playerID),
 		})
 		if err != nil {
 			return db.Match{}, fmt.Errorf("unable to insert match score for player %s: %w", playerID, err)
 		}
 	}
 
-	if err := s.recalculateEloFromDate(ctx, q, recalcStartDate); err != nil {
-		return db.Match{}, fmt.Errorf("unable to recalculate Elo: %w", err)
-	}
-
 	// Replace tournament associations with the provided set (an association can be
 	// dropped when the date moves out of a tournament's window). Memberships are
 	// only ever added — editing a match never removes tournament members.
+	// Done before the replay, which settles tournament-filtered custom arenas.
 	if err := q.DeleteMatchTournamentsByMatch(ctx, matchID); err != nil {
 		return db.Match{}, fmt.Errorf("unable to clear match tournaments: %w", err)
 	}
@@ -303,6 +486,26 @@ func (s *MatchService) UpdateMatch(ctx context.Context, matchID string, gameID s
 		return db.Match{}, err
 	}
 
+	if err := s.recalculateEloFromDate(ctx, q, recalcStartDate); err != nil {
+		return db.Match{}, fmt.Errorf("unable to recalculate Elo: %w", err)
+	}
+
+	if change != nil {
+		current, err := takeHistorySnapshot(ctx, q, time.Now())
+		if err != nil {
+			return db.Match{}, err
+		}
+		*change = diffHistory(history, current)
+	}
+
+	after, err := matchAuditSnapshot(ctx, q, matchID)
+	if err != nil {
+		return db.Match{}, err
+	}
+	if err := recordAudit(ctx, q, AuditEntityMatch, matchID, AuditActionUpdate, before, after); err != nil {
+		return db.Match{}, err
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return db.Match{}, fmt.Errorf("unable to commit tx: %w", err)
 	}
@@ -314,6 +517,64 @@ func (s *MatchService) UpdateMatch(ctx context.Context, matchID string, gameID s
 	return updatedMatch, nil
 }
 
+// DeleteMatch removes a match with its scores, tournament links and calculator
+// data, then replays history from the match date so later matches, market
+// resolutions and bet limits are settled as if it never happened. Fails with
+// ErrMatchNotFound or a history change conflict; nothing is changed then.
+func (s *MatchService) DeleteMatch(ctx context.Context, matchID string) error {
+	err := runInTx(ctx, s.Pool, func(q *db.Queries) error {
+		match, err := q.GetMatch(ctx, matchID)
+		if db.IsNoRows(err) {
+			return fmt.Errorf("%w: %s", ErrMatchNotFound, matchID)
+		}
+		if err != nil {
+			return fmt.Errorf("get match %s: %w", matchID, err)
+		}
+		before, err := matchAuditSnapshot(ctx, q, matchID)
+		if err != nil {
+			return err
+		}
+		if err := recordAudit(ctx, q, AuditEntityMatch, matchID, AuditActionDelete, before, nil); err != nil {
+			return err
+		}
+
+		// Every row referencing the match goes before the match itself; the
+		// replay below would only remove those dated on or after its date.
+		if err := q.DeleteGlobalArenaSettlementByMatch(ctx, &matchID); err != nil {
+			return fmt.Errorf("delete global arena settlement for match %s: %w", matchID, err)
+		}
+		if err := q.DeleteGlobalArenaDecayReversalsByMatch(ctx, &matchID); err != nil {
+			return fmt.Errorf("delete decay reversals for match %s: %w", matchID, err)
+		}
+		if err := q.DeleteGameArenaSettlementByMatch(ctx, &matchID); err != nil {
+			return fmt.Errorf("delete game arena settlement for match %s: %w", matchID, err)
+		}
+		if err := q.DeleteGameVirtualOpponentSettlementByMatch(ctx, matchID); err != nil {
+			return fmt.Errorf("delete virtual opponent settlement for match %s: %w", matchID, err)
+		}
+		if err := q.DeleteArenaSettlementsByMatch(ctx, matchID); err != nil {
+			return fmt.Errorf("delete arena settlements for match %s: %w", matchID, err)
+		}
+		if err := q.DeleteMatchScores(ctx, matchID); err != nil {
+			return fmt.Errorf("delete scores for match %s: %w", matchID, err)
+		}
+		if err := q.DeleteMatchTournamentsByMatch(ctx, matchID); err != nil {
+			return fmt.Errorf("delete tournament links for match %s: %w", matchID, err)
+		}
+		if err := q.DeleteMatch(ctx, matchID); err != nil {
+			return fmt.Errorf("delete match %s: %w", matchID, err)
+		}
+
+		return s.recalculateEloFromDate(ctx, q, match.Date.Time)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.MarketService.ScheduleNextExpiry(context.Background())
+	return nil
+}
+
 // RecalculateAllGameElo recalculates game Elo for all matches from the beginning of time.
 // Used as a one-time backfill after the game Elo columns were added.
 func (s *MatchService) RecalculateAllGameElo(ctx context.Context) error {
@@ -352,6 +613,9 @@ func (s *MatchService) DeleteMarketAndRecalculate(ctx context.Context, marketID
 	if market.Status != "open" && market.Status != "betting_closed" {
 		return ErrMarketNotOpen
 	}
+	if err := recordAudit(ctx, q, AuditEntityMarket, marketID, AuditActionDelete, market, nil); err != nil {
+		return err
+	}
 
 	createdAt := market.CreatedAt.Time
 
@@ -375,10 +639,105 @@ func (s *MatchService) DeleteMarketAndRecalculate(ctx context.Context, marketID
 	return nil
 }
 
+// UpdateGameScoring updates the game's scoring rules and replays Elo from its
+// first match in a single transaction. The replay is skipped when the rules
+// did not change or the game has no matches.
+func (s *MatchService) UpdateGameScoring(ctx context.Context, gameID string, scoring GameScoring) (db.Game, error) {
+	if err := scoring.Validate(); err != nil {
+		return db.Game{}, err
+	}
+
+	var updated db.Game
+	err := runInTx(ctx, s.Pool, func(q *db.Queries) error {
+		game, err := q.GetGameByID(ctx, gameID)
+		if err != nil {
+			return fmt.Errorf("get game %s: %w", gameID, err)
+		}
+		if GameScoringOf(game) == scoring {
+			updated = game
+			return nil
+		}
+
+		if scoring.ResultType == ResultTypePlacement {
+			byMatch, err := gameMatchScores(ctx, q, gameID)
+			if err != nil {
+				return err
+			}
+			for matchID, res := range byMatch {
+				if err := scoring.ValidateResult(res.scores, res.teams); err != nil {
+					return fmt.Errorf("match %s: %w", matchID, err)
+				}
+			}
+		}
+
+		updated, err = q.UpdateGameScoring(ctx, db.UpdateGameScoringParams{
+			ID:               gameID,
+			ScoringDirection: scoring.Direction,
+			ResultType:       scoring.ResultType,
+		})
+		if err != nil {
+			return fmt.Errorf("update game scoring %s: %w", gameID, err)
+		}
+
+		firstDate, err := q.GetFirstMatchDateByGame(ctx, gameID)
+		if db.IsNoRows(err) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("get first match date for game %s: %w", gameID, err)
+		}
+		return s.recalculateEloFromDate(ctx, q, firstDate.Time)
+	})
+	if err != nil {
+		return db.Game{}, err
+	}
+	return updated, nil
+}
+
+// matchResult is the raw result of one match: player id → score and, for a
+// team match, player id → team.
+type matchResult struct {
+	scores map[string]float64
+	teams  map[string]string
+}
+
+// gameMatchScores reads the raw results of every competitive match of a
+// game, by match id.
+func gameMatchScores(ctx context.Context, q *db.Queries, gameID string) (map[string]matchResult, error) {
+	rows, err := q.ListMatchScoresByGame(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("list match scores for game %s: %w", gameID, err)
+	}
+	byMatch := make(map[string]matchResult)
+	for _, r := range rows {
+		res, ok := byMatch[r.MatchID]
+		if !ok {
+			res = matchResult{scores: make(map[string]float64)}
+		}
+		res.scores[r.PlayerID] = r.Score
+		if r.Team.Valid {
+			if res.teams == nil {
+				res.teams = make(map[string]string)
+			}
+			res.teams[r.PlayerID] = r.Team.String
+		}
+		byMatch[r.MatchID] = res
+	}
+	return byMatch, nil
+}
+
 // recalculateEloFromDate delegates to EventProcessor.RecalculateFrom.
 // Must be called within a transaction.
 func (s *MatchService) recalculateEloFromDate(ctx context.Context, q *db.Queries, startDate time.Time) error {
-	return s.EventProcessor.RecalculateFrom(ctx, q, startDate, s.calculateAndUpdateElo, s.lockAndGetPrevElos)
+	return s.EventProcessor.RecalculateFrom(ctx, q, startDate, nil)
+}
+
+// RecalculateByEvent replays history from startDate through the per-event
+// reference path (EventProcessor.RecalculateFromByEvent) and commits it.
+func (s *MatchService) RecalculateByEvent(ctx context.Context, startDate time.Time) error {
+	return runInTx(ctx, s.Pool, func(q *db.Queries) error {
+		return s.EventProcessor.RecalculateFromByEvent(ctx, q, startDate, s.calculateAndUpdateElo, s.lockAndGetPrevElos)
+	})
 }
 
 // lockAndGetPrevElos locks players in sorted order and returns all prior state
@@ -400,13 +759,43 @@ func (s *MatchService) lockAndGetPrevElos(ctx context.Context, q *db.Queries, ma
 		Count6M:    make(map[string]int),
 		Count2M:    make(map[string]int),
 		Settings:   settings,
+
+		Deviation:      make(map[string]float64),
+		Volatility:     make(map[string]float64),
+		GameDeviation:  make(map[string]float64),
+		GameVolatility: make(map[string]float64),
+	}
+
+	state.Coop, err = loadCoopPrevState(ctx, q, match, settings.StartingElo)
+	if err != nil {
+		return MatchPrevState{}, err
 	}
 
+	game, err := q.GetGameByID(ctx, match.GameID)
+	if err != nil {
+		return MatchPrevState{}, fmt.Errorf("get game %s: %w", match.GameID, err)
+	}
+	state.Scoring = GameScoringOf(game)
+	state.GameParams, err = gameRatingParamsAt(ctx, q, match.GameID, match.Date.Time)
+	if err != nil {
+		return MatchPrevState{}, err
+	}
+
+	// Inactivity drops and season resets due before the match go first; a
+	// returning player's drop is reversed below, once they are locked.
+	if err := applyTimedSettlements(ctx, q, match.Date.Time); err != nil {
+		return MatchPrevState{}, err
+	}
+	settlesGlobal := state.Coop == nil || state.Coop.GlobalArena
+
 	playerIDs := make([]string, 0, len(playerScores))
 	for playerID := range playerScores {
 		playerIDs = append(playerIDs, playerID)
 	}
 	sortPlayerIDs(playerIDs)
+	if state.Guests, err = listGuests(ctx, q, playerIDs); err != nil {
+		return MatchPrevState{}, err
+	}
 
 	matchDate := match.Date
 	date6MAgo := pgtype.Timestamptz{Time: match.Date.Time.Add(-6 * 30 * 24 * time.Hour), Valid: true}
@@ -417,6 +806,15 @@ func (s *MatchService) lockAndGetPrevElos(ctx context.Context, q *db.Queries, ma
 		if err != nil {
 			return MatchPrevState{}, fmt.Errorf("unable to lock player %s: %w", playerID, err)
 		}
+		if state.Guests[playerID] {
+			setGuestPrevState(&state, playerID)
+			continue
+		}
+		if settlesGlobal {
+			if err := reverseInactivityDecay(ctx, q, match, playerID); err != nil {
+				return MatchPrevState{}, err
+			}
+		}
 
 		prevGlobalElo, err := q.GetPlayerLatestGlobalEloBeforeMatch(ctx, db.GetPlayerLatestGlobalEloBeforeMatchParams{
 			PlayerID: playerID,
@@ -441,6 +839,10 @@ func (s *MatchService) lockAndGetPrevElos(ctx context.Context, q *db.Queries, ma
 			state.GameElo[playerID] = prevGameElo
 		}
 
+		if err := loadUncertainty(ctx, q, match, playerID, settings, &state); err != nil {
+			return MatchPrevState{}, err
+		}
+
 		prevGlobalRating, err := q.GetPlayerLatestGlobalRatingBeforeMatch(ctx, db.GetPlayerLatestGlobalRatingBeforeMatchParams{
 			PlayerID: playerID,
 			Date:     matchDate,
@@ -508,6 +910,39 @@ func (s *MatchService) lockAndGetPrevElos(ctx context.Context, q *db.Queries, ma
 	return state, nil
 }
 
+// loadUncertainty reads a player's rating-algorithm uncertainty before the
+// match for every arena whose algorithm tracks it (not Elo).
+func loadUncertainty(ctx context.Context, q *db.Queries, match db.Match, playerID string, settings EloSettings, state *MatchPrevState) error {
+	if tracksUncertainty(settings.GlobalAlgorithm) {
+		u, err := q.GetPlayerGlobalUncertaintyBeforeMatch(ctx, db.GetPlayerGlobalUncertaintyBeforeMatchParams{
+			PlayerID: playerID,
+			Date:     match.Date,
+			MatchID:  &match.ID,
+		})
+		if err == nil {
+			state.Deviation[playerID] = u.Deviation
+			state.Volatility[playerID] = u.Volatility.Float64
+		} else if !db.IsNoRows(err) {
+			return fmt.Errorf("get global uncertainty for player %s: %w", playerID, err)
+		}
+	}
+	if tracksUncertainty(settings.GameAlgorithm) {
+		u, err := q.GetPlayerGameUncertaintyBeforeMatch(ctx, db.GetPlayerGameUncertaintyBeforeMatchParams{
+			PlayerID: playerID,
+			GameID:   match.GameID,
+			Date:     match.Date,
+			MatchID:  &match.ID,
+		})
+		if err == nil {
+			state.GameDeviation[playerID] = u.Deviation
+			state.GameVolatility[playerID] = u.Volatility.Float64
+		} else if !db.IsNoRows(err) {
+			return fmt.Errorf("get game uncertainty for player %s: %w", playerID, err)
+		}
+	}
+	return nil
+}
+
 // eloCalcResult holds per-player dual-track Elo/rating deltas and new values for one match.
 type eloCalcResult struct {
 	eloStaked        float64
@@ -517,6 +952,8 @@ type eloCalcResult struct {
 	ratingEarned     float64
 	newGlobalRating  float64
 	newGlobalLeague  string
+	globalDeviation  float64 // rating-algorithm uncertainty; 0 when not tracked
+	globalVolatility float64
 	gameEloStaked    float64
 	gameEloEarned    float64
 	newGameElo       float64
@@ -524,6 +961,8 @@ type eloCalcResult struct {
 	gameRatingEarned float64
 	newGameRating    float64
 	newGameLeague    string
+	gameDeviation    float64
+	gameVolatility   float64
 }
 
 // isInNewbieLeague returns true if elo still exceeds rating by more than the amateur threshold.
@@ -565,11 +1004,9 @@ func scaleRatingStaked(ratingStakedRaw, prevElo, prevRating float64, s EloSettin
 }
 
 // initialLeagueForStarting returns the league for a player with no prior settlement.
+// Elite is only reached by settling matches, so both arenas start on the game ladder.
 func initialLeagueForStarting(startingRating, startingElo float64, s EloSettings) string {
-	if startingElo-startingRating <= s.NewbieLeagueGoalGap {
-		return "amateur"
-	}
-	return "newbie"
+	return gameLeagueLadder(s).Initial(eliteProgress(startingRating, startingElo, 0, 0))
 }
 
 // effectiveLeague accounts for time-based demotion from elite to amateur.
@@ -577,53 +1014,60 @@ func initialLeagueForStarting(startingRating, startingElo float64, s EloSettings
 // match counts have since dropped below the elite thresholds, they are effectively
 // in amateur even if their last record says 'elite'.
 func effectiveLeague(storedLeague string, cnt60, cnt180 int, s EloSettings) string {
-	if storedLeague == "elite" {
-		if cnt180 >= s.EliteMatches6M && cnt60 >= s.EliteMatches2M {
-			return "elite"
-		}
-		return "amateur"
-	}
-	return storedLeague
+	return globalLeagueLadder(s).Effective(storedLeague, eliteProgress(0, 0, cnt180, cnt60), 0)
 }
 
 // determineGlobalLeague returns the league a player is in AFTER a global-arena settlement.
 // When a newbie's gap condition is met, the elite check is also applied immediately —
 // a player who simultaneously satisfies both the amateur and elite thresholds goes straight to elite.
 func determineGlobalLeague(prev string, newRating, newElo float64, count6M, count2M int, s EloSettings) string {
-	if prev == "newbie" && isInNewbieLeague(newRating, newElo, s) {
-		return "newbie"
-	}
-	if count6M >= s.EliteMatches6M && count2M >= s.EliteMatches2M {
-		return "elite"
-	}
-	return "amateur"
+	return globalLeagueLadder(s).After(prev, eliteProgress(newRating, newElo, count6M, count2M))
 }
 
 // determineGameLeague returns the league a player is in AFTER a game-arena settlement.
 func determineGameLeague(prev string, newRating, newElo float64, s EloSettings) string {
-	if prev == "newbie" {
-		if isInNewbieLeague(newRating, newElo, s) {
-			return "newbie"
-		}
-		return "amateur"
-	}
-	return "amateur"
+	return gameLeagueLadder(s).After(prev, eliteProgress(newRating, newElo, 0, 0))
 }
 
 // buildEloResults computes the dual-track (elo + rating) settlement for every player in the match.
+// Expectation and normalized score are computed per side (see teams.go): in an
+// individual match every player is a side of one; in a team match each team is
+// rated by its members' mean Elo and every member receives the team's delta; in
+// a cooperative match the group is one team against the virtual opponent (coop.go).
+// Raw scores are first ranked by the game's scoring rules (scoring.go). The
+// game's rating parameters replace K, D and win_reward in the game arena and
+// weight K in the global arena (game_rating_params.go).
 // Pure calculation — no DB writes.
 func buildEloResults(playerScores map[string]float64, state MatchPrevState) map[string]eloCalcResult {
-	s := state.Settings
+	s := state.GameParams.globalSettings(state.Settings)
+	gs := state.GameParams.gameSettings(state.Settings)
+
+	sides := newMatchSides(state.Scoring.RankingScores(playerScores), state.Teams)
+	if state.Coop != nil {
+		sides = coopSides(playerScores, *state.Coop)
+	}
+	sideScores := sides.scores
+	absoluteLoserScore := GetAbsoluteLoserScore(sideScores)
 
-	newGlobalElos := CalculateNewElo(state.Elo, s.StartingElo, playerScores, s.K, s.D, s.WinReward)
-	newGameElos := CalculateNewElo(state.GameElo, s.StartingElo, playerScores, s.K, s.D, s.WinReward)
-	absoluteLoserScore := GetAbsoluteLoserScore(playerScores)
+	// True-skill tracks: each arena's rating algorithm (rating_algorithm.go).
+	globalAlgo := newRatingAlgorithm(s.GlobalAlgorithm, s)
+	gameAlgo := newRatingAlgorithm(gs.GameAlgorithm, gs)
+	sideSkill := sides.skills(state.Elo, state.Deviation, state.Volatility, globalAlgo.Starting())
+	sideGameSkill := sides.skills(state.GameElo, state.GameDeviation, state.GameVolatility, gameAlgo.Starting())
+	changes := globalAlgo.Rate(sideSkill, sideScores)
+	gameChanges := gameAlgo.Rate(sideGameSkill, sideScores)
 
 	results := make(map[string]eloCalcResult, len(playerScores))
-	for id, score := range playerScores {
+	for id := range playerScores {
+		side := sides.sideOf[id]
+		sideScore := sideScores[side]
+
 		// Global elo track
-		eloStaked := -s.K * WinExpectation(state.Elo[id], playerScores, s.StartingElo, state.Elo, s.D)
-		eloEarned := s.K * NormalizedScore(score, playerScores, absoluteLoserScore, s.WinReward)
+		eloStaked := changes[side].Staked
+		eloEarned := changes[side].Earned
+		prevGlobal := playerSkill(id, state.Elo, state.Deviation, state.Volatility, globalAlgo.Starting())
+		newGlobal := sides.memberSkill(id, prevGlobal, sideSkill[side], changes[side].After)
+		newGlobalElo := newGlobal.Rating
 
 		// Global rating track: player's own rating replaces their elo in WinExpectation;
 		// earned is scaled by gap between true elo and display rating (ADR-03).
@@ -632,17 +1076,21 @@ func buildEloResults(playerScores map[string]float64, state MatchPrevState) map[
 			prevEloForRating[k] = v
 		}
 		prevEloForRating[id] = state.Rating[id]
+		sideEloForRating := sides.aggregate(prevEloForRating, s.StartingElo)
 
-		ratingStakedRaw := -s.K * WinExpectation(state.Rating[id], playerScores, s.StartingElo, prevEloForRating, s.D)
+		ratingStakedRaw := -s.K * WinExpectation(sideEloForRating[side], sideScores, s.StartingElo, sideEloForRating, s.D)
 		ratingStaked := scaleRatingStaked(ratingStakedRaw, state.Elo[id], state.Rating[id], s)
-		ratingEarnedRaw := s.K * NormalizedScore(score, playerScores, absoluteLoserScore, s.WinReward)
+		ratingEarnedRaw := s.K * NormalizedScore(sideScore, sideScores, absoluteLoserScore, s.WinReward)
 		ratingEarned := scaleRatingEarned(ratingEarnedRaw, state.Elo[id], state.Rating[id], s)
 		newGlobalRating := state.Rating[id] + ratingStaked + ratingEarned
-		newGlobalLeague := determineGlobalLeague(state.League[id], newGlobalRating, newGlobalElos[id], state.Count6M[id], state.Count2M[id], s)
+		newGlobalLeague := determineGlobalLeague(state.League[id], newGlobalRating, newGlobalElo, state.Count6M[id], state.Count2M[id], s)
 
 		// Game elo track
-		gameEloStaked := -s.K * WinExpectation(state.GameElo[id], playerScores, s.StartingElo, state.GameElo, s.D)
-		gameEloEarned := s.K * NormalizedScore(score, playerScores, absoluteLoserScore, s.WinReward)
+		gameEloStaked := gameChanges[side].Staked
+		gameEloEarned := gameChanges[side].Earned
+		prevGame := playerSkill(id, state.GameElo, state.GameDeviation, state.GameVolatility, gameAlgo.Starting())
+		newGame := sides.memberSkill(id, prevGame, sideGameSkill[side], gameChanges[side].After)
+		newGameElo := newGame.Rating
 
 		// Game rating track: same earned-scaling approach as global rating track.
 		prevGameEloForRating := make(map[string]float64, len(state.GameElo))
@@ -650,29 +1098,34 @@ func buildEloResults(playerScores map[string]float64, state MatchPrevState) map[
 			prevGameEloForRating[k] = v
 		}
 		prevGameEloForRating[id] = state.GameRating[id]
+		sideGameEloForRating := sides.aggregate(prevGameEloForRating, gs.StartingElo)
 
-		gameRatingStakedRaw := -s.K * WinExpectation(state.GameRating[id], playerScores, s.StartingElo, prevGameEloForRating, s.D)
-		gameRatingStaked := scaleRatingStaked(gameRatingStakedRaw, state.GameElo[id], state.GameRating[id], s)
-		gameRatingEarnedRaw := s.K * NormalizedScore(score, playerScores, absoluteLoserScore, s.WinReward)
-		gameRatingEarned := scaleRatingEarned(gameRatingEarnedRaw, state.GameElo[id], state.GameRating[id], s)
+		gameRatingStakedRaw := -gs.K * WinExpectation(sideGameEloForRating[side], sideScores, gs.StartingElo, sideGameEloForRating, gs.D)
+		gameRatingStaked := scaleRatingStaked(gameRatingStakedRaw, state.GameElo[id], state.GameRating[id], gs)
+		gameRatingEarnedRaw := gs.K * NormalizedScore(sideScore, sideScores, absoluteLoserScore, gs.WinReward)
+		gameRatingEarned := scaleRatingEarned(gameRatingEarnedRaw, state.GameElo[id], state.GameRating[id], gs)
 		newGameRating := state.GameRating[id] + gameRatingStaked + gameRatingEarned
-		newGameLeague := determineGameLeague(state.GameLeague[id], newGameRating, newGameElos[id], s)
+		newGameLeague := determineGameLeague(state.GameLeague[id], newGameRating, newGameElo, gs)
 
 		results[id] = eloCalcResult{
 			eloStaked:        eloStaked,
 			eloEarned:        eloEarned,
-			newGlobalElo:     newGlobalElos[id],
+			newGlobalElo:     newGlobalElo,
 			ratingStaked:     ratingStaked,
 			ratingEarned:     ratingEarned,
 			newGlobalRating:  newGlobalRating,
 			newGlobalLeague:  newGlobalLeague,
+			globalDeviation:  newGlobal.Deviation,
+			globalVolatility: newGlobal.Volatility,
 			gameEloStaked:    gameEloStaked,
 			gameEloEarned:    gameEloEarned,
-			newGameElo:       newGameElos[id],
+			newGameElo:       newGameElo,
 			gameRatingStaked: gameRatingStaked,
 			gameRatingEarned: gameRatingEarned,
 			newGameRating:    newGameRating,
 			newGameLeague:    newGameLeague,
+			gameDeviation:    newGame.Deviation,
+			gameVolatility:   newGame.Volatility,
 		}
 	}
 	return results
@@ -689,22 +1142,31 @@ func (s *MatchService) calculateAndStoreEloWithScores(ctx context.Context, q *db
 			MatchID:  matchID,
 			PlayerID: playerID,
 			Score:    score,
+			Team:     teamText(state.Teams, playerID),
 		}); err != nil {
 			return fmt.Errorf("unable to upsert match score for player %s: %w", playerID, err)
 		}
-		if err := q.UpsertGlobalArenaSettlementByMatch(ctx, db.UpsertGlobalArenaSettlementByMatchParams{
-			ID:           newSettlementID(),
-			MatchID:      &matchID,
-			PlayerID:     playerID,
-			RatingAfter:  r.newGlobalRating,
-			EloAfter:     r.newGlobalElo,
-			EloStaked:    r.eloStaked,
-			EloEarned:    r.eloEarned,
-			RatingStaked: r.ratingStaked,
-			RatingEarned: r.ratingEarned,
-			League:       r.newGlobalLeague,
-		}); err != nil {
-			return fmt.Errorf("unable to upsert global arena settlement for player %s: %w", playerID, err)
+		if state.Guests[playerID] {
+			continue
+		}
+		if state.settlesGlobalArena() {
+			if err := q.UpsertGlobalArenaSettlementByMatch(ctx, db.UpsertGlobalArenaSettlementByMatchParams{
+				ID:           newSettlementID(),
+				MatchID:      &matchID,
+				PlayerID:     playerID,
+				RatingAfter:  r.newGlobalRating,
+				EloAfter:     r.newGlobalElo,
+				EloStaked:    r.eloStaked,
+				EloEarned:    r.eloEarned,
+				RatingStaked: r.ratingStaked,
+				RatingEarned: r.ratingEarned,
+				League:       r.newGlobalLeague,
+
+				DeviationAfter:  uncertaintyColumn(r.globalDeviation),
+				VolatilityAfter: uncertaintyColumn(r.globalVolatility),
+			}); err != nil {
+				return fmt.Errorf("unable to upsert global arena settlement for player %s: %w", playerID, err)
+			}
 		}
 		if err := q.UpsertGameArenaSettlementByMatch(ctx, db.UpsertGameArenaSettlementByMatchParams{
 			ID:           newSettlementID(),
@@ -718,12 +1180,15 @@ func (s *MatchService) calculateAndStoreEloWithScores(ctx context.Context, q *db
 			RatingStaked: r.gameRatingStaked,
 			RatingEarned: r.gameRatingEarned,
 			League:       r.newGameLeague,
+
+			DeviationAfter:  uncertaintyColumn(r.gameDeviation),
+			VolatilityAfter: uncertaintyColumn(r.gameVolatility),
 		}); err != nil {
 			return fmt.Errorf("unable to upsert game arena settlement for player %s: %w", playerID, err)
 		}
 	}
 
-	return nil
+	return storeVirtualOpponentSettlement(ctx, q, matchID, gameID, playerScores, state)
 }
 
 // calculateAndUpdateElo upserts settlement records without touching match_scores.
@@ -732,20 +1197,28 @@ func (s *MatchService) calculateAndUpdateElo(ctx context.Context, q *db.Queries,
 	results := buildEloResults(playerScores, state)
 
 	for playerID := range playerScores {
+		if state.Guests[playerID] {
+			continue
+		}
 		r := results[playerID]
-		if err := q.UpsertGlobalArenaSettlementByMatch(ctx, db.UpsertGlobalArenaSettlementByMatchParams{
-			ID:           newSettlementID(),
-			MatchID:      &matchID,
-			PlayerID:     playerID,
-			RatingAfter:  r.newGlobalRating,
-			EloAfter:     r.newGlobalElo,
-			EloStaked:    r.eloStaked,
-			EloEarned:    r.eloEarned,
-			RatingStaked: r.ratingStaked,
-			RatingEarned: r.ratingEarned,
-			League:       r.newGlobalLeague,
-		}); err != nil {
-			return fmt.Errorf("unable to upsert global arena settlement for player %s: %w", playerID, err)
+		if state.settlesGlobalArena() {
+			if err := q.UpsertGlobalArenaSettlementByMatch(ctx, db.UpsertGlobalArenaSettlementByMatchParams{
+				ID:           newSettlementID(),
+				MatchID:      &matchID,
+				PlayerID:     playerID,
+				RatingAfter:  r.newGlobalRating,
+				EloAfter:     r.newGlobalElo,
+				EloStaked:    r.eloStaked,
+				EloEarned:    r.eloEarned,
+				RatingStaked: r.ratingStaked,
+				RatingEarned: r.ratingEarned,
+				League:       r.newGlobalLeague,
+
+				DeviationAfter:  uncertaintyColumn(r.globalDeviation),
+				VolatilityAfter: uncertaintyColumn(r.globalVolatility),
+			}); err != nil {
+				return fmt.Errorf("unable to upsert global arena settlement for player %s: %w", playerID, err)
+			}
 		}
 		if err := q.UpsertGameArenaSettlementByMatch(ctx, db.UpsertGameArenaSettlementByMatchParams{
 			ID:           newSettlementID(),
@@ -759,12 +1232,58 @@ func (s *MatchService) calculateAndUpdateElo(ctx context.Context, q *db.Queries,
 			RatingStaked: r.gameRatingStaked,
 			RatingEarned: r.gameRatingEarned,
 			League:       r.newGameLeague,
+
+			DeviationAfter:  uncertaintyColumn(r.gameDeviation),
+			VolatilityAfter: uncertaintyColumn(r.gameVolatility),
 		}); err != nil {
 			return fmt.Errorf("unable to upsert game arena settlement for player %s: %w", playerID, err)
 		}
 	}
 
-	return nil
+	return storeVirtualOpponentSettlement(ctx, q, matchID, gameID, playerScores, state)
+}
+
+// teamText converts a player's team label into the nullable match_scores.team
+// column value (NULL for an individual match).
+func teamText(teams map[string]string, playerID string) pgtype.Text {
+	team, ok := teams[playerID]
+	return pgtype.Text{String: team, Valid: ok && team != ""}
+}
+
+// currentTeams is the stored team assignment of the players that stay in the
+// match, for an update that leaves the teams unspecified. A cooperative match
+// has no teams, so the assignment is dropped when the update makes it one.
+func currentTeams(ctx context.Context, q *db.Queries, matchID string, playerScores map[string]float64, coop *Cooperative) (map[string]string, error) {
+	if coop != nil {
+		return nil, nil
+	}
+	rows, err := q.GetMatchScoresForMatch(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get match scores: %w", err)
+	}
+	teams := teamsOf(rows)
+	for playerID := range teams {
+		if _, ok := playerScores[playerID]; !ok {
+			delete(teams, playerID)
+		}
+	}
+	return teams, nil
+}
+
+// teamsOf rebuilds the player→team map from stored match_scores rows; nil when
+// the match has no teams.
+func teamsOf(rows []db.GetMatchScoresForMatchRow) map[string]string {
+	var teams map[string]string
+	for _, r := range rows {
+		if !r.Team.Valid {
+			continue
+		}
+		if teams == nil {
+			teams = make(map[string]string, len(rows))
+		}
+		teams[r.PlayerID] = r.Team.String
+	}
+	return teams
 }
 
 // sortPlayerIDs sorts player IDs numerically (for consistent locking order)
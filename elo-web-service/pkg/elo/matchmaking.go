@@ -0,0 +1,413 @@
+package elo
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+// MatchmakingObjective is what SuggestTables optimises first; the other
+// criterion breaks ties.
+type MatchmakingObjective string
+
+const (
+	// MatchmakingBalance evens out the win probabilities at every table.
+	MatchmakingBalance MatchmakingObjective = "balance"
+	// MatchmakingNewPairings seats together players who have not played each
+	// other yet.
+	MatchmakingNewPairings MatchmakingObjective = "new_pairings"
+)
+
+// matchmakingMaxRounds bounds the local search; every round applies the best
+// single move or swap.
+const matchmakingMaxRounds = 500
+
+// MatchmakingTable is a table to fill: the game played at it and how many
+// players it takes.
+type MatchmakingTable struct {
+	GameID     string
+	MinPlayers int
+	MaxPlayers int
+}
+
+// MatchmakingRequest is a game night: who came, which tables there are, and
+// optionally the club or tournament every attendee must belong to. With a
+// tournament, only its matches count as earlier pairings.
+type MatchmakingRequest struct {
+	PlayerIDs    []string
+	Tables       []MatchmakingTable
+	Objective    MatchmakingObjective
+	ClubID       *string
+	TournamentID *string
+}
+
+// TableSuggestion is a suggested table with the predictions of its players,
+// favourite first. Balance is 1 when every player of the table has the same
+// chance to win in the game's arena and 0 when one of them is certain to.
+// RepeatPairings counts the pairs at the table who have played together
+// before, NewPairings those who have not.
+type TableSuggestion struct {
+	GameID         string
+	Players        []PlayerPrediction
+	Balance        float64
+	NewPairings    int
+	RepeatPairings int
+}
+
+type IMatchmakingService interface {
+	// SuggestTables seats every attendee at one of the tables, within each
+	// table's player count, optimising req.Objective.
+	SuggestTables(ctx context.Context, req MatchmakingRequest) ([]TableSuggestion, error)
+}
+
+type MatchmakingService struct {
+	Queries *db.Queries
+	Pool    *pgxpool.Pool
+}
+
+func NewMatchmakingService(pool *pgxpool.Pool) IMatchmakingService {
+	return &MatchmakingService{Queries: db.New(pool), Pool: pool}
+}
+
+func (s *MatchmakingService) SuggestTables(ctx context.Context, req MatchmakingRequest) ([]TableSuggestion, error) {
+	playerIDs := uniqueIDs(req.PlayerIDs)
+	if len(playerIDs) < 2 {
+		return nil, ErrTooFewPlayers
+	}
+	if req.Objective != MatchmakingBalance && req.Objective != MatchmakingNewPairings {
+		return nil, ErrInvalidMatchmaking
+	}
+	sizes, err := tableSizes(req.Tables, len(playerIDs))
+	if err != nil {
+		return nil, err
+	}
+	q := s.Queries
+
+	for _, playerID := range playerIDs {
+		if _, err := q.GetPlayer(ctx, playerID); err != nil {
+			return nil, fmt.Errorf("get player %s: %w", playerID, err)
+		}
+	}
+	if err := checkAttendees(ctx, q, playerIDs, req.ClubID, req.TournamentID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	settingsRow, err := q.GetEloSettingsForDate(ctx, pgtype.Timestamptz{Time: now, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("get elo settings: %w", err)
+	}
+	settings := EloSettingsFromDB(settingsRow)
+	guests, err := listGuests(ctx, q, playerIDs)
+	if err != nil {
+		return nil, err
+	}
+	globalElo, err := currentArenaElo(ctx, q, "", playerIDs, guests, settings.StartingElo)
+	if err != nil {
+		return nil, err
+	}
+	gameSettings := make(map[string]EloSettings)
+	gameElo := make(map[string]map[string]float64)
+	for _, t := range req.Tables {
+		if _, ok := gameSettings[t.GameID]; ok {
+			continue
+		}
+		if _, err := q.GetGameByID(ctx, t.GameID); err != nil {
+			return nil, fmt.Errorf("get game %s: %w", t.GameID, err)
+		}
+		params, err := gameRatingParamsAt(ctx, q, t.GameID, now)
+		if err != nil {
+			return nil, err
+		}
+		gameSettings[t.GameID] = params.gameSettings(settings)
+		if gameElo[t.GameID], err = currentArenaElo(ctx, q, t.GameID, playerIDs, guests, gameSettings[t.GameID].StartingElo); err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := q.ListPairingCounts(ctx, db.ListPairingCountsParams{PlayerIds: playerIDs, TournamentID: req.TournamentID})
+	if err != nil {
+		return nil, fmt.Errorf("list pairings: %w", err)
+	}
+	met := make(map[[2]string]bool, len(rows))
+	for _, r := range rows {
+		met[pairKey(r.PlayerID, r.OpponentID)] = true
+	}
+
+	m := &matchmaker{
+		tables:    req.Tables,
+		objective: req.Objective,
+		met:       met,
+		imbalance: func(table int, players []string) float64 {
+			gameID := req.Tables[table].GameID
+			return 1 - tableBalance(predictArena(scoreMap(players), gameElo[gameID], gameSettings[gameID]))
+		},
+	}
+	seating := m.search(snakeSeating(playerIDs, globalElo, sizes))
+
+	suggestions := make([]TableSuggestion, 0, len(req.Tables))
+	for i, t := range req.Tables {
+		players := seating[i]
+		global := predictArena(scoreMap(players), globalElo, settings)
+		game := predictArena(scoreMap(players), gameElo[t.GameID], gameSettings[t.GameID])
+		suggestion := TableSuggestion{GameID: t.GameID, Balance: tableBalance(game)}
+		for _, playerID := range players {
+			suggestion.Players = append(suggestion.Players, PlayerPrediction{PlayerID: playerID, Global: global[playerID], Game: game[playerID]})
+		}
+		sort.SliceStable(suggestion.Players, func(a, b int) bool {
+			return suggestion.Players[a].Game.WinProbability > suggestion.Players[b].Game.WinProbability
+		})
+		pairs := len(players) * (len(players) - 1) / 2
+		suggestion.RepeatPairings = m.repeats(players)
+		suggestion.NewPairings = pairs - suggestion.RepeatPairings
+		suggestions = append(suggestions, suggestion)
+	}
+	return suggestions, nil
+}
+
+// checkAttendees returns ErrMatchmakingOutsider when an attendee is not a
+// member of the given club or tournament.
+func checkAttendees(ctx context.Context, q *db.Queries, playerIDs []string, clubID, tournamentID *string) error {
+	members, err := clubMembers(ctx, q, clubID)
+	if err != nil {
+		return err
+	}
+	for _, playerID := range playerIDs {
+		if members != nil && !members[playerID] {
+			return ErrMatchmakingOutsider
+		}
+	}
+	if tournamentID == nil {
+		return nil
+	}
+	rows, err := q.GetTournament(ctx, *tournamentID)
+	if err != nil {
+		return fmt.Errorf("get tournament %s: %w", *tournamentID, err)
+	}
+	if len(rows) == 0 {
+		return fmt.Errorf("get tournament %s: %w", *tournamentID, pgx.ErrNoRows)
+	}
+	entrants := make(map[string]bool, len(rows))
+	for _, r := range rows {
+		if r.PlayerID != nil {
+			entrants[*r.PlayerID] = true
+		}
+	}
+	for _, playerID := range playerIDs {
+		if !entrants[playerID] {
+			return ErrMatchmakingOutsider
+		}
+	}
+	return nil
+}
+
+// tableSizes splits n players across the tables as evenly as their limits
+// allow: every table starts at its minimum and each remaining seat goes to
+// the smallest table that still has room.
+func tableSizes(tables []MatchmakingTable, n int) ([]int, error) {
+	if len(tables) == 0 {
+		return nil, ErrInvalidMatchmaking
+	}
+	sizes := make([]int, len(tables))
+	seated := 0
+	for i, t := range tables {
+		if t.MinPlayers < 2 || t.MaxPlayers < t.MinPlayers {
+			return nil, ErrInvalidMatchmaking
+		}
+		sizes[i] = t.MinPlayers
+		seated += t.MinPlayers
+	}
+	if seated > n {
+		return nil, ErrInvalidMatchmaking
+	}
+	for ; seated < n; seated++ {
+		next := -1
+		for i, t := range tables {
+			if sizes[i] < t.MaxPlayers && (next < 0 || sizes[i] < sizes[next]) {
+				next = i
+			}
+		}
+		if next < 0 {
+			return nil, ErrInvalidMatchmaking
+		}
+		sizes[next]++
+	}
+	return sizes, nil
+}
+
+// snakeSeating deals the players, strongest first by global Elo, to the
+// tables back and forth, so every table starts with a similar spread.
+func snakeSeating(playerIDs []string, elo map[string]float64, sizes []int) [][]string {
+	order := append([]string(nil), playerIDs...)
+	sort.SliceStable(order, func(a, b int) bool { return elo[order[a]] > elo[order[b]] })
+
+	seating := make([][]string, len(sizes))
+	table, step := 0, 1
+	for _, playerID := range order {
+		for len(seating[table]) == sizes[table] {
+			table, step = nextSnakeTable(table, step, len(sizes))
+		}
+		seating[table] = append(seating[table], playerID)
+		table, step = nextSnakeTable(table, step, len(sizes))
+	}
+	return seating
+}
+
+func nextSnakeTable(table, step, n int) (int, int) {
+	if next := table + step; next >= 0 && next < n {
+		return next, step
+	}
+	if n == 1 {
+		return 0, step
+	}
+	return table, -step
+}
+
+// matchmaker improves a seating by local search: every round applies the
+// move of one player to another table, or swap of two players, that lowers
+// the cost the most, until none does.
+type matchmaker struct {
+	tables    []MatchmakingTable
+	objective MatchmakingObjective
+	met       map[[2]string]bool
+	imbalance func(table int, players []string) float64
+}
+
+// seatingCost orders seatings by the objective first and the other criterion
+// second.
+type seatingCost struct {
+	primary, secondary float64
+}
+
+func (c seatingCost) less(o seatingCost) bool {
+	const eps = 1e-9
+	if math.Abs(c.primary-o.primary) > eps {
+		return c.primary < o.primary
+	}
+	return c.secondary < o.secondary-eps
+}
+
+func (m *matchmaker) tableCost(table int, players []string) seatingCost {
+	imbalance, repeats := m.imbalance(table, players), float64(m.repeats(players))
+	if m.objective == MatchmakingNewPairings {
+		return seatingCost{primary: repeats, secondary: imbalance}
+	}
+	return seatingCost{primary: imbalance, secondary: repeats}
+}
+
+func (m *matchmaker) repeats(players []string) int {
+	n := 0
+	for i := range players {
+		for j := i + 1; j < len(players); j++ {
+			if m.met[pairKey(players[i], players[j])] {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+func (m *matchmaker) search(seating [][]string) [][]string {
+	costs := make([]seatingCost, len(seating))
+	for i, players := range seating {
+		costs[i] = m.tableCost(i, players)
+	}
+
+	for round := 0; round < matchmakingMaxRounds; round++ {
+		var best seatingCost
+		var bestA, bestB []string
+		ta, tb := -1, -1
+		try := func(a, b int, playersA, playersB []string) {
+			ca, cb := m.tableCost(a, playersA), m.tableCost(b, playersB)
+			delta := seatingCost{
+				primary:   ca.primary + cb.primary - costs[a].primary - costs[b].primary,
+				secondary: ca.secondary + cb.secondary - costs[a].secondary - costs[b].secondary,
+			}
+			if delta.less(best) {
+				best, bestA, bestB, ta, tb = delta, playersA, playersB, a, b
+			}
+		}
+
+		for a := range seating {
+			for b := range seating {
+				if a == b {
+					continue
+				}
+				for i := range seating[a] {
+					if len(seating[a]) > m.tables[a].MinPlayers && len(seating[b]) < m.tables[b].MaxPlayers {
+						try(a, b, without(seating[a], i), append(append([]string(nil), seating[b]...), seating[a][i]))
+					}
+					if b < a {
+						continue
+					}
+					for j := range seating[b] {
+						playersA := append([]string(nil), seating[a]...)
+						playersB := append([]string(nil), seating[b]...)
+						playersA[i], playersB[j] = playersB[j], playersA[i]
+						try(a, b, playersA, playersB)
+					}
+				}
+			}
+		}
+		if ta < 0 {
+			break
+		}
+		seating[ta], seating[tb] = bestA, bestB
+		costs[ta], costs[tb] = m.tableCost(ta, bestA), m.tableCost(tb, bestB)
+	}
+	return seating
+}
+
+// tableBalance is 1 minus the distance of the win probabilities from an even
+// split, scaled so a certain winner gives 0.
+func tableBalance(predictions map[string]ArenaPrediction) float64 {
+	n := float64(len(predictions))
+	if n < 2 {
+		return 1
+	}
+	var distance float64
+	for _, p := range predictions {
+		distance += math.Abs(p.WinProbability - 1/n)
+	}
+	return 1 - distance/2/(1-1/n)
+}
+
+func pairKey(a, b string) [2]string {
+	if b < a {
+		a, b = b, a
+	}
+	return [2]string{a, b}
+}
+
+func scoreMap(playerIDs []string) map[string]float64 {
+	m := make(map[string]float64, len(playerIDs))
+	for _, playerID := range playerIDs {
+		m[playerID] = 0
+	}
+	return m
+}
+
+func uniqueIDs(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	var result []string
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+func without(players []string, i int) []string {
+	result := make([]string, 0, len(players)-1)
+	result = append(result, players[:i]...)
+	return append(result, players[i+1:]...)
+}
@@ -0,0 +1,89 @@
+package elo
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestTableSizesSplitsEvenlyWithinLimits(t *testing.T) {
+	tables := []MatchmakingTable{
+		{GameID: "a", MinPlayers: 2, MaxPlayers: 5},
+		{GameID: "b", MinPlayers: 2, MaxPlayers: 4},
+		{GameID: "c", MinPlayers: 3, MaxPlayers: 3},
+	}
+	sizes, err := tableSizes(tables, 11)
+	if err != nil {
+		t.Fatalf("tableSizes: %v", err)
+	}
+	if want := []int{4, 4, 3}; !reflect.DeepEqual(sizes, want) {
+		t.Errorf("sizes = %v, want %v", sizes, want)
+	}
+
+	for _, n := range []int{6, 13} {
+		if _, err := tableSizes(tables, n); !errors.Is(err, ErrInvalidMatchmaking) {
+			t.Errorf("%d players: err = %v, want ErrInvalidMatchmaking", n, err)
+		}
+	}
+	if _, err := tableSizes([]MatchmakingTable{{GameID: "a", MinPlayers: 1, MaxPlayers: 4}}, 3); !errors.Is(err, ErrInvalidMatchmaking) {
+		t.Errorf("table for one player: err = %v, want ErrInvalidMatchmaking", err)
+	}
+}
+
+func TestSnakeSeatingDealsBackAndForth(t *testing.T) {
+	elo := map[string]float64{"p1": 1600, "p2": 1500, "p3": 1400, "p4": 1300, "p5": 1200, "p6": 1100}
+	seating := snakeSeating([]string{"p6", "p5", "p4", "p3", "p2", "p1"}, elo, []int{3, 3})
+	want := [][]string{{"p1", "p4", "p5"}, {"p2", "p3", "p6"}}
+	if !reflect.DeepEqual(seating, want) {
+		t.Errorf("seating = %v, want %v", seating, want)
+	}
+}
+
+func TestMatchmakerSeparatesPlayersWhoHaveMet(t *testing.T) {
+	tables := []MatchmakingTable{{GameID: "a", MinPlayers: 2, MaxPlayers: 2}, {GameID: "a", MinPlayers: 2, MaxPlayers: 2}}
+	m := &matchmaker{
+		tables:    tables,
+		objective: MatchmakingNewPairings,
+		met:       map[[2]string]bool{pairKey("p1", "p2"): true, pairKey("p3", "p4"): true},
+		imbalance: func(int, []string) float64 { return 0 },
+	}
+	seating := m.search([][]string{{"p1", "p2"}, {"p3", "p4"}})
+	for i, players := range seating {
+		if m.repeats(players) != 0 {
+			t.Errorf("table %d seats %v, who have met", i, players)
+		}
+	}
+}
+
+func TestMatchmakerBalancesTables(t *testing.T) {
+	s := EloSettings{D: 400, StartingElo: 1000}
+	elo := map[string]float64{"p1": 1400, "p2": 1380, "p3": 900, "p4": 880}
+	tables := []MatchmakingTable{{GameID: "a", MinPlayers: 2, MaxPlayers: 2}, {GameID: "a", MinPlayers: 2, MaxPlayers: 2}}
+	m := &matchmaker{
+		tables:    tables,
+		objective: MatchmakingBalance,
+		imbalance: func(_ int, players []string) float64 {
+			return 1 - tableBalance(predictArena(scoreMap(players), elo, s))
+		},
+	}
+	seating := m.search([][]string{{"p1", "p3"}, {"p2", "p4"}})
+	for _, players := range seating {
+		sort.Strings(players)
+	}
+	sort.Slice(seating, func(a, b int) bool { return seating[a][0] < seating[b][0] })
+	if want := [][]string{{"p1", "p2"}, {"p3", "p4"}}; !reflect.DeepEqual(seating, want) {
+		t.Errorf("seating = %v, want the strong and the weak pair apart: %v", seating, want)
+	}
+}
+
+func TestTableBalance(t *testing.T) {
+	even := map[string]ArenaPrediction{"a": {WinProbability: 0.5}, "b": {WinProbability: 0.5}}
+	if got := tableBalance(even); !floatsEqual(got, 1) {
+		t.Errorf("even table balance = %v, want 1", got)
+	}
+	certain := map[string]ArenaPrediction{"a": {WinProbability: 1}, "b": {}, "c": {}}
+	if got := tableBalance(certain); !floatsEqual(got, 0) {
+		t.Errorf("certain winner balance = %v, want 0", got)
+	}
+}
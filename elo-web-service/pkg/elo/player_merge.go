@@ -0,0 +1,180 @@
+package elo
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+// MergePlayers folds a duplicate player into the kept one: scores, bets,
+// guarantees, market outcomes and targets, corrections, club and tournament
+// memberships, arena filters and the linked user move to targetID, the
+// duplicate is deleted and history is replayed from the duplicate's first
+// event, so the kept player is settled as if they had played it all.
+//
+// The merge fails with ErrPlayerMergeConflict, changing nothing, when the two
+// played the same match, are both guarantors of or outcomes on one market, or
+// are both linked to users.
+func (s *MatchService) MergePlayers(ctx context.Context, targetID, duplicateID string) (db.Player, error) {
+	if targetID == duplicateID {
+		return db.Player{}, ErrPlayerMergeSelf
+	}
+	var merged db.Player
+	err := runInTx(ctx, s.Pool, func(q *db.Queries) error {
+		if _, err := q.LockPlayersForEloCalculation(ctx, sortedPair(targetID, duplicateID)); err != nil {
+			return fmt.Errorf("lock players: %w", err)
+		}
+		target, err := q.GetPlayer(ctx, targetID)
+		if err != nil {
+			return fmt.Errorf("get player %s: %w", targetID, err)
+		}
+		duplicate, err := q.GetPlayer(ctx, duplicateID)
+		if err != nil {
+			return fmt.Errorf("get player %s: %w", duplicateID, err)
+		}
+		if err := checkPlayerMerge(ctx, q, target, duplicate); err != nil {
+			return err
+		}
+
+		from, err := q.GetPlayerFirstEventDate(ctx, duplicateID)
+		hasEvents := err == nil
+		if err != nil && !db.IsNoRows(err) {
+			return fmt.Errorf("get first event of player %s: %w", duplicateID, err)
+		}
+
+		arenas, err := reassignPlayer(ctx, q, targetID, duplicateID)
+		if err != nil {
+			return err
+		}
+
+		// The replay drops every settlement of the duplicate, all dated on or
+		// after its first event, before the player row goes.
+		if hasEvents {
+			if err := s.recalculateEloFromDate(ctx, q, from); err != nil {
+				return fmt.Errorf("unable to recalculate Elo: %w", err)
+			}
+		}
+		for _, arena := range arenas {
+			if err := rebuildArena(ctx, q, arena); err != nil {
+				return err
+			}
+		}
+		if err := q.DeletePlayer(ctx, duplicateID); err != nil {
+			return fmt.Errorf("delete player %s: %w", duplicateID, err)
+		}
+		if err := RecalculateBetLimits(ctx, q, []string{targetID}); err != nil {
+			return fmt.Errorf("recalculate bet limits: %w", err)
+		}
+
+		if merged, err = q.GetPlayer(ctx, targetID); err != nil {
+			return fmt.Errorf("get player %s: %w", targetID, err)
+		}
+		return recordAudit(ctx, q, AuditEntityPlayer, targetID, AuditActionMerge, duplicate, merged)
+	})
+	return merged, err
+}
+
+// checkPlayerMerge rejects merges that would leave one player on both sides
+// of a match or a market, or mix a guest with a rated player: a guest target
+// cannot take over bets, guarantees or corrections, and a rated target would
+// turn the guest's matches into rated ones.
+func checkPlayerMerge(ctx context.Context, q *db.Queries, target, duplicate db.Player) error {
+	targetID, duplicateID := target.ID, duplicate.ID
+	if target.IsGuest {
+		hasStakes, err := q.PlayerHasRatingStakes(ctx, duplicateID)
+		if err != nil {
+			return fmt.Errorf("check stakes of player %s: %w", duplicateID, err)
+		}
+		if hasStakes {
+			return fmt.Errorf("%w: игрок-гость не может принять ставки, гарантии рынков или корректировки", ErrPlayerMergeConflict)
+		}
+	}
+	if target.IsGuest != duplicate.IsGuest {
+		return fmt.Errorf("%w: у игроков разный статус гостя", ErrPlayerMergeConflict)
+	}
+	matches, err := q.ListSharedMatches(ctx, db.ListSharedMatchesParams{TargetID: targetID, DuplicateID: duplicateID})
+	if err != nil {
+		return fmt.Errorf("list shared matches: %w", err)
+	}
+	if len(matches) > 0 {
+		return fmt.Errorf("%w: оба игрока участвовали в партии %s", ErrPlayerMergeConflict, matches[0])
+	}
+	markets, err := q.ListSharedMarkets(ctx, db.ListSharedMarketsParams{TargetID: targetID, DuplicateID: duplicateID})
+	if err != nil {
+		return fmt.Errorf("list shared markets: %w", err)
+	}
+	if len(markets) > 0 {
+		return fmt.Errorf("%w: оба игрока участвуют в рынке %s", ErrPlayerMergeConflict, markets[0])
+	}
+	links, err := q.ListPlayerUserLinks(ctx)
+	if err != nil {
+		return fmt.Errorf("list player user links: %w", err)
+	}
+	linked := 0
+	for _, l := range links {
+		if l.PlayerID != nil && (*l.PlayerID == targetID || *l.PlayerID == duplicateID) {
+			linked++
+		}
+	}
+	if linked > 1 {
+		return fmt.Errorf("%w: оба игрока привязаны к пользователям", ErrPlayerMergeConflict)
+	}
+	return nil
+}
+
+// reassignPlayer points every reference to duplicateID at targetID except
+// settlements, which the replay rebuilds. It returns the arenas whose player
+// or club filter now admits the target, which are rebuilt from scratch.
+func reassignPlayer(ctx context.Context, q *db.Queries, targetID, duplicateID string) ([]db.Arena, error) {
+	if err := q.ReassignMatchScores(ctx, db.ReassignMatchScoresParams{TargetID: targetID, DuplicateID: duplicateID}); err != nil {
+		return nil, fmt.Errorf("move match scores of player %s: %w", duplicateID, err)
+	}
+	if err := q.ReassignBets(ctx, db.ReassignBetsParams{TargetID: targetID, DuplicateID: duplicateID}); err != nil {
+		return nil, fmt.Errorf("move bets of player %s: %w", duplicateID, err)
+	}
+	if err := q.ReassignMarketGuarantors(ctx, db.ReassignMarketGuarantorsParams{TargetID: targetID, DuplicateID: duplicateID}); err != nil {
+		return nil, fmt.Errorf("move guarantees of player %s: %w", duplicateID, err)
+	}
+	if err := q.ReassignMarketOutcomes(ctx, db.ReassignMarketOutcomesParams{TargetID: targetID, DuplicateID: duplicateID}); err != nil {
+		return nil, fmt.Errorf("move market outcomes of player %s: %w", duplicateID, err)
+	}
+	if err := q.ReassignMarketTargets(ctx, db.ReassignMarketTargetsParams{TargetID: targetID, DuplicateID: duplicateID}); err != nil {
+		return nil, fmt.Errorf("move market targets of player %s: %w", duplicateID, err)
+	}
+	if err := q.ReassignCorrections(ctx, db.ReassignCorrectionsParams{TargetID: targetID, DuplicateID: duplicateID}); err != nil {
+		return nil, fmt.Errorf("move corrections of player %s: %w", duplicateID, err)
+	}
+	clubArenas, err := q.ListPlayerClubArenas(ctx, duplicateID)
+	if err != nil {
+		return nil, fmt.Errorf("list club arenas of player %s: %w", duplicateID, err)
+	}
+	if err := q.ReassignMemberships(ctx, db.ReassignMembershipsParams{TargetID: targetID, DuplicateID: duplicateID}); err != nil {
+		return nil, fmt.Errorf("move memberships of player %s: %w", duplicateID, err)
+	}
+	playerArenas, err := q.ReassignArenaPlayers(ctx, db.ReassignArenaPlayersParams{TargetID: targetID, DuplicateID: duplicateID})
+	if err != nil {
+		return nil, fmt.Errorf("move arena filters of player %s: %w", duplicateID, err)
+	}
+	if err := q.ReassignLinkedUser(ctx, db.ReassignLinkedUserParams{TargetID: targetID, DuplicateID: duplicateID}); err != nil {
+		return nil, fmt.Errorf("move linked user of player %s: %w", duplicateID, err)
+	}
+	if err := q.ReassignSeasonStandings(ctx, db.ReassignSeasonStandingsParams{TargetID: targetID, DuplicateID: duplicateID}); err != nil {
+		return nil, fmt.Errorf("move season standings of player %s: %w", duplicateID, err)
+	}
+
+	arenas := clubArenas
+	for _, a := range playerArenas {
+		if !slices.ContainsFunc(arenas, func(b db.Arena) bool { return b.ID == a.ID }) {
+			arenas = append(arenas, a)
+		}
+	}
+	return arenas, nil
+}
+
+func sortedPair(a, b string) []string {
+	ids := []string{a, b}
+	sortPlayerIDs(ids)
+	return ids
+}
@@ -18,13 +18,21 @@ type Player struct {
 	Elo                       float64
 	League                    string
 	Rank                      *int
-	MatchesLeftForElite       int // > 0 only for amateur players
-	WinsNeededForAmateurLower int // lower bound: elo fixed, only rating grows
-	WinsNeededForAmateurUpper int // upper bound: elo also grows ≈ K/2 per win
+	MatchesLeftForElite       int  // > 0 only for amateur players
+	WinsNeededForAmateurLower int  // lower bound: elo fixed, only rating grows
+	WinsNeededForAmateurUpper int  // upper bound: elo also grows ≈ K/2 per win
+	Guest                     bool // listed after the ranked players, without a rank
+	Settled                   bool // has a global settlement as of the reference date
 }
 type IPlayerService interface {
 	GetPlayersWithRank(ctx context.Context, when *time.Time) ([]Player, error)
-	CreatePlayer(ctx context.Context, id string, name string) (db.Player, error)
+	// Leaderboard returns the ranked table of an arena as of at. Returns
+	// ErrClubNotFound for an unknown club.
+	Leaderboard(ctx context.Context, at time.Time, query LeaderboardQuery) ([]LeaderboardEntry, error)
+	// LeaderboardDiff returns the table as of at with each player's standing
+	// as of since. Returns ErrInvalidLeaderboardPeriod unless since < at.
+	LeaderboardDiff(ctx context.Context, since, at time.Time, query LeaderboardQuery) ([]LeaderboardEntry, error)
+	CreatePlayer(ctx context.Context, id string, name string, guest bool) (db.Player, error)
 	UpdatePlayer(ctx context.Context, id string, name string) (db.Player, error)
 	DeletePlayer(ctx context.Context, id string) error
 	GetPlayer(ctx context.Context, id string) (db.Player, error)
@@ -33,6 +41,11 @@ type IPlayerService interface {
 	RatingHistory(ctx context.Context, playerID string) ([]db.RatingHistoryRow, error)
 	GetPlayerGameStats(ctx context.Context, playerID string) ([]db.GetPlayerGameStatsRow, error)
 	GetPlayerGameEloStats(ctx context.Context, playerID string) ([]db.GetPlayerGameEloStatsRow, error)
+	// HeadToHead compares two players over their shared matches. Returns
+	// ErrHeadToHeadSelf when both ids are the same player.
+	HeadToHead(ctx context.Context, playerID, otherID string) (HeadToHead, error)
+	Rivals(ctx context.Context, playerID string) (Rivals, error)
+	ListAchievements(ctx context.Context, playerID string) ([]db.PlayerAchievement, error)
 }
 
 type PlayerService struct {
@@ -102,22 +115,37 @@ func (s *PlayerService) GetPlayersWithRank(ctx context.Context, when *time.Time)
 	if when != nil {
 		ref = *when
 	}
+	return playersWithRank(ctx, s.Queries, ref)
+}
 
+// playersWithRank builds the ranked global leaderboard as of ref.
+func playersWithRank(ctx context.Context, q *db.Queries, ref time.Time) ([]Player, error) {
 	dt := pgtype.Timestamptz{Time: ref, Valid: true}
 
-	settingsRow, err := s.Queries.GetEloSettingsForDate(ctx, pgtype.Timestamptz{Time: ref, Valid: true})
+	settingsRow, err := q.GetEloSettingsForDate(ctx, pgtype.Timestamptz{Time: ref, Valid: true})
 	if err != nil {
 		return nil, fmt.Errorf("unable to get elo settings: %w", err)
 	}
 	settings := EloSettingsFromDB(settingsRow)
 
-	rows, err := s.Queries.ListPlayersWithStats(ctx, dt)
+	rows, err := q.ListPlayersWithStats(ctx, dt)
 	if err != nil {
 		return nil, fmt.Errorf("unable to retrieve players from db: %w", err)
 	}
 
 	players := make([]Player, 0, len(rows))
+	var guests []Player
 	for _, r := range rows {
+		if r.IsGuest {
+			guests = append(guests, Player{
+				ID:     r.ID,
+				Name:   r.Name,
+				Elo:    settings.StartingRatingGlobal,
+				League: initialLeagueForStarting(settings.StartingRatingGlobal, settings.StartingElo, settings),
+				Guest:  true,
+			})
+			continue
+		}
 		ratingVal := settings.StartingRatingGlobal
 		if r.Rating != nil {
 			ratingVal = r.Rating.(float64)
@@ -160,10 +188,18 @@ func (s *PlayerService) GetPlayersWithRank(ctx context.Context, when *time.Time)
 			MatchesLeftForElite:       matchesLeftForElite,
 			WinsNeededForAmateurLower: winsLower,
 			WinsNeededForAmateurUpper: winsUpper,
+			Settled:                   r.Rating != nil,
 		})
 	}
 
-	// Sort: elite first, then amateur, then newbie; within each league by rating descending.
+	rankPlayers(players)
+
+	return append(players, guests...), nil
+}
+
+// rankPlayers sorts a leaderboard (elite first, then amateur, then newbie;
+// within each league by rating descending) and assigns continuous ranks.
+func rankPlayers(players []Player) {
 	slices.SortFunc(players, func(a, b Player) int {
 		pa, pb := leaguePriority(a.League), leaguePriority(b.League)
 		if pa != pb {
@@ -197,15 +233,14 @@ func (s *PlayerService) GetPlayersWithRank(ctx context.Context, when *time.Time)
 		}
 		rank++
 	}
-
-	return players, nil
 }
 
-func (s *PlayerService) CreatePlayer(ctx context.Context, id string, name string) (db.Player, error) {
+func (s *PlayerService) CreatePlayer(ctx context.Context, id string, name string, guest bool) (db.Player, error) {
 	return s.Queries.CreatePlayer(ctx, db.CreatePlayerParams{
 		ID:            id,
 		Name:          name,
 		GeologistName: pgtype.Text{Valid: false},
+		IsGuest:       guest,
 	})
 }
 
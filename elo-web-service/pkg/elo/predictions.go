@@ -0,0 +1,141 @@
+package elo
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+// ArenaPrediction is a player's outlook for a proposed match in one arena.
+// ExpectedScore is the normalized score WinExpectation predicts (the shares
+// of all players sum to 1); WinProbability is the chance of finishing first
+// alone.
+type ArenaPrediction struct {
+	Elo            float64
+	ExpectedScore  float64
+	WinProbability float64
+}
+
+// PlayerPrediction is a player's outlook in the global and the game arena.
+type PlayerPrediction struct {
+	PlayerID string
+	Global   ArenaPrediction
+	Game     ArenaPrediction
+}
+
+// PredictMatch rates a proposed lineup of gameID from the players' current
+// Elo, with the settings a match played now would use. Nothing is stored.
+func (s *MatchService) PredictMatch(ctx context.Context, gameID string, playerIDs []string) ([]PlayerPrediction, error) {
+	scores := make(map[string]float64, len(playerIDs))
+	for _, playerID := range playerIDs {
+		scores[playerID] = 0
+	}
+	if len(scores) < 2 {
+		return nil, ErrTooFewPlayers
+	}
+	q := s.Queries
+
+	if _, err := q.GetGameByID(ctx, gameID); err != nil {
+		return nil, fmt.Errorf("get game %s: %w", gameID, err)
+	}
+	now := time.Now()
+	settingsRow, err := q.GetEloSettingsForDate(ctx, pgtype.Timestamptz{Time: now, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("get elo settings: %w", err)
+	}
+	settings := EloSettingsFromDB(settingsRow)
+	params, err := gameRatingParamsAt(ctx, q, gameID, now)
+	if err != nil {
+		return nil, err
+	}
+	gameSettings := params.gameSettings(settings)
+
+	for playerID := range scores {
+		if _, err := q.GetPlayer(ctx, playerID); err != nil {
+			return nil, fmt.Errorf("get player %s: %w", playerID, err)
+		}
+	}
+	guests, err := listGuests(ctx, q, playerIDs)
+	if err != nil {
+		return nil, err
+	}
+	globalElo, err := currentArenaElo(ctx, q, "", playerIDs, guests, settings.StartingElo)
+	if err != nil {
+		return nil, err
+	}
+	gameElo, err := currentArenaElo(ctx, q, gameID, playerIDs, guests, gameSettings.StartingElo)
+	if err != nil {
+		return nil, err
+	}
+
+	global := predictArena(scores, globalElo, settings)
+	game := predictArena(scores, gameElo, gameSettings)
+	predictions := make([]PlayerPrediction, 0, len(scores))
+	for _, playerID := range playerIDs {
+		if _, ok := scores[playerID]; !ok {
+			continue
+		}
+		delete(scores, playerID)
+		predictions = append(predictions, PlayerPrediction{PlayerID: playerID, Global: global[playerID], Game: game[playerID]})
+	}
+	return predictions, nil
+}
+
+// currentArenaElo returns the latest Elo of every player in the global arena
+// (gameID "") or in the game's arena. Guests and players never settled there
+// get startingElo.
+func currentArenaElo(ctx context.Context, q *db.Queries, gameID string, playerIDs []string, guests map[string]bool, startingElo float64) (map[string]float64, error) {
+	result := make(map[string]float64, len(playerIDs))
+	for _, playerID := range playerIDs {
+		result[playerID] = startingElo
+		if guests[playerID] {
+			continue
+		}
+		var elo float64
+		var err error
+		if gameID == "" {
+			elo, err = q.GetPlayerLatestGlobalElo(ctx, playerID)
+		} else {
+			elo, err = q.GetPlayerLatestGameElo(ctx, db.GetPlayerLatestGameEloParams{PlayerID: playerID, GameID: gameID})
+		}
+		switch {
+		case err == nil:
+			result[playerID] = elo
+		case !db.IsNoRows(err):
+			return nil, fmt.Errorf("get elo of player %s: %w", playerID, err)
+		}
+	}
+	return result, nil
+}
+
+// predictArena computes every player's expected score and win probability
+// from their Elo. The win probability follows the Plackett–Luce model with
+// strengths 10^(elo/D): for two players it equals the expected score, for
+// more it is each player's strength over the sum of all.
+func predictArena(players map[string]float64, elo map[string]float64, s EloSettings) map[string]ArenaPrediction {
+	top := math.Inf(-1)
+	for playerID := range players {
+		top = math.Max(top, elo[playerID])
+	}
+	strength := make(map[string]float64, len(players))
+	var total float64
+	for playerID := range players {
+		// Scaled by the strongest player's strength so large Elo cannot overflow.
+		strength[playerID] = math.Pow(10, (elo[playerID]-top)/s.D)
+		total += strength[playerID]
+	}
+
+	result := make(map[string]ArenaPrediction, len(players))
+	for playerID := range players {
+		result[playerID] = ArenaPrediction{
+			Elo:            elo[playerID],
+			ExpectedScore:  WinExpectation(elo[playerID], players, s.StartingElo, elo, s.D),
+			WinProbability: strength[playerID] / total,
+		}
+	}
+	return result
+}
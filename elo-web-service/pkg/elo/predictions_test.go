@@ -0,0 +1,41 @@
+package elo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPredictArenaTwoPlayersMatchesEloExpectation(t *testing.T) {
+	s := EloSettings{D: 400, StartingElo: 1000}
+	players := map[string]float64{"a": 0, "b": 0}
+	elo := map[string]float64{"a": 1200, "b": 1000}
+
+	got := predictArena(players, elo, s)
+	want := 1 / (1 + math.Pow(10, -200.0/400))
+	if !floatsEqual(got["a"].WinProbability, want) {
+		t.Errorf("win probability of a = %v, want %v", got["a"].WinProbability, want)
+	}
+	if !floatsEqual(got["a"].ExpectedScore, got["a"].WinProbability) {
+		t.Errorf("expected score %v differs from win probability %v for two players", got["a"].ExpectedScore, got["a"].WinProbability)
+	}
+}
+
+func TestPredictArenaSharesSumToOne(t *testing.T) {
+	s := EloSettings{D: 400, StartingElo: 1000}
+	players := map[string]float64{"a": 0, "b": 0, "c": 0, "d": 0}
+	// Large values check the strengths do not overflow.
+	elo := map[string]float64{"a": 90000, "b": 89800, "c": 89000, "d": 89000}
+
+	got := predictArena(players, elo, s)
+	var expected, win float64
+	for _, p := range got {
+		expected += p.ExpectedScore
+		win += p.WinProbability
+	}
+	if !floatsEqual(expected, 1) || !floatsEqual(win, 1) {
+		t.Errorf("sums = %v expected score, %v win probability, want 1", expected, win)
+	}
+	if got["a"].WinProbability <= got["b"].WinProbability || !floatsEqual(got["c"].WinProbability, got["d"].WinProbability) {
+		t.Errorf("win probabilities do not follow Elo: %+v", got)
+	}
+}
@@ -0,0 +1,128 @@
+package elo
+
+import (
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Rating algorithms as stored in elo_settings.global_arena_algorithm /
+// game_arena_algorithm. The algorithm drives an arena's true-skill track
+// (settlement elo_after); the display rating track and leagues (ADR-03) are
+// algorithm-independent and follow it on the same Elo scale.
+const (
+	AlgorithmElo       = "elo"
+	AlgorithmGlicko2   = "glicko2"
+	AlgorithmTrueSkill = "trueskill"
+)
+
+// Skill is one competitor's estimate on the Elo scale. Deviation and
+// Volatility are zero for algorithms that do not track them.
+type Skill struct {
+	Rating     float64
+	Deviation  float64 // Glicko-2 RD, TrueSkill sigma
+	Volatility float64 // Glicko-2 only
+}
+
+// SkillChange is one competitor's outcome of a match, split like a settlement's
+// elo_staked / elo_earned: Staked is the expectation part of the change (about
+// what finishing alone in last place would cost), Earned the result part, and
+// After.Rating = prev.Rating + Staked + Earned.
+type SkillChange struct {
+	After  Skill
+	Staked float64
+	Earned float64
+}
+
+// RatingAlgorithm settles one match between competitors (match sides, see
+// teams.go). prev and scores share their keys; scores are ranking scores
+// (higher is better, equal is a tie).
+type RatingAlgorithm interface {
+	// Starting returns the skill of a competitor with no history.
+	Starting() Skill
+	Rate(prev map[string]Skill, scores map[string]float64) map[string]SkillChange
+}
+
+// ValidateRatingAlgorithm checks an algorithm name for elo_settings.
+func ValidateRatingAlgorithm(name string) error {
+	switch name {
+	case AlgorithmElo, AlgorithmGlicko2, AlgorithmTrueSkill:
+		return nil
+	}
+	return fmt.Errorf("%w: %q", ErrInvalidRatingAlgorithm, name)
+}
+
+// tracksUncertainty reports whether an algorithm keeps a deviation (and
+// possibly a volatility) besides the rating.
+func tracksUncertainty(name string) bool {
+	return name == AlgorithmGlicko2 || name == AlgorithmTrueSkill
+}
+
+// newRatingAlgorithm returns the named algorithm configured from the settings.
+// The empty name (settings built in code) means Elo; unknown names never reach
+// here — they are rejected by ValidateRatingAlgorithm and the column CHECK.
+func newRatingAlgorithm(name string, s EloSettings) RatingAlgorithm {
+	switch name {
+	case AlgorithmGlicko2:
+		return glicko2Algorithm{
+			startingRating:     s.StartingElo,
+			startingDeviation:  s.Glicko2StartingDeviation,
+			startingVolatility: s.Glicko2StartingVolatility,
+			tau:                s.Glicko2Tau,
+		}
+	case AlgorithmTrueSkill:
+		return trueSkillAlgorithm{
+			startingMu:      s.StartingElo,
+			startingSigma:   s.TrueSkillStartingSigma,
+			beta:            s.TrueSkillBeta,
+			tau:             s.TrueSkillTau,
+			drawProbability: s.TrueSkillDrawProbability,
+		}
+	default:
+		return eloAlgorithm{k: s.K, d: s.D, startingElo: s.StartingElo, winReward: s.WinReward}
+	}
+}
+
+// eloAlgorithm is the classic multiplayer Elo of elo.go.
+type eloAlgorithm struct {
+	k, d, startingElo, winReward float64
+}
+
+func (a eloAlgorithm) Starting() Skill { return Skill{Rating: a.startingElo} }
+
+func (a eloAlgorithm) Rate(prev map[string]Skill, scores map[string]float64) map[string]SkillChange {
+	prevElo := make(map[string]float64, len(prev))
+	for key, sk := range prev {
+		prevElo[key] = sk.Rating
+	}
+	newElo := CalculateNewElo(prevElo, a.startingElo, scores, a.k, a.d, a.winReward)
+	absoluteLoserScore := GetAbsoluteLoserScore(scores)
+
+	out := make(map[string]SkillChange, len(scores))
+	for key, score := range scores {
+		out[key] = SkillChange{
+			After:  Skill{Rating: newElo[key]},
+			Staked: -a.k * WinExpectation(prevElo[key], scores, a.startingElo, prevElo, a.d),
+			Earned: a.k * NormalizedScore(score, scores, absoluteLoserScore, a.winReward),
+		}
+	}
+	return out
+}
+
+// withStartingUncertainty fills a missing deviation / volatility from the
+// algorithm's starting values.
+func withStartingUncertainty(sk, start Skill) Skill {
+	if sk.Deviation <= 0 {
+		sk.Deviation = start.Deviation
+	}
+	if sk.Volatility <= 0 {
+		sk.Volatility = start.Volatility
+	}
+	return sk
+}
+
+// uncertaintyColumn converts a deviation / volatility into the nullable
+// settlement column (NULL when the algorithm does not track it).
+func uncertaintyColumn(v float64) pgtype.Float8 {
+	return pgtype.Float8{Float64: v, Valid: v > 0}
+}
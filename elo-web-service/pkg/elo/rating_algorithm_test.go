@@ -0,0 +1,160 @@
+package elo
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func skillNear(t *testing.T, name string, got, want, tol float64) {
+	t.Helper()
+	if math.Abs(got-want) > tol {
+		t.Errorf("%s: got %.5f, want %.5f", name, got, want)
+	}
+}
+
+// TestGlicko2PaperExample reproduces the worked example of Glickman's
+// "Example of the Glicko-2 system": a 1500/200 player beats 1400/30 and loses
+// to 1550/100 and 1700/300 in one rating period.
+func TestGlicko2PaperExample(t *testing.T) {
+	algo := glicko2Algorithm{startingRating: 1500, startingDeviation: 350, startingVolatility: 0.06, tau: 0.5}
+	prev := map[string]Skill{
+		"p":    {Rating: 1500, Deviation: 200, Volatility: 0.06},
+		"1400": {Rating: 1400, Deviation: 30, Volatility: 0.06},
+		"1550": {Rating: 1550, Deviation: 100, Volatility: 0.06},
+		"1700": {Rating: 1700, Deviation: 300, Volatility: 0.06},
+	}
+	scores := map[string]float64{"1700": 4, "1550": 3, "p": 2, "1400": 1}
+
+	got := algo.Rate(prev, scores)["p"]
+	skillNear(t, "rating", got.After.Rating, 1464.06, 0.01)
+	skillNear(t, "deviation", got.After.Deviation, 151.52, 0.01)
+	skillNear(t, "volatility", got.After.Volatility, 0.05999, 0.00001)
+	skillNear(t, "staked+earned", got.Staked+got.Earned, got.After.Rating-1500, 1e-9)
+}
+
+func TestGlicko2DrawBetweenEqualsKeepsRating(t *testing.T) {
+	algo := newRatingAlgorithm(AlgorithmGlicko2, EloSettings{
+		StartingElo: 1000, Glicko2StartingDeviation: 350, Glicko2StartingVolatility: 0.06, Glicko2Tau: 0.5,
+	})
+	got := algo.Rate(map[string]Skill{"a": {Rating: 1000}, "b": {Rating: 1000}}, map[string]float64{"a": 1, "b": 1})
+	for key, r := range got {
+		skillNear(t, key+" rating", r.After.Rating, 1000, 1e-9)
+		if r.After.Deviation >= 350 {
+			t.Errorf("%s: deviation %v should shrink after a match", key, r.After.Deviation)
+		}
+	}
+}
+
+// TrueSkill reference values (mu 25, sigma 25/3, beta 25/6, tau 25/300,
+// draw probability 0.1) as published with the Moserware / python trueskill
+// implementations.
+var trueSkillReference = trueSkillAlgorithm{
+	startingMu: 25, startingSigma: 25.0 / 3, beta: 25.0 / 6, tau: 25.0 / 300, drawProbability: 0.1,
+}
+
+func TestTrueSkillTwoPlayers(t *testing.T) {
+	prev := map[string]Skill{"a": {Rating: 25}, "b": {Rating: 25}}
+
+	won := trueSkillReference.Rate(prev, map[string]float64{"a": 1, "b": 0})
+	skillNear(t, "winner mu", won["a"].After.Rating, 29.39583, 1e-4)
+	skillNear(t, "winner sigma", won["a"].After.Deviation, 7.17148, 1e-4)
+	skillNear(t, "loser mu", won["b"].After.Rating, 20.60417, 1e-4)
+	skillNear(t, "loser sigma", won["b"].After.Deviation, 7.17148, 1e-4)
+	// The loser finished alone in last place: the whole change is staked.
+	skillNear(t, "loser earned", won["b"].Earned, 0, 1e-9)
+
+	drawn := trueSkillReference.Rate(prev, map[string]float64{"a": 1, "b": 1})
+	skillNear(t, "draw mu", drawn["a"].After.Rating, 25, 1e-4)
+	skillNear(t, "draw sigma", drawn["a"].After.Deviation, 6.45755, 1e-4)
+}
+
+func TestTrueSkillThreePlayers(t *testing.T) {
+	prev := map[string]Skill{"a": {Rating: 25}, "b": {Rating: 25}, "c": {Rating: 25}}
+	got := trueSkillReference.Rate(prev, map[string]float64{"a": 3, "b": 2, "c": 1})
+
+	skillNear(t, "first mu", got["a"].After.Rating, 31.67535, 1e-3)
+	skillNear(t, "first sigma", got["a"].After.Deviation, 6.65594, 1e-3)
+	skillNear(t, "second mu", got["b"].After.Rating, 25.00000, 1e-3)
+	skillNear(t, "second sigma", got["b"].After.Deviation, 6.20810, 1e-3)
+	skillNear(t, "third mu", got["c"].After.Rating, 18.32465, 1e-3)
+	skillNear(t, "third sigma", got["c"].After.Deviation, 6.65594, 1e-3)
+}
+
+func TestEloAlgorithmMatchesCalculateNewElo(t *testing.T) {
+	algo := newRatingAlgorithm("", testSettings)
+	prev := map[string]Skill{"a": {Rating: 1100}, "b": {Rating: 1000}, "c": {Rating: 900}}
+	scores := map[string]float64{"a": 10, "b": 30, "c": 20}
+
+	want := CalculateNewElo(map[string]float64{"a": 1100, "b": 1000, "c": 900}, testSettings.StartingElo,
+		scores, testSettings.K, testSettings.D, testSettings.WinReward)
+	for key, r := range algo.Rate(prev, scores) {
+		if !floatsEqual(r.After.Rating, want[key]) {
+			t.Errorf("%s: got %v, want %v", key, r.After.Rating, want[key])
+		}
+		if !floatsEqual(prev[key].Rating+r.Staked+r.Earned, r.After.Rating) {
+			t.Errorf("%s: staked+earned does not add up", key)
+		}
+	}
+}
+
+func TestValidateRatingAlgorithm(t *testing.T) {
+	for _, name := range []string{AlgorithmElo, AlgorithmGlicko2, AlgorithmTrueSkill} {
+		if err := ValidateRatingAlgorithm(name); err != nil {
+			t.Errorf("%s: unexpected error %v", name, err)
+		}
+	}
+	if err := ValidateRatingAlgorithm("glicko"); !errors.Is(err, ErrInvalidRatingAlgorithm) {
+		t.Errorf("got %v, want ErrInvalidRatingAlgorithm", err)
+	}
+}
+
+func TestBuildEloResultsPerArenaAlgorithm(t *testing.T) {
+	elos := map[string]float64{"a": 1000, "b": 1000}
+	state := prevStateFor(elos, nil)
+	state.Settings.GlobalAlgorithm = AlgorithmGlicko2
+	state.Settings.Glicko2StartingDeviation = 350
+	state.Settings.Glicko2StartingVolatility = 0.06
+	state.Settings.Glicko2Tau = 0.5
+
+	results := buildEloResults(map[string]float64{"a": 1, "b": 0}, state)
+	elo := buildEloResults(map[string]float64{"a": 1, "b": 0}, prevStateFor(elos, nil))
+
+	a := results["a"]
+	if a.globalDeviation <= 0 || a.globalDeviation >= 350 || a.globalVolatility <= 0 {
+		t.Errorf("glicko-2 global track: deviation %v, volatility %v", a.globalDeviation, a.globalVolatility)
+	}
+	// A fresh, uncertain Glicko-2 player moves much further than with K = 32.
+	if a.newGlobalElo-1000 <= elo["a"].newGlobalElo-1000 {
+		t.Errorf("glicko-2 gain %v should exceed elo gain %v", a.newGlobalElo-1000, elo["a"].newGlobalElo-1000)
+	}
+	if !floatsEqual(a.eloStaked+a.eloEarned, a.newGlobalElo-1000) {
+		t.Errorf("staked %v + earned %v != delta %v", a.eloStaked, a.eloEarned, a.newGlobalElo-1000)
+	}
+	// The game arena still runs Elo and tracks no uncertainty.
+	if !floatsEqual(a.newGameElo, elo["a"].newGameElo) || a.gameDeviation != 0 {
+		t.Errorf("game track: elo %v (want %v), deviation %v", a.newGameElo, elo["a"].newGameElo, a.gameDeviation)
+	}
+}
+
+func TestBuildEloResultsTeamUncertaintyScalesPerMember(t *testing.T) {
+	state := prevStateFor(map[string]float64{"a": 1000, "b": 1000, "c": 1000, "d": 1000},
+		map[string]string{"a": "A", "b": "A", "c": "B", "d": "B"})
+	state.Settings.GameAlgorithm = AlgorithmTrueSkill
+	state.Settings.TrueSkillStartingSigma = 400
+	state.Settings.TrueSkillBeta = 200
+	state.Settings.TrueSkillTau = 4
+	state.Settings.TrueSkillDrawProbability = 0.1
+	state.GameDeviation = map[string]float64{"a": 100, "b": 300}
+
+	results := buildEloResults(map[string]float64{"a": 1, "b": 1, "c": 0, "d": 0}, state)
+	if results["a"].newGameElo != results["b"].newGameElo {
+		t.Errorf("teammates with equal ratings should move equally: %v vs %v", results["a"].newGameElo, results["b"].newGameElo)
+	}
+	if results["a"].gameDeviation >= 100 || results["b"].gameDeviation >= 300 {
+		t.Errorf("deviations should shrink: a %v, b %v", results["a"].gameDeviation, results["b"].gameDeviation)
+	}
+	if !floatsEqual(results["a"].gameDeviation/results["b"].gameDeviation, 100.0/300.0) {
+		t.Errorf("member deviations should keep their ratio, got %v / %v", results["a"].gameDeviation, results["b"].gameDeviation)
+	}
+}
@@ -0,0 +1,248 @@
+package elo
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+// Record is one hall of fame entry: who holds it, the value and when it was
+// set. MatchID and MarketID point at the event that set it, when there is one.
+type Record struct {
+	PlayerID   string
+	PlayerName string
+	Value      float64
+	Date       time.Time
+	MatchID    *string
+	MarketID   *string
+}
+
+// WinStreakRecord is the longest run of consecutive matches a player finished
+// first alone. A shared first place or any other finish ends the run.
+type WinStreakRecord struct {
+	PlayerID    string
+	PlayerName  string
+	Length      int
+	StartedAt   time.Time
+	EndedAt     time.Time
+	LastMatchID string
+}
+
+// GameScoreRecords are the highest and lowest raw scores ever recorded in a
+// score-based game.
+type GameScoreRecords struct {
+	GameID    string
+	GameName  string
+	HighScore Record
+	LowScore  Record
+}
+
+// Records is the hall of fame across all games. Every entry is nil until the
+// history has something to hold it.
+type Records struct {
+	Scores              []GameScoreRecords
+	LargestRatingGain   *Record
+	LongestWinStreak    *WinStreakRecord
+	MostMatchesInDay    *Record
+	BiggestMarketPayout *Record
+}
+
+// GameRecords is the hall of fame of one game. Scores is nil for placement
+// games; the rating gain is measured in the game's arena.
+type GameRecords struct {
+	GameID            string
+	GameName          string
+	Scores            *GameScoreRecords
+	LargestRatingGain *Record
+	LongestWinStreak  *WinStreakRecord
+	MostMatchesInDay  *Record
+}
+
+type IRecordsService interface {
+	Records(ctx context.Context) (Records, error)
+	GameRecords(ctx context.Context, gameID string) (GameRecords, error)
+}
+
+// RecordsService reads records straight from matches and settlements, so any
+// history edit is reflected on the next read.
+type RecordsService struct {
+	Queries *db.Queries
+	Pool    *pgxpool.Pool
+}
+
+func NewRecordsService(pool *pgxpool.Pool) IRecordsService {
+	return &RecordsService{Queries: db.New(pool), Pool: pool}
+}
+
+func (s *RecordsService) Records(ctx context.Context) (Records, error) {
+	var result Records
+	var err error
+	if result.Scores, err = s.scoreRecords(ctx, nil); err != nil {
+		return Records{}, err
+	}
+
+	gains, err := s.Queries.ListLargestGlobalMatchGain(ctx)
+	if err != nil {
+		return Records{}, fmt.Errorf("list largest rating gain: %w", err)
+	}
+	for _, r := range gains {
+		result.LargestRatingGain = &Record{PlayerID: r.PlayerID, PlayerName: r.PlayerName, Value: r.Gain, Date: r.Date.Time, MatchID: r.MatchID}
+	}
+
+	payouts, err := s.Queries.ListBiggestMarketPayout(ctx)
+	if err != nil {
+		return Records{}, fmt.Errorf("list biggest market payout: %w", err)
+	}
+	for _, r := range payouts {
+		result.BiggestMarketPayout = &Record{PlayerID: r.PlayerID, PlayerName: r.PlayerName, Value: r.Payout, Date: r.Date.Time, MarketID: r.MarketID}
+	}
+
+	if result.MostMatchesInDay, err = s.mostMatchesInDay(ctx, nil); err != nil {
+		return Records{}, err
+	}
+	if result.LongestWinStreak, err = s.longestWinStreak(ctx, nil); err != nil {
+		return Records{}, err
+	}
+	return result, nil
+}
+
+func (s *RecordsService) GameRecords(ctx context.Context, gameID string) (GameRecords, error) {
+	game, err := s.Queries.GetGameByID(ctx, gameID)
+	if err != nil {
+		return GameRecords{}, fmt.Errorf("get game %s: %w", gameID, err)
+	}
+	result := GameRecords{GameID: game.ID, GameName: game.Name}
+
+	scores, err := s.scoreRecords(ctx, &gameID)
+	if err != nil {
+		return GameRecords{}, err
+	}
+	if len(scores) > 0 {
+		result.Scores = &scores[0]
+	}
+
+	gains, err := s.Queries.ListLargestGameMatchGain(ctx, gameID)
+	if err != nil {
+		return GameRecords{}, fmt.Errorf("list largest rating gain: %w", err)
+	}
+	for _, r := range gains {
+		result.LargestRatingGain = &Record{PlayerID: r.PlayerID, PlayerName: r.PlayerName, Value: r.Gain, Date: r.Date.Time, MatchID: r.MatchID}
+	}
+
+	if result.MostMatchesInDay, err = s.mostMatchesInDay(ctx, &gameID); err != nil {
+		return GameRecords{}, err
+	}
+	if result.LongestWinStreak, err = s.longestWinStreak(ctx, &gameID); err != nil {
+		return GameRecords{}, err
+	}
+	return result, nil
+}
+
+// scoreRecords pairs the high and low score of every score-based game, or of
+// one game when gameID is set.
+func (s *RecordsService) scoreRecords(ctx context.Context, gameID *string) ([]GameScoreRecords, error) {
+	highs, err := s.Queries.ListGameHighScores(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("list high scores: %w", err)
+	}
+	lows, err := s.Queries.ListGameLowScores(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("list low scores: %w", err)
+	}
+	low := make(map[string]db.ListGameLowScoresRow, len(lows))
+	for _, r := range lows {
+		low[r.GameID] = r
+	}
+
+	result := make([]GameScoreRecords, 0, len(highs))
+	for _, h := range highs {
+		l := low[h.GameID]
+		result = append(result, GameScoreRecords{
+			GameID:    h.GameID,
+			GameName:  h.GameName,
+			HighScore: Record{PlayerID: h.PlayerID, PlayerName: h.PlayerName, Value: h.Score, Date: h.Date.Time, MatchID: &h.MatchID},
+			LowScore:  Record{PlayerID: l.PlayerID, PlayerName: l.PlayerName, Value: l.Score, Date: l.Date.Time, MatchID: &l.MatchID},
+		})
+	}
+	return result, nil
+}
+
+func (s *RecordsService) mostMatchesInDay(ctx context.Context, gameID *string) (*Record, error) {
+	rows, err := s.Queries.ListMostMatchesInDay(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("list most matches in a day: %w", err)
+	}
+	for _, r := range rows {
+		return &Record{PlayerID: r.PlayerID, PlayerName: r.PlayerName, Value: float64(r.Matches), Date: r.Day}, nil
+	}
+	return nil, nil
+}
+
+// longestWinStreak walks the whole history, or one game's matches when gameID
+// is set.
+func (s *RecordsService) longestWinStreak(ctx context.Context, gameID *string) (*WinStreakRecord, error) {
+	rows, err := s.Queries.ListAchievementMatchScores(ctx, db.ListAchievementMatchScoresParams{
+		GameID:   gameID,
+		FromDate: pgtype.Timestamptz{Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list match scores: %w", err)
+	}
+	streak := longestWinStreak(groupAchievementMatches(rows))
+	if streak == nil {
+		return nil, nil
+	}
+	player, err := s.Queries.GetPlayer(ctx, streak.PlayerID)
+	if err != nil {
+		return nil, fmt.Errorf("get player %s: %w", streak.PlayerID, err)
+	}
+	streak.PlayerName = player.Name
+	return streak, nil
+}
+
+// longestWinStreak finds the longest run of sole wins in matches ordered
+// oldest first, with the winners the achievements use: a winning team wins
+// for every member, and cooperative matches neither win nor end a run.
+// Guests hold no record, though a guest finishing first ends the others'
+// runs. Of equally long runs the one completed first holds the record.
+func longestWinStreak(matches []achievementMatch) *WinStreakRecord {
+	type run struct {
+		length    int
+		startedAt time.Time
+	}
+	current := make(map[string]run)
+	var best *WinStreakRecord
+
+	for _, m := range matches {
+		if m.cooperative {
+			continue
+		}
+		winners := m.winners()
+		// Teammates reach the same length together; the first by id holds it.
+		for _, playerID := range slices.Sorted(maps.Keys(m.scores)) {
+			if m.guests[playerID] {
+				continue
+			}
+			if !winners[playerID] {
+				delete(current, playerID)
+				continue
+			}
+			r := current[playerID]
+			if r.length == 0 {
+				r.startedAt = m.date
+			}
+			r.length++
+			current[playerID] = r
+			if best == nil || r.length > best.Length {
+				best = &WinStreakRecord{PlayerID: playerID, Length: r.length, StartedAt: r.startedAt, EndedAt: m.date, LastMatchID: m.id}
+			}
+		}
+	}
+	return best
+}
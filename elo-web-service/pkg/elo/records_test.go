@@ -0,0 +1,90 @@
+package elo
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+func TestLongestWinStreak(t *testing.T) {
+	var scores []db.ListAchievementMatchScoresRow
+	add := func(i int, gameID string, s map[string]float64) {
+		scores = append(scores, achievementScores(fmt.Sprintf("m%02d", i), i, gameID, s)...)
+	}
+	// a wins three in a row, a shared first place ends the run, then b wins
+	// three in a row too, and a wins four in another game.
+	add(0, "g", map[string]float64{"a": 10, "b": 1})
+	add(1, "g", map[string]float64{"a": 10, "b": 1})
+	add(2, "g", map[string]float64{"a": 10, "b": 1})
+	add(3, "g", map[string]float64{"a": 5, "b": 5})
+	add(4, "g", map[string]float64{"a": 1, "b": 10})
+	add(5, "g", map[string]float64{"a": 1, "b": 10})
+	add(6, "g", map[string]float64{"a": 1, "b": 10})
+	add(7, "h", map[string]float64{"a": 10, "c": 1})
+	matches := groupAchievementMatches(scores)
+
+	var inG []achievementMatch
+	for _, m := range matches {
+		if m.gameID == "g" {
+			inG = append(inG, m)
+		}
+	}
+	got := longestWinStreak(inG)
+	if got == nil || got.PlayerID != "a" || got.Length != 3 || got.LastMatchID != "m02" {
+		t.Fatalf("streak in g = %+v, want a's first run of 3 ending at m02", got)
+	}
+	if !got.StartedAt.Equal(achievementsT0) {
+		t.Errorf("started at %v, want %v", got.StartedAt, achievementsT0)
+	}
+
+	// Across games a's win in h starts a new run after the losses to b, and
+	// b's equal run of 3 came later.
+	got = longestWinStreak(matches)
+	if got == nil || got.PlayerID != "a" || got.Length != 3 {
+		t.Errorf("global streak = %+v, want a's run of 3", got)
+	}
+
+	if got := longestWinStreak(nil); got != nil {
+		t.Errorf("streak of no matches = %+v, want nil", got)
+	}
+}
+
+func TestLongestWinStreakLowerWins(t *testing.T) {
+	scores := achievementScores("m00", 0, "g", map[string]float64{"a": 1, "b": 9})
+	for i := range scores {
+		scores[i].ScoringDirection = ScoringLowerWins
+	}
+	got := longestWinStreak(groupAchievementMatches(scores))
+	if got == nil || got.PlayerID != "a" || got.Length != 1 {
+		t.Errorf("streak = %+v, want a with the lower score", got)
+	}
+}
+
+func TestLongestWinStreakTeamsGuestsAndCooperative(t *testing.T) {
+	var scores []db.ListAchievementMatchScoresRow
+	add := func(i int, s map[string]float64, teams map[string]string, coop bool) {
+		rows := achievementScores(fmt.Sprintf("m%02d", i), i, "g", s)
+		for j := range rows {
+			if team, ok := teams[rows[j].PlayerID]; ok {
+				rows[j].Team = pgtype.Text{String: team, Valid: true}
+			}
+			rows[j].CooperativeResult = pgtype.Text{String: "lost", Valid: coop}
+		}
+		scores = append(scores, rows...)
+	}
+	teams := map[string]string{"a": "x", "b": "x", "c": "y"}
+	// a and b win twice as a team, a cooperative match in between changes
+	// nothing, then the guest wins three in a row.
+	add(0, map[string]float64{"a": 5, "b": 5, "c": 1}, teams, false)
+	add(1, map[string]float64{"a": 1, "c": 9}, nil, true)
+	add(2, map[string]float64{"a": 5, "b": 5, "c": 1}, teams, false)
+	for i := 3; i < 6; i++ {
+		add(i, map[string]float64{"guest": 10, "a": 1}, nil, false)
+	}
+	got := longestWinStreak(groupAchievementMatches(scores))
+	if got == nil || got.PlayerID != "a" || got.Length != 2 || got.LastMatchID != "m02" {
+		t.Errorf("streak = %+v, want a's team run of 2 ending at m02", got)
+	}
+}
@@ -0,0 +1,777 @@
+package elo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+// The in-memory replay behind EventProcessor.RecalculateFrom.
+//
+// Everything a match settlement reads is bulk-loaded once for the window:
+// matches with their scores, Elo settings, game scoring rules and every
+// player's global and game arena state before the window, taken from the
+// nearest settlement checkpoint (checkpoints.go) and the rows after it. Match settlements
+// are then computed in memory in event order and written back with COPY.
+//
+// Markets, corrections, inactivity decay and season resets keep their
+// per-event code: when one of them has something to do, pending rows are
+// flushed first so it reads the same database the per-event replay would, and
+// global arena state is re-read afterwards. None of them writes game arena
+// rows, so game and virtual opponent state never leave memory. RecalculateFromByEvent is the reference
+// path the result must match row for row.
+
+// MatchObserver sees each replayed match with the state it is settled from.
+type MatchObserver func(playerScores map[string]float64, state MatchPrevState)
+
+// leagueLookBack is how far back elite league counts reach (Count6M).
+const leagueLookBack = 6 * 30 * 24 * time.Hour
+
+// arenaStanding is a player's latest settlement in one arena. epoch records
+// when it was read or computed; it is stale once a per-event step may have
+// written global settlements after that.
+type arenaStanding struct {
+	elo     float64
+	rating  float64
+	league  string
+	settled bool // false: no settlement yet, the match's starting values apply
+	// Uncertainty of the latest settlement that tracked it; 0 when none did.
+	deviation  float64
+	volatility float64
+	epoch      int
+}
+
+type playerGame struct{ playerID, gameID string }
+
+// decayStanding mirrors the inactivity decay rows of a player.
+type decayStanding struct {
+	lastMatch time.Time
+	// decayed: a drop follows lastMatch and the next match reverses it.
+	decayed bool
+	// checked: the drop came due but was worth nothing, so nothing can happen
+	// until the next match.
+	checked bool
+}
+
+// settingsPeriod is an elo_settings row and the date it takes effect.
+type settingsPeriod struct {
+	from     pgtype.Timestamptz
+	settings EloSettings
+}
+
+type replayMatch struct {
+	match        db.Match
+	playerScores map[string]float64
+	scoreRows    []db.GetMatchScoresForMatchRow
+}
+
+type replayWindow struct {
+	p       *EventProcessor
+	q       *db.Queries
+	observe MatchObserver
+
+	settings   []settingsPeriod // effective date descending
+	games      map[string]GameScoring
+	gameParams map[string][]GameRatingParams // game → versions, effective date descending
+	matchDates map[string][]time.Time        // player → dates of their matches since the look-back start
+	guests     map[string]bool
+	hasArenas  bool
+
+	global  map[string]*arenaStanding
+	game    map[playerGame]*arenaStanding
+	virtual map[string]*arenaStanding
+	decay   map[string]*decayStanding
+	resets  []time.Time // dates of the season resets not written yet, ascending
+	// epoch is bumped after every per-event step that may write global settlements.
+	epoch int
+
+	matchWinner []db.ListOpenMatchWinnerMarketsRow
+	winStreak   []db.ListOpenWinStreakMarketsRow
+
+	pendingGlobal  []db.CopyGlobalArenaMatchSettlementsParams
+	pendingGame    []db.CopyGameArenaSettlementsParams
+	pendingVirtual []db.CopyGameVirtualOpponentSettlementsParams
+}
+
+// replayInMemory replays matches and corrections from startDate, which
+// RecalculateFrom has already unsettled. It returns the players of the
+// replayed events.
+func (p *EventProcessor) replayInMemory(ctx context.Context, q *db.Queries, startDate time.Time, observe MatchObserver) ([]string, error) {
+	from := pgtype.Timestamptz{Time: startDate, Valid: true}
+	if err := q.DeleteGameArenaSettlementsFromDate(ctx, from); err != nil {
+		return nil, fmt.Errorf("delete game arena settlements from date: %w", err)
+	}
+	if err := q.DeleteGameVirtualOpponentSettlementsFromDate(ctx, from); err != nil {
+		return nil, fmt.Errorf("delete virtual opponent settlements from date: %w", err)
+	}
+
+	matches, err := q.GetMatchesFromDate(ctx, from)
+	if err != nil {
+		return nil, fmt.Errorf("get matches from date %v: %w", startDate, err)
+	}
+	corrections, err := q.GetCorrectionsFromDate(ctx, from)
+	if err != nil {
+		return nil, fmt.Errorf("get corrections from date %v: %w", startDate, err)
+	}
+
+	w := &replayWindow{p: p, q: q, observe: observe}
+	window, err := w.load(ctx, startDate, matches)
+	if err != nil {
+		return nil, err
+	}
+
+	affected := make(map[string]bool)
+	for _, m := range window {
+		for playerID := range m.playerScores {
+			affected[playerID] = true
+		}
+	}
+	for _, c := range corrections {
+		affected[c.PlayerID] = true
+	}
+	affectedIDs := make([]string, 0, len(affected))
+	for playerID := range affected {
+		affectedIDs = append(affectedIDs, playerID)
+	}
+	sortPlayerIDs(affectedIDs)
+	if _, err := q.LockPlayersForEloCalculation(ctx, affectedIDs); err != nil {
+		return nil, fmt.Errorf("lock players: %w", err)
+	}
+
+	// Same merge as the per-event replay: on the same date, matches come first.
+	mi, ci := 0, 0
+	for mi < len(window) || ci < len(corrections) {
+		pickMatch := mi < len(window) &&
+			(ci >= len(corrections) || !corrections[ci].Date.Time.Before(window[mi].match.Date.Time))
+
+		if pickMatch {
+			if err := w.settleMatch(ctx, window[mi]); err != nil {
+				return nil, err
+			}
+			mi++
+			continue
+		}
+
+		correction := corrections[ci]
+		ci++
+		if err := w.applyTimed(ctx, correction.Date.Time); err != nil {
+			return nil, err
+		}
+		if err := w.flush(ctx); err != nil {
+			return nil, err
+		}
+		if err := applyCorrectionWithinTx(ctx, q, correction); err != nil {
+			return nil, fmt.Errorf("apply correction %s: %w", correction.ID, err)
+		}
+		w.epoch++
+	}
+
+	// Drops due after the last replayed event.
+	if err := w.applyTimed(ctx, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := w.flush(ctx); err != nil {
+		return nil, err
+	}
+	return affectedIDs, nil
+}
+
+// load reads everything the window's match settlements depend on and returns
+// the window's matches with their scores.
+func (w *replayWindow) load(ctx context.Context, startDate time.Time, matches []db.Match) ([]replayMatch, error) {
+	q := w.q
+
+	settingsRows, err := q.ListEloSettings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list elo settings: %w", err)
+	}
+	for _, row := range settingsRows {
+		w.settings = append(w.settings, settingsPeriod{from: row.EffectiveDate, settings: eloSettingsFromListRow(row)})
+	}
+
+	games, err := q.ListGames(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list games: %w", err)
+	}
+	w.games = make(map[string]GameScoring, len(games))
+	for _, g := range games {
+		w.games[g.ID] = GameScoringOf(g)
+	}
+
+	paramRows, err := q.ListGameRatingParams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list game rating params: %w", err)
+	}
+	w.gameParams = make(map[string][]GameRatingParams)
+	for _, row := range paramRows {
+		w.gameParams[row.GameID] = append(w.gameParams[row.GameID], gameRatingParamsFromDB(row))
+	}
+
+	arenas, err := q.ListArenas(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list arenas: %w", err)
+	}
+	w.hasArenas = len(arenas) > 0
+
+	guests, err := q.ListGuestPlayerIDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list guests: %w", err)
+	}
+	w.guests = guestSet(guests)
+
+	// One pass over the scores covers both the window and the elite league
+	// look-back of its first match.
+	scoreRows, err := q.ListMatchScoresFromDate(ctx, pgtype.Timestamptz{Time: startDate.Add(-leagueLookBack), Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("list match scores: %w", err)
+	}
+	w.matchDates = make(map[string][]time.Time)
+	byMatch := make(map[string][]db.GetMatchScoresForMatchRow)
+	for _, r := range scoreRows {
+		w.matchDates[r.PlayerID] = append(w.matchDates[r.PlayerID], r.Date.Time)
+		if !r.Date.Time.Before(startDate) {
+			byMatch[r.MatchID] = append(byMatch[r.MatchID], db.GetMatchScoresForMatchRow{
+				PlayerID: r.PlayerID,
+				Score:    r.Score,
+				Team:     r.Team,
+			})
+		}
+	}
+	window := make([]replayMatch, 0, len(matches))
+	for _, m := range matches {
+		rows := byMatch[m.ID]
+		playerScores := make(map[string]float64, len(rows))
+		for _, r := range rows {
+			playerScores[r.PlayerID] = r.Score
+		}
+		window = append(window, replayMatch{match: m, playerScores: playerScores, scoreRows: rows})
+	}
+
+	state, err := loadSettlementState(ctx, q, startDate)
+	if err != nil {
+		return nil, err
+	}
+	w.global, w.game, w.virtual = state.global, state.game, state.virtual
+
+	decayRows, err := q.ListInactivityDecayStates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list inactivity decay states: %w", err)
+	}
+	w.decay = make(map[string]*decayStanding, len(decayRows))
+	for _, r := range decayRows {
+		w.decay[r.PlayerID] = &decayStanding{lastMatch: r.LastMatchDate, decayed: r.Decayed}
+	}
+
+	seasonStarts, err := q.ListUnwrittenSeasonResetDates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list season resets: %w", err)
+	}
+	for _, startsAt := range seasonStarts {
+		w.resets = append(w.resets, seasonResetDate(startsAt))
+	}
+
+	if err := w.loadOpenMarkets(ctx); err != nil {
+		return nil, err
+	}
+	return window, nil
+}
+
+func (w *replayWindow) loadOpenMarkets(ctx context.Context) error {
+	var err error
+	if w.matchWinner, err = w.q.ListOpenMatchWinnerMarkets(ctx); err != nil {
+		return fmt.Errorf("list match_winner markets: %w", err)
+	}
+	if w.winStreak, err = w.q.ListOpenWinStreakMarkets(ctx); err != nil {
+		return fmt.Errorf("list win_streak markets: %w", err)
+	}
+	return nil
+}
+
+// settleMatch runs the per-match steps of processMatchSettlements.
+func (w *replayWindow) settleMatch(ctx context.Context, m replayMatch) error {
+	matchDate := m.match.Date.Time
+	if err := w.applyTimed(ctx, matchDate); err != nil {
+		return err
+	}
+
+	state, err := w.prevState(ctx, m)
+	if err != nil {
+		return fmt.Errorf("get prev state for match %s: %w", m.match.ID, err)
+	}
+	if w.observe != nil {
+		w.observe(m.playerScores, state)
+	}
+	w.settle(m, state)
+
+	if w.hasArenas {
+		if err := settleCustomArenas(ctx, w.q, m.match.ID, m.match.GameID, m.playerScores, state, matchDate); err != nil {
+			return fmt.Errorf("custom arenas for match %s: %w", m.match.ID, err)
+		}
+	}
+
+	if w.resolvesMarkets(m) {
+		if err := w.flush(ctx); err != nil {
+			return err
+		}
+		if err := w.p.MarketService.TriggerResolutionForMatch(ctx, w.q, m.match.ID); err != nil {
+			return fmt.Errorf("market resolution for match %s: %w", m.match.ID, err)
+		}
+		if err := w.afterMarkets(ctx); err != nil {
+			return err
+		}
+	}
+	if w.expiresMarkets(matchDate) {
+		if err := w.flush(ctx); err != nil {
+			return err
+		}
+		if err := w.p.MarketService.ExpireMarketsAtDate(ctx, w.q, matchDate); err != nil {
+			return fmt.Errorf("expire markets at date %v: %w", matchDate, err)
+		}
+		if err := w.afterMarkets(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *replayWindow) afterMarkets(ctx context.Context) error {
+	w.epoch++
+	return w.loadOpenMarkets(ctx)
+}
+
+// prevState builds the MatchPrevState lockAndGetPrevElos would read.
+func (w *replayWindow) prevState(ctx context.Context, m replayMatch) (MatchPrevState, error) {
+	match := m.match
+	settings := w.settingsAt(match.Date.Time)
+	scoring, ok := w.games[match.GameID]
+	if !ok {
+		return MatchPrevState{}, fmt.Errorf("get game %s: not found", match.GameID)
+	}
+
+	state := MatchPrevState{
+		Elo:        make(map[string]float64),
+		GameElo:    make(map[string]float64),
+		Rating:     make(map[string]float64),
+		GameRating: make(map[string]float64),
+		League:     make(map[string]string),
+		GameLeague: make(map[string]string),
+		Count6M:    make(map[string]int),
+		Count2M:    make(map[string]int),
+		Teams:      teamsOf(m.scoreRows),
+		Scoring:    scoring,
+		Guests:     w.guests,
+		Settings:   settings,
+		GameParams: w.gameParamsAt(match.GameID, match.Date.Time),
+
+		Deviation:      make(map[string]float64),
+		Volatility:     make(map[string]float64),
+		GameDeviation:  make(map[string]float64),
+		GameVolatility: make(map[string]float64),
+	}
+	if coop := cooperativeOf(match); coop != nil {
+		state.Coop = &CoopPrevState{Cooperative: *coop, VirtualElo: settings.StartingElo}
+		if v, ok := w.virtual[match.GameID]; ok {
+			state.Coop.VirtualElo = v.elo
+			state.Coop.VirtualDeviation = v.deviation
+			state.Coop.VirtualVolatility = v.volatility
+		}
+	}
+
+	playerIDs := playerIDsOf(m.playerScores)
+	if state.settlesGlobalArena() {
+		if err := w.reverseDecay(ctx, match, playerIDs); err != nil {
+			return MatchPrevState{}, err
+		}
+	}
+
+	for _, playerID := range playerIDs {
+		if w.guests[playerID] {
+			setGuestPrevState(&state, playerID)
+			continue
+		}
+		global, err := w.globalStanding(ctx, match, playerID)
+		if err != nil {
+			return MatchPrevState{}, err
+		}
+		if global.settled {
+			state.Elo[playerID] = global.elo
+			state.Rating[playerID] = global.rating
+			state.League[playerID] = global.league
+		} else {
+			state.Elo[playerID] = settings.StartingElo
+			state.Rating[playerID] = settings.StartingRatingGlobal
+			state.League[playerID] = initialLeagueForStarting(settings.StartingRatingGlobal, settings.StartingElo, settings)
+		}
+		if tracksUncertainty(settings.GlobalAlgorithm) && global.deviation != 0 {
+			state.Deviation[playerID] = global.deviation
+			state.Volatility[playerID] = global.volatility
+		}
+
+		if game, ok := w.game[playerGame{playerID, match.GameID}]; ok {
+			state.GameElo[playerID] = game.elo
+			state.GameRating[playerID] = game.rating
+			state.GameLeague[playerID] = game.league
+			if tracksUncertainty(settings.GameAlgorithm) && game.deviation != 0 {
+				state.GameDeviation[playerID] = game.deviation
+				state.GameVolatility[playerID] = game.volatility
+			}
+		} else {
+			state.GameElo[playerID] = settings.StartingElo
+			state.GameRating[playerID] = settings.StartingRatingGame
+			state.GameLeague[playerID] = initialLeagueForStarting(settings.StartingRatingGame, settings.StartingElo, settings)
+		}
+
+		dates := w.matchDates[playerID]
+		state.Count6M[playerID] = countDatesBetween(dates, match.Date.Time.Add(-leagueLookBack), match.Date.Time)
+		state.Count2M[playerID] = countDatesBetween(dates, match.Date.Time.Add(-2*30*24*time.Hour), match.Date.Time)
+
+		// As in lockAndGetPrevElos: the counts include this match.
+		state.League[playerID] = effectiveLeague(state.League[playerID],
+			max(state.Count2M[playerID]-1, 0), max(state.Count6M[playerID]-1, 0), settings)
+	}
+	return state, nil
+}
+
+// globalStanding returns a player's global state before the match, re-reading
+// it when a per-event step may have changed it.
+func (w *replayWindow) globalStanding(ctx context.Context, match db.Match, playerID string) (arenaStanding, error) {
+	if st, ok := w.global[playerID]; ok && st.epoch == w.epoch {
+		return *st, nil
+	}
+	if _, ok := w.global[playerID]; !ok && w.epoch == 0 {
+		return arenaStanding{}, nil
+	}
+
+	st := &arenaStanding{epoch: w.epoch}
+	row, err := w.q.GetPlayerLatestGlobalStateBeforeMatch(ctx, db.GetPlayerLatestGlobalStateBeforeMatchParams{
+		PlayerID: playerID,
+		Date:     match.Date,
+		MatchID:  &match.ID,
+	})
+	switch {
+	case err == nil:
+		st.elo, st.rating, st.league, st.settled = row.Elo, row.Rating, row.League, true
+	case !db.IsNoRows(err):
+		return arenaStanding{}, fmt.Errorf("get global state of player %s: %w", playerID, err)
+	}
+	u, err := w.q.GetPlayerGlobalUncertaintyBeforeMatch(ctx, db.GetPlayerGlobalUncertaintyBeforeMatchParams{
+		PlayerID: playerID,
+		Date:     match.Date,
+		MatchID:  &match.ID,
+	})
+	switch {
+	case err == nil:
+		st.deviation, st.volatility = u.Deviation, u.Volatility.Float64
+	case !db.IsNoRows(err):
+		return arenaStanding{}, fmt.Errorf("get global uncertainty for player %s: %w", playerID, err)
+	}
+	w.global[playerID] = st
+	return *st, nil
+}
+
+// settle computes the match's settlements, queues their rows and moves the
+// in-memory state past the match.
+func (w *replayWindow) settle(m replayMatch, state MatchPrevState) {
+	match := m.match
+	matchID := match.ID
+	results := buildEloResults(m.playerScores, state)
+
+	for _, playerID := range playerIDsOf(m.playerScores) {
+		if state.Guests[playerID] {
+			continue
+		}
+		r := results[playerID]
+		if state.settlesGlobalArena() {
+			w.pendingGlobal = append(w.pendingGlobal, db.CopyGlobalArenaMatchSettlementsParams{
+				ID:              newSettlementID(),
+				PlayerID:        playerID,
+				Date:            match.Date,
+				RatingAfter:     r.newGlobalRating,
+				EloAfter:        r.newGlobalElo,
+				Discriminator:   "match",
+				MatchID:         &matchID,
+				EloStaked:       r.eloStaked,
+				EloEarned:       r.eloEarned,
+				RatingStaked:    r.ratingStaked,
+				RatingEarned:    r.ratingEarned,
+				League:          r.newGlobalLeague,
+				DeviationAfter:  uncertaintyColumn(r.globalDeviation),
+				VolatilityAfter: uncertaintyColumn(r.globalVolatility),
+			})
+			w.global[playerID] = advanceStanding(w.global[playerID], r.newGlobalElo, r.newGlobalRating,
+				r.newGlobalLeague, r.globalDeviation, r.globalVolatility, w.epoch)
+			w.decay[playerID] = &decayStanding{lastMatch: match.Date.Time}
+		}
+
+		w.pendingGame = append(w.pendingGame, db.CopyGameArenaSettlementsParams{
+			ID:              newSettlementID(),
+			GameID:          match.GameID,
+			PlayerID:        playerID,
+			Date:            match.Date,
+			RatingAfter:     r.newGameRating,
+			EloAfter:        r.newGameElo,
+			Discriminator:   "match",
+			MatchID:         &matchID,
+			EloStaked:       r.gameEloStaked,
+			EloEarned:       r.gameEloEarned,
+			RatingStaked:    r.gameRatingStaked,
+			RatingEarned:    r.gameRatingEarned,
+			League:          r.newGameLeague,
+			DeviationAfter:  uncertaintyColumn(r.gameDeviation),
+			VolatilityAfter: uncertaintyColumn(r.gameVolatility),
+		})
+		key := playerGame{playerID, match.GameID}
+		w.game[key] = advanceStanding(w.game[key], r.newGameElo, r.newGameRating,
+			r.newGameLeague, r.gameDeviation, r.gameVolatility, w.epoch)
+	}
+
+	if state.Coop != nil {
+		v := buildVirtualOpponentResult(m.playerScores, state)
+		deviation, volatility := uncertaintyColumn(v.deviationAfter), uncertaintyColumn(v.volatilityAfter)
+		w.pendingVirtual = append(w.pendingVirtual, db.CopyGameVirtualOpponentSettlementsParams{
+			ID:              newSettlementID(),
+			GameID:          match.GameID,
+			MatchID:         matchID,
+			Date:            match.Date,
+			EloAfter:        v.eloAfter,
+			EloStaked:       v.eloStaked,
+			EloEarned:       v.eloEarned,
+			DeviationAfter:  deviation,
+			VolatilityAfter: volatility,
+		})
+		// A NULL column reads back as 0, as in loadCoopPrevState.
+		w.virtual[match.GameID] = &arenaStanding{
+			elo:        v.eloAfter,
+			settled:    true,
+			deviation:  storedFloat(deviation),
+			volatility: storedFloat(volatility),
+		}
+	}
+}
+
+// advanceStanding is a standing after a match settlement. Uncertainty carries
+// over from earlier settlements when this one does not track it, as the
+// "uncertainty before match" queries skip such rows.
+func advanceStanding(prev *arenaStanding, elo, rating float64, league string, deviation, volatility float64, epoch int) *arenaStanding {
+	next := &arenaStanding{elo: elo, rating: rating, league: league, settled: true, epoch: epoch}
+	if prev != nil {
+		next.deviation, next.volatility = prev.deviation, prev.volatility
+	}
+	if dev := uncertaintyColumn(deviation); dev.Valid {
+		next.deviation, next.volatility = dev.Float64, storedFloat(uncertaintyColumn(volatility))
+	}
+	return next
+}
+
+// storedFloat is the value a nullable column reads back as.
+func storedFloat(v pgtype.Float8) float64 {
+	if !v.Valid {
+		return 0
+	}
+	return v.Float64
+}
+
+// flush copies the queued settlement rows into their tables.
+func (w *replayWindow) flush(ctx context.Context) error {
+	if len(w.pendingGlobal) > 0 {
+		if _, err := w.q.CopyGlobalArenaMatchSettlements(ctx, w.pendingGlobal); err != nil {
+			return fmt.Errorf("copy global arena settlements: %w", err)
+		}
+		w.pendingGlobal = w.pendingGlobal[:0]
+	}
+	if len(w.pendingGame) > 0 {
+		if _, err := w.q.CopyGameArenaSettlements(ctx, w.pendingGame); err != nil {
+			return fmt.Errorf("copy game arena settlements: %w", err)
+		}
+		w.pendingGame = w.pendingGame[:0]
+	}
+	if len(w.pendingVirtual) > 0 {
+		if _, err := w.q.CopyGameVirtualOpponentSettlements(ctx, w.pendingVirtual); err != nil {
+			return fmt.Errorf("copy virtual opponent settlements: %w", err)
+		}
+		w.pendingVirtual = w.pendingVirtual[:0]
+	}
+	return nil
+}
+
+// applyTimed runs applyTimedSettlements for until when a season reset or a
+// decay drop may be due.
+func (w *replayWindow) applyTimed(ctx context.Context, until time.Time) error {
+	resetDue := len(w.resets) > 0 && w.resets[0].Before(until)
+	if !resetDue && !w.decayDue(until) {
+		return nil
+	}
+	if err := w.flush(ctx); err != nil {
+		return err
+	}
+	if err := applyTimedSettlements(ctx, w.q, until); err != nil {
+		return err
+	}
+	w.epoch++
+	for len(w.resets) > 0 && w.resets[0].Before(until) {
+		w.resets = w.resets[1:]
+	}
+
+	rows, err := w.q.ListInactivityDecayStates(ctx)
+	if err != nil {
+		return fmt.Errorf("list inactivity decay states: %w", err)
+	}
+	for _, r := range rows {
+		d := &decayStanding{lastMatch: r.LastMatchDate, decayed: r.Decayed}
+		if !d.decayed {
+			// Still no drop although it was due: its amount was zero.
+			_, due := w.decayDate(d, until)
+			d.checked = due
+		}
+		w.decay[r.PlayerID] = d
+	}
+	return nil
+}
+
+// decayDue reports whether applyInactivityDecay would consider any player
+// before until.
+func (w *replayWindow) decayDue(until time.Time) bool {
+	for _, d := range w.decay {
+		if d.decayed || d.checked {
+			continue
+		}
+		if _, due := w.decayDate(d, until); due {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *replayWindow) decayDate(d *decayStanding, until time.Time) (time.Time, bool) {
+	due, ok := inactivityDecayDate(d.lastMatch, w.settingsAt(d.lastMatch))
+	return due, ok && due.Before(until)
+}
+
+// reverseDecay writes the decay reversals of the match's returning players.
+func (w *replayWindow) reverseDecay(ctx context.Context, match db.Match, playerIDs []string) error {
+	flushed := false
+	for _, playerID := range playerIDs {
+		d, ok := w.decay[playerID]
+		if !ok || !d.decayed {
+			continue
+		}
+		if !flushed {
+			if err := w.flush(ctx); err != nil {
+				return err
+			}
+			flushed = true
+		}
+		if err := reverseInactivityDecay(ctx, w.q, match, playerID); err != nil {
+			return err
+		}
+		d.decayed = false
+		if st, ok := w.global[playerID]; ok {
+			st.epoch = -1
+		} else {
+			w.global[playerID] = &arenaStanding{epoch: -1}
+		}
+	}
+	return nil
+}
+
+// resolvesMarkets reports whether TriggerResolutionForMatch may settle any
+// open market for the match, by the same conditions the market triggers check.
+func (w *replayWindow) resolvesMarkets(m replayMatch) bool {
+	participants := make(map[string]bool, len(m.playerScores))
+	for playerID := range m.playerScores {
+		participants[playerID] = true
+	}
+	info := MatchInfo{Match: m.match, ParticipantSet: participants}
+	for _, mk := range w.matchWinner {
+		cond := MatchWinnerCondition{
+			TargetPlayerIDs:   mk.TargetPlayerIds,
+			AllowOtherPlayers: mk.AllowOtherPlayers,
+			GameIDs:           mk.GameIds,
+		}
+		if resolved, _ := cond.Evaluate(info, TimeWindow{StartsAt: mk.StartsAt.Time, ClosesAt: mk.ClosesAt.Time}); resolved {
+			return true
+		}
+	}
+	for _, mk := range w.winStreak {
+		if participants[mk.TargetPlayerID] && containsString(mk.GameIds, m.match.GameID) {
+			return true
+		}
+	}
+	return false
+}
+
+// expiresMarkets reports whether any open market closes by date.
+func (w *replayWindow) expiresMarkets(date time.Time) bool {
+	for _, mk := range w.matchWinner {
+		if !mk.ClosesAt.Time.After(date) {
+			return true
+		}
+	}
+	for _, mk := range w.winStreak {
+		if !mk.ClosesAt.Time.After(date) {
+			return true
+		}
+	}
+	return false
+}
+
+// settingsAt mirrors GetEloSettingsForDate.
+func (w *replayWindow) settingsAt(date time.Time) EloSettings {
+	for _, p := range w.settings {
+		if p.from.InfinityModifier == pgtype.NegativeInfinity || !p.from.Time.After(date) {
+			return p.settings
+		}
+	}
+	// The first elo_settings row is effective from -infinity.
+	return w.settings[len(w.settings)-1].settings
+}
+
+// gameParamsAt mirrors GetGameRatingParamsForDate.
+func (w *replayWindow) gameParamsAt(gameID string, date time.Time) GameRatingParams {
+	for _, p := range w.gameParams[gameID] {
+		if !p.EffectiveDate.After(date) {
+			return p
+		}
+	}
+	return GameRatingParams{}
+}
+
+// countDatesBetween counts the sorted dates within [from, to].
+func countDatesBetween(dates []time.Time, from, to time.Time) int {
+	lo := sort.Search(len(dates), func(i int) bool { return !dates[i].Before(from) })
+	hi := sort.Search(len(dates), func(i int) bool { return dates[i].After(to) })
+	return max(hi-lo, 0)
+}
+
+// eloSettingsFromListRow converts a ListEloSettings row like EloSettingsFromDB.
+func eloSettingsFromListRow(row db.ListEloSettingsRow) EloSettings {
+	return EloSettingsFromDB(db.GetEloSettingsForDateRow{
+		EloConstK:                 row.EloConstK,
+		EloConstD:                 row.EloConstD,
+		StartingElo:               row.StartingElo,
+		WinReward:                 row.WinReward,
+		NewbieLeagueEarnedMin:     row.NewbieLeagueEarnedMin,
+		NewbieLeagueEarnedMax:     row.NewbieLeagueEarnedMax,
+		NewbieLeagueEarnedTau:     row.NewbieLeagueEarnedTau,
+		NewbieLeagueGoalGap:       row.NewbieLeagueGoalGap,
+		StartingRatingGlobalArena: row.StartingRatingGlobalArena,
+		StartingRatingGameArena:   row.StartingRatingGameArena,
+		EliteLeagueMatches6months: row.EliteLeagueMatches6months,
+		EliteLeagueMatches2months: row.EliteLeagueMatches2months,
+		GlobalArenaAlgorithm:      row.GlobalArenaAlgorithm,
+		GameArenaAlgorithm:        row.GameArenaAlgorithm,
+		Glicko2StartingDeviation:  row.Glicko2StartingDeviation,
+		Glicko2StartingVolatility: row.Glicko2StartingVolatility,
+		Glicko2Tau:                row.Glicko2Tau,
+		TrueskillStartingSigma:    row.TrueskillStartingSigma,
+		TrueskillBeta:             row.TrueskillBeta,
+		TrueskillTau:              row.TrueskillTau,
+		TrueskillDrawProbability:  row.TrueskillDrawProbability,
+		InactivityDecayDays:       row.InactivityDecayDays,
+		InactivityDecayRate:       row.InactivityDecayRate,
+	})
+}
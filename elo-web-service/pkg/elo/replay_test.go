@@ -0,0 +1,130 @@
+package elo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+func TestCountDatesBetween(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 12, 0, 0, 0, time.UTC) }
+	dates := []time.Time{day(1), day(3), day(3), day(5), day(9)}
+
+	cases := []struct {
+		name     string
+		from, to time.Time
+		want     int
+	}{
+		{"both ends inclusive", day(3), day(5), 3},
+		{"whole range", day(1), day(9), 5},
+		{"before the first date", day(1).Add(-time.Hour), day(1).Add(-time.Minute), 0},
+		{"between dates", day(6), day(8), 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := countDatesBetween(dates, tc.from, tc.to); got != tc.want {
+				t.Errorf("got %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestReplaySettingsAt(t *testing.T) {
+	first, second := testSettings, testSettings
+	second.K = 64
+	switchDate := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	w := &replayWindow{settings: []settingsPeriod{
+		{from: pgtype.Timestamptz{Time: switchDate, Valid: true}, settings: second},
+		{from: pgtype.Timestamptz{InfinityModifier: pgtype.NegativeInfinity, Valid: true}, settings: first},
+	}}
+
+	if got := w.settingsAt(switchDate.Add(-time.Second)).K; got != first.K {
+		t.Errorf("before the switch: K = %v, want %v", got, first.K)
+	}
+	if got := w.settingsAt(switchDate).K; got != second.K {
+		t.Errorf("on the switch date: K = %v, want %v", got, second.K)
+	}
+}
+
+func TestReplayDecayDue(t *testing.T) {
+	s := testSettings
+	s.InactivityDecayDays = 10
+	w := &replayWindow{
+		settings: []settingsPeriod{{from: pgtype.Timestamptz{InfinityModifier: pgtype.NegativeInfinity, Valid: true}, settings: s}},
+	}
+	last := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	due := last.AddDate(0, 0, 10)
+
+	cases := []struct {
+		name  string
+		state decayStanding
+		until time.Time
+		want  bool
+	}{
+		{"due strictly before until", decayStanding{lastMatch: last}, due.Add(time.Second), true},
+		{"due at until", decayStanding{lastMatch: last}, due, false},
+		{"already decayed", decayStanding{lastMatch: last, decayed: true}, due.AddDate(0, 1, 0), false},
+		{"drop was worth nothing", decayStanding{lastMatch: last, checked: true}, due.AddDate(0, 1, 0), false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			state := tc.state
+			w.decay = map[string]*decayStanding{"p1": &state}
+			if got := w.decayDue(tc.until); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestReplayMarketTriggers(t *testing.T) {
+	date := time.Date(2026, 6, 1, 18, 0, 0, 0, time.UTC)
+	ts := func(t time.Time) pgtype.Timestamptz { return pgtype.Timestamptz{Time: t, Valid: true} }
+	m := replayMatch{
+		match:        db.Match{ID: "m1", GameID: "g1", Date: ts(date)},
+		playerScores: map[string]float64{"a": 3, "b": 1},
+	}
+
+	w := &replayWindow{matchWinner: []db.ListOpenMatchWinnerMarketsRow{{
+		StartsAt:          ts(date.Add(time.Hour)),
+		ClosesAt:          ts(date.Add(48 * time.Hour)),
+		TargetPlayerIds:   []string{"a", "b"},
+		AllowOtherPlayers: true,
+	}}}
+	if w.resolvesMarkets(m) {
+		t.Error("a match before the market window resolves nothing")
+	}
+	if w.expiresMarkets(date) {
+		t.Error("no market closes by the match date")
+	}
+
+	w.matchWinner[0].StartsAt = ts(date.Add(-time.Hour))
+	if !w.resolvesMarkets(m) {
+		t.Error("a match of both targets within the window resolves the market")
+	}
+
+	w.matchWinner = nil
+	w.winStreak = []db.ListOpenWinStreakMarketsRow{{TargetPlayerID: "a", GameIds: []string{"g2"}, ClosesAt: ts(date)}}
+	if w.resolvesMarkets(m) {
+		t.Error("a win streak market on another game is not evaluated")
+	}
+	if !w.expiresMarkets(date) {
+		t.Error("a market closing at the match date expires")
+	}
+}
+
+func TestAdvanceStandingKeepsUncertainty(t *testing.T) {
+	prev := &arenaStanding{elo: 1000, deviation: 120, volatility: 0.06}
+
+	elo := advanceStanding(prev, 1010, 1005, "amateur", 0, 0, 1)
+	if elo.deviation != 120 || elo.volatility != 0.06 {
+		t.Errorf("an untracked settlement keeps the earlier uncertainty, got %v/%v", elo.deviation, elo.volatility)
+	}
+
+	glicko := advanceStanding(prev, 1010, 1005, "amateur", 90, 0.059, 1)
+	if glicko.deviation != 90 || glicko.volatility != 0.059 {
+		t.Errorf("a tracked settlement replaces it, got %v/%v", glicko.deviation, glicko.volatility)
+	}
+}
@@ -44,8 +44,9 @@ type MatchWinnerCondition struct {
 // the sole first-place player's key when exactly one player holds the strict
 // maximum score AND that player is a target; OutcomeKeyOther otherwise (tie at
 // first place, or a non-target sole winner — possible only when other players
-// are allowed). Returns (false, "") when the match does not satisfy this
-// condition.
+// are allowed). In a team match the winning team plays the role of the sole
+// first-place player: the outcome goes to its only target member, if any.
+// Returns (false, "") when the match does not satisfy this condition.
 func (c MatchWinnerCondition) Evaluate(match MatchInfo, window TimeWindow) (bool, OutcomeKey) {
 	if !window.Contains(match.Match.Date.Time) {
 		return false, ""
@@ -64,9 +65,19 @@ func (c MatchWinnerCondition) Evaluate(match MatchInfo, window TimeWindow) (bool
 			return false, ""
 		}
 	}
-	if winner, ok := match.SoleWinnerID(); ok {
-		if containsString(c.TargetPlayerIDs, winner) {
-			return true, PlayerOutcomeKey(winner)
+	// In a team match a target wins when their team is the sole winner and no
+	// other target is on that team (two winning targets cannot both pay out).
+	if winners, ok := match.Winners(); ok {
+		var winningTarget string
+		targets := 0
+		for _, pid := range winners {
+			if containsString(c.TargetPlayerIDs, pid) {
+				winningTarget = pid
+				targets++
+			}
+		}
+		if targets == 1 {
+			return true, PlayerOutcomeKey(winningTarget)
 		}
 	}
 	return true, OutcomeKeyOther
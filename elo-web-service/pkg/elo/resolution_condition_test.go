@@ -258,3 +258,59 @@ func TestWinStreakCondition_Evaluate(t *testing.T) {
 		}
 	})
 }
+
+func TestWinnersTeamMatch(t *testing.T) {
+	teams := map[string]string{"10": "A", "20": "A", "30": "B", "40": "B"}
+
+	t.Run("winning team of two has no sole winner", func(t *testing.T) {
+		m := makeMatch(time.Now(), "g", map[string]float64{"10": 1, "20": 1, "30": 0, "40": 0})
+		m.Teams = teams
+		winners, ok := m.Winners()
+		if !ok || len(winners) != 2 || winners[0] != "10" || winners[1] != "20" {
+			t.Fatalf("got (%v, %v), want ([10 20], true)", winners, ok)
+		}
+		if _, ok := m.SoleWinnerID(); ok {
+			t.Fatal("expected no sole winner when a team of two wins")
+		}
+	})
+	t.Run("tied teams have no winner", func(t *testing.T) {
+		m := makeMatch(time.Now(), "g", map[string]float64{"10": 1, "20": 1, "30": 1, "40": 1})
+		m.Teams = teams
+		if _, ok := m.Winners(); ok {
+			t.Fatal("expected no winner when teams tie")
+		}
+	})
+	t.Run("team of one is a sole winner", func(t *testing.T) {
+		m := makeMatch(time.Now(), "g", map[string]float64{"10": 2, "30": 0, "40": 0})
+		m.Teams = map[string]string{"10": "A", "30": "B", "40": "B"}
+		winner, ok := m.SoleWinnerID()
+		if !ok || winner != "10" {
+			t.Fatalf("got (%q, %v), want (\"10\", true)", winner, ok)
+		}
+	})
+}
+
+func TestMatchWinnerCondition_EvaluateTeamMatch(t *testing.T) {
+	inWindow := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	scores := map[string]float64{"10": 1, "20": 1, "30": 0, "40": 0}
+	teams := map[string]string{"10": "A", "20": "A", "30": "B", "40": "B"}
+
+	t.Run("target on the winning team wins", func(t *testing.T) {
+		cond := MatchWinnerCondition{TargetPlayerIDs: []string{"10", "30"}, AllowOtherPlayers: true}
+		match := makeMatch(inWindow, "g", scores)
+		match.Teams = teams
+		resolved, key := cond.Evaluate(match, testWindow)
+		if !resolved || key != PlayerOutcomeKey("10") {
+			t.Errorf("got resolved=%v key=%q, want true/player:10", resolved, key)
+		}
+	})
+	t.Run("two targets on the winning team resolve to other", func(t *testing.T) {
+		cond := MatchWinnerCondition{TargetPlayerIDs: []string{"10", "20"}, AllowOtherPlayers: true}
+		match := makeMatch(inWindow, "g", scores)
+		match.Teams = teams
+		resolved, key := cond.Evaluate(match, testWindow)
+		if !resolved || key != OutcomeKeyOther {
+			t.Errorf("got resolved=%v key=%q, want true/other", resolved, key)
+		}
+	})
+}
@@ -0,0 +1,117 @@
+package elo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+// Game scoring rules as stored in games.scoring_direction / games.result_type.
+const (
+	ScoringHigherWins = "higher_wins"
+	ScoringLowerWins  = "lower_wins"
+
+	ResultTypeScore     = "score"
+	ResultTypePlacement = "placement"
+)
+
+// GameScoring describes how a game's match_scores.score is read. The zero
+// value is the classic "higher score wins" rule.
+type GameScoring struct {
+	Direction  string
+	ResultType string
+}
+
+// GameScoringOf reads the scoring rules of a game row.
+func GameScoringOf(g db.Game) GameScoring {
+	return GameScoring{Direction: g.ScoringDirection, ResultType: g.ResultType}
+}
+
+// Validate checks that both settings hold known values.
+func (s GameScoring) Validate() error {
+	if s.Direction != ScoringHigherWins && s.Direction != ScoringLowerWins {
+		return fmt.Errorf("%w: направление подсчёта %q", ErrInvalidGameScoring, s.Direction)
+	}
+	if s.ResultType != ResultTypeScore && s.ResultType != ResultTypePlacement {
+		return fmt.Errorf("%w: тип результата %q", ErrInvalidGameScoring, s.ResultType)
+	}
+	return nil
+}
+
+// RankingScores converts raw match scores into higher-is-better scores that
+// the Elo formulas (NormalizedScore, GetAbsoluteLoserScore) and winner
+// detection expect:
+//   - higher_wins: unchanged;
+//   - lower_wins:  negated, so the lowest raw score ranks first;
+//   - placement:   place p of n players becomes n − p (1st of 4 → 3, last → 0;
+//     tied places share the value).
+func (s GameScoring) RankingScores(raw map[string]float64) map[string]float64 {
+	out := make(map[string]float64, len(raw))
+	switch {
+	case s.ResultType == ResultTypePlacement:
+		n := float64(len(raw))
+		for id, place := range raw {
+			out[id] = n - place
+		}
+	case s.Direction == ScoringLowerWins:
+		for id, score := range raw {
+			out[id] = -score
+		}
+	default:
+		for id, score := range raw {
+			out[id] = score
+		}
+	}
+	return out
+}
+
+// ValidateResult checks raw scores against the result type. Score results
+// accept any numbers; placements must form a standard competition ranking of
+// the sides: whole places starting at 1 where a place p is preceded by exactly
+// p−1 sides (1, 2, 2, 4 is valid; 1, 2, 2, 3 is not). Teammates share their
+// team's place, so a 2v2 is entered as 1, 1, 2, 2; with no teams every player
+// is a side of one.
+func (s GameScoring) ValidateResult(raw map[string]float64, teams map[string]string) error {
+	if s.ResultType != ResultTypePlacement {
+		return nil
+	}
+	places := newMatchSides(raw, teams).scores
+	for key, place := range places {
+		if place < 1 || place != float64(int(place)) || place > float64(len(places)) {
+			if team, ok := strings.CutPrefix(key, teamSidePrefix); ok {
+				return fmt.Errorf("%w: команда %s, место %v", ErrInvalidPlacement, team, place)
+			}
+			return fmt.Errorf("%w: игрок %s, место %v", ErrInvalidPlacement, key, place)
+		}
+		ahead := 0
+		for _, other := range places {
+			if other < place {
+				ahead++
+			}
+		}
+		if ahead != int(place)-1 {
+			return fmt.Errorf("%w: место %v занято не по порядку", ErrInvalidPlacement, place)
+		}
+	}
+	return nil
+}
+
+// validateMatchResult checks the raw scores of a match against its game's
+// result type. Cooperative matches are decided by their outcome, not by the
+// scores, and are not checked.
+func validateMatchResult(ctx context.Context, q *db.Queries, gameID string, playerScores map[string]float64, teams map[string]string, coop *Cooperative) error {
+	if coop != nil {
+		return nil
+	}
+	game, err := q.GetGameByID(ctx, gameID)
+	if db.IsNoRows(err) {
+		// The match insert reports the unknown game (foreign key violation).
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get game %s: %w", gameID, err)
+	}
+	return GameScoringOf(game).ValidateResult(playerScores, teams)
+}
@@ -0,0 +1,110 @@
+package elo
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestGameScoringRankingScores(t *testing.T) {
+	raw := map[string]float64{"a": 1, "b": 2, "c": 2, "d": 4}
+	cases := []struct {
+		name    string
+		scoring GameScoring
+		want    map[string]float64
+	}{
+		{"zero value is higher wins", GameScoring{}, raw},
+		{"higher wins", GameScoring{Direction: ScoringHigherWins, ResultType: ResultTypeScore}, raw},
+		{"lower wins", GameScoring{Direction: ScoringLowerWins, ResultType: ResultTypeScore},
+			map[string]float64{"a": -1, "b": -2, "c": -2, "d": -4}},
+		{"placement ignores direction", GameScoring{Direction: ScoringHigherWins, ResultType: ResultTypePlacement},
+			map[string]float64{"a": 3, "b": 2, "c": 2, "d": 0}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.scoring.RankingScores(raw)
+			for id, want := range tc.want {
+				if got[id] != want {
+					t.Errorf("player %s: got %v, want %v", id, got[id], want)
+				}
+			}
+		})
+	}
+}
+
+func TestGameScoringValidateResult(t *testing.T) {
+	placement := GameScoring{Direction: ScoringHigherWins, ResultType: ResultTypePlacement}
+	cases := []struct {
+		name   string
+		scores map[string]float64
+		teams  map[string]string
+		valid  bool
+	}{
+		{"strict order", map[string]float64{"a": 1, "b": 2, "c": 3}, nil, true},
+		{"tie skips next place", map[string]float64{"a": 1, "b": 2, "c": 2, "d": 4}, nil, true},
+		{"everyone tied", map[string]float64{"a": 1, "b": 1}, nil, true},
+		{"dense ranking is rejected", map[string]float64{"a": 1, "b": 2, "c": 2, "d": 3}, nil, false},
+		{"no first place", map[string]float64{"a": 2, "b": 3}, nil, false},
+		{"fractional place", map[string]float64{"a": 1, "b": 1.5}, nil, false},
+		{"place beyond player count", map[string]float64{"a": 1, "b": 3}, nil, false},
+		{"zero place", map[string]float64{"a": 0, "b": 1}, nil, false},
+		{"teams share a place", map[string]float64{"a": 1, "b": 1, "c": 2, "d": 2}, map[string]string{"a": "x", "b": "x", "c": "y", "d": "y"}, true},
+		{"team places count sides", map[string]float64{"a": 1, "b": 1, "c": 3, "d": 3}, map[string]string{"a": "x", "b": "x", "c": "y", "d": "y"}, false},
+		{"teams tied", map[string]float64{"a": 1, "b": 1, "c": 1, "d": 1}, map[string]string{"a": "x", "b": "x", "c": "y", "d": "y"}, true},
+		{"team against a single player", map[string]float64{"a": 2, "b": 2, "c": 1}, map[string]string{"a": "x", "b": "x", "c": "y"}, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := placement.ValidateResult(tc.scores, tc.teams)
+			if tc.valid && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tc.valid && !errors.Is(err, ErrInvalidPlacement) {
+				t.Fatalf("got %v, want ErrInvalidPlacement", err)
+			}
+		})
+	}
+
+	if err := (GameScoring{Direction: ScoringLowerWins, ResultType: ResultTypeScore}).ValidateResult(map[string]float64{"a": -3.5, "b": 100}, nil); err != nil {
+		t.Errorf("score results accept any numbers, got %v", err)
+	}
+}
+
+func TestGameScoringValidate(t *testing.T) {
+	if err := (GameScoring{Direction: ScoringLowerWins, ResultType: ResultTypePlacement}).Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := (GameScoring{Direction: "sideways", ResultType: ResultTypeScore}).Validate(); !errors.Is(err, ErrInvalidGameScoring) {
+		t.Errorf("got %v, want ErrInvalidGameScoring", err)
+	}
+	if err := (GameScoring{Direction: ScoringHigherWins}).Validate(); !errors.Is(err, ErrInvalidGameScoring) {
+		t.Errorf("got %v, want ErrInvalidGameScoring", err)
+	}
+}
+
+func TestBuildEloResultsLowerWinsMirrorsHigherWins(t *testing.T) {
+	elos := map[string]float64{"a": 1050, "b": 1000, "c": 950}
+
+	lower := prevStateFor(elos, nil)
+	lower.Scoring = GameScoring{Direction: ScoringLowerWins, ResultType: ResultTypeScore}
+	got := buildEloResults(map[string]float64{"a": 10, "b": 25, "c": 40}, lower)
+
+	want := buildEloResults(map[string]float64{"a": 40, "b": 25, "c": 10}, prevStateFor(elos, nil))
+	for id := range elos {
+		if !floatsEqual(got[id].newGlobalElo, want[id].newGlobalElo) {
+			t.Errorf("player %s: lower-wins elo %v, want %v", id, got[id].newGlobalElo, want[id].newGlobalElo)
+		}
+	}
+	if got["a"].newGlobalElo <= elos["a"] {
+		t.Errorf("lowest score should gain elo, got %v", got["a"].newGlobalElo-elos["a"])
+	}
+}
+
+func TestSoleWinnerIDPlacement(t *testing.T) {
+	placement := GameScoring{Direction: ScoringHigherWins, ResultType: ResultTypePlacement}
+	m := makeMatch(time.Now(), "g", placement.RankingScores(map[string]float64{"10": 2, "20": 1, "30": 3}))
+	winner, ok := m.SoleWinnerID()
+	if !ok || winner != "20" {
+		t.Fatalf("got (%q, %v), want (\"20\", true)", winner, ok)
+	}
+}
@@ -0,0 +1,364 @@
+package elo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+// A season is a window [starts_at, ends_at) of history with a table of its
+// own. It may softly reset the global arena at its start: every settled
+// player keeps reset_factor of their distance from the starting rating and
+// Elo. The reset is a global_arena_settlement row per player with
+// discriminator 'season_reset', derived like decay drops, so a replay writes
+// it again at the same point of the event order. Archiving an ended season
+// freezes its tables in season_standings.
+
+type ISeasonService interface {
+	ListSeasons(ctx context.Context) ([]db.Season, error)
+	ListSeasonChampions(ctx context.Context) ([]db.ListSeasonChampionsRow, error)
+	CreateSeason(ctx context.Context, arg db.CreateSeasonParams) (db.Season, error)
+	DeleteSeason(ctx context.Context, id string) (db.Season, error)
+	// SeasonLeaderboard returns the season's table of the global arena
+	// (gameID "") or of a game: the archived one once the season is
+	// archived, computed from the settlements otherwise.
+	SeasonLeaderboard(ctx context.Context, id, gameID string) ([]LeaderboardEntry, bool, error)
+	ArchiveSeason(ctx context.Context, id string) (db.Season, error)
+}
+
+type SeasonService struct {
+	Queries        *db.Queries
+	Pool           *pgxpool.Pool
+	EventProcessor *EventProcessor
+}
+
+func NewSeasonService(pool *pgxpool.Pool, marketService IMarketService) ISeasonService {
+	return &SeasonService{
+		Queries:        db.New(pool),
+		Pool:           pool,
+		EventProcessor: &EventProcessor{MarketService: marketService},
+	}
+}
+
+// seasonResetDate is when a season's reset is dated: the last instant before
+// the season, after every event of the previous one.
+func seasonResetDate(startsAt time.Time) time.Time {
+	return startsAt.Add(-time.Microsecond)
+}
+
+// seasonReset moves value towards start, keeping factor of the distance.
+func seasonReset(value, start, factor float64) float64 {
+	return start + factor*(value-start)
+}
+
+// seasonTableDates are the dates the season's tables are read at: the start
+// table includes the season's own reset, the final one stops short of the
+// reset of a season starting right at ends_at.
+func seasonTableDates(season db.Season, now time.Time) (start, end time.Time) {
+	end = seasonResetDate(season.EndsAt).Add(-time.Microsecond)
+	if now.Before(end) {
+		end = now
+	}
+	return seasonResetDate(season.StartsAt), end
+}
+
+func (s *SeasonService) ListSeasons(ctx context.Context) ([]db.Season, error) {
+	return s.Queries.ListSeasons(ctx)
+}
+
+func (s *SeasonService) ListSeasonChampions(ctx context.Context) ([]db.ListSeasonChampionsRow, error) {
+	return s.Queries.ListSeasonChampions(ctx)
+}
+
+// CreateSeason adds a season. A reset dated in the past is written by
+// replaying history from it; a future one by the first event after it or the
+// inactivity decay job.
+func (s *SeasonService) CreateSeason(ctx context.Context, arg db.CreateSeasonParams) (db.Season, error) {
+	if !arg.StartsAt.Before(arg.EndsAt) ||
+		(arg.ResetFactor.Valid && (arg.ResetFactor.Float64 < 0 || arg.ResetFactor.Float64 >= 1)) {
+		return db.Season{}, ErrInvalidSeason
+	}
+	var season db.Season
+	err := runInTx(ctx, s.Pool, func(q *db.Queries) error {
+		overlaps, err := q.SeasonOverlaps(ctx, db.SeasonOverlapsParams{StartsAt: arg.StartsAt, EndsAt: arg.EndsAt})
+		if err != nil {
+			return fmt.Errorf("check season overlap: %w", err)
+		}
+		if overlaps {
+			return ErrSeasonOverlap
+		}
+		if season, err = q.CreateSeason(ctx, arg); err != nil {
+			return err
+		}
+		if err := s.replayReset(ctx, q, season); err != nil {
+			return err
+		}
+		return recordAudit(ctx, q, AuditEntitySeason, season.ID, AuditActionCreate, nil, season)
+	})
+	return season, err
+}
+
+// DeleteSeason removes a season that is not archived, and its reset.
+func (s *SeasonService) DeleteSeason(ctx context.Context, id string) (db.Season, error) {
+	var season db.Season
+	err := runInTx(ctx, s.Pool, func(q *db.Queries) error {
+		var err error
+		if season, err = q.GetSeasonForUpdate(ctx, id); err != nil {
+			return fmt.Errorf("get season %s: %w", id, err)
+		}
+		if season.ArchivedAt.Valid {
+			return ErrSeasonArchived
+		}
+		if err := q.DeleteSeason(ctx, id); err != nil {
+			return err
+		}
+		if err := s.replayReset(ctx, q, season); err != nil {
+			return err
+		}
+		return recordAudit(ctx, q, AuditEntitySeason, id, AuditActionDelete, season, nil)
+	})
+	return season, err
+}
+
+// replayReset replays history from the season's reset when it is already due.
+// The reset moves every player's Elo, not only the replayed players', so all
+// bet limits are recalculated.
+func (s *SeasonService) replayReset(ctx context.Context, q *db.Queries, season db.Season) error {
+	if !season.ResetFactor.Valid {
+		return nil
+	}
+	resetDate := seasonResetDate(season.StartsAt)
+	if !resetDate.Before(time.Now()) {
+		return nil
+	}
+	if err := s.EventProcessor.RecalculateFrom(ctx, q, resetDate, nil); err != nil {
+		return fmt.Errorf("unable to recalculate Elo: %w", err)
+	}
+	players, err := q.ListPlayers(ctx)
+	if err != nil {
+		return fmt.Errorf("list players: %w", err)
+	}
+	playerIDs := make([]string, 0, len(players))
+	for _, p := range players {
+		playerIDs = append(playerIDs, p.ID)
+	}
+	if err := RecalculateBetLimits(ctx, q, playerIDs); err != nil {
+		return fmt.Errorf("recalculate bet limits: %w", err)
+	}
+	return nil
+}
+
+func (s *SeasonService) SeasonLeaderboard(ctx context.Context, id, gameID string) ([]LeaderboardEntry, bool, error) {
+	season, err := s.Queries.GetSeason(ctx, id)
+	if err != nil {
+		return nil, false, fmt.Errorf("get season %s: %w", id, err)
+	}
+	if season.ArchivedAt.Valid {
+		entries, err := archivedSeasonTable(ctx, s.Queries, id, gameID)
+		return entries, true, err
+	}
+	if gameID != "" {
+		if _, err := s.Queries.GetGameByID(ctx, gameID); err != nil {
+			return nil, false, fmt.Errorf("get game %s: %w", gameID, err)
+		}
+	}
+	entries, err := seasonTable(ctx, s.Queries, season, gameID, time.Now())
+	return entries, false, err
+}
+
+// ArchiveSeason freezes the tables of an ended season: the global arena's and
+// one for every game played in it.
+func (s *SeasonService) ArchiveSeason(ctx context.Context, id string) (db.Season, error) {
+	var archived db.Season
+	err := runInTx(ctx, s.Pool, func(q *db.Queries) error {
+		season, err := q.GetSeasonForUpdate(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get season %s: %w", id, err)
+		}
+		if season.ArchivedAt.Valid {
+			return ErrSeasonArchived
+		}
+		now := time.Now()
+		if now.Before(season.EndsAt) {
+			return ErrSeasonNotEnded
+		}
+
+		games, err := q.ListSeasonGames(ctx, db.ListSeasonGamesParams{
+			FromDate: pgtype.Timestamptz{Time: season.StartsAt, Valid: true},
+			ToDate:   pgtype.Timestamptz{Time: season.EndsAt, Valid: true},
+		})
+		if err != nil {
+			return fmt.Errorf("list season games: %w", err)
+		}
+		for _, gameID := range append([]string{""}, games...) {
+			entries, err := seasonTable(ctx, q, season, gameID, now)
+			if err != nil {
+				return err
+			}
+			if err := storeSeasonTable(ctx, q, id, gameID, entries); err != nil {
+				return err
+			}
+		}
+
+		if archived, err = q.ArchiveSeason(ctx, db.ArchiveSeasonParams{
+			ID:         id,
+			ArchivedAt: pgtype.Timestamptz{Time: now, Valid: true},
+		}); err != nil {
+			return fmt.Errorf("archive season %s: %w", id, err)
+		}
+		return recordAudit(ctx, q, AuditEntitySeason, id, AuditActionArchive, season, archived)
+	})
+	return archived, err
+}
+
+// seasonTable ranks the players who played in the season's window, so far
+// when it has not ended, with their rank and rating at its start.
+func seasonTable(ctx context.Context, q *db.Queries, season db.Season, gameID string, now time.Time) ([]LeaderboardEntry, error) {
+	from := pgtype.Timestamptz{Time: season.StartsAt, Valid: true}
+	to := pgtype.Timestamptz{Time: season.EndsAt, Valid: true}
+	var participants []string
+	var err error
+	if gameID == "" {
+		participants, err = q.ListSeasonGlobalParticipants(ctx, db.ListSeasonGlobalParticipantsParams{FromDate: from, ToDate: to})
+	} else {
+		participants, err = q.ListSeasonGameParticipants(ctx, db.ListSeasonGameParticipantsParams{GameID: gameID, FromDate: from, ToDate: to})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("list season participants: %w", err)
+	}
+	members := make(map[string]bool, len(participants))
+	for _, playerID := range participants {
+		members[playerID] = true
+	}
+
+	startAt, endAt := seasonTableDates(season, now)
+	before, err := leaderboard(ctx, q, startAt, gameID, members)
+	if err != nil {
+		return nil, err
+	}
+	after, err := leaderboard(ctx, q, endAt, gameID, members)
+	if err != nil {
+		return nil, err
+	}
+	return diffLeaderboards(before, after), nil
+}
+
+func storeSeasonTable(ctx context.Context, q *db.Queries, seasonID, gameID string, entries []LeaderboardEntry) error {
+	var game *string
+	if gameID != "" {
+		game = &gameID
+	}
+	for _, e := range entries {
+		arg := db.InsertSeasonStandingParams{
+			SeasonID: seasonID,
+			GameID:   game,
+			PlayerID: e.PlayerID,
+			Rank:     int32(e.Rank),
+			Rating:   e.Rating,
+			League:   e.League,
+		}
+		if e.PreviousRank != nil {
+			arg.StartRank = pgtype.Int4{Int32: int32(*e.PreviousRank), Valid: true}
+			arg.StartRating = pgtype.Float8{Float64: *e.PreviousRating, Valid: true}
+		}
+		if err := q.InsertSeasonStanding(ctx, arg); err != nil {
+			return fmt.Errorf("store standing of player %s: %w", e.PlayerID, err)
+		}
+	}
+	return nil
+}
+
+func archivedSeasonTable(ctx context.Context, q *db.Queries, seasonID, gameID string) ([]LeaderboardEntry, error) {
+	var game *string
+	if gameID != "" {
+		game = &gameID
+	}
+	rows, err := q.ListSeasonStandings(ctx, db.ListSeasonStandingsParams{SeasonID: seasonID, GameID: game})
+	if err != nil {
+		return nil, fmt.Errorf("list season standings: %w", err)
+	}
+	entries := make([]LeaderboardEntry, 0, len(rows))
+	for _, r := range rows {
+		e := LeaderboardEntry{
+			PlayerID: r.PlayerID,
+			Name:     r.Name,
+			Rank:     int(r.Rank),
+			Rating:   r.Rating,
+			League:   r.League,
+		}
+		if r.StartRank.Valid {
+			rank, rating := int(r.StartRank.Int32), r.StartRating.Float64
+			e.PreviousRank, e.PreviousRating = &rank, &rating
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// applyTimedSettlements writes the derived global rows due strictly before
+// until that no event of their own triggers: season resets and inactivity
+// decay drops, in date order.
+func applyTimedSettlements(ctx context.Context, q *db.Queries, until time.Time) error {
+	seasons, err := q.ListPendingSeasonResets(ctx, until)
+	if err != nil {
+		return fmt.Errorf("list pending season resets: %w", err)
+	}
+	for _, season := range seasons {
+		if err := applyInactivityDecay(ctx, q, seasonResetDate(season.StartsAt)); err != nil {
+			return err
+		}
+		if err := applySeasonReset(ctx, q, season.StartsAt, season.ResetFactor); err != nil {
+			return err
+		}
+	}
+	return applyInactivityDecay(ctx, q, until)
+}
+
+// applySeasonReset writes the reset of the season starting at startsAt for
+// every player settled before it, with the settings in force then. The
+// league is carried over.
+func applySeasonReset(ctx context.Context, q *db.Queries, startsAt time.Time, factor float64) error {
+	resetDate := pgtype.Timestamptz{Time: seasonResetDate(startsAt), Valid: true}
+	settingsRow, err := q.GetEloSettingsForDate(ctx, resetDate)
+	if err != nil {
+		return fmt.Errorf("get elo settings: %w", err)
+	}
+	settings := EloSettingsFromDB(settingsRow)
+
+	state, err := loadSettlementState(ctx, q, startsAt)
+	if err != nil {
+		return err
+	}
+	playerIDs := make([]string, 0, len(state.global))
+	for playerID := range state.global {
+		playerIDs = append(playerIDs, playerID)
+	}
+	sort.Strings(playerIDs)
+
+	for _, playerID := range playerIDs {
+		st := state.global[playerID]
+		rating := seasonReset(st.rating, settings.StartingRatingGlobal, factor)
+		elo := seasonReset(st.elo, settings.StartingElo, factor)
+		if err := q.InsertGlobalArenaSeasonResetSettlement(ctx, db.InsertGlobalArenaSeasonResetSettlementParams{
+			ID:           newSettlementID(),
+			PlayerID:     playerID,
+			Date:         resetDate,
+			RatingAfter:  rating,
+			EloAfter:     elo,
+			EloEarned:    elo - st.elo,
+			RatingEarned: rating - st.rating,
+			League:       st.league,
+		}); err != nil {
+			return fmt.Errorf("insert season reset for player %s: %w", playerID, err)
+		}
+	}
+	if err := RecalculateBetLimits(ctx, q, playerIDs); err != nil {
+		return fmt.Errorf("recalculate bet limits: %w", err)
+	}
+	return nil
+}
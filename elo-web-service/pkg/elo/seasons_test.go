@@ -0,0 +1,41 @@
+package elo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+func TestSeasonResetKeepsShareOfDistance(t *testing.T) {
+	if got := seasonReset(1200, 1000, 0.25); !floatsEqual(got, 1050) {
+		t.Errorf("reset from above = %v, want 1050", got)
+	}
+	if got := seasonReset(900, 1000, 0.5); !floatsEqual(got, 950) {
+		t.Errorf("reset from below = %v, want 950", got)
+	}
+	if got := seasonReset(1300, 1000, 0); !floatsEqual(got, 1000) {
+		t.Errorf("hard reset = %v, want the starting value", got)
+	}
+}
+
+func TestSeasonTableDatesSkipNextSeasonReset(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	season := db.Season{StartsAt: start, EndsAt: end}
+
+	from, to := seasonTableDates(season, end.Add(time.Hour))
+	if !from.Equal(seasonResetDate(start)) {
+		t.Errorf("start table date = %v, want the season's own reset date", from)
+	}
+	// A season starting at end resets at seasonResetDate(end); the final
+	// table must not include it.
+	if !to.Before(seasonResetDate(end)) || !to.After(start) {
+		t.Errorf("final table date = %v, want just before %v", to, seasonResetDate(end))
+	}
+
+	now := start.Add(24 * time.Hour)
+	if _, to := seasonTableDates(season, now); !to.Equal(now) {
+		t.Errorf("table date of a season in progress = %v, want now", to)
+	}
+}
@@ -0,0 +1,255 @@
+package elo
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tolyandre/elo-web-service/pkg/db"
+)
+
+// SettingsSimulation is the outcome of replaying the whole history under
+// proposed Elo settings, next to today's state.
+type SettingsSimulation struct {
+	Leaderboard []SimulatedPlayer
+	// Current and Proposed measure how well WinExpectation predicted each
+	// replayed match under today's settings and under the proposed ones.
+	Current  PredictionMetrics
+	Proposed PredictionMetrics
+}
+
+// SimulatedPlayer is a leaderboard row under the proposed settings with the
+// player's current standing for comparison.
+type SimulatedPlayer struct {
+	PlayerID      string
+	Name          string
+	Rank          *int
+	Rating        float64
+	League        string
+	CurrentRank   *int
+	CurrentRating float64
+	CurrentLeague string
+}
+
+// PredictionMetrics aggregates the pre-match expectations of competitive
+// matches (cooperative matches have nothing to rank and are skipped).
+type PredictionMetrics struct {
+	Matches int
+	// Pairs counts pairs of sides that finished with different results;
+	// PairwiseAccuracy is the share of them the higher-rated side won
+	// (equal ratings count as half a correct prediction).
+	Pairs            int
+	PairwiseAccuracy float64
+	// MeanSquaredError compares each side's WinExpectation with its
+	// NormalizedScore, both of which sum to 1 over a match.
+	MeanSquaredError float64
+
+	correct      float64
+	squaredError float64
+	sides        int
+}
+
+// observe records the expectations for one match from its prior state.
+func (m *PredictionMetrics) observe(playerScores map[string]float64, state MatchPrevState) {
+	if state.Coop != nil {
+		return
+	}
+	s := state.Settings
+	sides := newMatchSides(state.Scoring.RankingScores(playerScores), state.Teams)
+	scores := sides.scores
+	if len(scores) < 2 {
+		return
+	}
+	elo := sides.aggregate(state.Elo, s.StartingElo)
+	absoluteLoserScore := GetAbsoluteLoserScore(scores)
+
+	keys := make([]string, 0, len(scores))
+	for key := range scores {
+		keys = append(keys, key)
+	}
+	sortPlayerIDs(keys)
+
+	m.Matches++
+	for i, a := range keys {
+		expected := WinExpectation(elo[a], scores, s.StartingElo, elo, s.D)
+		actual := NormalizedScore(scores[a], scores, absoluteLoserScore, s.WinReward)
+		m.squaredError += (expected - actual) * (expected - actual)
+		m.sides++
+
+		for _, b := range keys[i+1:] {
+			if scores[a] == scores[b] {
+				continue
+			}
+			m.Pairs++
+			switch {
+			case elo[a] == elo[b]:
+				m.correct += 0.5
+			case (elo[a] > elo[b]) == (scores[a] > scores[b]):
+				m.correct++
+			}
+		}
+	}
+	m.finish()
+}
+
+func (m *PredictionMetrics) finish() {
+	if m.Pairs > 0 {
+		m.PairwiseAccuracy = m.correct / float64(m.Pairs)
+	}
+	if m.sides > 0 {
+		m.MeanSquaredError = m.squaredError / float64(m.sides)
+	}
+}
+
+// SimulateEloSettings replays the whole history twice in a transaction that is
+// always rolled back: under today's settings to measure them, then with every
+// elo_settings row replaced by proposed. Players are locked for the duration,
+// so concurrent writes wait for the simulation.
+func (s *MatchService) SimulateEloSettings(ctx context.Context, proposed EloSettings) (SettingsSimulation, error) {
+	if err := validateEloSettings(proposed); err != nil {
+		return SettingsSimulation{}, err
+	}
+
+	tx, err := s.Pool.Begin(ctx)
+	if err != nil {
+		return SettingsSimulation{}, fmt.Errorf("unable to begin tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	q := s.Queries.WithTx(tx)
+	now := time.Now()
+
+	current, err := playersWithRank(ctx, q, now)
+	if err != nil {
+		return SettingsSimulation{}, err
+	}
+
+	var result SettingsSimulation
+	if err := s.replayObserving(ctx, q, &result.Current); err != nil {
+		return SettingsSimulation{}, err
+	}
+
+	if err := q.DeleteAllEloSettings(ctx); err != nil {
+		return SettingsSimulation{}, fmt.Errorf("delete elo settings: %w", err)
+	}
+	if err := q.CreateEloSettings(ctx, eloSettingsParams(
+		pgtype.Timestamptz{InfinityModifier: pgtype.NegativeInfinity, Valid: true}, proposed,
+	)); err != nil {
+		return SettingsSimulation{}, fmt.Errorf("create proposed elo settings: %w", err)
+	}
+	if err := s.replayObserving(ctx, q, &result.Proposed); err != nil {
+		return SettingsSimulation{}, err
+	}
+
+	simulated, err := playersWithRank(ctx, q, now)
+	if err != nil {
+		return SettingsSimulation{}, err
+	}
+	result.Leaderboard = compareLeaderboards(current, simulated)
+	return result, nil
+}
+
+// replayObserving recalculates all history, recording every match's
+// expectations into metrics.
+func (s *MatchService) replayObserving(ctx context.Context, q *db.Queries, metrics *PredictionMetrics) error {
+	if err := s.EventProcessor.RecalculateFrom(ctx, q, time.Time{}, metrics.observe); err != nil {
+		return fmt.Errorf("replay history: %w", err)
+	}
+	return nil
+}
+
+// compareLeaderboards lists the simulated leaderboard in its order with each
+// player's current rank, rating and league.
+func compareLeaderboards(current, simulated []Player) []SimulatedPlayer {
+	byID := make(map[string]Player, len(current))
+	for _, p := range current {
+		byID[p.ID] = p
+	}
+	out := make([]SimulatedPlayer, 0, len(simulated))
+	for _, p := range simulated {
+		c := byID[p.ID]
+		out = append(out, SimulatedPlayer{
+			PlayerID:      p.ID,
+			Name:          p.Name,
+			Rank:          p.Rank,
+			Rating:        p.Elo,
+			League:        p.League,
+			CurrentRank:   c.Rank,
+			CurrentRating: c.Elo,
+			CurrentLeague: c.League,
+		})
+	}
+	return out
+}
+
+// eloSettingsParams converts settings into an elo_settings row effective at date.
+func eloSettingsParams(date pgtype.Timestamptz, s EloSettings) db.CreateEloSettingsParams {
+	return db.CreateEloSettingsParams{
+		EffectiveDate:             date,
+		EloConstK:                 s.K,
+		EloConstD:                 s.D,
+		StartingElo:               s.StartingElo,
+		WinReward:                 s.WinReward,
+		NewbieLeagueEarnedMin:     s.NewbieLeagueEarnedMin,
+		NewbieLeagueEarnedMax:     s.NewbieLeagueEarnedMax,
+		NewbieLeagueEarnedTau:     s.NewbieLeagueEarnedTau,
+		NewbieLeagueGoalGap:       s.NewbieLeagueGoalGap,
+		StartingRatingGlobalArena: s.StartingRatingGlobal,
+		StartingRatingGameArena:   s.StartingRatingGame,
+		EliteLeagueMatches6months: int32(s.EliteMatches6M),
+		EliteLeagueMatches2months: int32(s.EliteMatches2M),
+		GlobalArenaAlgorithm:      s.GlobalAlgorithm,
+		GameArenaAlgorithm:        s.GameAlgorithm,
+		Glicko2StartingDeviation:  s.Glicko2StartingDeviation,
+		Glicko2StartingVolatility: s.Glicko2StartingVolatility,
+		Glicko2Tau:                s.Glicko2Tau,
+		TrueskillStartingSigma:    s.TrueSkillStartingSigma,
+		TrueskillBeta:             s.TrueSkillBeta,
+		TrueskillTau:              s.TrueSkillTau,
+		TrueskillDrawProbability:  s.TrueSkillDrawProbability,
+		InactivityDecayDays:       int32(s.InactivityDecayDays),
+		InactivityDecayRate:       s.InactivityDecayRate,
+	}
+}
+
+// validateEloSettings checks settings that will drive a replay.
+func validateEloSettings(s EloSettings) error {
+	switch {
+	case s.K <= 0 || math.IsNaN(s.K):
+		return fmt.Errorf("%w: elo_const_k must be positive", ErrInvalidEloSettings)
+	case s.D <= 0 || math.IsNaN(s.D):
+		return fmt.Errorf("%w: elo_const_d must be positive", ErrInvalidEloSettings)
+	case s.WinReward < 0.1 || s.WinReward > 5:
+		return fmt.Errorf("%w: win_reward must be between 0.1 and 5", ErrInvalidEloSettings)
+	case s.NewbieLeagueEarnedTau <= 0:
+		return fmt.Errorf("%w: newbie_league_earned_tau must be positive", ErrInvalidEloSettings)
+	case s.InactivityDecayDays < 0:
+		return fmt.Errorf("%w: inactivity_decay_days must not be negative", ErrInvalidEloSettings)
+	case s.InactivityDecayRate < 0 || s.InactivityDecayRate > 1:
+		return fmt.Errorf("%w: inactivity_decay_rate must be between 0 and 1", ErrInvalidEloSettings)
+	case s.Glicko2StartingDeviation <= 0 || math.IsNaN(s.Glicko2StartingDeviation):
+		return fmt.Errorf("%w: glicko2_starting_deviation must be positive", ErrInvalidEloSettings)
+	case s.Glicko2StartingVolatility <= 0 || math.IsNaN(s.Glicko2StartingVolatility):
+		return fmt.Errorf("%w: glicko2_starting_volatility must be positive", ErrInvalidEloSettings)
+	case s.Glicko2Tau <= 0 || math.IsNaN(s.Glicko2Tau):
+		return fmt.Errorf("%w: glicko2_tau must be positive", ErrInvalidEloSettings)
+	case s.TrueSkillStartingSigma <= 0 || math.IsNaN(s.TrueSkillStartingSigma):
+		return fmt.Errorf("%w: trueskill_starting_sigma must be positive", ErrInvalidEloSettings)
+	case s.TrueSkillBeta <= 0 || math.IsNaN(s.TrueSkillBeta):
+		return fmt.Errorf("%w: trueskill_beta must be positive", ErrInvalidEloSettings)
+	case s.TrueSkillTau < 0 || math.IsNaN(s.TrueSkillTau):
+		return fmt.Errorf("%w: trueskill_tau must not be negative", ErrInvalidEloSettings)
+	case s.TrueSkillDrawProbability < 0 || s.TrueSkillDrawProbability >= 1 || math.IsNaN(s.TrueSkillDrawProbability):
+		return fmt.Errorf("%w: trueskill_draw_probability must be at least 0 and below 1", ErrInvalidEloSettings)
+	}
+	for _, name := range []string{s.GlobalAlgorithm, s.GameAlgorithm} {
+		if err := ValidateRatingAlgorithm(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
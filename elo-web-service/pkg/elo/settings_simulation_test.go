@@ -0,0 +1,75 @@
+package elo
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestPredictionMetricsObserve(t *testing.T) {
+	var m PredictionMetrics
+
+	// The favourite wins: one correct pair.
+	m.observe(map[string]float64{"a": 10, "b": 5}, prevStateFor(map[string]float64{"a": 1100, "b": 1000}, nil))
+	// The underdog b wins; its pair with the equally rated c is worth half.
+	m.observe(map[string]float64{"a": 3, "b": 7, "c": 3}, prevStateFor(map[string]float64{"a": 1100, "b": 1000, "c": 1000}, nil))
+	// Equal ratings again.
+	m.observe(map[string]float64{"a": 1, "b": 0}, prevStateFor(map[string]float64{"a": 1000, "b": 1000}, nil))
+	// Cooperative matches are not ranked.
+	coop := prevStateFor(map[string]float64{"a": 1000}, nil)
+	coop.Coop = &CoopPrevState{}
+	m.observe(map[string]float64{"a": 1}, coop)
+
+	if m.Matches != 3 || m.Pairs != 4 {
+		t.Fatalf("matches/pairs: got %d/%d, want 3/4", m.Matches, m.Pairs)
+	}
+	if !floatsEqual(m.PairwiseAccuracy, 2.0/4) {
+		t.Errorf("pairwise accuracy: got %v, want 0.5", m.PairwiseAccuracy)
+	}
+	if m.MeanSquaredError <= 0 || m.MeanSquaredError >= 1 {
+		t.Errorf("mean squared error out of range: %v", m.MeanSquaredError)
+	}
+}
+
+func TestPredictionMetricsPerfectEvenMatch(t *testing.T) {
+	// A draw between equal players is exactly what WinExpectation predicts.
+	var m PredictionMetrics
+	m.observe(map[string]float64{"a": 5, "b": 5}, prevStateFor(map[string]float64{"a": 1000, "b": 1000}, nil))
+	if m.Pairs != 0 || !floatsEqual(m.MeanSquaredError, 0) {
+		t.Errorf("got pairs=%d mse=%v, want 0 and 0", m.Pairs, m.MeanSquaredError)
+	}
+}
+
+func TestValidateEloSettingsAlgorithmParameters(t *testing.T) {
+	valid := EloSettings{
+		K: 32, D: 400, WinReward: 1, NewbieLeagueEarnedTau: 10,
+		GlobalAlgorithm: AlgorithmElo, GameAlgorithm: AlgorithmElo,
+		Glicko2StartingDeviation: 350, Glicko2StartingVolatility: 0.06, Glicko2Tau: 0.5,
+		TrueSkillStartingSigma: 400, TrueSkillBeta: 200, TrueSkillTau: 4, TrueSkillDrawProbability: 0.1,
+	}
+	if err := validateEloSettings(valid); err != nil {
+		t.Fatalf("valid settings: %v", err)
+	}
+	cases := []struct {
+		name string
+		edit func(*EloSettings)
+	}{
+		{"zero glicko2 deviation", func(s *EloSettings) { s.Glicko2StartingDeviation = 0 }},
+		{"negative glicko2 volatility", func(s *EloSettings) { s.Glicko2StartingVolatility = -0.06 }},
+		{"NaN glicko2 tau", func(s *EloSettings) { s.Glicko2Tau = math.NaN() }},
+		{"zero trueskill sigma", func(s *EloSettings) { s.TrueSkillStartingSigma = 0 }},
+		{"zero trueskill beta", func(s *EloSettings) { s.TrueSkillBeta = 0 }},
+		{"negative trueskill tau", func(s *EloSettings) { s.TrueSkillTau = -1 }},
+		{"certain draw", func(s *EloSettings) { s.TrueSkillDrawProbability = 1 }},
+		{"negative draw probability", func(s *EloSettings) { s.TrueSkillDrawProbability = -0.1 }},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := valid
+			tc.edit(&s)
+			if err := validateEloSettings(s); !errors.Is(err, ErrInvalidEloSettings) {
+				t.Errorf("got %v, want ErrInvalidEloSettings", err)
+			}
+		})
+	}
+}
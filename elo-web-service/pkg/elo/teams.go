@@ -0,0 +1,202 @@
+package elo
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// Team matches are settled side-vs-side: each team is one opponent whose Elo is
+// the mean of its members' Elo, and every member moves by the team's delta.
+// An individual match is the degenerate case where every player is a side of
+// one, so buildEloResults uses the same code path for both.
+
+// teamSidePrefix keeps team side keys apart from player ids (UUIDs).
+const teamSidePrefix = "team:"
+
+// validateMatchPlayers checks the player set of a new or edited match: a
+// cooperative match needs at least one player and has no teams; a competitive
+// one needs at least two players and a valid team assignment, if any.
+func validateMatchPlayers(playerScores map[string]float64, teams map[string]string, coop *Cooperative) error {
+	if coop != nil {
+		if len(playerScores) < 1 {
+			return ErrTooFewPlayers
+		}
+		if len(teams) > 0 {
+			return fmt.Errorf("%w: в кооперативной партии все игроки — одна команда", ErrInvalidTeams)
+		}
+		return nil
+	}
+	if len(playerScores) < 2 {
+		return ErrTooFewPlayers
+	}
+	return validateTeams(playerScores, teams)
+}
+
+// validateTeams checks a player→team assignment against the match scores.
+// An empty assignment means an individual match. Otherwise every player must be
+// on exactly one team, there must be at least two teams, and teammates must
+// share the same score (the team's result).
+func validateTeams(playerScores map[string]float64, teams map[string]string) error {
+	if len(teams) == 0 {
+		return nil
+	}
+	for playerID := range teams {
+		if _, ok := playerScores[playerID]; !ok {
+			return fmt.Errorf("%w: игрок %s не участвует в партии", ErrInvalidTeams, playerID)
+		}
+	}
+	teamScores := make(map[string][]float64)
+	for playerID, score := range playerScores {
+		team := teams[playerID]
+		if team == "" {
+			return fmt.Errorf("%w: игрок %s не назначен в команду", ErrInvalidTeams, playerID)
+		}
+		teamScores[team] = append(teamScores[team], score)
+	}
+	if len(teamScores) < 2 {
+		return fmt.Errorf("%w: нужно минимум 2 команды", ErrInvalidTeams)
+	}
+	for team, scores := range teamScores {
+		for _, score := range scores[1:] {
+			if score != scores[0] {
+				return fmt.Errorf("%w: команда %s", ErrTeamScoreMismatch, team)
+			}
+		}
+	}
+	return nil
+}
+
+// matchSides groups the players of one match into competing sides.
+type matchSides struct {
+	sideOf  map[string]string   // player id → side key
+	members map[string][]string // side key → player ids (sorted)
+	scores  map[string]float64  // side key → side score
+	fixed   map[string]Skill    // side key → skill of a member-less side (virtual opponent)
+}
+
+// newMatchSides builds the sides of a match. Players without a team (or every
+// player, when teams is empty) form a side of their own keyed by player id.
+// A team's score is the mean of its members' scores; validateTeams guarantees
+// they are equal for stored matches.
+func newMatchSides(playerScores map[string]float64, teams map[string]string) matchSides {
+	sides := matchSides{
+		sideOf:  make(map[string]string, len(playerScores)),
+		members: make(map[string][]string),
+		scores:  make(map[string]float64),
+	}
+	for playerID, score := range playerScores {
+		key := playerID
+		if team := teams[playerID]; team != "" {
+			key = teamSidePrefix + team
+		}
+		sides.sideOf[playerID] = key
+		sides.members[key] = append(sides.members[key], playerID)
+		sides.scores[key] += score
+	}
+	for key, members := range sides.members {
+		slices.Sort(members)
+		sides.scores[key] /= float64(len(members))
+	}
+	return sides
+}
+
+// winners returns the members of the side with the highest score, a whole
+// team when a team finished first. ok is false when sides share the top score.
+func (s matchSides) winners() ([]string, bool) {
+	winner, sole := "", false
+	best := math.Inf(-1)
+	for key, score := range s.scores {
+		switch {
+		case score > best:
+			best, winner, sole = score, key, true
+		case score == best:
+			sole = false
+		}
+	}
+	if !sole {
+		return nil, false
+	}
+	return s.members[winner], true
+}
+
+// aggregate returns the mean of values over each side's members. Members
+// missing from values count as fallback (starting Elo for unseen players).
+// Member-less sides take their fixed rating.
+func (s matchSides) aggregate(values map[string]float64, fallback float64) map[string]float64 {
+	out := make(map[string]float64, len(s.members)+len(s.fixed))
+	for key, sk := range s.fixed {
+		out[key] = sk.Rating
+	}
+	for key, members := range s.members {
+		sum := 0.0
+		for _, playerID := range members {
+			if v, ok := values[playerID]; ok {
+				sum += v
+			} else {
+				sum += fallback
+			}
+		}
+		out[key] = sum / float64(len(members))
+	}
+	return out
+}
+
+// memberValue shifts a member's own value by its side's change. For a side of
+// one the new side value is returned as-is, so individual matches reproduce
+// CalculateNewElo exactly.
+func (s matchSides) memberValue(playerID string, prevMember, prevSide, newSide float64) float64 {
+	if len(s.members[s.sideOf[playerID]]) == 1 {
+		return newSide
+	}
+	return prevMember + (newSide - prevSide)
+}
+
+// playerSkill reads one player's rating-algorithm state; missing values start
+// from start.
+func playerSkill(playerID string, ratings, deviations, volatilities map[string]float64, start Skill) Skill {
+	sk := Skill{Rating: start.Rating, Deviation: deviations[playerID], Volatility: volatilities[playerID]}
+	if r, ok := ratings[playerID]; ok {
+		sk.Rating = r
+	}
+	return withStartingUncertainty(sk, start)
+}
+
+// skills returns each side's skill for a rating algorithm: the mean rating and
+// volatility of its members and the root mean square of their deviations (the
+// mean variance). Member-less sides take their fixed skill.
+func (s matchSides) skills(ratings, deviations, volatilities map[string]float64, start Skill) map[string]Skill {
+	out := make(map[string]Skill, len(s.members)+len(s.fixed))
+	for key, sk := range s.fixed {
+		out[key] = withStartingUncertainty(sk, start)
+	}
+	for key, members := range s.members {
+		var side Skill
+		for _, playerID := range members {
+			sk := playerSkill(playerID, ratings, deviations, volatilities, start)
+			side.Rating += sk.Rating
+			side.Deviation += sk.Deviation * sk.Deviation
+			side.Volatility += sk.Volatility
+		}
+		n := float64(len(members))
+		out[key] = Skill{Rating: side.Rating / n, Deviation: math.Sqrt(side.Deviation / n), Volatility: side.Volatility / n}
+	}
+	return out
+}
+
+// memberSkill maps a side's new skill back to one member: the rating shifts by
+// the side's change (memberValue), the deviation scales by it and the
+// volatility shifts by it. Uncertainty the algorithm does not track stays zero.
+func (s matchSides) memberSkill(playerID string, prevMember, prevSide, newSide Skill) Skill {
+	if len(s.members[s.sideOf[playerID]]) == 1 {
+		return newSide
+	}
+	out := Skill{Rating: s.memberValue(playerID, prevMember.Rating, prevSide.Rating, newSide.Rating)}
+	if newSide.Deviation > 0 && prevSide.Deviation > 0 {
+		out.Deviation = prevMember.Deviation * newSide.Deviation / prevSide.Deviation
+	}
+	if newSide.Volatility > 0 {
+		out.Volatility = math.Max(prevMember.Volatility+(newSide.Volatility-prevSide.Volatility), 0)
+	}
+	return out
+}
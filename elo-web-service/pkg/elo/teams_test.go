@@ -0,0 +1,135 @@
+package elo
+
+import (
+	"errors"
+	"testing"
+)
+
+// testSettings are neutral league settings: rating == elo keeps every player
+// out of the newbie scaling, so rating deltas equal elo deltas.
+var testSettings = EloSettings{
+	K:                     testK,
+	D:                     testD,
+	StartingElo:           testStartingElo,
+	WinReward:             testWinReward,
+	NewbieLeagueEarnedMin: 0,
+	NewbieLeagueEarnedMax: testK,
+	NewbieLeagueEarnedTau: 100,
+	NewbieLeagueGoalGap:   50,
+	StartingRatingGlobal:  testStartingElo,
+	StartingRatingGame:    testStartingElo,
+	EliteMatches6M:        1000,
+	EliteMatches2M:        1000,
+}
+
+// prevStateFor builds a MatchPrevState where rating == elo for every player.
+func prevStateFor(elos map[string]float64, teams map[string]string) MatchPrevState {
+	state := MatchPrevState{
+		Elo:        map[string]float64{},
+		GameElo:    map[string]float64{},
+		Rating:     map[string]float64{},
+		GameRating: map[string]float64{},
+		League:     map[string]string{},
+		GameLeague: map[string]string{},
+		Count6M:    map[string]int{},
+		Count2M:    map[string]int{},
+		Teams:      teams,
+		Settings:   testSettings,
+	}
+	for id, e := range elos {
+		state.Elo[id] = e
+		state.GameElo[id] = e
+		state.Rating[id] = e
+		state.GameRating[id] = e
+		state.League[id] = "amateur"
+		state.GameLeague[id] = "amateur"
+	}
+	return state
+}
+
+func TestValidateTeams(t *testing.T) {
+	scores := map[string]float64{"a": 1, "b": 1, "c": 0, "d": 0}
+	cases := []struct {
+		name  string
+		teams map[string]string
+		want  error
+	}{
+		{"individual match", nil, nil},
+		{"two teams", map[string]string{"a": "A", "b": "A", "c": "B", "d": "B"}, nil},
+		{"team of one is allowed", map[string]string{"a": "A", "b": "A", "c": "B", "d": "C"}, nil},
+		{"unassigned player", map[string]string{"a": "A", "b": "A", "c": "B"}, ErrInvalidTeams},
+		{"unknown player", map[string]string{"a": "A", "b": "A", "c": "B", "d": "B", "x": "B"}, ErrInvalidTeams},
+		{"single team", map[string]string{"a": "A", "b": "A", "c": "A", "d": "A"}, ErrInvalidTeams},
+		{"teammates disagree on score", map[string]string{"a": "A", "b": "B", "c": "A", "d": "B"}, ErrTeamScoreMismatch},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateTeams(scores, tc.teams)
+			if tc.want == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.want != nil && !errors.Is(err, tc.want) {
+				t.Fatalf("got %v, want %v", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestBuildEloResultsIndividualMatchesCalculateNewElo(t *testing.T) {
+	elos := map[string]float64{"a": 1100, "b": 1000, "c": 900}
+	scores := map[string]float64{"a": 3, "b": 10, "c": 7}
+
+	results := buildEloResults(scores, prevStateFor(elos, nil))
+	want := CalculateNewElo(elos, testStartingElo, scores, testK, testD, testWinReward)
+	for id := range scores {
+		if results[id].newGlobalElo != want[id] {
+			t.Errorf("player %s: global elo %v, want %v", id, results[id].newGlobalElo, want[id])
+		}
+		if results[id].newGameElo != want[id] {
+			t.Errorf("player %s: game elo %v, want %v", id, results[id].newGameElo, want[id])
+		}
+	}
+}
+
+func TestBuildEloResultsTeamMembersShareTeamDelta(t *testing.T) {
+	// Team A (mean 1000) beats team B (mean 1000): equal teams, so each member
+	// of A gains K*(1 - 0.5) and each member of B loses the same amount.
+	elos := map[string]float64{"a1": 1100, "a2": 900, "b1": 1000, "b2": 1000}
+	scores := map[string]float64{"a1": 1, "a2": 1, "b1": 0, "b2": 0}
+	teams := map[string]string{"a1": "A", "a2": "A", "b1": "B", "b2": "B"}
+
+	results := buildEloResults(scores, prevStateFor(elos, teams))
+
+	wantDelta := testK * 0.5
+	for id, prev := range elos {
+		r := results[id]
+		delta := r.newGlobalElo - prev
+		want := wantDelta
+		if teams[id] == "B" {
+			want = -wantDelta
+		}
+		if !floatsEqual(delta, want) {
+			t.Errorf("player %s: global delta %v, want %v", id, delta, want)
+		}
+		if !floatsEqual(r.eloStaked+r.eloEarned, want) {
+			t.Errorf("player %s: staked+earned %v, want %v", id, r.eloStaked+r.eloEarned, want)
+		}
+		if !floatsEqual(r.newGameElo-prev, want) {
+			t.Errorf("player %s: game delta %v, want %v", id, r.newGameElo-prev, want)
+		}
+	}
+}
+
+func TestBuildEloResultsTeamExpectationUsesMeanElo(t *testing.T) {
+	// A strong team winning gains less than an even team would.
+	scores := map[string]float64{"a1": 1, "a2": 1, "b1": 0, "b2": 0}
+	teams := map[string]string{"a1": "A", "a2": "A", "b1": "B", "b2": "B"}
+
+	even := buildEloResults(scores, prevStateFor(map[string]float64{"a1": 1000, "a2": 1000, "b1": 1000, "b2": 1000}, teams))
+	strong := buildEloResults(scores, prevStateFor(map[string]float64{"a1": 1200, "a2": 1200, "b1": 1000, "b2": 1000}, teams))
+
+	if strong["a1"].newGlobalElo-1200 >= even["a1"].newGlobalElo-1000 {
+		t.Errorf("favoured team gained %v, even team %v; favourite should gain less",
+			strong["a1"].newGlobalElo-1200, even["a1"].newGlobalElo-1000)
+	}
+}
@@ -18,7 +18,8 @@ type ITournamentService interface {
 	// transaction. It rejects narrowing the dates past already-played matches and
 	// removing a member who has played a match in the tournament.
 	UpdateTournament(ctx context.Context, id string, name string, start, end time.Time, playerIDs []string) (db.Tournament, error)
-	// DeleteTournament removes a tournament only when it has no members.
+	// DeleteTournament removes a tournament only when it has no members and
+	// no arena filters by it.
 	DeleteTournament(ctx context.Context, id string) (db.Tournament, error)
 	GetStats(ctx context.Context, id string) ([]db.GetTournamentStatsRow, error)
 }
@@ -66,6 +67,9 @@ func (s *TournamentService) CreateTournament(ctx context.Context, id string, nam
 			return db.Tournament{}, fmt.Errorf("add member %s: %w", pid, err)
 		}
 	}
+	if err := recordTournamentAudit(ctx, q, created.ID, AuditActionCreate, nil); err != nil {
+		return db.Tournament{}, err
+	}
 	if err := tx.Commit(ctx); err != nil {
 		return db.Tournament{}, fmt.Errorf("commit tx: %w", err)
 	}
@@ -91,6 +95,11 @@ func (s *TournamentService) UpdateTournament(ctx context.Context, id string, nam
 		}
 	}
 
+	before, err := tournamentAuditSnapshot(ctx, q, id)
+	if err != nil {
+		return db.Tournament{}, err
+	}
+
 	// A removed member must not have played any match in the tournament.
 	current, err := q.GetTournament(ctx, id)
 	if err != nil {
@@ -143,6 +152,9 @@ func (s *TournamentService) UpdateTournament(ctx context.Context, id string, nam
 			}
 		}
 	}
+	if err := recordTournamentAudit(ctx, q, id, AuditActionUpdate, before); err != nil {
+		return db.Tournament{}, err
+	}
 
 	if err := tx.Commit(ctx); err != nil {
 		return db.Tournament{}, fmt.Errorf("commit tx: %w", err)
@@ -158,5 +170,34 @@ func (s *TournamentService) DeleteTournament(ctx context.Context, id string) (db
 	if count > 0 {
 		return db.Tournament{}, ErrTournamentHasMembers
 	}
-	return s.Queries.DeleteTournament(ctx, id)
+
+	var deleted db.Tournament
+	err = runInTx(ctx, s.Pool, func(q *db.Queries) error {
+		before, err := tournamentAuditSnapshot(ctx, q, id)
+		if err != nil {
+			return err
+		}
+		used, err := q.TournamentHasArenas(ctx, &id)
+		if err != nil {
+			return fmt.Errorf("check arenas of tournament %s: %w", id, err)
+		}
+		if used {
+			return ErrTournamentUsedByArena
+		}
+		if deleted, err = q.DeleteTournament(ctx, id); err != nil {
+			return err
+		}
+		return recordAudit(ctx, q, AuditEntityTournament, id, AuditActionDelete, auditValue(before), nil)
+	})
+	return deleted, err
+}
+
+// recordTournamentAudit records a tournament change, reading the state after
+// it from the transaction.
+func recordTournamentAudit(ctx context.Context, q *db.Queries, id, action string, before *tournamentAuditState) error {
+	after, err := tournamentAuditSnapshot(ctx, q, id)
+	if err != nil {
+		return err
+	}
+	return recordAudit(ctx, q, AuditEntityTournament, id, action, auditValue(before), auditValue(after))
 }
@@ -0,0 +1,230 @@
+package elo
+
+import (
+	"math"
+	"slices"
+)
+
+// TrueSkill message-passing limits for the ranking chain.
+const (
+	trueSkillMaxIterations = 30
+	trueSkillMinDelta      = 1e-6
+)
+
+// trueSkillAlgorithm is TrueSkill (Herbrich, Minka, Graepel 2006) for a
+// free-for-all match: sides are ordered by ranking score, neighbours are linked
+// by a "wins by more than the draw margin" factor — or a draw factor when their
+// scores are equal — and the chain is solved by expectation propagation.
+// Score margins play no part, only the order.
+type trueSkillAlgorithm struct {
+	startingMu      float64
+	startingSigma   float64
+	beta            float64 // performance noise
+	tau             float64 // skill drift added before every match
+	drawProbability float64
+}
+
+func (a trueSkillAlgorithm) Starting() Skill {
+	return Skill{Rating: a.startingMu, Deviation: a.startingSigma}
+}
+
+// Rate runs the update once for the real ranking and once per side with that
+// side moved alone to last place: the latter change is its Staked part.
+func (a trueSkillAlgorithm) Rate(prev map[string]Skill, scores map[string]float64) map[string]SkillChange {
+	after := a.posteriors(prev, scores)
+	lowest := GetAbsoluteLoserScore(scores)
+
+	out := make(map[string]SkillChange, len(scores))
+	for key := range scores {
+		before := withStartingUncertainty(prev[key], a.Starting())
+		lastPlace := make(map[string]float64, len(scores))
+		for k, v := range scores {
+			lastPlace[k] = v
+		}
+		lastPlace[key] = lowest - 1
+		staked := a.posteriors(prev, lastPlace)[key].Rating - before.Rating
+		out[key] = SkillChange{
+			After:  after[key],
+			Staked: staked,
+			Earned: after[key].Rating - before.Rating - staked,
+		}
+	}
+	return out
+}
+
+// posteriors returns every side's skill after the match.
+func (a trueSkillAlgorithm) posteriors(prev map[string]Skill, scores map[string]float64) map[string]Skill {
+	keys := make([]string, 0, len(scores))
+	for key := range scores {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(x, y string) int {
+		if scores[x] != scores[y] {
+			if scores[x] > scores[y] {
+				return -1
+			}
+			return 1
+		}
+		if x < y {
+			return -1
+		}
+		if x > y {
+			return 1
+		}
+		return 0
+	})
+
+	n := len(keys)
+	skillPrior := make([]gaussian, n)
+	perfPrior := make([]gaussian, n)
+	for i, key := range keys {
+		sk := withStartingUncertainty(prev[key], a.Starting())
+		variance := sk.Deviation*sk.Deviation + a.tau*a.tau
+		skillPrior[i] = gaussianOf(sk.Rating, variance)
+		perfPrior[i] = gaussianOf(sk.Rating, variance+a.beta*a.beta)
+	}
+
+	out := make(map[string]Skill, n)
+	if n < 2 {
+		for i, key := range keys {
+			out[key] = Skill{Rating: skillPrior[i].mean(), Deviation: math.Sqrt(skillPrior[i].variance())}
+		}
+		return out
+	}
+
+	// Messages from the difference factor between places k and k+1 to the
+	// performance of place k (toUpper) and of place k+1 (toLower).
+	toUpper := make([]gaussian, n-1)
+	toLower := make([]gaussian, n-1)
+	marginal := func(i int) gaussian {
+		g := perfPrior[i]
+		if i > 0 {
+			g = g.mul(toLower[i-1])
+		}
+		if i < n-1 {
+			g = g.mul(toUpper[i])
+		}
+		return g
+	}
+	drawMargin := normalPPF((a.drawProbability+1)/2) * math.Sqrt2 * a.beta
+
+	update := func(k int) float64 {
+		upper := marginal(k).div(toUpper[k])
+		lower := marginal(k + 1).div(toLower[k])
+		mUpper, vUpper := upper.mean(), upper.variance()
+		mLower, vLower := lower.mean(), lower.variance()
+
+		// Difference d = perf(k) − perf(k+1), truncated by the outcome.
+		mD, vD := mUpper-mLower, vUpper+vLower
+		c := math.Sqrt(vD)
+		var v, w float64
+		if scores[keys[k]] == scores[keys[k+1]] {
+			v, w = trueSkillVWithin(mD/c, drawMargin/c), trueSkillWWithin(mD/c, drawMargin/c)
+		} else {
+			v, w = trueSkillVExceeds(mD/c, drawMargin/c), trueSkillWExceeds(mD/c, drawMargin/c)
+		}
+		toD := gaussianOf(mD+c*v, vD*(1-w)).div(gaussianOf(mD, vD))
+
+		newUpper := gaussianOf(toD.mean()+mLower, toD.variance()+vLower)
+		newLower := gaussianOf(mUpper-toD.mean(), vUpper+toD.variance())
+		change := math.Max(math.Abs(newUpper.pi-toUpper[k].pi), math.Abs(newLower.pi-toLower[k].pi))
+		toUpper[k], toLower[k] = newUpper, newLower
+		return change
+	}
+
+	for range trueSkillMaxIterations {
+		change := 0.0
+		for k := 0; k < n-1; k++ {
+			change = math.Max(change, update(k))
+		}
+		for k := n - 2; k >= 0; k-- {
+			change = math.Max(change, update(k))
+		}
+		if change < trueSkillMinDelta {
+			break
+		}
+	}
+
+	// Back through the performance noise to the skill.
+	for i, key := range keys {
+		perfMsg := marginal(i).div(perfPrior[i])
+		toSkill := gaussianOf(perfMsg.mean(), perfMsg.variance()+a.beta*a.beta)
+		post := skillPrior[i].mul(toSkill)
+		out[key] = Skill{Rating: post.mean(), Deviation: math.Sqrt(post.variance())}
+	}
+	return out
+}
+
+// gaussian is a normal distribution in natural parameters: precision pi and
+// precision-adjusted mean tau. The zero value is the uniform distribution.
+type gaussian struct {
+	pi, tau float64
+}
+
+func gaussianOf(mean, variance float64) gaussian {
+	pi := 1 / variance
+	return gaussian{pi: pi, tau: pi * mean}
+}
+
+func (g gaussian) mean() float64 {
+	if g.pi == 0 {
+		return 0
+	}
+	return g.tau / g.pi
+}
+
+func (g gaussian) variance() float64       { return 1 / g.pi }
+func (g gaussian) mul(o gaussian) gaussian { return gaussian{pi: g.pi + o.pi, tau: g.tau + o.tau} }
+func (g gaussian) div(o gaussian) gaussian { return gaussian{pi: g.pi - o.pi, tau: g.tau - o.tau} }
+
+// trueSkillWMin keeps the variance multiplier (1 − w) inside (0, 1) when the
+// truncation functions underflow for extreme differences.
+const trueSkillWMin = 1e-9
+
+// trueSkillVExceeds / trueSkillWExceeds are the mean and variance corrections
+// of a normal truncated to d > ε (in units of its standard deviation).
+func trueSkillVExceeds(t, epsilon float64) float64 {
+	x := t - epsilon
+	if denom := normalCDF(x); denom > 0 {
+		return normalPDF(x) / denom
+	}
+	return -x
+}
+
+func trueSkillWExceeds(t, epsilon float64) float64 {
+	v := trueSkillVExceeds(t, epsilon)
+	return clampW(v * (v + t - epsilon))
+}
+
+// trueSkillVWithin / trueSkillWWithin are the corrections for a draw, |d| ≤ ε.
+func trueSkillVWithin(t, epsilon float64) float64 {
+	abs := math.Abs(t)
+	a, b := epsilon-abs, -epsilon-abs
+	v := a
+	if denom := normalCDF(a) - normalCDF(b); denom > 0 {
+		v = (normalPDF(b) - normalPDF(a)) / denom
+	}
+	if t < 0 {
+		return -v
+	}
+	return v
+}
+
+func trueSkillWWithin(t, epsilon float64) float64 {
+	abs := math.Abs(t)
+	a, b := epsilon-abs, -epsilon-abs
+	denom := normalCDF(a) - normalCDF(b)
+	if denom <= 0 {
+		return 1 - trueSkillWMin
+	}
+	v := trueSkillVWithin(abs, epsilon)
+	return clampW(v*v + (a*normalPDF(a)-b*normalPDF(b))/denom)
+}
+
+func clampW(w float64) float64 {
+	return math.Min(math.Max(w, trueSkillWMin), 1-trueSkillWMin)
+}
+
+func normalPDF(x float64) float64 { return math.Exp(-x*x/2) / math.Sqrt(2*math.Pi) }
+func normalCDF(x float64) float64 { return math.Erfc(-x/math.Sqrt2) / 2 }
+func normalPPF(p float64) float64 { return -math.Sqrt2 * math.Erfcinv(2*p) }
@@ -28,6 +28,23 @@ type EloSettings struct {
 
 	EliteMatches6M int
 	EliteMatches2M int
+
+	// Rating algorithm of each arena's true-skill track (see rating_algorithm.go).
+	GlobalAlgorithm string
+	GameAlgorithm   string
+
+	Glicko2StartingDeviation  float64
+	Glicko2StartingVolatility float64
+	Glicko2Tau                float64
+
+	TrueSkillStartingSigma   float64
+	TrueSkillBeta            float64
+	TrueSkillTau             float64
+	TrueSkillDrawProbability float64
+
+	// Inactivity decay of the global display rating (see decay.go); 0 days disables it.
+	InactivityDecayDays int
+	InactivityDecayRate float64
 }
 
 // EloSettingsFromDB converts a sqlc-generated row to a domain value object,
@@ -46,6 +63,19 @@ func EloSettingsFromDB(row db.GetEloSettingsForDateRow) EloSettings {
 		StartingRatingGame:    row.StartingRatingGameArena,
 		EliteMatches6M:   int(row.EliteLeagueMatches6months),
 		EliteMatches2M:   int(row.EliteLeagueMatches2months),
+
+		GlobalAlgorithm:           row.GlobalArenaAlgorithm,
+		GameAlgorithm:             row.GameArenaAlgorithm,
+		Glicko2StartingDeviation:  row.Glicko2StartingDeviation,
+		Glicko2StartingVolatility: row.Glicko2StartingVolatility,
+		Glicko2Tau:                row.Glicko2Tau,
+		TrueSkillStartingSigma:    row.TrueskillStartingSigma,
+		TrueSkillBeta:             row.TrueskillBeta,
+		TrueSkillTau:              row.TrueskillTau,
+		TrueSkillDrawProbability:  row.TrueskillDrawProbability,
+
+		InactivityDecayDays: int(row.InactivityDecayDays),
+		InactivityDecayRate: row.InactivityDecayRate,
 	}
 }
 
@@ -62,7 +92,27 @@ type MatchPrevState struct {
 	Count6M map[string]int // matches in last 6 months
 	Count2M map[string]int // matches in last 2 months
 
+	// Teams maps player → team label for a team match; nil for an individual match.
+	Teams map[string]string
+	// Coop is set for a cooperative match (players vs. the game); nil otherwise.
+	Coop *CoopPrevState
+	// Scoring is the game's scoring rules, used to rank the raw scores.
+	Scoring GameScoring
+	// Guests marks the guest players of the match. They are rated from the
+	// starting values and get no settlements.
+	Guests map[string]bool
+
+	// Rating-algorithm uncertainty before this match (Glicko-2 deviation and
+	// volatility, TrueSkill sigma). Players without an entry start from the
+	// algorithm's defaults; Elo ignores them.
+	Deviation      map[string]float64
+	Volatility     map[string]float64
+	GameDeviation  map[string]float64
+	GameVolatility map[string]float64
+
 	Settings EloSettings
+	// GameParams are the game's overrides of Settings at the match date.
+	GameParams GameRatingParams
 }
 
 // EloCalcFunc is the signature for functions that compute and persist Elo for one match.
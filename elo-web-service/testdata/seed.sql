@@ -6,10 +6,11 @@ ON CONFLICT (id) DO NOTHING;
 
 -- User for mock-oauth2 login (sub matches mock-oauth2/main.go handleUserinfo)
 -- Uses a different id from the 035_schema user (116214603310517670471) to avoid PK conflict.
-INSERT INTO users (id, allow_editing, google_oauth_user_id, google_oauth_user_name)
-VALUES ('00000000-0000-0000-0000-000000000002', true, 'dev-user-001', 'Dev User')
+INSERT INTO users (id, allow_editing, is_admin, google_oauth_user_id, google_oauth_user_name)
+VALUES ('00000000-0000-0000-0000-000000000002', true, true, 'dev-user-001', 'Dev User')
 ON CONFLICT (google_oauth_user_id) DO UPDATE
     SET allow_editing = EXCLUDED.allow_editing,
+        is_admin = EXCLUDED.is_admin,
         google_oauth_user_name = EXCLUDED.google_oauth_user_name;
 
 -- Test players